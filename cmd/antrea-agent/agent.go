@@ -82,6 +82,7 @@ import (
 	ofconfig "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
+	antreaquerier "antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/signals"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -482,11 +483,17 @@ func run(o *Options) error {
 	// AntreaPolicy feature is enabled.
 	statusManagerEnabled := antreaPolicyEnabled
 
+	auditLoggingSyslogConfig, err := getAuditLoggingSyslogConfig(o.config.AuditLogging.Syslog, nodeConfig.Name)
+	if err != nil {
+		return fmt.Errorf("error when getting syslog configuration for audit logging: %w", err)
+	}
 	var auditLoggerOptions = &networkpolicy.AuditLoggerOptions{
 		MaxSize:    int(o.config.AuditLogging.MaxSize),
 		MaxBackups: int(*o.config.AuditLogging.MaxBackups),
 		MaxAge:     int(*o.config.AuditLogging.MaxAge),
 		Compress:   *o.config.AuditLogging.Compress,
+		Format:     o.config.AuditLogging.Format,
+		Syslog:     auditLoggingSyslogConfig,
 	}
 
 	var gwPort, tunPort uint32
@@ -532,6 +539,7 @@ func run(o *Options) error {
 		podNetworkWait,
 		l7Reconciler,
 		uint32(o.config.FQDNCacheMinTTL),
+		o.fqdnTrustedDNSServers,
 	)
 	if err != nil {
 		return fmt.Errorf("error creating new NetworkPolicy controller: %v", err)
@@ -712,6 +720,7 @@ func run(o *Options) error {
 			PollInterval:           o.pollInterval,
 			ConnectUplinkToBridge:  connectUplinkToBridge,
 			ProtocolFilter:         o.config.FlowExporter.ProtocolFilter,
+			FlowFilter:             o.flowFilter,
 		}
 		flowExporter, err = flowexporter.NewFlowExporter(
 			podStore,
@@ -761,6 +770,7 @@ func run(o *Options) error {
 		nplController, err := npl.InitializeNPLAgent(
 			k8sClient,
 			serviceInformer,
+			namespaceInformer,
 			localPodInformer.Get(),
 			o.nplStartPort,
 			o.nplEndPort,
@@ -790,8 +800,10 @@ func run(o *Options) error {
 			egressInformer,
 			bgpPolicyInformer,
 			endpointSliceInformer,
+			localPodInformer.Get(),
 			o.enableEgress,
 			k8sClient,
+			routeClient,
 			nodeConfig,
 			networkConfig)
 		if err != nil {
@@ -823,6 +835,8 @@ func run(o *Options) error {
 			antreaClientProvider,
 			nodeInformer,
 			nodeLatencyMonitorInformer,
+			serviceInformer,
+			endpointSliceInformer,
 			nodeConfig,
 			networkConfig.TrafficEncapMode,
 		)
@@ -948,9 +962,18 @@ func run(o *Options) error {
 	}
 
 	// statsCollector collects stats and reports to the antrea-controller periodically. For now it's only used for
-	// NetworkPolicy stats and Multicast stats.
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController)
+	// NetworkPolicy stats, Multicast stats and Egress stats.
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) || o.enableEgress {
+		// The Collector only collects the stats of the features whose querier is provided.
+		var npQuerier antreaquerier.AgentNetworkPolicyInfoQuerier
+		if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+			npQuerier = networkPolicyController
+		}
+		var egressQuerier antreaquerier.EgressQuerier
+		if o.enableEgress {
+			egressQuerier = egressController
+		}
+		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, npQuerier, mcastController, egressQuerier)
 		go statsCollector.Run(stopCh)
 	}
 
@@ -17,6 +17,7 @@ package main
 import (
 	"fmt"
 	"net"
+	"net/netip"
 	"os"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy"
 	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/cni"
 	agentconfig "antrea.io/antrea/pkg/config/agent"
@@ -36,8 +38,10 @@ import (
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/util/env"
 	"antrea.io/antrea/pkg/util/flowexport"
+	"antrea.io/antrea/pkg/util/flowfilter"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
+	"antrea.io/antrea/pkg/util/syslog"
 	"antrea.io/antrea/pkg/util/validation"
 	"antrea.io/antrea/pkg/util/yaml"
 )
@@ -62,6 +66,9 @@ const (
 	defaultAuditLogsMaxBackups     = 3
 	defaultAuditLogsMaxAge         = 28
 	defaultAuditLogsCompressed     = true
+	defaultAuditLogsFormat         = networkpolicy.AuditLogFormatText
+	defaultAuditLogsSyslogProtocol = syslog.ProtocolUDP
+	defaultAuditLogsSyslogFacility = "local0"
 	defaultPacketInRate            = 5000
 )
 
@@ -86,12 +93,16 @@ type Options struct {
 	idleFlowTimeout time.Duration
 	// Stale connection timeout to delete connections if they are not exported.
 	staleConnectionTimeout time.Duration
-	igmpQueryInterval      time.Duration
-	igmpQueryVersions      []uint8
-	nplStartPort           int
-	nplEndPort             int
-	dnsServerOverride      string
-	nodeType               config.NodeType
+	// Compiled filters to select the flows to export.
+	flowFilter        *flowfilter.Matcher
+	igmpQueryInterval time.Duration
+	igmpQueryVersions []uint8
+	nplStartPort      int
+	nplEndPort        int
+	dnsServerOverride string
+	nodeType          config.NodeType
+	// fqdnTrustedDNSServers are the parsed IP addresses of FQDNTrustedDNSServers.
+	fqdnTrustedDNSServers []netip.Addr
 
 	// enableEgress represents whether Egress should run or not, calculated from its feature gate configuration and
 	// whether the traffic mode supports it.
@@ -163,6 +174,9 @@ func (o *Options) validate(args []string) error {
 	if err := validation.ValidatePort(o.config.APIPort); err != nil {
 		return fmt.Errorf("apiPort is invalid: %w", err)
 	}
+	if err := o.validateAuditLoggingConfig(); err != nil {
+		return err
+	}
 	if o.config.NodeType == config.ExternalNode.String() {
 		o.nodeType = config.ExternalNode
 		return o.validateExternalNodeOptions()
@@ -329,6 +343,13 @@ func (o *Options) validateFlowExporterConfig() error {
 		} else {
 			o.staleConnectionTimeout = defaultStaleConnectionTimeout
 		}
+		o.flowFilter, err = flowfilter.NewMatcher(o.config.FlowExporter.Filters)
+		if err != nil {
+			return fmt.Errorf("invalid FlowExporter filters: %w", err)
+		}
+		if o.flowFilter.NeedsFlowType() {
+			return fmt.Errorf("invalid FlowExporter filters: the flowTypes condition is only supported by the Flow Aggregator")
+		}
 	} else if o.config.FlowExporter.Enable {
 		klog.InfoS("The FlowExporter.enable config option is set to true, but it will be ignored because the FlowExporter feature gate is disabled")
 	}
@@ -645,6 +666,13 @@ func (o *Options) validateK8sNodeOptions() error {
 		}
 		o.dnsServerOverride = hostPort
 	}
+	for _, server := range o.config.FQDNTrustedDNSServers {
+		addr, err := netip.ParseAddr(server)
+		if err != nil {
+			return fmt.Errorf("fqdnTrustedDNSServers %s is invalid: %w", server, err)
+		}
+		o.fqdnTrustedDNSServers = append(o.fqdnTrustedDNSServers, addr)
+	}
 
 	if err := o.validateSecondaryNetworkConfig(); err != nil {
 		return fmt.Errorf("failed to validate secondary network config: %v", err)
@@ -763,6 +791,39 @@ func (o *Options) setAuditLoggingDefaultOptions() {
 		compress := defaultAuditLogsCompressed
 		auditLogging.Compress = &compress
 	}
+	if auditLogging.Format == "" {
+		auditLogging.Format = defaultAuditLogsFormat
+	}
+	if auditLogging.Syslog.Protocol == "" {
+		auditLogging.Syslog.Protocol = string(defaultAuditLogsSyslogProtocol)
+	}
+	if auditLogging.Syslog.Facility == "" {
+		auditLogging.Syslog.Facility = defaultAuditLogsSyslogFacility
+	}
+}
+
+func (o *Options) validateAuditLoggingConfig() error {
+	auditLogging := o.config.AuditLogging
+	switch auditLogging.Format {
+	case networkpolicy.AuditLogFormatText, networkpolicy.AuditLogFormatJSON:
+	default:
+		return fmt.Errorf("auditLogging.format %s is not supported", auditLogging.Format)
+	}
+	if !auditLogging.Syslog.Enable {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(auditLogging.Syslog.Address); err != nil {
+		return fmt.Errorf("auditLogging.syslog.address %s is invalid: %w", auditLogging.Syslog.Address, err)
+	}
+	switch syslog.Protocol(auditLogging.Syslog.Protocol) {
+	case syslog.ProtocolUDP, syslog.ProtocolTCP, syslog.ProtocolTLS:
+	default:
+		return fmt.Errorf("auditLogging.syslog.protocol %s is not supported", auditLogging.Syslog.Protocol)
+	}
+	if _, err := syslog.ParseFacility(auditLogging.Syslog.Facility); err != nil {
+		return fmt.Errorf("auditLogging.syslog.facility is invalid: %w", err)
+	}
+	return nil
 }
 
 func (o *Options) validateSecondaryNetworkConfig() error {
@@ -343,3 +343,70 @@ func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsValidateAuditLoggingConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      agentconfig.AuditLoggingConfig
+		expectedErr string
+	}{
+		{
+			name:   "text format",
+			config: agentconfig.AuditLoggingConfig{Format: "text"},
+		},
+		{
+			name:        "invalid format",
+			config:      agentconfig.AuditLoggingConfig{Format: "xml"},
+			expectedErr: "auditLogging.format xml is not supported",
+		},
+		{
+			name: "syslog disabled",
+			config: agentconfig.AuditLoggingConfig{
+				Format: "json",
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Protocol: "sctp"},
+			},
+		},
+		{
+			name: "valid syslog config",
+			config: agentconfig.AuditLoggingConfig{
+				Format: "json",
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1:6514", Protocol: "tls", Facility: "local3"},
+			},
+		},
+		{
+			name: "invalid syslog address",
+			config: agentconfig.AuditLoggingConfig{
+				Format: "text",
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1", Protocol: "udp", Facility: "local0"},
+			},
+			expectedErr: "auditLogging.syslog.address 10.0.0.1 is invalid",
+		},
+		{
+			name: "invalid syslog protocol",
+			config: agentconfig.AuditLoggingConfig{
+				Format: "text",
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1:514", Protocol: "sctp", Facility: "local0"},
+			},
+			expectedErr: "auditLogging.syslog.protocol sctp is not supported",
+		},
+		{
+			name: "invalid syslog facility",
+			config: agentconfig.AuditLoggingConfig{
+				Format: "text",
+				Syslog: agentconfig.AuditLoggingSyslogConfig{Enable: true, Address: "10.0.0.1:514", Protocol: "tcp", Facility: "local9"},
+			},
+			expectedErr: "auditLogging.syslog.facility is invalid: unknown syslog facility: local9",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{AuditLogging: tt.config}}
+			err := o.validateAuditLoggingConfig()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
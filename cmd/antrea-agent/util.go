@@ -15,12 +15,17 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"strings"
 
 	"antrea.io/antrea/pkg/agent/util"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/util/syslog"
 )
 
 var getAllNodeAddresses = util.GetAllNodeAddresses
@@ -79,3 +84,41 @@ func parsePortRange(portRangeStr string) (start, end int, err error) {
 
 	return start, end, nil
 }
+
+// getAuditLoggingSyslogConfig returns the configuration of the syslog sink for NetworkPolicy audit logging, or nil if
+// the syslog sink is disabled. It must be called after the options are validated.
+func getAuditLoggingSyslogConfig(syslogConfig agentconfig.AuditLoggingSyslogConfig, nodeName string) (*syslog.Config, error) {
+	if !syslogConfig.Enable {
+		return nil, nil
+	}
+	facility, err := syslog.ParseFacility(syslogConfig.Facility)
+	if err != nil {
+		return nil, err
+	}
+	config := &syslog.Config{
+		Protocol: syslog.Protocol(syslogConfig.Protocol),
+		Address:  syslogConfig.Address,
+		Facility: facility,
+		Hostname: nodeName,
+		AppName:  "antrea-agent",
+		MsgID:    "NetworkPolicy",
+	}
+	if config.Protocol == syslog.ProtocolTLS {
+		tlsConfig := &tls.Config{
+			ServerName: syslogConfig.ServerName,
+			MinVersion: tls.VersionTLS12,
+		}
+		if syslogConfig.CACertFile != "" {
+			caCert, err := os.ReadFile(syslogConfig.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("error when reading CA certificate of syslog server: %w", err)
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid CA certificate found in %s", syslogConfig.CACertFile)
+			}
+		}
+		config.TLSConfig = tlsConfig
+	}
+	return config, nil
+}
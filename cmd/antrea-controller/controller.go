@@ -264,10 +264,10 @@ func run(o *Options) error {
 	}
 
 	// statsAggregator takes stats summaries from antrea-agents, aggregates them, and serves the Stats APIs with the
-	// aggregated data. For now it's only used for NetworkPolicy stats.
+	// aggregated data. For now it's only used for NetworkPolicy stats and Egress stats.
 	var statsAggregator *stats.Aggregator
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer)
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) || features.DefaultFeatureGate.Enabled(features.Egress) {
+		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer, egressInformer)
 	}
 
 	cipherSuites, err := cipher.GenerateCipherSuitesList(o.config.TLSCipherSuites)
@@ -369,7 +369,7 @@ func run(o *Options) error {
 
 	go apiServer.Run(ctx)
 
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+	if statsAggregator != nil {
 		go statsAggregator.Run(stopCh)
 	}
 
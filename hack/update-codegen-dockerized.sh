@@ -144,6 +144,7 @@ function generate_antrea_client_code {
     --plural-exceptions "ClusterGroupMembers:ClusterGroupMembers" \
     --plural-exceptions "GroupMembers:GroupMembers" \
     --plural-exceptions "NodeLatencyStats:NodeLatencyStats" \
+    --plural-exceptions "EgressStats:EgressStats" \
     --go-header-file hack/boilerplate/license_header.go.txt
 
   # Generate listers with K8s codegen tools.
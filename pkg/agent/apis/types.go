@@ -95,6 +95,40 @@ func (r FQDNCacheResponse) SortRows() bool {
 	return true
 }
 
+// EndpointHealthResponse describes the response struct of endpointhealth command.
+type EndpointHealthResponse struct {
+	Namespace           string    `json:"namespace,omitempty"`
+	Service             string    `json:"service,omitempty"`
+	Port                string    `json:"port,omitempty"`
+	Endpoint            string    `json:"endpoint,omitempty"`
+	Probe               string    `json:"probe,omitempty"`
+	Healthy             bool      `json:"healthy"`
+	ConsecutiveFailures int       `json:"consecutiveFailures,omitempty"`
+	LastProbeTime       time.Time `json:"lastProbeTime,omitempty"`
+	LastError           string    `json:"lastError,omitempty"`
+}
+
+func (r EndpointHealthResponse) GetTableHeader() []string {
+	return []string{"NAMESPACE", "SERVICE", "PORT", "ENDPOINT", "PROBE", "HEALTHY", "FAILURES", "LAST ERROR"}
+}
+
+func (r EndpointHealthResponse) GetTableRow(maxColumn int) []string {
+	return []string{
+		r.Namespace,
+		r.Service,
+		r.Port,
+		r.Endpoint,
+		r.Probe,
+		strconv.FormatBool(r.Healthy),
+		strconv.Itoa(r.ConsecutiveFailures),
+		r.LastError,
+	}
+}
+
+func (r EndpointHealthResponse) SortRows() bool {
+	return true
+}
+
 type FeatureGateResponse struct {
 	Component string `json:"component,omitempty"`
 	Name      string `json:"name,omitempty"`
@@ -236,17 +270,21 @@ func (r BGPPolicyResponse) SortRows() bool {
 
 // BGPPeerResponse describes the response struct of bgppeers command.
 type BGPPeerResponse struct {
-	Peer  string `json:"peer,omitempty"`
-	ASN   int32  `json:"asn,omitempty"`
-	State string `json:"state,omitempty"`
+	Peer          string `json:"peer,omitempty"`
+	ASN           int32  `json:"asn,omitempty"`
+	State         string `json:"state,omitempty"`
+	HoldTime      int32  `json:"holdTime,omitempty"`
+	KeepaliveTime int32  `json:"keepaliveTime,omitempty"`
+	// BFDState is empty if BFD is not enabled for the peer.
+	BFDState string `json:"bfdState,omitempty"`
 }
 
 func (r BGPPeerResponse) GetTableHeader() []string {
-	return []string{"PEER", "ASN", "STATE"}
+	return []string{"PEER", "ASN", "STATE", "HOLD-TIME", "KEEPALIVE", "BFD"}
 }
 
 func (r BGPPeerResponse) GetTableRow(_ int) []string {
-	return []string{r.Peer, strconv.Itoa(int(r.ASN)), r.State}
+	return []string{r.Peer, strconv.Itoa(int(r.ASN)), r.State, strconv.Itoa(int(r.HoldTime)), strconv.Itoa(int(r.KeepaliveTime)), r.BFDState}
 }
 
 func (r BGPPeerResponse) SortRows() bool {
@@ -258,14 +296,17 @@ type BGPRouteResponse struct {
 	Route     string `json:"route,omitempty"`
 	Type      string `json:"type,omitempty"`
 	K8sObjRef string `json:"k8sObjRef,omitempty"`
+	// NextHop and Peer are only set for the routes imported from BGP peers.
+	NextHop string `json:"nextHop,omitempty"`
+	Peer    string `json:"peer,omitempty"`
 }
 
 func (r BGPRouteResponse) GetTableHeader() []string {
-	return []string{"ROUTE", "TYPE", "K8S-OBJ-REF"}
+	return []string{"ROUTE", "TYPE", "K8S-OBJ-REF", "NEXT-HOP", "PEER"}
 }
 
 func (r BGPRouteResponse) GetTableRow(_ int) []string {
-	return []string{r.Route, r.Type, r.K8sObjRef}
+	return []string{r.Route, r.Type, r.K8sObjRef, r.NextHop, r.Peer}
 }
 
 func (r BGPRouteResponse) SortRows() bool {
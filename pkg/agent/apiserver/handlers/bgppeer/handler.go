@@ -82,9 +82,12 @@ func HandleFunc(bq querier.AgentBGPPolicyInfoQuerier) http.HandlerFunc {
 				continue
 			}
 			bgpPeersResp = append(bgpPeersResp, apis.BGPPeerResponse{
-				Peer:  net.JoinHostPort(peer.Address, strconv.Itoa(int(peer.Port))),
-				ASN:   peer.ASN,
-				State: string(peer.SessionState),
+				Peer:          net.JoinHostPort(peer.Address, strconv.Itoa(int(peer.Port))),
+				ASN:           peer.ASN,
+				State:         string(peer.SessionState),
+				HoldTime:      peer.HoldTimeSeconds,
+				KeepaliveTime: peer.KeepaliveTimeSeconds,
+				BFDState:      string(peer.BFDState),
 			})
 		}
 		// make sure that we provide a stable order for the API response
@@ -31,6 +31,9 @@ import (
 	"antrea.io/antrea/pkg/querier"
 )
 
+// importedRouteType is the type of the routes received from BGP peers and installed on the Node.
+const importedRouteType = "Imported"
+
 // HandleFunc returns the function which can handle queries issued by the bgproutes command.
 func HandleFunc(bq querier.AgentBGPPolicyInfoQuerier) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -89,6 +92,27 @@ func HandleFunc(bq querier.AgentBGPPolicyInfoQuerier) http.HandlerFunc {
 				K8sObjRef: routeMetadata.K8sObjRef,
 			})
 		}
+		if bgpRouteType == "" || bgpRouteType == importedRouteType {
+			importedRoutes, err := bq.GetImportedBGPRoutes(r.Context())
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			for _, importedRoute := range importedRoutes {
+				if ipv4Only && !utilnet.IsIPv4CIDRString(importedRoute.Prefix) {
+					continue
+				}
+				if ipv6Only && !utilnet.IsIPv6CIDRString(importedRoute.Prefix) {
+					continue
+				}
+				bgpRoutesResp = append(bgpRoutesResp, apis.BGPRouteResponse{
+					Route:   importedRoute.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedRoute.NextHop,
+					Peer:    importedRoute.Peer,
+				})
+			}
+		}
 		// make sure that we provide a stable order for the API response
 		slices.SortFunc(bgpRoutesResp, func(a, b apis.BGPRouteResponse) int {
 			pA, _ := netip.ParsePrefix(a.Route)
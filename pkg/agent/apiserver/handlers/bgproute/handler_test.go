@@ -62,6 +62,10 @@ var (
 		egressIPv6Route:       {Type: bgpcontroller.EgressIP, K8sObjRef: ipv6EgressName},
 		podIPv4CIDRRoute:      {Type: bgpcontroller.NodeIPAMPodCIDR},
 	}
+
+	importedIPv4Route = bgp.ReceivedRoute{Prefix: "172.16.0.0/16", NextHop: "192.168.77.200", Peer: "192.168.77.200"}
+	importedIPv6Route = bgp.ReceivedRoute{Prefix: "fd00:172:16::/48", NextHop: "fec0::192:168:77:200", Peer: "fec0::192:168:77:200"}
+	importedRoutes    = []bgp.ReceivedRoute{importedIPv6Route, importedIPv4Route}
 )
 
 func TestBGPRouteQuery(t *testing.T) {
@@ -81,9 +85,10 @@ func TestBGPRouteQuery(t *testing.T) {
 			expectedStatus: http.StatusNotFound,
 		},
 		{
-			name: "get all advertised routes",
+			name: "get all routes",
 			expectedCalls: func(mockBGPServer *queriertest.MockAgentBGPPolicyInfoQuerier) {
 				mockBGPServer.EXPECT().GetBGPRoutes(ctx).Return(allRoutes, nil)
+				mockBGPServer.EXPECT().GetImportedBGPRoutes(ctx).Return(importedRoutes, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: []apis.BGPRouteResponse{
@@ -92,6 +97,12 @@ func TestBGPRouteQuery(t *testing.T) {
 					Type:      string(allRoutes[egressIPv4Route].Type),
 					K8sObjRef: allRoutes[egressIPv4Route].K8sObjRef,
 				},
+				{
+					Route:   importedIPv4Route.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedIPv4Route.NextHop,
+					Peer:    importedIPv4Route.Peer,
+				},
 				{
 					Route: podIPv4CIDR,
 					Type:  string(bgpcontroller.NodeIPAMPodCIDR),
@@ -106,6 +117,12 @@ func TestBGPRouteQuery(t *testing.T) {
 					Type:      string(allRoutes[egressIPv6Route].Type),
 					K8sObjRef: allRoutes[egressIPv6Route].K8sObjRef,
 				},
+				{
+					Route:   importedIPv6Route.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedIPv6Route.NextHop,
+					Peer:    importedIPv6Route.Peer,
+				},
 				{
 					Route:     loadBalancerIPv6Route.Prefix,
 					Type:      string(allRoutes[loadBalancerIPv6Route].Type),
@@ -114,10 +131,11 @@ func TestBGPRouteQuery(t *testing.T) {
 			},
 		},
 		{
-			name: "get advertised ipv4 routes only",
+			name: "get ipv4 routes only",
 			url:  "?ipv4-only",
 			expectedCalls: func(mockBGPServer *queriertest.MockAgentBGPPolicyInfoQuerier) {
 				mockBGPServer.EXPECT().GetBGPRoutes(ctx).Return(allRoutes, nil)
+				mockBGPServer.EXPECT().GetImportedBGPRoutes(ctx).Return(importedRoutes, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: []apis.BGPRouteResponse{
@@ -126,6 +144,12 @@ func TestBGPRouteQuery(t *testing.T) {
 					Type:      string(allRoutes[egressIPv4Route].Type),
 					K8sObjRef: allRoutes[egressIPv4Route].K8sObjRef,
 				},
+				{
+					Route:   importedIPv4Route.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedIPv4Route.NextHop,
+					Peer:    importedIPv4Route.Peer,
+				},
 				{
 					Route: podIPv4CIDRRoute.Prefix,
 					Type:  string(allRoutes[podIPv4CIDRRoute].Type),
@@ -138,10 +162,11 @@ func TestBGPRouteQuery(t *testing.T) {
 			},
 		},
 		{
-			name: "get advertised ipv6 routes only",
+			name: "get ipv6 routes only",
 			url:  "?ipv6-only=",
 			expectedCalls: func(mockBGPServer *queriertest.MockAgentBGPPolicyInfoQuerier) {
 				mockBGPServer.EXPECT().GetBGPRoutes(ctx).Return(allRoutes, nil)
+				mockBGPServer.EXPECT().GetImportedBGPRoutes(ctx).Return(importedRoutes, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: []apis.BGPRouteResponse{
@@ -150,6 +175,12 @@ func TestBGPRouteQuery(t *testing.T) {
 					Type:      string(allRoutes[egressIPv6Route].Type),
 					K8sObjRef: allRoutes[egressIPv6Route].K8sObjRef,
 				},
+				{
+					Route:   importedIPv6Route.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedIPv6Route.NextHop,
+					Peer:    importedIPv6Route.Peer,
+				},
 				{
 					Route:     loadBalancerIPv6Route.Prefix,
 					Type:      string(allRoutes[loadBalancerIPv6Route].Type),
@@ -187,6 +218,29 @@ func TestBGPRouteQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "get imported routes",
+			url:  "?type=Imported",
+			expectedCalls: func(mockBGPServer *queriertest.MockAgentBGPPolicyInfoQuerier) {
+				mockBGPServer.EXPECT().GetBGPRoutes(ctx).Return(allRoutes, nil)
+				mockBGPServer.EXPECT().GetImportedBGPRoutes(ctx).Return(importedRoutes, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResponse: []apis.BGPRouteResponse{
+				{
+					Route:   importedIPv4Route.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedIPv4Route.NextHop,
+					Peer:    importedIPv4Route.Peer,
+				},
+				{
+					Route:   importedIPv6Route.Prefix,
+					Type:    importedRouteType,
+					NextHop: importedIPv6Route.NextHop,
+					Peer:    importedIPv6Route.Peer,
+				},
+			},
+		},
 		{
 			name: "get advertised IPv4 egressIP routes",
 			url:  "?ipv4-only&type=EgressIP",
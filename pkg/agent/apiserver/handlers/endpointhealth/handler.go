@@ -0,0 +1,68 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpointhealth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/proxy/endpointprober"
+	"antrea.io/antrea/pkg/agent/querier"
+)
+
+func generateResponse(status endpointprober.Status) apis.EndpointHealthResponse {
+	return apis.EndpointHealthResponse{
+		Namespace:           status.ServicePortName.Namespace,
+		Service:             status.ServicePortName.Name,
+		Port:                status.ServicePortName.Port,
+		Endpoint:            status.Endpoint,
+		Probe:               status.Config.String(),
+		Healthy:             status.Healthy,
+		ConsecutiveFailures: status.ConsecutiveFailures,
+		LastProbeTime:       status.LastProbeTime,
+		LastError:           status.LastError,
+	}
+}
+
+// HandleFunc returns the function which can handle queries issued by the endpointhealth command.
+func HandleFunc(aq querier.AgentQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		proxier := aq.GetProxier()
+		if proxier == nil {
+			// The error message must match the "FOO is not enabled" pattern to pass antctl e2e tests.
+			http.Error(w, "AntreaProxy is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		namespace := r.URL.Query().Get("namespace")
+		service := r.URL.Query().Get("service")
+		resps := []apis.EndpointHealthResponse{}
+		for _, status := range proxier.GetEndpointHealthStatuses() {
+			if namespace != "" && status.ServicePortName.Namespace != namespace {
+				continue
+			}
+			if service != "" && status.ServicePortName.Name != service {
+				continue
+			}
+			resps = append(resps, generateResponse(status))
+		}
+		if err := json.NewEncoder(w).Encode(resps); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			klog.ErrorS(err, "Error when encoding Endpoint health statuses to json")
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpointhealth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/proxy/endpointprober"
+	proxytest "antrea.io/antrea/pkg/agent/proxy/testing"
+	aqtest "antrea.io/antrea/pkg/agent/querier/testing"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+func TestEndpointHealthQuery(t *testing.T) {
+	probeTime := time.Now().UTC()
+	svc1PortName := k8sproxy.ServicePortName{NamespacedName: apimachinerytypes.NamespacedName{Namespace: "ns1", Name: "svc1"}, Port: "http"}
+	svc2PortName := k8sproxy.ServicePortName{NamespacedName: apimachinerytypes.NamespacedName{Namespace: "ns2", Name: "svc2"}, Port: "tcp"}
+	statuses := []endpointprober.Status{
+		{
+			ServicePortName: svc1PortName,
+			Endpoint:        "10.10.0.1:80",
+			Config:          endpointprober.ProbeConfig{Type: endpointprober.ProbeTypeHTTP, Path: "/healthz"},
+			Healthy:         true,
+			LastProbeTime:   probeTime,
+		},
+		{
+			ServicePortName:     svc2PortName,
+			Endpoint:            "10.10.0.2:3306",
+			Config:              endpointprober.ProbeConfig{Type: endpointprober.ProbeTypeTCP},
+			Healthy:             false,
+			ConsecutiveFailures: 3,
+			LastProbeTime:       probeTime,
+			LastError:           "connection refused",
+		},
+	}
+	svc1Response := apis.EndpointHealthResponse{
+		Namespace:     "ns1",
+		Service:       "svc1",
+		Port:          "http",
+		Endpoint:      "10.10.0.1:80",
+		Probe:         "HTTP /healthz",
+		Healthy:       true,
+		LastProbeTime: probeTime,
+	}
+	svc2Response := apis.EndpointHealthResponse{
+		Namespace:           "ns2",
+		Service:             "svc2",
+		Port:                "tcp",
+		Endpoint:            "10.10.0.2:3306",
+		Probe:               "TCP",
+		Healthy:             false,
+		ConsecutiveFailures: 3,
+		LastProbeTime:       probeTime,
+		LastError:           "connection refused",
+	}
+	tests := []struct {
+		name             string
+		query            string
+		expectedResponse []apis.EndpointHealthResponse
+	}{
+		{
+			name:             "all Endpoints",
+			expectedResponse: []apis.EndpointHealthResponse{svc1Response, svc2Response},
+		},
+		{
+			name:             "Namespace filter",
+			query:            "?namespace=ns2",
+			expectedResponse: []apis.EndpointHealthResponse{svc2Response},
+		},
+		{
+			name:             "Service filter",
+			query:            "?namespace=ns1&service=svc1",
+			expectedResponse: []apis.EndpointHealthResponse{svc1Response},
+		},
+		{
+			name:             "no matching Service",
+			query:            "?namespace=ns1&service=svc2",
+			expectedResponse: []apis.EndpointHealthResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := aqtest.NewMockAgentQuerier(ctrl)
+			p := proxytest.NewMockProxier(ctrl)
+			q.EXPECT().GetProxier().Return(p)
+			p.EXPECT().GetEndpointHealthStatuses().Return(statuses)
+			handler := HandleFunc(q)
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var receivedResponse []apis.EndpointHealthResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &receivedResponse))
+			assert.Equal(t, tt.expectedResponse, receivedResponse)
+		})
+	}
+}
+
+func TestEndpointHealthQueryProxyDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	q := aqtest.NewMockAgentQuerier(ctrl)
+	q.EXPECT().GetProxier().Return(nil)
+	handler := HandleFunc(q)
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
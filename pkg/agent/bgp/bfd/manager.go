@@ -0,0 +1,334 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"strconv"
+	"sync"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// singleHopPort is the UDP port of single-hop BFD Control packets, see RFC 5881.
+	singleHopPort = 3784
+	// multihopPort is the UDP port of multi-hop BFD Control packets, see RFC 5883.
+	multihopPort = 4784
+	// The source port of BFD Control packets must be in the range from 49152 to 65535.
+	minSourcePort = 49152
+	maxSourcePort = 65535
+	// ttl is the TTL (or hop limit) of the BFD Control packets. Single-hop packets received with a different TTL
+	// are discarded, as required by RFC 5881.
+	ttl = 255
+	// maxSourcePortAttempts is the number of attempts to find an available source port.
+	maxSourcePortAttempts = 16
+)
+
+// StateChangeHandler is called when the state of the BFD session with a peer changes. It is called from the goroutine
+// running the session, and must not block.
+type StateChangeHandler func(peerAddress string, oldState, newState State)
+
+type listenerKey struct {
+	isIPv6   bool
+	multihop bool
+}
+
+type managedSession struct {
+	*session
+	conn *net.UDPConn
+	// multihop is immutable, as the session is re-created when it changes.
+	multihop bool
+}
+
+// Manager manages the BFD sessions with a set of peers. Sockets are only opened when the first session requiring them
+// is added.
+type Manager struct {
+	handler StateChangeHandler
+	clock   clock.Clock
+	// The UDP ports on which packets are received, and to which they are sent. They only differ in tests.
+	listenPorts map[bool]int
+	peerPorts   map[bool]int
+
+	mutex           sync.RWMutex
+	sessions        map[netip.Addr]*managedSession
+	discriminators  map[uint32]*managedSession
+	listeners       map[listenerKey]net.PacketConn
+	listenersStopCh chan struct{}
+}
+
+func NewManager(handler StateChangeHandler) *Manager {
+	ports := map[bool]int{false: singleHopPort, true: multihopPort}
+	return newManager(handler, clock.RealClock{}, ports, ports)
+}
+
+func newManager(handler StateChangeHandler, clock clock.Clock, listenPorts, peerPorts map[bool]int) *Manager {
+	return &Manager{
+		handler:         handler,
+		clock:           clock,
+		listenPorts:     listenPorts,
+		peerPorts:       peerPorts,
+		sessions:        make(map[netip.Addr]*managedSession),
+		discriminators:  make(map[uint32]*managedSession),
+		listeners:       make(map[listenerKey]net.PacketConn),
+		listenersStopCh: make(chan struct{}),
+	}
+}
+
+// AddOrUpdateSession creates a BFD session with the peer, or updates the configuration of the existing session.
+func (m *Manager) AddOrUpdateSession(peerAddress string, config Config) error {
+	addr, err := netip.ParseAddr(peerAddress)
+	if err != nil {
+		return fmt.Errorf("invalid peer address %s: %w", peerAddress, err)
+	}
+	addr = addr.Unmap()
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if s, exists := m.sessions[addr]; exists {
+		if s.multihop == config.Multihop {
+			s.updateConfig(config)
+			return nil
+		}
+		// The session must be re-created to use the other UDP port.
+		m.removeSessionLocked(addr)
+	}
+
+	if err := m.ensureListenerLocked(listenerKey{isIPv6: addr.Is6(), multihop: config.Multihop}); err != nil {
+		return err
+	}
+	conn, err := m.dial(addr, config.Multihop)
+	if err != nil {
+		return err
+	}
+	discriminator := m.allocateDiscriminatorLocked()
+	s := &managedSession{conn: conn, multihop: config.Multihop}
+	s.session = newSession(addr, discriminator, config, m.clock,
+		func(b []byte) error {
+			_, err := conn.Write(b)
+			return err
+		},
+		func(oldState, newState State) {
+			if m.handler != nil {
+				m.handler(peerAddress, oldState, newState)
+			}
+		})
+	m.sessions[addr] = s
+	m.discriminators[discriminator] = s
+	go s.run()
+	klog.InfoS("Added BFD session", "peer", peerAddress, "multihop", config.Multihop, "minTxInterval", config.MinTxInterval,
+		"minRxInterval", config.MinRxInterval, "detectMultiplier", config.DetectMultiplier)
+	return nil
+}
+
+// RemoveSession removes the BFD session with the peer, if it exists. The peer is notified that the session is
+// administratively down.
+func (m *Manager) RemoveSession(peerAddress string) {
+	addr, err := netip.ParseAddr(peerAddress)
+	if err != nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.removeSessionLocked(addr.Unmap())
+}
+
+func (m *Manager) removeSessionLocked(addr netip.Addr) {
+	s, exists := m.sessions[addr]
+	if !exists {
+		return
+	}
+	delete(m.sessions, addr)
+	delete(m.discriminators, s.localDiscriminator)
+	s.stop()
+	s.conn.Close()
+	klog.InfoS("Removed BFD session", "peer", addr)
+}
+
+// GetSessionState returns the state of the BFD session with the peer, and whether the session exists.
+func (m *Manager) GetSessionState(peerAddress string) (State, bool) {
+	addr, err := netip.ParseAddr(peerAddress)
+	if err != nil {
+		return StateDown, false
+	}
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	s, exists := m.sessions[addr.Unmap()]
+	if !exists {
+		return StateDown, false
+	}
+	return s.getState(), true
+}
+
+// Stop removes all the BFD sessions and closes all the sockets.
+func (m *Manager) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for addr := range m.sessions {
+		m.removeSessionLocked(addr)
+	}
+	close(m.listenersStopCh)
+	for key, listener := range m.listeners {
+		listener.Close()
+		delete(m.listeners, key)
+	}
+	m.listenersStopCh = make(chan struct{})
+}
+
+func (m *Manager) allocateDiscriminatorLocked() uint32 {
+	for {
+		discriminator := rand.Uint32()
+		if _, exists := m.discriminators[discriminator]; discriminator != 0 && !exists {
+			return discriminator
+		}
+	}
+}
+
+// dial creates the socket used to send BFD Control packets to the peer, bound to a random source port in the range
+// required by RFC 5881.
+func (m *Manager) dial(addr netip.Addr, multihop bool) (*net.UDPConn, error) {
+	network := "udp4"
+	if addr.Is6() {
+		network = "udp6"
+	}
+	raddr := net.UDPAddrFromAddrPort(netip.AddrPortFrom(addr, uint16(m.peerPorts[multihop])))
+	var conn *net.UDPConn
+	var err error
+	for range maxSourcePortAttempts {
+		laddr := &net.UDPAddr{Port: minSourcePort + rand.IntN(maxSourcePort-minSourcePort+1)}
+		conn, err = net.DialUDP(network, laddr, raddr)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create BFD socket for peer %s: %w", addr, err)
+	}
+	if addr.Is6() {
+		err = ipv6.NewConn(conn).SetHopLimit(ttl)
+	} else {
+		err = ipv4.NewConn(conn).SetTTL(ttl)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set TTL of BFD socket for peer %s: %w", addr, err)
+	}
+	return conn, nil
+}
+
+// ensureListenerLocked opens the socket receiving BFD Control packets for the given IP family and hop mode, if it is
+// not open yet.
+func (m *Manager) ensureListenerLocked(key listenerKey) error {
+	if _, exists := m.listeners[key]; exists {
+		return nil
+	}
+	network := "udp4"
+	if key.isIPv6 {
+		network = "udp6"
+	}
+	conn, err := net.ListenPacket(network, net.JoinHostPort("", strconv.Itoa(m.listenPorts[key.multihop])))
+	if err != nil {
+		return fmt.Errorf("failed to listen for BFD Control packets: %w", err)
+	}
+	// The TTL of received packets is required to validate single-hop packets.
+	var readFrom func(b []byte) (int, int, net.Addr, error)
+	if key.isIPv6 {
+		pc := ipv6.NewPacketConn(conn)
+		err = pc.SetControlMessage(ipv6.FlagHopLimit, true)
+		readFrom = func(b []byte) (int, int, net.Addr, error) {
+			n, cm, src, err := pc.ReadFrom(b)
+			if cm == nil {
+				return n, -1, src, err
+			}
+			return n, cm.HopLimit, src, err
+		}
+	} else {
+		pc := ipv4.NewPacketConn(conn)
+		err = pc.SetControlMessage(ipv4.FlagTTL, true)
+		readFrom = func(b []byte) (int, int, net.Addr, error) {
+			n, cm, src, err := pc.ReadFrom(b)
+			if cm == nil {
+				return n, -1, src, err
+			}
+			return n, cm.TTL, src, err
+		}
+	}
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to enable TTL control messages on BFD socket: %w", err)
+	}
+	m.listeners[key] = conn
+	go m.receiveLoop(key, readFrom, m.listenersStopCh)
+	return nil
+}
+
+func (m *Manager) receiveLoop(key listenerKey, readFrom func(b []byte) (int, int, net.Addr, error), stopCh <-chan struct{}) {
+	b := make([]byte, 128)
+	for {
+		n, receivedTTL, src, err := readFrom(b)
+		if err != nil {
+			select {
+			case <-stopCh:
+				return
+			default:
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			klog.ErrorS(err, "Failed to receive BFD Control packet")
+			continue
+		}
+		udpAddr, ok := src.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		srcAddr := udpAddr.AddrPort().Addr().Unmap()
+		if !key.multihop && receivedTTL != -1 && receivedTTL != ttl {
+			klog.V(4).InfoS("Discarding single-hop BFD Control packet with invalid TTL", "peer", srcAddr, "ttl", receivedTTL)
+			continue
+		}
+		p, err := unmarshalControlPacket(b[:n])
+		if err != nil {
+			klog.V(4).InfoS("Discarding invalid BFD Control packet", "peer", srcAddr, "err", err)
+			continue
+		}
+		m.dispatch(srcAddr, key.multihop, p)
+	}
+}
+
+// dispatch delivers a packet to its session, which is selected by the Your Discriminator field if it is set, and by
+// the source address otherwise.
+func (m *Manager) dispatch(srcAddr netip.Addr, multihop bool, p *controlPacket) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	var s *managedSession
+	if p.yourDiscriminator != 0 {
+		s = m.discriminators[p.yourDiscriminator]
+	} else {
+		s = m.sessions[srcAddr]
+	}
+	if s == nil || s.peerAddress != srcAddr || s.multihop != multihop {
+		klog.V(4).InfoS("Discarding BFD Control packet not matching any session", "peer", srcAddr, "yourDiscriminator", p.yourDiscriminator)
+		return
+	}
+	s.receive(p)
+}
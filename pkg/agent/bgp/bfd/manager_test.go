@@ -0,0 +1,82 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/clock"
+)
+
+func getFreeUDPPort(t *testing.T) int {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+func TestManager(t *testing.T) {
+	portA, portB := getFreeUDPPort(t), getFreeUDPPort(t)
+	stateChangesA := make(chan State, 10)
+	managerA := newManager(func(peerAddress string, _, newState State) {
+		assert.Equal(t, "127.0.0.1", peerAddress)
+		stateChangesA <- newState
+	}, clock.RealClock{}, map[bool]int{false: portA}, map[bool]int{false: portB})
+	defer managerA.Stop()
+	managerB := newManager(nil, clock.RealClock{}, map[bool]int{false: portB}, map[bool]int{false: portA})
+	defer managerB.Stop()
+
+	config := Config{
+		MinTxInterval:    50 * time.Millisecond,
+		MinRxInterval:    50 * time.Millisecond,
+		DetectMultiplier: 3,
+	}
+	require.NoError(t, managerA.AddOrUpdateSession("127.0.0.1", config))
+	require.NoError(t, managerB.AddOrUpdateSession("127.0.0.1", config))
+
+	require.Eventually(t, func() bool {
+		stateA, _ := managerA.GetSessionState("127.0.0.1")
+		stateB, _ := managerB.GetSessionState("127.0.0.1")
+		return stateA == StateUp && stateB == StateUp
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Updating the timing parameters should not bring the session down.
+	config.MinTxInterval = 100 * time.Millisecond
+	require.NoError(t, managerB.AddOrUpdateSession("127.0.0.1", config))
+	require.Never(t, func() bool {
+		stateA, _ := managerA.GetSessionState("127.0.0.1")
+		return stateA != StateUp
+	}, 500*time.Millisecond, 10*time.Millisecond)
+
+	// Removing the session on one side should bring the session down on the other side immediately.
+	managerB.RemoveSession("127.0.0.1")
+	_, exists := managerB.GetSessionState("127.0.0.1")
+	assert.False(t, exists)
+	require.Eventually(t, func() bool {
+		stateA, _ := managerA.GetSessionState("127.0.0.1")
+		return stateA == StateDown
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var states []State
+	for len(stateChangesA) > 0 {
+		states = append(states, <-stateChangesA)
+	}
+	assert.Equal(t, StateUp, states[len(states)-2])
+	assert.Equal(t, StateDown, states[len(states)-1])
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+const (
+	bfdVersion = 1
+	// controlPacketLength is the length of a BFD Control packet without the optional Authentication Section.
+	controlPacketLength = 24
+)
+
+const (
+	flagPoll        = 0x20
+	flagFinal       = 0x10
+	flagAuthPresent = 0x04
+	flagMultipoint  = 0x01
+)
+
+// Diagnostic is the diagnostic code specifying the reason of the last state change of the local system.
+// See https://datatracker.ietf.org/doc/html/rfc5880#section-4.1.
+type Diagnostic uint8
+
+const (
+	DiagnosticNone                    Diagnostic = 0
+	DiagnosticControlDetectionExpired Diagnostic = 1
+	DiagnosticNeighborDown            Diagnostic = 3
+	DiagnosticAdminDown               Diagnostic = 7
+)
+
+// controlPacket is a BFD Control packet, as defined in https://datatracker.ietf.org/doc/html/rfc5880#section-4.1.
+// Authentication is not supported.
+type controlPacket struct {
+	diagnostic            Diagnostic
+	state                 State
+	poll                  bool
+	final                 bool
+	detectMultiplier      uint8
+	myDiscriminator       uint32
+	yourDiscriminator     uint32
+	desiredMinTxInterval  time.Duration
+	requiredMinRxInterval time.Duration
+}
+
+func (p *controlPacket) marshal() []byte {
+	b := make([]byte, controlPacketLength)
+	b[0] = bfdVersion<<5 | uint8(p.diagnostic)&0x1f
+	b[1] = uint8(p.state) << 6
+	if p.poll {
+		b[1] |= flagPoll
+	}
+	if p.final {
+		b[1] |= flagFinal
+	}
+	b[2] = p.detectMultiplier
+	b[3] = controlPacketLength
+	binary.BigEndian.PutUint32(b[4:8], p.myDiscriminator)
+	binary.BigEndian.PutUint32(b[8:12], p.yourDiscriminator)
+	binary.BigEndian.PutUint32(b[12:16], uint32(p.desiredMinTxInterval.Microseconds()))
+	binary.BigEndian.PutUint32(b[16:20], uint32(p.requiredMinRxInterval.Microseconds()))
+	// The Required Min Echo RX Interval is left to 0, as the Echo function is not supported.
+	return b
+}
+
+// unmarshalControlPacket parses a BFD Control packet and performs the checks of
+// https://datatracker.ietf.org/doc/html/rfc5880#section-6.8.6 which do not depend on the session.
+func unmarshalControlPacket(b []byte) (*controlPacket, error) {
+	if len(b) < controlPacketLength {
+		return nil, fmt.Errorf("packet too short: %d bytes", len(b))
+	}
+	if version := b[0] >> 5; version != bfdVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+	length := int(b[3])
+	if length < controlPacketLength || length > len(b) {
+		return nil, fmt.Errorf("invalid length %d", length)
+	}
+	flags := b[1] & 0x3f
+	if flags&flagAuthPresent != 0 {
+		return nil, fmt.Errorf("authentication is not supported")
+	}
+	if flags&flagMultipoint != 0 {
+		return nil, fmt.Errorf("multipoint bit is set")
+	}
+	p := &controlPacket{
+		diagnostic:            Diagnostic(b[0] & 0x1f),
+		state:                 State(b[1] >> 6),
+		poll:                  flags&flagPoll != 0,
+		final:                 flags&flagFinal != 0,
+		detectMultiplier:      b[2],
+		myDiscriminator:       binary.BigEndian.Uint32(b[4:8]),
+		yourDiscriminator:     binary.BigEndian.Uint32(b[8:12]),
+		desiredMinTxInterval:  time.Duration(binary.BigEndian.Uint32(b[12:16])) * time.Microsecond,
+		requiredMinRxInterval: time.Duration(binary.BigEndian.Uint32(b[16:20])) * time.Microsecond,
+	}
+	if p.detectMultiplier == 0 {
+		return nil, fmt.Errorf("detect multiplier is 0")
+	}
+	if p.myDiscriminator == 0 {
+		return nil, fmt.Errorf("my discriminator is 0")
+	}
+	if p.yourDiscriminator == 0 && p.state != StateDown && p.state != StateAdminDown {
+		return nil, fmt.Errorf("your discriminator is 0 in state %s", p.state)
+	}
+	return p, nil
+}
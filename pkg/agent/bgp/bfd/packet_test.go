@@ -0,0 +1,108 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControlPacketMarshalUnmarshal(t *testing.T) {
+	p := &controlPacket{
+		diagnostic:            DiagnosticNeighborDown,
+		state:                 StateUp,
+		poll:                  true,
+		detectMultiplier:      3,
+		myDiscriminator:       0x11223344,
+		yourDiscriminator:     0x55667788,
+		desiredMinTxInterval:  300 * time.Millisecond,
+		requiredMinRxInterval: 100 * time.Millisecond,
+	}
+	b := p.marshal()
+	assert.Equal(t, []byte{
+		0x23, 0xe0, 0x03, 0x18,
+		0x11, 0x22, 0x33, 0x44,
+		0x55, 0x66, 0x77, 0x88,
+		0x00, 0x04, 0x93, 0xe0,
+		0x00, 0x01, 0x86, 0xa0,
+		0x00, 0x00, 0x00, 0x00,
+	}, b)
+	parsed, err := unmarshalControlPacket(b)
+	require.NoError(t, err)
+	assert.Equal(t, p, parsed)
+}
+
+func TestUnmarshalInvalidControlPacket(t *testing.T) {
+	valid := (&controlPacket{
+		state:             StateDown,
+		detectMultiplier:  3,
+		myDiscriminator:   1,
+		yourDiscriminator: 0,
+	}).marshal()
+	_, err := unmarshalControlPacket(valid)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		mutate      func(b []byte) []byte
+		expectedErr string
+	}{
+		{
+			name:        "too short",
+			mutate:      func(b []byte) []byte { return b[:20] },
+			expectedErr: "packet too short: 20 bytes",
+		},
+		{
+			name:        "invalid version",
+			mutate:      func(b []byte) []byte { b[0] = 0; return b },
+			expectedErr: "unsupported version 0",
+		},
+		{
+			name:        "invalid length",
+			mutate:      func(b []byte) []byte { b[3] = 48; return b },
+			expectedErr: "invalid length 48",
+		},
+		{
+			name:        "authentication",
+			mutate:      func(b []byte) []byte { b[1] |= flagAuthPresent; return b },
+			expectedErr: "authentication is not supported",
+		},
+		{
+			name:        "zero detect multiplier",
+			mutate:      func(b []byte) []byte { b[2] = 0; return b },
+			expectedErr: "detect multiplier is 0",
+		},
+		{
+			name:        "zero my discriminator",
+			mutate:      func(b []byte) []byte { b[7] = 0; return b },
+			expectedErr: "my discriminator is 0",
+		},
+		{
+			name:        "zero your discriminator in Up state",
+			mutate:      func(b []byte) []byte { b[1] = uint8(StateUp) << 6; return b },
+			expectedErr: "your discriminator is 0 in state Up",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.mutate(append([]byte(nil), valid...))
+			_, err := unmarshalControlPacket(b)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
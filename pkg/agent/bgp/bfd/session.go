@@ -0,0 +1,305 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"math/rand/v2"
+	"net/netip"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+// State is the state of a BFD session. The values are the ones used in BFD Control packets.
+type State uint8
+
+const (
+	StateAdminDown State = 0
+	StateDown      State = 1
+	StateInit      State = 2
+	StateUp        State = 3
+)
+
+func (s State) String() string {
+	switch s {
+	case StateAdminDown:
+		return "AdminDown"
+	case StateDown:
+		return "Down"
+	case StateInit:
+		return "Init"
+	case StateUp:
+		return "Up"
+	}
+	return "Unknown"
+}
+
+// slowTxInterval is the minimum interval between BFD Control packets when the session is not Up.
+// See https://datatracker.ietf.org/doc/html/rfc5880#section-6.8.3.
+const slowTxInterval = time.Second
+
+// Config is the configuration of a BFD session.
+type Config struct {
+	// MinTxInterval is the minimum interval between BFD Control packets sent to the peer.
+	MinTxInterval time.Duration
+	// MinRxInterval is the minimum interval between BFD Control packets which can be received from the peer.
+	MinRxInterval time.Duration
+	// DetectMultiplier is the number of packets which can be missed before the session goes down.
+	DetectMultiplier uint8
+	// Multihop indicates whether the peer is not directly connected, in which case RFC 5883 is used instead of RFC
+	// 5881.
+	Multihop bool
+}
+
+// session implements the state machine of an asynchronous mode BFD session, as defined in
+// https://datatracker.ietf.org/doc/html/rfc5880#section-6.8. All the state variables except state are only accessed
+// from the goroutine running the session.
+type session struct {
+	peerAddress   netip.Addr
+	config        Config
+	clock         clock.Clock
+	send          func(b []byte) error
+	onStateChange func(oldState, newState State)
+
+	rxCh     chan *controlPacket
+	configCh chan Config
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+
+	stateMutex sync.RWMutex
+	state      State
+
+	diagnostic                 Diagnostic
+	localDiscriminator         uint32
+	remoteDiscriminator        uint32
+	remoteDesiredMinTxInterval time.Duration
+	remoteMinRxInterval        time.Duration
+	remoteDetectMultiplier     uint8
+	// pollActive indicates whether a Poll Sequence is in progress, which is the case after the local timing
+	// parameters have changed until a packet with the Final bit is received.
+	pollActive bool
+}
+
+func newSession(peerAddress netip.Addr, localDiscriminator uint32, config Config, clock clock.Clock, send func(b []byte) error, onStateChange func(oldState, newState State)) *session {
+	return &session{
+		peerAddress:        peerAddress,
+		config:             config,
+		clock:              clock,
+		send:               send,
+		onStateChange:      onStateChange,
+		rxCh:               make(chan *controlPacket, 16),
+		configCh:           make(chan Config),
+		stopCh:             make(chan struct{}),
+		doneCh:             make(chan struct{}),
+		state:              StateDown,
+		localDiscriminator: localDiscriminator,
+		// As required by RFC 5880, the remote minimum RX interval is initialized to 1 microsecond, so that packets
+		// are sent at the local rate until the peer advertises its own value.
+		remoteMinRxInterval: time.Microsecond,
+	}
+}
+
+func (s *session) getState() State {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+	return s.state
+}
+
+func (s *session) setState(state State, diagnostic Diagnostic) {
+	s.stateMutex.Lock()
+	oldState := s.state
+	s.state = state
+	s.stateMutex.Unlock()
+	if oldState == state {
+		return
+	}
+	s.diagnostic = diagnostic
+	klog.InfoS("BFD session state changed", "peer", s.peerAddress, "oldState", oldState, "newState", state, "diagnostic", diagnostic)
+	if state == StateUp && s.config.MinTxInterval < slowTxInterval {
+		// The desired TX interval is decreased when the session goes Up, which must be notified to the peer with a
+		// Poll Sequence.
+		s.pollActive = true
+	}
+	if s.onStateChange != nil {
+		s.onStateChange(oldState, state)
+	}
+}
+
+// receive queues a packet received from the peer. The packet is dropped if the session is too slow to process it.
+func (s *session) receive(p *controlPacket) {
+	select {
+	case s.rxCh <- p:
+	default:
+		klog.V(2).InfoS("Dropping BFD Control packet", "peer", s.peerAddress)
+	}
+}
+
+// updateConfig updates the timing parameters of the session.
+func (s *session) updateConfig(config Config) {
+	select {
+	case s.configCh <- config:
+	case <-s.doneCh:
+	}
+}
+
+// stop stops the session, after notifying the peer that the session is administratively down.
+func (s *session) stop() {
+	close(s.stopCh)
+	<-s.doneCh
+}
+
+func (s *session) run() {
+	defer close(s.doneCh)
+
+	s.sendControlPacket(false)
+	txTimer := s.clock.NewTimer(s.txInterval())
+	defer txTimer.Stop()
+	// The detection timer is started when the first packet is received from the peer.
+	detectionTimer := s.clock.NewTimer(time.Hour)
+	detectionTimer.Stop()
+	defer detectionTimer.Stop()
+
+	for {
+		select {
+		case p := <-s.rxCh:
+			oldState := s.getState()
+			s.handleControlPacket(p)
+			detectionTimer.Reset(s.detectionTime())
+			if p.poll {
+				s.sendControlPacket(true)
+			}
+			// Notify the peer of state changes immediately, to speed up the session establishment.
+			if s.getState() != oldState {
+				s.sendControlPacket(false)
+				txTimer.Reset(s.txInterval())
+			}
+		case <-txTimer.C():
+			// The peer does not want to receive periodic packets if its minimum RX interval is 0.
+			if s.remoteMinRxInterval != 0 {
+				s.sendControlPacket(false)
+			}
+			txTimer.Reset(s.txInterval())
+		case <-detectionTimer.C():
+			s.handleDetectionTimeout()
+		case config := <-s.configCh:
+			s.config = config
+			if s.getState() == StateUp {
+				s.pollActive = true
+			}
+			s.sendControlPacket(false)
+			txTimer.Reset(s.txInterval())
+		case <-s.stopCh:
+			s.setState(StateAdminDown, DiagnosticAdminDown)
+			s.sendControlPacket(false)
+			return
+		}
+	}
+}
+
+// handleControlPacket updates the session with a packet received from the peer, as specified in
+// https://datatracker.ietf.org/doc/html/rfc5880#section-6.8.6.
+func (s *session) handleControlPacket(p *controlPacket) {
+	s.remoteDiscriminator = p.myDiscriminator
+	s.remoteDesiredMinTxInterval = p.desiredMinTxInterval
+	s.remoteMinRxInterval = p.requiredMinRxInterval
+	s.remoteDetectMultiplier = p.detectMultiplier
+	if p.final {
+		s.pollActive = false
+	}
+
+	state := s.getState()
+	if state == StateAdminDown {
+		return
+	}
+	if p.state == StateAdminDown {
+		if state != StateDown {
+			s.setState(StateDown, DiagnosticNeighborDown)
+		}
+		return
+	}
+	switch state {
+	case StateDown:
+		if p.state == StateDown {
+			s.setState(StateInit, DiagnosticNone)
+		} else if p.state == StateInit {
+			s.setState(StateUp, DiagnosticNone)
+		}
+	case StateInit:
+		if p.state == StateInit || p.state == StateUp {
+			s.setState(StateUp, DiagnosticNone)
+		}
+	case StateUp:
+		if p.state == StateDown {
+			s.setState(StateDown, DiagnosticNeighborDown)
+		}
+	}
+}
+
+func (s *session) handleDetectionTimeout() {
+	state := s.getState()
+	if state != StateInit && state != StateUp {
+		return
+	}
+	klog.InfoS("BFD session detection time expired", "peer", s.peerAddress)
+	s.setState(StateDown, DiagnosticControlDetectionExpired)
+	s.remoteDiscriminator = 0
+}
+
+// desiredMinTxInterval returns the TX interval advertised to the peer, which must be at least 1 second when the
+// session is not Up.
+func (s *session) desiredMinTxInterval() time.Duration {
+	if s.getState() != StateUp {
+		return max(s.config.MinTxInterval, slowTxInterval)
+	}
+	return s.config.MinTxInterval
+}
+
+// txInterval returns the interval until the next periodic packet. It is jittered as required by
+// https://datatracker.ietf.org/doc/html/rfc5880#section-6.8.7: it is reduced by 0 to 25%, or by 10 to 25% if the
+// detect multiplier is 1.
+func (s *session) txInterval() time.Duration {
+	interval := max(s.desiredMinTxInterval(), s.remoteMinRxInterval)
+	minReductionPercent := 0
+	if s.config.DetectMultiplier == 1 {
+		minReductionPercent = 10
+	}
+	reductionPercent := minReductionPercent + rand.IntN(25-minReductionPercent+1)
+	return interval - interval*time.Duration(reductionPercent)/100
+}
+
+// detectionTime returns the time after which the session goes down if no packet is received from the peer, in
+// asynchronous mode.
+func (s *session) detectionTime() time.Duration {
+	return time.Duration(s.remoteDetectMultiplier) * max(s.config.MinRxInterval, s.remoteDesiredMinTxInterval)
+}
+
+func (s *session) sendControlPacket(final bool) {
+	p := &controlPacket{
+		diagnostic:            s.diagnostic,
+		state:                 s.getState(),
+		poll:                  s.pollActive && !final,
+		final:                 final,
+		detectMultiplier:      s.config.DetectMultiplier,
+		myDiscriminator:       s.localDiscriminator,
+		yourDiscriminator:     s.remoteDiscriminator,
+		desiredMinTxInterval:  s.desiredMinTxInterval(),
+		requiredMinRxInterval: s.config.MinRxInterval,
+	}
+	if err := s.send(p.marshal()); err != nil {
+		klog.V(2).InfoS("Failed to send BFD Control packet", "peer", s.peerAddress, "err", err)
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bfd
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+const (
+	localDiscriminator  = 0x1111
+	remoteDiscriminator = 0x2222
+)
+
+var testConfig = Config{
+	MinTxInterval:    100 * time.Millisecond,
+	MinRxInterval:    100 * time.Millisecond,
+	DetectMultiplier: 3,
+}
+
+type testSession struct {
+	*session
+	clock        *clocktesting.FakeClock
+	sentCh       chan *controlPacket
+	stateChanges chan State
+}
+
+func newTestSession(t *testing.T) *testSession {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	sentCh := make(chan *controlPacket, 100)
+	stateChanges := make(chan State, 100)
+	s := newSession(netip.MustParseAddr("192.168.77.200"), localDiscriminator, testConfig, fakeClock,
+		func(b []byte) error {
+			p, err := unmarshalControlPacket(b)
+			require.NoError(t, err)
+			sentCh <- p
+			return nil
+		},
+		func(_, newState State) {
+			stateChanges <- newState
+		})
+	return &testSession{session: s, clock: fakeClock, sentCh: sentCh, stateChanges: stateChanges}
+}
+
+func (s *testSession) expectSent(t *testing.T) *controlPacket {
+	select {
+	case p := <-s.sentCh:
+		return p
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "No BFD Control packet was sent")
+	}
+	return nil
+}
+
+func (s *testSession) expectState(t *testing.T, state State) {
+	select {
+	case newState := <-s.stateChanges:
+		require.Equal(t, state, newState)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "BFD session state did not change", "expected state %s", state)
+	}
+}
+
+func (s *testSession) drainSent() {
+	for {
+		select {
+		case <-s.sentCh:
+		default:
+			return
+		}
+	}
+}
+
+func remotePacket(state State, yourDiscriminator uint32) *controlPacket {
+	return &controlPacket{
+		state:                 state,
+		detectMultiplier:      3,
+		myDiscriminator:       remoteDiscriminator,
+		yourDiscriminator:     yourDiscriminator,
+		desiredMinTxInterval:  100 * time.Millisecond,
+		requiredMinRxInterval: 100 * time.Millisecond,
+	}
+}
+
+func TestSessionStateMachine(t *testing.T) {
+	s := newTestSession(t)
+	go s.run()
+
+	// The session starts in the Down state, and advertises the slow TX interval.
+	p := s.expectSent(t)
+	assert.Equal(t, StateDown, p.state)
+	assert.Equal(t, uint32(localDiscriminator), p.myDiscriminator)
+	assert.Equal(t, uint32(0), p.yourDiscriminator)
+	assert.Equal(t, time.Second, p.desiredMinTxInterval)
+
+	// Down -> Init when the peer is Down.
+	s.receive(remotePacket(StateDown, 0))
+	s.expectState(t, StateInit)
+	p = s.expectSent(t)
+	assert.Equal(t, StateInit, p.state)
+	assert.Equal(t, uint32(remoteDiscriminator), p.yourDiscriminator)
+
+	// Init -> Up when the peer is Up. A Poll Sequence is started, as the TX interval is decreased.
+	s.receive(remotePacket(StateUp, localDiscriminator))
+	s.expectState(t, StateUp)
+	p = s.expectSent(t)
+	assert.Equal(t, StateUp, p.state)
+	assert.True(t, p.poll)
+	assert.Equal(t, 100*time.Millisecond, p.desiredMinTxInterval)
+
+	// The Poll Sequence ends when a packet with the Final bit is received.
+	final := remotePacket(StateUp, localDiscriminator)
+	final.final = true
+	s.receive(final)
+	// Packets with the Poll bit are answered immediately with the Final bit.
+	poll := remotePacket(StateUp, localDiscriminator)
+	poll.poll = true
+	s.receive(poll)
+	p = s.expectSent(t)
+	assert.True(t, p.final)
+	assert.False(t, p.poll)
+
+	// Up -> Down when no packet is received within the detection time.
+	require.Eventually(t, func() bool {
+		return s.clock.HasWaiters()
+	}, 5*time.Second, 10*time.Millisecond)
+	s.clock.Step(300 * time.Millisecond)
+	s.expectState(t, StateDown)
+	s.drainSent()
+	s.clock.Step(time.Second)
+	p = s.expectSent(t)
+	assert.Equal(t, StateDown, p.state)
+	assert.Equal(t, DiagnosticControlDetectionExpired, p.diagnostic)
+	assert.Equal(t, uint32(0), p.yourDiscriminator)
+
+	// Down -> Up when the peer is Init, and Up -> Down when the peer is AdminDown.
+	s.receive(remotePacket(StateInit, localDiscriminator))
+	s.expectState(t, StateUp)
+	s.receive(remotePacket(StateAdminDown, localDiscriminator))
+	s.expectState(t, StateDown)
+	s.drainSent()
+
+	// The peer is notified when the session is stopped.
+	s.stop()
+	s.expectState(t, StateAdminDown)
+	p = s.expectSent(t)
+	assert.Equal(t, StateAdminDown, p.state)
+	assert.Equal(t, DiagnosticAdminDown, p.diagnostic)
+}
+
+func TestSessionTxInterval(t *testing.T) {
+	s := newTestSession(t)
+	for range 100 {
+		interval := s.txInterval()
+		assert.GreaterOrEqual(t, interval, 750*time.Millisecond)
+		assert.LessOrEqual(t, interval, time.Second)
+	}
+
+	s.state = StateUp
+	s.remoteMinRxInterval = 200 * time.Millisecond
+	for range 100 {
+		interval := s.txInterval()
+		assert.GreaterOrEqual(t, interval, 150*time.Millisecond)
+		assert.LessOrEqual(t, interval, 200*time.Millisecond)
+	}
+
+	s.config.DetectMultiplier = 1
+	for range 100 {
+		interval := s.txInterval()
+		assert.GreaterOrEqual(t, interval, 150*time.Millisecond)
+		assert.LessOrEqual(t, interval, 180*time.Millisecond)
+	}
+}
+
+func TestSessionDetectionTime(t *testing.T) {
+	s := newTestSession(t)
+	s.handleControlPacket(&controlPacket{
+		state:                StateDown,
+		detectMultiplier:     5,
+		myDiscriminator:      remoteDiscriminator,
+		desiredMinTxInterval: 50 * time.Millisecond,
+	})
+	// The larger of the local RX interval and the remote TX interval is used.
+	assert.Equal(t, 500*time.Millisecond, s.detectionTime())
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gobgp
+
+import (
+	"context"
+	"time"
+
+	gobgpapi "github.com/osrg/gobgp/v3/api"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
+)
+
+// goBGP does not support BFD. The BFD sessions are managed separately, and the BGP session with a peer is reset when
+// the BFD session with the peer goes down.
+
+const (
+	defaultBFDMinTransmitInterval = 300 * time.Millisecond
+	defaultBFDMinReceiveInterval  = 300 * time.Millisecond
+	defaultBFDDetectMultiplier    = 3
+)
+
+// convertPeerConfigToBFDConfig returns the configuration of the BFD session with a BGP peer, or nil if BFD is not
+// enabled for the peer.
+func convertPeerConfigToBFDConfig(peerConfig bgp.PeerConfig) *bfd.Config {
+	if peerConfig.BFD == nil {
+		return nil
+	}
+	config := &bfd.Config{
+		MinTxInterval:    defaultBFDMinTransmitInterval,
+		MinRxInterval:    defaultBFDMinReceiveInterval,
+		DetectMultiplier: defaultBFDDetectMultiplier,
+		Multihop:         peerConfig.MultihopTTL != nil && *peerConfig.MultihopTTL > 1,
+	}
+	if peerConfig.BFD.MinTransmitIntervalMilliseconds != nil {
+		config.MinTxInterval = time.Duration(*peerConfig.BFD.MinTransmitIntervalMilliseconds) * time.Millisecond
+	}
+	if peerConfig.BFD.MinReceiveIntervalMilliseconds != nil {
+		config.MinRxInterval = time.Duration(*peerConfig.BFD.MinReceiveIntervalMilliseconds) * time.Millisecond
+	}
+	if peerConfig.BFD.DetectMultiplier != nil {
+		config.DetectMultiplier = uint8(*peerConfig.BFD.DetectMultiplier)
+	}
+	return config
+}
+
+// syncBFDSession creates, updates or removes the BFD session with a BGP peer according to its configuration.
+func (s *Server) syncBFDSession(peerConfig bgp.PeerConfig) error {
+	bfdConfig := convertPeerConfigToBFDConfig(peerConfig)
+	if bfdConfig == nil {
+		s.bfdManager.RemoveSession(peerConfig.Address)
+		return nil
+	}
+	return s.bfdManager.AddOrUpdateSession(peerConfig.Address, *bfdConfig)
+}
+
+// handleBFDStateChange resets the BGP session with a peer when the BFD session with the peer goes down, so that the
+// routes received from the peer are withdrawn without waiting for the BGP hold timer to expire.
+func (s *Server) handleBFDStateChange(peerAddress string, oldState, newState bfd.State) {
+	if oldState != bfd.StateUp || newState != bfd.StateDown {
+		return
+	}
+	klog.InfoS("BFD session with BGP peer is down, resetting BGP session", "peer", peerAddress)
+	// The handler must not block the BFD session.
+	go func() {
+		request := &gobgpapi.ResetPeerRequest{Address: peerAddress, Communication: "BFD session down"}
+		if err := s.server.ResetPeer(context.Background(), request); err != nil {
+			klog.ErrorS(err, "Failed to reset BGP session after BFD session went down", "peer", peerAddress)
+		}
+	}()
+}
+
+func convertBFDStateToBFDSessionState(state bfd.State) bgp.BFDSessionState {
+	switch state {
+	case bfd.StateAdminDown:
+		return bgp.BFDSessionAdminDown
+	case bfd.StateDown:
+		return bgp.BFDSessionDown
+	case bfd.StateInit:
+		return bgp.BFDSessionInit
+	case bfd.StateUp:
+		return bgp.BFDSessionUp
+	default:
+		return bgp.BFDSessionDown
+	}
+}
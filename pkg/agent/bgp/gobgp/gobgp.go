@@ -23,19 +23,41 @@ import (
 	gobgpapi "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/pkg/server"
 	"google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/net"
 
 	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
 const (
 	ipv4AllZero = "0.0.0.0"
 	ipv6AllZero = "::"
+
+	// minHoldTimeSeconds is the minimum non-zero hold time allowed by RFC 4271.
+	minHoldTimeSeconds = 3
 )
 
 type Server struct {
 	server       *server.BgpServer
 	globalConfig *gobgpapi.Global
+	// exportPolicyNames and importPolicyNames are the names of the policies implementing the export and import
+	// policies of BGP peers.
+	exportPolicyNames sets.Set[string]
+	importPolicyNames sets.Set[string]
+	// peerDefinedSets stores the defined sets used by the policies of each BGP peer, keyed by peer address.
+	peerDefinedSets map[string][]*gobgpapi.DefinedSet
+	// peerTimers stores the timers configured for each BGP peer, keyed by peer address. goBGP only applies the new
+	// hold time and keepalive interval when the session is re-established.
+	peerTimers map[string]*gobgpapi.TimersConfig
+	// peerFamilies stores the address families enabled for each BGP peer, keyed by peer address.
+	peerFamilies map[string][]*gobgpapi.Family
+	bfdManager   *bfd.Manager
+	// ipv4NextHop and ipv6NextHop are the next hops of the advertised routes. They are the all-zero addresses when
+	// the local address of the BGP session should be used.
+	ipv4NextHop string
+	ipv6NextHop string
 }
 
 func NewGoBGPServer(globalConfig *bgp.GlobalConfig) *Server {
@@ -46,7 +68,21 @@ func NewGoBGPServer(globalConfig *bgp.GlobalConfig) *Server {
 			RouterId:   globalConfig.RouterID,
 			ListenPort: globalConfig.ListenPort,
 		},
+		exportPolicyNames: sets.New[string](),
+		importPolicyNames: sets.New[string](),
+		peerDefinedSets:   make(map[string][]*gobgpapi.DefinedSet),
+		peerTimers:        make(map[string]*gobgpapi.TimersConfig),
+		peerFamilies:      make(map[string][]*gobgpapi.Family),
+		ipv4NextHop:       ipv4AllZero,
+		ipv6NextHop:       ipv6AllZero,
+	}
+	if globalConfig.IPv4NextHop != "" {
+		s.ipv4NextHop = globalConfig.IPv4NextHop
+	}
+	if globalConfig.IPv6NextHop != "" {
+		s.ipv6NextHop = globalConfig.IPv6NextHop
 	}
+	s.bfdManager = bfd.NewManager(s.handleBFDStateChange)
 	if globalConfig.Confederation != nil {
 		s.globalConfig.Confederation = &gobgpapi.Confederation{
 			Enabled:      true,
@@ -66,6 +102,7 @@ func (s *Server) Start(ctx context.Context) error {
 }
 
 func (s *Server) Stop(ctx context.Context) error {
+	s.bfdManager.Stop()
 	if err := s.server.StopBgp(ctx, &gobgpapi.StopBgpRequest{}); err != nil {
 		return err
 	}
@@ -77,10 +114,19 @@ func (s *Server) AddPeer(ctx context.Context, peerConf bgp.PeerConfig) error {
 	if err != nil {
 		return err
 	}
+	// The policies are installed before the peer is added, so that they apply to the initial route exchange.
+	if err := s.setPeerPolicies(ctx, peerConf.Address, peerConf.ExportPolicy, peerConf.ImportPolicy); err != nil {
+		return err
+	}
 	request := &gobgpapi.AddPeerRequest{Peer: peer}
 	if err := s.server.AddPeer(ctx, request); err != nil {
 		return err
 	}
+	s.peerTimers[peerConf.Address] = peer.GetTimers().GetConfig()
+	s.peerFamilies[peerConf.Address] = getGoBGPPeerFamilies(peer)
+	if err := s.syncBFDSession(peerConf); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -89,10 +135,39 @@ func (s *Server) UpdatePeer(ctx context.Context, peerConf bgp.PeerConfig) error
 	if err != nil {
 		return err
 	}
+	if err := s.setPeerPolicies(ctx, peerConf.Address, peerConf.ExportPolicy, peerConf.ImportPolicy); err != nil {
+		return err
+	}
+	// goBGP re-establishes the session when the address families are updated, as they are negotiated in the OPEN
+	// message.
 	request := &gobgpapi.UpdatePeerRequest{Peer: peer}
 	if _, err := s.server.UpdatePeer(ctx, request); err != nil {
 		return err
 	}
+	s.peerFamilies[peerConf.Address] = getGoBGPPeerFamilies(peer)
+	if err := s.syncBFDSession(peerConf); err != nil {
+		return err
+	}
+	// Policy changes are not applied to the routes which have already been exchanged with the peer, a soft reset is
+	// required to re-evaluate them. A hard reset is required when the hold time or the keepalive interval change, as
+	// they are negotiated when the session is established.
+	resetRequest := &gobgpapi.ResetPeerRequest{
+		Address:   peerConf.Address,
+		Soft:      true,
+		Direction: gobgpapi.ResetPeerRequest_BOTH,
+	}
+	timers := peer.GetTimers().GetConfig()
+	if oldTimers := s.peerTimers[peerConf.Address]; oldTimers.GetHoldTime() != timers.GetHoldTime() ||
+		oldTimers.GetKeepaliveInterval() != timers.GetKeepaliveInterval() {
+		resetRequest = &gobgpapi.ResetPeerRequest{
+			Address:       peerConf.Address,
+			Communication: "BGP timers updated",
+		}
+	}
+	s.peerTimers[peerConf.Address] = timers
+	if err := s.server.ResetPeer(ctx, resetRequest); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -101,6 +176,12 @@ func (s *Server) RemovePeer(ctx context.Context, peerConf bgp.PeerConfig) error
 	if err := s.server.DeletePeer(ctx, request); err != nil {
 		return err
 	}
+	s.bfdManager.RemoveSession(peerConf.Address)
+	delete(s.peerTimers, peerConf.Address)
+	delete(s.peerFamilies, peerConf.Address)
+	if err := s.removePeerPolicies(ctx, peerConf.Address); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -109,6 +190,9 @@ func (s *Server) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 	fn := func(peer *gobgpapi.Peer) {
 		peerStatus := convertGoBGPPeerToPeerStatus(peer)
 		if peerStatus != nil {
+			if bfdState, exists := s.bfdManager.GetSessionState(peerStatus.Address); exists {
+				peerStatus.BFDState = convertBFDStateToBFDSessionState(bfdState)
+			}
 			peerStatuses = append(peerStatuses, *peerStatus)
 		}
 	}
@@ -121,7 +205,7 @@ func (s *Server) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 
 func (s *Server) AdvertiseRoutes(ctx context.Context, routes []bgp.Route) error {
 	for i := range routes {
-		request := &gobgpapi.AddPathRequest{Path: convertRouteToGoBGPPath(&routes[i])}
+		request := &gobgpapi.AddPathRequest{Path: s.convertRouteToGoBGPPath(&routes[i])}
 		if _, err := s.server.AddPath(ctx, request); err != nil {
 			return err
 		}
@@ -131,7 +215,7 @@ func (s *Server) AdvertiseRoutes(ctx context.Context, routes []bgp.Route) error
 
 func (s *Server) WithdrawRoutes(ctx context.Context, routes []bgp.Route) error {
 	for i := range routes {
-		request := &gobgpapi.DeletePathRequest{Path: convertRouteToGoBGPPath(&routes[i])}
+		request := &gobgpapi.DeletePathRequest{Path: s.convertRouteToGoBGPPath(&routes[i])}
 		if err := s.server.DeletePath(ctx, request); err != nil {
 			return err
 		}
@@ -150,17 +234,56 @@ func (s *Server) GetRoutes(ctx context.Context, routeType bgp.RouteType, peerAdd
 			routes = append(routes, *route)
 		}
 	}
-	request := &gobgpapi.ListPathRequest{
-		TableType: convertRouteTypeToGoBGPTableType(routeType),
-		Family:    &gobgpapi.Family{Afi: convertToGoBGPFamilyAfi(net.IsIPv6String(peerAddress)), Safi: gobgpapi.Family_SAFI_UNICAST},
-		Name:      peerAddress,
+	families, exists := s.peerFamilies[peerAddress]
+	if !exists {
+		families = []*gobgpapi.Family{{Afi: convertToGoBGPFamilyAfi(net.IsIPv6String(peerAddress)), Safi: gobgpapi.Family_SAFI_UNICAST}}
 	}
-	if err := s.server.ListPath(ctx, request, fn); err != nil {
-		return nil, err
+	for _, family := range families {
+		request := &gobgpapi.ListPathRequest{
+			TableType: convertRouteTypeToGoBGPTableType(routeType),
+			Family:    family,
+			Name:      peerAddress,
+		}
+		if err := s.server.ListPath(ctx, request, fn); err != nil {
+			return nil, err
+		}
 	}
 	return routes, nil
 }
 
+func (s *Server) GetBestReceivedRoutes(ctx context.Context) ([]bgp.ReceivedRoute, error) {
+	var routes []bgp.ReceivedRoute
+	fn := func(destination *gobgpapi.Destination) {
+		route := convertGoBGPDestinationToReceivedRoute(destination)
+		if route != nil {
+			routes = append(routes, *route)
+		}
+	}
+	for _, isIPv6 := range []bool{false, true} {
+		request := &gobgpapi.ListPathRequest{
+			TableType: gobgpapi.TableType_GLOBAL,
+			Family:    &gobgpapi.Family{Afi: convertToGoBGPFamilyAfi(isIPv6), Safi: gobgpapi.Family_SAFI_UNICAST},
+		}
+		if err := s.server.ListPath(ctx, request, fn); err != nil {
+			return nil, err
+		}
+	}
+	return routes, nil
+}
+
+func (s *Server) WatchReceivedRoutes(ctx context.Context, handler func()) error {
+	request := &gobgpapi.WatchEventRequest{
+		Table: &gobgpapi.WatchEventRequest_Table{
+			Filters: []*gobgpapi.WatchEventRequest_Table_Filter{
+				{Type: gobgpapi.WatchEventRequest_Table_Filter_BEST},
+			},
+		},
+	}
+	return s.server.WatchEvent(ctx, request, func(*gobgpapi.WatchEventResponse) {
+		handler()
+	})
+}
+
 func convertGoBGPPeerToPeerStatus(peer *gobgpapi.Peer) *bgp.PeerStatus {
 	if peer == nil {
 		return nil
@@ -187,8 +310,15 @@ func convertGoBGPPeerToPeerStatus(peer *gobgpapi.Peer) *bgp.PeerStatus {
 			if timers := peer.GetTimers(); timers != nil {
 				if timerState := timers.GetState(); timerState != nil {
 					peerStatus.UptimeSeconds = int(time.Since(timerState.GetUptime().AsTime()).Seconds())
+					peerStatus.HoldTimeSeconds = int32(timerState.GetNegotiatedHoldTime())
+					peerStatus.KeepaliveTimeSeconds = int32(timerState.GetKeepaliveInterval())
 				}
 			}
+		} else if timers := peer.GetTimers(); timers != nil {
+			if timerConfig := timers.GetConfig(); timerConfig != nil {
+				peerStatus.HoldTimeSeconds = int32(timerConfig.GetHoldTime())
+				peerStatus.KeepaliveTimeSeconds = int32(timerConfig.GetKeepaliveInterval())
+			}
 		}
 	}
 	return peerStatus
@@ -202,6 +332,52 @@ func convertGoBGPDestinationToRoute(destination *gobgpapi.Destination) *bgp.Rout
 	return route
 }
 
+// convertGoBGPDestinationToReceivedRoute returns the best path of the destination if it was received from a BGP peer,
+// or nil if the best path is originated locally.
+func convertGoBGPDestinationToReceivedRoute(destination *gobgpapi.Destination) *bgp.ReceivedRoute {
+	if destination == nil {
+		return nil
+	}
+	for _, path := range destination.GetPaths() {
+		if !path.GetBest() {
+			continue
+		}
+		if path.GetIsWithdraw() || path.GetIsNexthopInvalid() {
+			return nil
+		}
+		// The neighbor IP of locally originated paths is not set.
+		peer, err := netip.ParseAddr(path.GetNeighborIp())
+		if err != nil {
+			return nil
+		}
+		nextHop := getGoBGPPathNextHop(path)
+		if nextHop == "" {
+			return nil
+		}
+		return &bgp.ReceivedRoute{
+			Prefix:  destination.GetPrefix(),
+			NextHop: nextHop,
+			Peer:    peer.String(),
+		}
+	}
+	return nil
+}
+
+func getGoBGPPathNextHop(path *gobgpapi.Path) string {
+	for _, attr := range path.GetPattrs() {
+		nextHopAttr := &gobgpapi.NextHopAttribute{}
+		if attr.MessageIs(nextHopAttr) && attr.UnmarshalTo(nextHopAttr) == nil {
+			return nextHopAttr.GetNextHop()
+		}
+		mpReachAttr := &gobgpapi.MpReachNLRIAttribute{}
+		if attr.MessageIs(mpReachAttr) && attr.UnmarshalTo(mpReachAttr) == nil && len(mpReachAttr.GetNextHops()) > 0 {
+			// The first next hop is the global address, the second one, if present, is the link-local address.
+			return mpReachAttr.GetNextHops()[0]
+		}
+	}
+	return ""
+}
+
 func convertRouteTypeToGoBGPTableType(routeType bgp.RouteType) gobgpapi.TableType {
 	if routeType == bgp.RouteAdvertised {
 		return gobgpapi.TableType_ADJ_OUT
@@ -209,7 +385,7 @@ func convertRouteTypeToGoBGPTableType(routeType bgp.RouteType) gobgpapi.TableTyp
 	return gobgpapi.TableType_ADJ_IN
 }
 
-func convertRouteToGoBGPPath(route *bgp.Route) *gobgpapi.Path {
+func (s *Server) convertRouteToGoBGPPath(route *bgp.Route) *gobgpapi.Path {
 	isIPv6 := net.IsIPv6CIDRString(route.Prefix)
 	goBGPIPFamily := convertToGoBGPFamilyAfi(isIPv6)
 	prefix, _ := netip.ParsePrefix(route.Prefix)
@@ -226,12 +402,12 @@ func convertRouteToGoBGPPath(route *bgp.Route) *gobgpapi.Path {
 	if isIPv6 {
 		a2, _ = anypb.New(&gobgpapi.MpReachNLRIAttribute{
 			Family:   &gobgpapi.Family{Afi: goBGPIPFamily, Safi: gobgpapi.Family_SAFI_UNICAST},
-			NextHops: []string{ipv6AllZero},
+			NextHops: []string{s.ipv6NextHop},
 			Nlris:    []*anypb.Any{nlri},
 		})
 	} else {
 		a2, _ = anypb.New(&gobgpapi.NextHopAttribute{
-			NextHop: ipv4AllZero,
+			NextHop: s.ipv4NextHop,
 		})
 	}
 	attrs = append(attrs, a1, a2)
@@ -260,28 +436,29 @@ func convertPeerConfigToGoBGPPeer(peerConfig bgp.PeerConfig) (*gobgpapi.Peer, er
 		return nil, fmt.Errorf("invalid peer ASN: %d", peerConfig.ASN)
 	}
 
+	families, err := convertAddressFamiliesToGoBGPFamilies(peerConfig)
+	if err != nil {
+		return nil, err
+	}
 	peer := &gobgpapi.Peer{
 		Conf: &gobgpapi.PeerConf{
 			NeighborAddress: peerConfig.Address,
 			PeerAsn:         uint32(peerConfig.ASN),
 			AuthPassword:    peerConfig.Password,
 		},
-		AfiSafis: []*gobgpapi.AfiSafi{
-			{
-				Config: &gobgpapi.AfiSafiConfig{
-					Family: &gobgpapi.Family{
-						Afi:  convertToGoBGPFamilyAfi(net.IsIPv6String(peerConfig.Address)),
-						Safi: gobgpapi.Family_SAFI_UNICAST,
-					},
+	}
+	for _, family := range families {
+		peer.AfiSafis = append(peer.AfiSafis, &gobgpapi.AfiSafi{
+			Config: &gobgpapi.AfiSafiConfig{
+				Family:  family,
+				Enabled: true,
+			},
+			MpGracefulRestart: &gobgpapi.MpGracefulRestart{
+				Config: &gobgpapi.MpGracefulRestartConfig{
 					Enabled: true,
 				},
-				MpGracefulRestart: &gobgpapi.MpGracefulRestart{
-					Config: &gobgpapi.MpGracefulRestartConfig{
-						Enabled: true,
-					},
-				},
 			},
-		},
+		})
 	}
 	// The following pointer fields are set to default values when the corresponding BGPPolicy is created, so they
 	// should not be nil. However, it is safe and harmless to include nil checks.
@@ -302,9 +479,56 @@ func convertPeerConfigToGoBGPPeer(peerConfig bgp.PeerConfig) (*gobgpapi.Peer, er
 			RestartTime: uint32(*peerConfig.GracefulRestartTimeSeconds),
 		}
 	}
+	// The timers are optional, and goBGP uses its default values for the ones which are 0. The keepalive interval
+	// defaults to one third of the hold time. Note that a hold time of 0 cannot be used to disable the hold timer, as
+	// goBGP would replace it with its default value, so it is rejected.
+	if peerConfig.HoldTimeSeconds != nil && *peerConfig.HoldTimeSeconds < minHoldTimeSeconds {
+		return nil, fmt.Errorf("invalid hold time %d for BGP peer %s, it must be at least %d seconds", *peerConfig.HoldTimeSeconds, peerConfig.Address, minHoldTimeSeconds)
+	}
+	if peerConfig.HoldTimeSeconds != nil || peerConfig.KeepaliveTimeSeconds != nil || peerConfig.ConnectRetryTimeSeconds != nil {
+		timersConfig := &gobgpapi.TimersConfig{}
+		if peerConfig.HoldTimeSeconds != nil {
+			timersConfig.HoldTime = uint64(*peerConfig.HoldTimeSeconds)
+		}
+		if peerConfig.KeepaliveTimeSeconds != nil {
+			timersConfig.KeepaliveInterval = uint64(*peerConfig.KeepaliveTimeSeconds)
+		}
+		if peerConfig.ConnectRetryTimeSeconds != nil {
+			timersConfig.ConnectRetry = uint64(*peerConfig.ConnectRetryTimeSeconds)
+		}
+		peer.Timers = &gobgpapi.Timers{Config: timersConfig}
+	}
 	return peer, nil
 }
 
+// convertAddressFamiliesToGoBGPFamilies returns the goBGP families enabled for a BGP peer. The address family matching
+// the IP family of the peer address is used when no address family is configured.
+func convertAddressFamiliesToGoBGPFamilies(peerConfig bgp.PeerConfig) ([]*gobgpapi.Family, error) {
+	if len(peerConfig.AddressFamilies) == 0 {
+		return []*gobgpapi.Family{{Afi: convertToGoBGPFamilyAfi(net.IsIPv6String(peerConfig.Address)), Safi: gobgpapi.Family_SAFI_UNICAST}}, nil
+	}
+	var families []*gobgpapi.Family
+	for _, addressFamily := range peerConfig.AddressFamilies {
+		switch addressFamily {
+		case v1alpha1.BGPAddressFamilyIPv4Unicast:
+			families = append(families, &gobgpapi.Family{Afi: gobgpapi.Family_AFI_IP, Safi: gobgpapi.Family_SAFI_UNICAST})
+		case v1alpha1.BGPAddressFamilyIPv6Unicast:
+			families = append(families, &gobgpapi.Family{Afi: gobgpapi.Family_AFI_IP6, Safi: gobgpapi.Family_SAFI_UNICAST})
+		default:
+			return nil, fmt.Errorf("invalid address family: %s", addressFamily)
+		}
+	}
+	return families, nil
+}
+
+func getGoBGPPeerFamilies(peer *gobgpapi.Peer) []*gobgpapi.Family {
+	families := make([]*gobgpapi.Family, 0, len(peer.GetAfiSafis()))
+	for _, afiSafi := range peer.GetAfiSafis() {
+		families = append(families, afiSafi.GetConfig().GetFamily())
+	}
+	return families
+}
+
 func convertGoBGPSessionStateToSessionState(s gobgpapi.PeerState_SessionState) bgp.SessionState {
 	switch s {
 	case gobgpapi.PeerState_UNKNOWN:
@@ -19,11 +19,14 @@ import (
 	"time"
 
 	gobgpapi "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/agent/bgp/bfd"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
@@ -58,8 +61,14 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 					SessionState: gobgpapi.PeerState_ESTABLISHED,
 				},
 				Timers: &gobgpapi.Timers{
+					Config: &gobgpapi.TimersConfig{
+						HoldTime:          90,
+						KeepaliveInterval: 30,
+					},
 					State: &gobgpapi.TimersState{
-						Uptime: &timestamppb.Timestamp{Seconds: time.Now().Unix() - 3600},
+						Uptime:             &timestamppb.Timestamp{Seconds: time.Now().Unix() - 3600},
+						NegotiatedHoldTime: 9,
+						KeepaliveInterval:  3,
 					},
 				},
 			},
@@ -71,6 +80,8 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 				GracefulRestartTimeSeconds: 120,
 				SessionState:               bgp.SessionEstablished,
 				UptimeSeconds:              3600,
+				HoldTimeSeconds:            9,
+				KeepaliveTimeSeconds:       3,
 			},
 		},
 		{
@@ -86,13 +97,21 @@ func TestConvertGoBGPPeerToPeerStatus(t *testing.T) {
 				State: &gobgpapi.PeerState{
 					SessionState: gobgpapi.PeerState_IDLE,
 				},
+				Timers: &gobgpapi.Timers{
+					Config: &gobgpapi.TimersConfig{
+						HoldTime:          90,
+						KeepaliveInterval: 30,
+					},
+				},
 			},
 
 			expected: &bgp.PeerStatus{
-				Address:      "192.168.1.1",
-				ASN:          65001,
-				Port:         179,
-				SessionState: bgp.SessionIdle,
+				Address:              "192.168.1.1",
+				ASN:                  65001,
+				Port:                 179,
+				SessionState:         bgp.SessionIdle,
+				HoldTimeSeconds:      90,
+				KeepaliveTimeSeconds: 30,
 			},
 		},
 	}
@@ -135,6 +154,80 @@ func TestConvertGoBGPDestinationToRoute(t *testing.T) {
 	}
 }
 
+func TestConvertGoBGPDestinationToReceivedRoute(t *testing.T) {
+	nextHopAttr, _ := anypb.New(&gobgpapi.NextHopAttribute{NextHop: "192.168.77.200"})
+	mpReachAttr, _ := anypb.New(&gobgpapi.MpReachNLRIAttribute{NextHops: []string{"fec0::192:168:77:200", "fe80::1"}})
+	tests := []struct {
+		name        string
+		destination *gobgpapi.Destination
+		expected    *bgp.ReceivedRoute
+	}{
+		{
+			name:        "Nil destination",
+			destination: nil,
+			expected:    nil,
+		},
+		{
+			name: "IPv4 best path",
+			destination: &gobgpapi.Destination{
+				Prefix: "172.16.0.0/16",
+				Paths: []*gobgpapi.Path{
+					{Best: true, NeighborIp: "192.168.77.200", Pattrs: []*anypb.Any{nextHopAttr}},
+					{NeighborIp: "192.168.77.201", Pattrs: []*anypb.Any{nextHopAttr}},
+				},
+			},
+			expected: &bgp.ReceivedRoute{Prefix: "172.16.0.0/16", NextHop: "192.168.77.200", Peer: "192.168.77.200"},
+		},
+		{
+			name: "IPv6 best path",
+			destination: &gobgpapi.Destination{
+				Prefix: "fd00:172:16::/48",
+				Paths: []*gobgpapi.Path{
+					{Best: true, NeighborIp: "fec0::192:168:77:200", Pattrs: []*anypb.Any{mpReachAttr}},
+				},
+			},
+			expected: &bgp.ReceivedRoute{Prefix: "fd00:172:16::/48", NextHop: "fec0::192:168:77:200", Peer: "fec0::192:168:77:200"},
+		},
+		{
+			name: "Locally originated path",
+			destination: &gobgpapi.Destination{
+				Prefix: "10.10.0.0/24",
+				Paths: []*gobgpapi.Path{
+					{Best: true, NeighborIp: "<nil>", Pattrs: []*anypb.Any{nextHopAttr}},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Invalid next hop",
+			destination: &gobgpapi.Destination{
+				Prefix: "172.16.0.0/16",
+				Paths: []*gobgpapi.Path{
+					{Best: true, IsNexthopInvalid: true, NeighborIp: "192.168.77.200", Pattrs: []*anypb.Any{nextHopAttr}},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "No best path",
+			destination: &gobgpapi.Destination{
+				Prefix: "172.16.0.0/16",
+				Paths: []*gobgpapi.Path{
+					{NeighborIp: "192.168.77.200", Pattrs: []*anypb.Any{nextHopAttr}},
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := convertGoBGPDestinationToReceivedRoute(tt.destination)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
 func TestConvertRouteTypeToGoBGPTableType(t *testing.T) {
 	tableType := convertRouteTypeToGoBGPTableType(bgp.RouteAdvertised)
 	assert.Equal(t, gobgpapi.TableType_ADJ_OUT, tableType)
@@ -144,8 +237,9 @@ func TestConvertRouteTypeToGoBGPTableType(t *testing.T) {
 }
 
 func TestConvertRouteToGoBGPPath(t *testing.T) {
+	s := NewGoBGPServer(&bgp.GlobalConfig{})
 	route4 := &bgp.Route{Prefix: "192.168.0.0/24"}
-	path4 := convertRouteToGoBGPPath(route4)
+	path4 := s.convertRouteToGoBGPPath(route4)
 
 	ipAddressPrefix4 := &gobgpapi.IPAddressPrefix{}
 	assert.NoError(t, path4.GetNlri().UnmarshalTo(ipAddressPrefix4))
@@ -154,13 +248,19 @@ func TestConvertRouteToGoBGPPath(t *testing.T) {
 	assert.Equal(t, gobgpapi.Family_AFI_IP, path4.GetFamily().Afi)
 
 	route6 := &bgp.Route{Prefix: "2001:db8::/64"}
-	path6 := convertRouteToGoBGPPath(route6)
+	path6 := s.convertRouteToGoBGPPath(route6)
 
 	ipAddressPrefix6 := &gobgpapi.IPAddressPrefix{}
 	assert.NoError(t, path6.GetNlri().UnmarshalTo(ipAddressPrefix6))
 	assert.Equal(t, "2001:db8::", ipAddressPrefix6.Prefix)
 	assert.Equal(t, uint32(64), ipAddressPrefix6.PrefixLen)
 	assert.Equal(t, gobgpapi.Family_AFI_IP6, path6.GetFamily().Afi)
+	assert.Equal(t, ipv6AllZero, getGoBGPPathNextHop(path6))
+	assert.Equal(t, ipv4AllZero, getGoBGPPathNextHop(path4))
+
+	s = NewGoBGPServer(&bgp.GlobalConfig{IPv4NextHop: "192.168.77.100", IPv6NextHop: "fec0::192:168:77:100"})
+	assert.Equal(t, "192.168.77.100", getGoBGPPathNextHop(s.convertRouteToGoBGPPath(route4)))
+	assert.Equal(t, "fec0::192:168:77:100", getGoBGPPathNextHop(s.convertRouteToGoBGPPath(route6)))
 }
 
 func TestConvertPeerConfigToGoBGPPeer(t *testing.T) {
@@ -183,7 +283,188 @@ func TestConvertPeerConfigToGoBGPPeer(t *testing.T) {
 	assert.Equal(t, uint32(179), peer.GetTransport().GetRemotePort())
 	assert.Equal(t, uint32(2), peer.GetEbgpMultihop().GetMultihopTtl())
 	assert.Equal(t, uint32(120), peer.GetGracefulRestart().GetRestartTime())
+	assert.Nil(t, peer.GetTimers())
+
+	peerConfig.HoldTimeSeconds = ptr.To(int32(9))
+	peerConfig.KeepaliveTimeSeconds = ptr.To(int32(3))
+	peerConfig.ConnectRetryTimeSeconds = ptr.To(int32(10))
+	peer, err = convertPeerConfigToGoBGPPeer(peerConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, &gobgpapi.TimersConfig{HoldTime: 9, KeepaliveInterval: 3, ConnectRetry: 10}, peer.GetTimers().GetConfig())
+
+	peerConfig.HoldTimeSeconds = ptr.To(int32(0))
+	_, err = convertPeerConfigToGoBGPPeer(peerConfig)
+	assert.ErrorContains(t, err, "it must be at least 3 seconds")
+}
+
+// TestPeerHoldTimeWithGoBGPDefaults checks the hold time which is eventually used by goBGP for the peer, after goBGP has
+// applied its default values to the timers.
+func TestPeerHoldTimeWithGoBGPDefaults(t *testing.T) {
+	tests := []struct {
+		name              string
+		holdTimeSeconds   *int32
+		expectedHoldTime  float64
+		expectedKeepalive float64
+	}{
+		{
+			name:              "default hold time",
+			expectedHoldTime:  oc.DEFAULT_HOLDTIME,
+			expectedKeepalive: oc.DEFAULT_HOLDTIME / 3,
+		},
+		{
+			name:              "minimum hold time",
+			holdTimeSeconds:   ptr.To(int32(3)),
+			expectedHoldTime:  3,
+			expectedKeepalive: 1,
+		},
+		{
+			name:              "custom hold time",
+			holdTimeSeconds:   ptr.To(int32(30)),
+			expectedHoldTime:  30,
+			expectedKeepalive: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peerConfig := bgp.PeerConfig{
+				BGPPeer: &v1alpha1.BGPPeer{
+					Address:         "192.168.0.1",
+					ASN:             65000,
+					HoldTimeSeconds: tt.holdTimeSeconds,
+				},
+			}
+			peer, err := convertPeerConfigToGoBGPPeer(peerConfig)
+			assert.NoError(t, err)
+			neighbor := &oc.Neighbor{
+				Config: oc.NeighborConfig{
+					NeighborAddress: peer.GetConf().GetNeighborAddress(),
+					PeerAs:          peer.GetConf().GetPeerAsn(),
+				},
+				Timers: oc.Timers{
+					Config: oc.TimersConfig{
+						HoldTime:          float64(peer.GetTimers().GetConfig().GetHoldTime()),
+						KeepaliveInterval: float64(peer.GetTimers().GetConfig().GetKeepaliveInterval()),
+					},
+				},
+			}
+			global := &oc.Global{Config: oc.GlobalConfig{As: 64512, RouterId: "10.0.0.1"}}
+			assert.NoError(t, oc.SetDefaultNeighborConfigValues(neighbor, nil, global))
+			assert.Equal(t, tt.expectedHoldTime, neighbor.Timers.Config.HoldTime)
+			assert.Equal(t, tt.expectedKeepalive, neighbor.Timers.Config.KeepaliveInterval)
+		})
+	}
+}
 
+func TestConvertAddressFamiliesToGoBGPFamilies(t *testing.T) {
+	ipv4Family := &gobgpapi.Family{Afi: gobgpapi.Family_AFI_IP, Safi: gobgpapi.Family_SAFI_UNICAST}
+	ipv6Family := &gobgpapi.Family{Afi: gobgpapi.Family_AFI_IP6, Safi: gobgpapi.Family_SAFI_UNICAST}
+	tests := []struct {
+		name             string
+		address          string
+		addressFamilies  []v1alpha1.BGPAddressFamily
+		expectedFamilies []*gobgpapi.Family
+		expectedErr      string
+	}{
+		{
+			name:             "IPv4 peer without address families",
+			address:          "192.168.0.1",
+			expectedFamilies: []*gobgpapi.Family{ipv4Family},
+		},
+		{
+			name:             "IPv6 peer without address families",
+			address:          "fec0::1",
+			expectedFamilies: []*gobgpapi.Family{ipv6Family},
+		},
+		{
+			name:             "IPv4 peer with IPv4 and IPv6 address families",
+			address:          "192.168.0.1",
+			addressFamilies:  []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv4Unicast, v1alpha1.BGPAddressFamilyIPv6Unicast},
+			expectedFamilies: []*gobgpapi.Family{ipv4Family, ipv6Family},
+		},
+		{
+			name:             "IPv4 peer with IPv6 address family",
+			address:          "192.168.0.1",
+			addressFamilies:  []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv6Unicast},
+			expectedFamilies: []*gobgpapi.Family{ipv6Family},
+		},
+		{
+			name:            "invalid address family",
+			address:         "192.168.0.1",
+			addressFamilies: []v1alpha1.BGPAddressFamily{"L2VPN"},
+			expectedErr:     "invalid address family: L2VPN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peerConfig := bgp.PeerConfig{
+				BGPPeer:         &v1alpha1.BGPPeer{Address: tt.address, ASN: 65000},
+				AddressFamilies: tt.addressFamilies,
+			}
+			families, err := convertAddressFamiliesToGoBGPFamilies(peerConfig)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFamilies, families)
+
+			peer, err := convertPeerConfigToGoBGPPeer(peerConfig)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedFamilies, getGoBGPPeerFamilies(peer))
+		})
+	}
+}
+
+func TestConvertPeerConfigToBFDConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		peer     *v1alpha1.BGPPeer
+		expected *bfd.Config
+	}{
+		{
+			name:     "BFD disabled",
+			peer:     &v1alpha1.BGPPeer{Address: "192.168.0.1", ASN: 65000},
+			expected: nil,
+		},
+		{
+			name: "single-hop with default parameters",
+			peer: &v1alpha1.BGPPeer{
+				Address:     "192.168.0.1",
+				ASN:         65000,
+				MultihopTTL: ptr.To(int32(1)),
+				BFD:         &v1alpha1.BGPPeerBFD{},
+			},
+			expected: &bfd.Config{
+				MinTxInterval:    300 * time.Millisecond,
+				MinRxInterval:    300 * time.Millisecond,
+				DetectMultiplier: 3,
+			},
+		},
+		{
+			name: "multi-hop",
+			peer: &v1alpha1.BGPPeer{
+				Address:     "192.168.0.1",
+				ASN:         65000,
+				MultihopTTL: ptr.To(int32(2)),
+				BFD: &v1alpha1.BGPPeerBFD{
+					MinTransmitIntervalMilliseconds: ptr.To(int32(100)),
+					MinReceiveIntervalMilliseconds:  ptr.To(int32(200)),
+					DetectMultiplier:                ptr.To(int32(5)),
+				},
+			},
+			expected: &bfd.Config{
+				MinTxInterval:    100 * time.Millisecond,
+				MinRxInterval:    200 * time.Millisecond,
+				DetectMultiplier: 5,
+				Multihop:         true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, convertPeerConfigToBFDConfig(bgp.PeerConfig{BGPPeer: tt.peer}))
+		})
+	}
 }
 
 func TestConvertGoBGPSessionStateToSessionState(t *testing.T) {
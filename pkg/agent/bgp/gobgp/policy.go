@@ -0,0 +1,251 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gobgp
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	gobgpapi "github.com/osrg/gobgp/v3/api"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+// goBGP only supports per-peer policies for route server clients. For regular peers, the export and import policies
+// of a BGP peer are implemented as global policies, with a neighbor condition matching the address of the peer in
+// all their statements. All the policies are assigned to the global RIB, and routes not matching any policy are
+// accepted.
+
+const globalRIBName = "global"
+
+func peerNeighborSetName(peerAddress string) string {
+	return fmt.Sprintf("antrea-%s", peerAddress)
+}
+
+func peerPolicyName(peerAddress string, direction gobgpapi.PolicyDirection) string {
+	if direction == gobgpapi.PolicyDirection_EXPORT {
+		return fmt.Sprintf("antrea-%s-export", peerAddress)
+	}
+	return fmt.Sprintf("antrea-%s-import", peerAddress)
+}
+
+// convertRoutePolicyToGoBGPPolicy converts the route policy of a BGP peer to a goBGP policy, and returns the defined
+// sets referenced by the policy. It returns a nil policy if the route policy is a no-op.
+func convertRoutePolicyToGoBGPPolicy(peerAddress string, localASN uint32, routePolicy *v1alpha1.BGPRoutePolicy, direction gobgpapi.PolicyDirection) (*gobgpapi.Policy, []*gobgpapi.DefinedSet, error) {
+	if routePolicy == nil {
+		return nil, nil, nil
+	}
+	actions := &gobgpapi.Actions{RouteAction: gobgpapi.RouteAction_ACCEPT}
+	hasAttributeActions := false
+	if len(routePolicy.Communities) > 0 {
+		actions.Community = &gobgpapi.CommunityAction{
+			Type:        gobgpapi.CommunityAction_ADD,
+			Communities: routePolicy.Communities,
+		}
+		hasAttributeActions = true
+	}
+	if routePolicy.MED != nil {
+		actions.Med = &gobgpapi.MedAction{
+			Type:  gobgpapi.MedAction_REPLACE,
+			Value: *routePolicy.MED,
+		}
+		hasAttributeActions = true
+	}
+	if routePolicy.LocalPreference != nil {
+		actions.LocalPref = &gobgpapi.LocalPrefAction{Value: uint32(*routePolicy.LocalPreference)}
+		hasAttributeActions = true
+	}
+	if routePolicy.ASPathPrependCount != nil {
+		actions.AsPrepend = &gobgpapi.AsPrependAction{
+			Asn:    localASN,
+			Repeat: uint32(*routePolicy.ASPathPrependCount),
+		}
+		hasAttributeActions = true
+	}
+	if len(routePolicy.Prefixes) == 0 && !hasAttributeActions {
+		return nil, nil, nil
+	}
+
+	policyName := peerPolicyName(peerAddress, direction)
+	neighborSet := &gobgpapi.DefinedSet{
+		DefinedType: gobgpapi.DefinedType_NEIGHBOR,
+		Name:        peerNeighborSetName(peerAddress),
+		List:        []string{netip.MustParseAddr(peerAddress).String() + hostPrefixLen(peerAddress)},
+	}
+	definedSets := []*gobgpapi.DefinedSet{neighborSet}
+	neighborMatch := &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: neighborSet.Name}
+	policy := &gobgpapi.Policy{Name: policyName}
+
+	if len(routePolicy.Prefixes) == 0 {
+		policy.Statements = []*gobgpapi.Statement{{
+			Name:       policyName + "-accept",
+			Conditions: &gobgpapi.Conditions{NeighborSet: neighborMatch},
+			Actions:    actions,
+		}}
+		return policy, definedSets, nil
+	}
+
+	prefixSet := &gobgpapi.DefinedSet{
+		DefinedType: gobgpapi.DefinedType_PREFIX,
+		Name:        policyName,
+	}
+	for _, prefixMatch := range routePolicy.Prefixes {
+		prefix, err := convertPrefixMatchToGoBGPPrefix(&prefixMatch)
+		if err != nil {
+			return nil, nil, err
+		}
+		prefixSet.Prefixes = append(prefixSet.Prefixes, prefix)
+	}
+	definedSets = append(definedSets, prefixSet)
+	// Routes matching the allow-list are accepted, and the other routes exchanged with the peer are rejected.
+	policy.Statements = []*gobgpapi.Statement{
+		{
+			Name: policyName + "-accept",
+			Conditions: &gobgpapi.Conditions{
+				NeighborSet: neighborMatch,
+				PrefixSet:   &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: prefixSet.Name},
+			},
+			Actions: actions,
+		},
+		{
+			Name:       policyName + "-reject",
+			Conditions: &gobgpapi.Conditions{NeighborSet: neighborMatch},
+			Actions:    &gobgpapi.Actions{RouteAction: gobgpapi.RouteAction_REJECT},
+		},
+	}
+	return policy, definedSets, nil
+}
+
+func convertPrefixMatchToGoBGPPrefix(prefixMatch *v1alpha1.BGPPrefixMatch) (*gobgpapi.Prefix, error) {
+	prefix, err := netip.ParsePrefix(prefixMatch.CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix %s: %w", prefixMatch.CIDR, err)
+	}
+	prefix = prefix.Masked()
+	minLength, maxLength := uint32(prefix.Bits()), uint32(prefix.Bits())
+	if prefixMatch.MinLength != nil {
+		minLength = uint32(*prefixMatch.MinLength)
+	}
+	if prefixMatch.MaxLength != nil {
+		maxLength = uint32(*prefixMatch.MaxLength)
+	}
+	if minLength < uint32(prefix.Bits()) || maxLength < minLength || maxLength > uint32(prefix.Addr().BitLen()) {
+		return nil, fmt.Errorf("invalid prefix length range %d..%d for prefix %s", minLength, maxLength, prefixMatch.CIDR)
+	}
+	return &gobgpapi.Prefix{
+		IpPrefix:      prefix.String(),
+		MaskLengthMin: minLength,
+		MaskLengthMax: maxLength,
+	}, nil
+}
+
+func hostPrefixLen(address string) string {
+	if netip.MustParseAddr(address).Is4() {
+		return "/32"
+	}
+	return "/128"
+}
+
+// setPeerPolicies installs the export and import policies of a BGP peer, replacing the existing ones.
+func (s *Server) setPeerPolicies(ctx context.Context, peerAddress string, exportPolicy, importPolicy *v1alpha1.BGPRoutePolicy) error {
+	if err := s.removePeerPolicies(ctx, peerAddress); err != nil {
+		return err
+	}
+	for _, p := range []struct {
+		routePolicy *v1alpha1.BGPRoutePolicy
+		direction   gobgpapi.PolicyDirection
+		policyNames sets.Set[string]
+	}{
+		{exportPolicy, gobgpapi.PolicyDirection_EXPORT, s.exportPolicyNames},
+		{importPolicy, gobgpapi.PolicyDirection_IMPORT, s.importPolicyNames},
+	} {
+		policy, definedSets, err := convertRoutePolicyToGoBGPPolicy(peerAddress, s.globalConfig.Asn, p.routePolicy, p.direction)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			continue
+		}
+		for _, definedSet := range definedSets {
+			if err := s.server.AddDefinedSet(ctx, &gobgpapi.AddDefinedSetRequest{DefinedSet: definedSet, Replace: true}); err != nil {
+				return fmt.Errorf("failed to add defined set %s: %w", definedSet.Name, err)
+			}
+			s.peerDefinedSets[peerAddress] = append(s.peerDefinedSets[peerAddress], definedSet)
+		}
+		if err := s.server.AddPolicy(ctx, &gobgpapi.AddPolicyRequest{Policy: policy}); err != nil {
+			return fmt.Errorf("failed to add policy %s: %w", policy.Name, err)
+		}
+		p.policyNames.Insert(policy.Name)
+		if err := s.setPolicyAssignment(ctx, p.direction, p.policyNames); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePeerPolicies removes the export and import policies of a BGP peer, as well as the defined sets they use.
+func (s *Server) removePeerPolicies(ctx context.Context, peerAddress string) error {
+	for _, p := range []struct {
+		direction   gobgpapi.PolicyDirection
+		policyNames sets.Set[string]
+	}{
+		{gobgpapi.PolicyDirection_EXPORT, s.exportPolicyNames},
+		{gobgpapi.PolicyDirection_IMPORT, s.importPolicyNames},
+	} {
+		policyName := peerPolicyName(peerAddress, p.direction)
+		if !p.policyNames.Has(policyName) {
+			continue
+		}
+		p.policyNames.Delete(policyName)
+		if err := s.setPolicyAssignment(ctx, p.direction, p.policyNames); err != nil {
+			return err
+		}
+		// Deleting the policy also deletes its statements, so that the defined sets are no longer in use.
+		if err := s.server.DeletePolicy(ctx, &gobgpapi.DeletePolicyRequest{Policy: &gobgpapi.Policy{Name: policyName}, All: true}); err != nil {
+			return fmt.Errorf("failed to delete policy %s: %w", policyName, err)
+		}
+	}
+	deleted := sets.New[string]()
+	for _, definedSet := range s.peerDefinedSets[peerAddress] {
+		// The neighbor set is shared by the export and import policies.
+		if deleted.Has(definedSet.Name) {
+			continue
+		}
+		if err := s.server.DeleteDefinedSet(ctx, &gobgpapi.DeleteDefinedSetRequest{DefinedSet: definedSet, All: true}); err != nil {
+			return fmt.Errorf("failed to delete defined set %s: %w", definedSet.Name, err)
+		}
+		deleted.Insert(definedSet.Name)
+	}
+	delete(s.peerDefinedSets, peerAddress)
+	return nil
+}
+
+// setPolicyAssignment assigns the given policies to the global RIB for the given direction.
+func (s *Server) setPolicyAssignment(ctx context.Context, direction gobgpapi.PolicyDirection, policyNames sets.Set[string]) error {
+	assignment := &gobgpapi.PolicyAssignment{
+		Name:          globalRIBName,
+		Direction:     direction,
+		DefaultAction: gobgpapi.RouteAction_ACCEPT,
+	}
+	for _, name := range sets.List(policyNames) {
+		assignment.Policies = append(assignment.Policies, &gobgpapi.Policy{Name: name})
+	}
+	if err := s.server.SetPolicyAssignment(ctx, &gobgpapi.SetPolicyAssignmentRequest{Assignment: assignment}); err != nil {
+		return fmt.Errorf("failed to set %s policy assignment: %w", direction, err)
+	}
+	return nil
+}
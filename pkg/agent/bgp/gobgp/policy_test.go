@@ -0,0 +1,247 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gobgp
+
+import (
+	"context"
+	"testing"
+
+	gobgpapi "github.com/osrg/gobgp/v3/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/bgp"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func TestConvertRoutePolicyToGoBGPPolicy(t *testing.T) {
+	neighborSet := &gobgpapi.DefinedSet{
+		DefinedType: gobgpapi.DefinedType_NEIGHBOR,
+		Name:        "antrea-192.168.77.200",
+		List:        []string{"192.168.77.200/32"},
+	}
+	neighborMatch := &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: "antrea-192.168.77.200"}
+	tests := []struct {
+		name                string
+		routePolicy         *v1alpha1.BGPRoutePolicy
+		direction           gobgpapi.PolicyDirection
+		expectedPolicy      *gobgpapi.Policy
+		expectedDefinedSets []*gobgpapi.DefinedSet
+		expectedErr         string
+	}{
+		{
+			name:        "Nil policy",
+			routePolicy: nil,
+			direction:   gobgpapi.PolicyDirection_EXPORT,
+		},
+		{
+			name:        "Empty policy",
+			routePolicy: &v1alpha1.BGPRoutePolicy{},
+			direction:   gobgpapi.PolicyDirection_EXPORT,
+		},
+		{
+			name: "Export policy with attribute actions",
+			routePolicy: &v1alpha1.BGPRoutePolicy{
+				Communities:        []string{"65001:100", "no-export"},
+				MED:                ptr.To[int64](50),
+				ASPathPrependCount: ptr.To[int32](2),
+			},
+			direction: gobgpapi.PolicyDirection_EXPORT,
+			expectedPolicy: &gobgpapi.Policy{
+				Name: "antrea-192.168.77.200-export",
+				Statements: []*gobgpapi.Statement{{
+					Name:       "antrea-192.168.77.200-export-accept",
+					Conditions: &gobgpapi.Conditions{NeighborSet: neighborMatch},
+					Actions: &gobgpapi.Actions{
+						RouteAction: gobgpapi.RouteAction_ACCEPT,
+						Community: &gobgpapi.CommunityAction{
+							Type:        gobgpapi.CommunityAction_ADD,
+							Communities: []string{"65001:100", "no-export"},
+						},
+						Med:       &gobgpapi.MedAction{Type: gobgpapi.MedAction_REPLACE, Value: 50},
+						AsPrepend: &gobgpapi.AsPrependAction{Asn: 65000, Repeat: 2},
+					},
+				}},
+			},
+			expectedDefinedSets: []*gobgpapi.DefinedSet{neighborSet},
+		},
+		{
+			name: "Import policy with prefixes",
+			routePolicy: &v1alpha1.BGPRoutePolicy{
+				Prefixes: []v1alpha1.BGPPrefixMatch{
+					{CIDR: "10.10.0.0/16", MaxLength: ptr.To[int32](32)},
+					{CIDR: "10.20.1.1/24"},
+				},
+				LocalPreference: ptr.To[int64](200),
+			},
+			direction: gobgpapi.PolicyDirection_IMPORT,
+			expectedPolicy: &gobgpapi.Policy{
+				Name: "antrea-192.168.77.200-import",
+				Statements: []*gobgpapi.Statement{
+					{
+						Name: "antrea-192.168.77.200-import-accept",
+						Conditions: &gobgpapi.Conditions{
+							NeighborSet: neighborMatch,
+							PrefixSet:   &gobgpapi.MatchSet{Type: gobgpapi.MatchSet_ANY, Name: "antrea-192.168.77.200-import"},
+						},
+						Actions: &gobgpapi.Actions{
+							RouteAction: gobgpapi.RouteAction_ACCEPT,
+							LocalPref:   &gobgpapi.LocalPrefAction{Value: 200},
+						},
+					},
+					{
+						Name:       "antrea-192.168.77.200-import-reject",
+						Conditions: &gobgpapi.Conditions{NeighborSet: neighborMatch},
+						Actions:    &gobgpapi.Actions{RouteAction: gobgpapi.RouteAction_REJECT},
+					},
+				},
+			},
+			expectedDefinedSets: []*gobgpapi.DefinedSet{
+				neighborSet,
+				{
+					DefinedType: gobgpapi.DefinedType_PREFIX,
+					Name:        "antrea-192.168.77.200-import",
+					Prefixes: []*gobgpapi.Prefix{
+						{IpPrefix: "10.10.0.0/16", MaskLengthMin: 16, MaskLengthMax: 32},
+						{IpPrefix: "10.20.1.0/24", MaskLengthMin: 24, MaskLengthMax: 24},
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid prefix length range",
+			routePolicy: &v1alpha1.BGPRoutePolicy{
+				Prefixes: []v1alpha1.BGPPrefixMatch{{CIDR: "10.10.0.0/16", MinLength: ptr.To[int32](8)}},
+			},
+			direction:   gobgpapi.PolicyDirection_IMPORT,
+			expectedErr: "invalid prefix length range 8..16 for prefix 10.10.0.0/16",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, definedSets, err := convertRoutePolicyToGoBGPPolicy("192.168.77.200", 65000, tt.routePolicy, tt.direction)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPolicy, policy)
+			assert.Equal(t, tt.expectedDefinedSets, definedSets)
+		})
+	}
+}
+
+func TestConvertPrefixMatchToGoBGPPrefix(t *testing.T) {
+	tests := []struct {
+		name           string
+		prefixMatch    v1alpha1.BGPPrefixMatch
+		expectedPrefix *gobgpapi.Prefix
+		expectedErr    string
+	}{
+		{
+			name:           "Exact match",
+			prefixMatch:    v1alpha1.BGPPrefixMatch{CIDR: "10.0.0.0/8"},
+			expectedPrefix: &gobgpapi.Prefix{IpPrefix: "10.0.0.0/8", MaskLengthMin: 8, MaskLengthMax: 8},
+		},
+		{
+			name:           "IPv6 length range",
+			prefixMatch:    v1alpha1.BGPPrefixMatch{CIDR: "fec0::/64", MinLength: ptr.To[int32](96), MaxLength: ptr.To[int32](128)},
+			expectedPrefix: &gobgpapi.Prefix{IpPrefix: "fec0::/64", MaskLengthMin: 96, MaskLengthMax: 128},
+		},
+		{
+			name:        "Invalid CIDR",
+			prefixMatch: v1alpha1.BGPPrefixMatch{CIDR: "10.0.0.0"},
+			expectedErr: "invalid prefix 10.0.0.0",
+		},
+		{
+			name:        "Max length exceeding address length",
+			prefixMatch: v1alpha1.BGPPrefixMatch{CIDR: "10.0.0.0/8", MaxLength: ptr.To[int32](33)},
+			expectedErr: "invalid prefix length range 8..33 for prefix 10.0.0.0/8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, err := convertPrefixMatchToGoBGPPrefix(&tt.prefixMatch)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPrefix, prefix)
+		})
+	}
+}
+
+func getPolicyAssignmentNames(t *testing.T, s *Server, direction gobgpapi.PolicyDirection) []string {
+	var names []string
+	err := s.server.ListPolicyAssignment(context.Background(), &gobgpapi.ListPolicyAssignmentRequest{Name: globalRIBName, Direction: direction}, func(assignment *gobgpapi.PolicyAssignment) {
+		for _, policy := range assignment.Policies {
+			names = append(names, policy.Name)
+		}
+	})
+	require.NoError(t, err)
+	return names
+}
+
+func getDefinedSetNames(t *testing.T, s *Server) []string {
+	var names []string
+	for _, definedType := range []gobgpapi.DefinedType{gobgpapi.DefinedType_NEIGHBOR, gobgpapi.DefinedType_PREFIX} {
+		err := s.server.ListDefinedSet(context.Background(), &gobgpapi.ListDefinedSetRequest{DefinedType: definedType}, func(definedSet *gobgpapi.DefinedSet) {
+			names = append(names, definedSet.Name)
+		})
+		require.NoError(t, err)
+	}
+	return names
+}
+
+func TestPeerPolicies(t *testing.T) {
+	ctx := context.Background()
+	s := NewGoBGPServer(&bgp.GlobalConfig{ASN: 65000, RouterID: "192.168.77.1", ListenPort: -1})
+	require.NoError(t, s.Start(ctx))
+	defer s.Stop(ctx)
+
+	peerConf := bgp.PeerConfig{
+		BGPPeer: &v1alpha1.BGPPeer{
+			Address:                    "192.168.77.200",
+			ASN:                        65001,
+			Port:                       ptr.To[int32](179),
+			MultihopTTL:                ptr.To[int32](1),
+			GracefulRestartTimeSeconds: ptr.To[int32](120),
+			ExportPolicy: &v1alpha1.BGPRoutePolicy{
+				Communities: []string{"65001:100"},
+			},
+			ImportPolicy: &v1alpha1.BGPRoutePolicy{
+				Prefixes: []v1alpha1.BGPPrefixMatch{{CIDR: "10.10.0.0/16"}},
+			},
+		},
+	}
+	require.NoError(t, s.AddPeer(ctx, peerConf))
+	assert.Equal(t, []string{"antrea-192.168.77.200-export"}, getPolicyAssignmentNames(t, s, gobgpapi.PolicyDirection_EXPORT))
+	assert.Equal(t, []string{"antrea-192.168.77.200-import"}, getPolicyAssignmentNames(t, s, gobgpapi.PolicyDirection_IMPORT))
+	assert.ElementsMatch(t, []string{"antrea-192.168.77.200", "antrea-192.168.77.200-import"}, getDefinedSetNames(t, s))
+
+	// Removing the import policy should delete the policy and its prefix set.
+	peerConf.BGPPeer = peerConf.BGPPeer.DeepCopy()
+	peerConf.ImportPolicy = nil
+	require.NoError(t, s.UpdatePeer(ctx, peerConf))
+	assert.Equal(t, []string{"antrea-192.168.77.200-export"}, getPolicyAssignmentNames(t, s, gobgpapi.PolicyDirection_EXPORT))
+	assert.Empty(t, getPolicyAssignmentNames(t, s, gobgpapi.PolicyDirection_IMPORT))
+	assert.ElementsMatch(t, []string{"antrea-192.168.77.200"}, getDefinedSetNames(t, s))
+
+	require.NoError(t, s.RemovePeer(ctx, peerConf))
+	assert.Empty(t, getPolicyAssignmentNames(t, s, gobgpapi.PolicyDirection_EXPORT))
+	assert.Empty(t, getDefinedSetNames(t, s))
+}
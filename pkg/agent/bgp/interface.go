@@ -50,6 +50,14 @@ type Interface interface {
 
 	// GetRoutes retrieves the advertised / received routes to / from the given peer.
 	GetRoutes(ctx context.Context, routeType RouteType, peerAddress string) ([]Route, error)
+
+	// GetBestReceivedRoutes retrieves the best route to each prefix received from all BGP peers, after the import
+	// policies are applied.
+	GetBestReceivedRoutes(ctx context.Context) ([]ReceivedRoute, error)
+
+	// WatchReceivedRoutes calls the handler whenever the best routes received from BGP peers may have changed, until
+	// the context is canceled. The handler must not block.
+	WatchReceivedRoutes(ctx context.Context, handler func()) error
 }
 
 type Confederation struct {
@@ -63,6 +71,11 @@ type GlobalConfig struct {
 	RouterID      string
 	ListenPort    int32
 	Confederation *Confederation
+	// IPv4NextHop and IPv6NextHop are the next hops of the advertised IPv4 and IPv6 routes. If not set, the local
+	// address of the BGP session is used as the next hop, which is only valid when the BGP session and the routes
+	// are of the same IP family.
+	IPv4NextHop string
+	IPv6NextHop string
 }
 
 type SessionState string
@@ -80,6 +93,17 @@ const (
 	SessionEstablished SessionState = "Established"
 )
 
+type BFDSessionState string
+
+const (
+	// The following are the states of a BFD session.
+	// For more details see https://datatracker.ietf.org/doc/html/rfc5880#section-6.2.
+	BFDSessionAdminDown BFDSessionState = "AdminDown"
+	BFDSessionDown      BFDSessionState = "Down"
+	BFDSessionInit      BFDSessionState = "Init"
+	BFDSessionUp        BFDSessionState = "Up"
+)
+
 type RouteType int
 
 const (
@@ -94,6 +118,9 @@ type PeerConfig struct {
 	// required to establish a secure BGP connection. If the peer requires password-based authentication, this value
 	// must be set to the appropriate password. Leaving this field empty will disable password authentication.
 	Password string
+	// AddressFamilies lists the address families enabled on the BGP session with the BGP peer. If empty, only the
+	// address family matching the IP family of the peer address is enabled.
+	AddressFamilies []v1alpha1.BGPAddressFamily
 }
 
 // PeerStatus contains the status information for a BGP peer. More attributes related to status might be added later.
@@ -105,6 +132,12 @@ type PeerStatus struct {
 	GracefulRestartTimeSeconds int32
 	SessionState               SessionState
 	UptimeSeconds              int
+	// HoldTimeSeconds and KeepaliveTimeSeconds are the negotiated timers when the session is established, and the
+	// configured timers otherwise.
+	HoldTimeSeconds      int32
+	KeepaliveTimeSeconds int32
+	// BFDState is the state of the BFD session with the peer. It is empty if BFD is not enabled for the peer.
+	BFDState BFDSessionState
 }
 
 // Route represents a BGP route. Currently only prefix (e.g., "192.168.0.0/24") is needed. More attributes might be
@@ -112,3 +145,12 @@ type PeerStatus struct {
 type Route struct {
 	Prefix string
 }
+
+// ReceivedRoute represents the best route to a prefix received from a BGP peer.
+type ReceivedRoute struct {
+	Prefix string
+	// NextHop is the IP address of the next hop of the route.
+	NextHop string
+	// Peer is the IP address of the BGP peer from which the route was received.
+	Peer string
+}
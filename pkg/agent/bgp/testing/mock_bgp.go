@@ -84,6 +84,21 @@ func (mr *MockInterfaceMockRecorder) AdvertiseRoutes(ctx, routes any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvertiseRoutes", reflect.TypeOf((*MockInterface)(nil).AdvertiseRoutes), ctx, routes)
 }
 
+// GetBestReceivedRoutes mocks base method.
+func (m *MockInterface) GetBestReceivedRoutes(ctx context.Context) ([]bgp.ReceivedRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestReceivedRoutes", ctx)
+	ret0, _ := ret[0].([]bgp.ReceivedRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestReceivedRoutes indicates an expected call of GetBestReceivedRoutes.
+func (mr *MockInterfaceMockRecorder) GetBestReceivedRoutes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestReceivedRoutes", reflect.TypeOf((*MockInterface)(nil).GetBestReceivedRoutes), ctx)
+}
+
 // GetPeers mocks base method.
 func (m *MockInterface) GetPeers(ctx context.Context) ([]bgp.PeerStatus, error) {
 	m.ctrl.T.Helper()
@@ -183,3 +198,17 @@ func (mr *MockInterfaceMockRecorder) WithdrawRoutes(ctx, routes any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawRoutes", reflect.TypeOf((*MockInterface)(nil).WithdrawRoutes), ctx, routes)
 }
+
+// WatchReceivedRoutes mocks base method.
+func (m *MockInterface) WatchReceivedRoutes(ctx context.Context, handler func()) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchReceivedRoutes", ctx, handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchReceivedRoutes indicates an expected call of WatchReceivedRoutes.
+func (mr *MockInterfaceMockRecorder) WatchReceivedRoutes(ctx, handler any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchReceivedRoutes", reflect.TypeOf((*MockInterface)(nil).WatchReceivedRoutes), ctx, handler)
+}
@@ -108,15 +108,26 @@ func (m *Map) Get(key string) string {
 
 // GetWithFilters gets the closest item in the hash to which passes all filters.
 func (m *Map) GetWithFilters(key string, filters ...func(string) bool) string {
-	if m.IsEmpty() {
+	result := m.GetNWithFilters(key, 1, filters...)
+	if len(result) == 0 {
 		return ""
 	}
+	return result[0]
+}
+
+// GetNWithFilters gets up to n distinct items which pass all filters, ordered by their distance to the provided key in
+// the hash. The first item is the one returned by GetWithFilters. If n is not positive, all items which pass all
+// filters are returned.
+func (m *Map) GetNWithFilters(key string, n int, filters ...func(string) bool) []string {
+	if m.IsEmpty() {
+		return nil
+	}
 	hash := m.hash([]byte(key))
 	pivot := &replica{
 		key:  key,
 		hash: hash,
 	}
-	var result *replica
+	var result []string
 	visited := make(map[string]struct{})
 	iterator := func(item btree.Item) bool {
 		// all keys visited
@@ -127,25 +138,21 @@ func (m *Map) GetWithFilters(key string, filters ...func(string) bool) string {
 		if _, exists := visited[r.key]; exists {
 			return true
 		}
+		visited[r.key] = struct{}{}
 		for _, f := range filters {
 			if !f(r.key) {
-				visited[r.key] = struct{}{}
 				return true
 			}
 		}
-		// stop iterating
-		result = r
-		return false
+		result = append(result, r.key)
+		// stop iterating once enough keys are found
+		return n <= 0 || len(result) < n
 	}
 	// search in [pivot, last]
 	m.tree.AscendGreaterOrEqual(pivot, iterator)
-	if result == nil {
+	if len(visited) < len(m.keys) && (n <= 0 || len(result) < n) {
 		// search in [first, pivot)
 		m.tree.AscendLessThan(pivot, iterator)
 	}
-	// no key passes all filters
-	if result == nil {
-		return ""
-	}
-	return result.key
+	return result
 }
@@ -163,6 +163,66 @@ func TestGetWithFilter(t *testing.T) {
 	}
 }
 
+func TestGetNWithFilters(t *testing.T) {
+	// Given the simple hash function, this will give replicas with "hashes":
+	// 1, 11, 21 for key "1", 2, 12, 22 for key "2", 3, 13, 23 for key "3"
+	hash := New(3, simpleHashFn)
+	hash.Add("1", "2", "3")
+	testCases := []struct {
+		name     string
+		testKey  string
+		n        int
+		filters  []func(string) bool
+		expected []string
+	}{
+		{
+			name:     "all keys",
+			testKey:  "2",
+			n:        0,
+			expected: []string{"2", "3", "1"},
+		},
+		{
+			name:     "all keys with wrap around",
+			testKey:  "22",
+			n:        -1,
+			expected: []string{"2", "3", "1"},
+		},
+		{
+			name:     "first two keys",
+			testKey:  "4",
+			n:        2,
+			expected: []string{"1", "2"},
+		},
+		{
+			name:    "with filter",
+			testKey: "2",
+			n:       0,
+			filters: []func(string) bool{
+				func(s string) bool {
+					return s != "3"
+				},
+			},
+			expected: []string{"2", "1"},
+		},
+		{
+			name:    "no valid value",
+			testKey: "2",
+			n:       2,
+			filters: []func(string) bool{
+				func(s string) bool {
+					return s == "0"
+				},
+			},
+			expected: nil,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hash.GetNWithFilters(tt.testKey, tt.n, tt.filters...))
+		})
+	}
+}
+
 func TestRemove(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net"
+	"net/netip"
 	"reflect"
 	"sync"
 	"time"
@@ -48,6 +49,7 @@ import (
 	"antrea.io/antrea/pkg/agent/bgp"
 	"antrea.io/antrea/pkg/agent/bgp/gobgp"
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -86,6 +88,7 @@ const (
 	ServiceExternalIP     AdvertisedRouteType = "ServiceExternalIP"
 	ServiceClusterIP      AdvertisedRouteType = "ServiceClusterIP"
 	NodeIPAMPodCIDR       AdvertisedRouteType = "NodeIPAMPodCIDR"
+	PodIP                 AdvertisedRouteType = "PodIP"
 )
 
 type RouteMetadata struct {
@@ -111,11 +114,21 @@ type bgpPolicyState struct {
 	routerID string
 	// The confederation config used by the local BGP server.
 	confederationConfig *confederationConfig
+	// The next hops of the IPv4 and IPv6 routes advertised by the local BGP server. They are empty when the local
+	// address of the BGP session is used as the next hop.
+	ipv4NextHop string
+	ipv6NextHop string
 	// routes stores all BGP routes advertised to BGP peers.
 	routes map[bgp.Route]RouteMetadata
 	// peerConfigs is a map that stores configurations of BGP peers. The map keys are the concatenated strings of BGP
 	// peer IP address and ASN (e.g., "192.168.77.100-65000", "2001::1-65000").
 	peerConfigs map[string]bgp.PeerConfig
+	// importedRoutes stores the routes received from BGP peers and installed on the Node. The map keys are the
+	// prefixes of the routes.
+	importedRoutes map[string]bgp.ReceivedRoute
+	// stopWatchingReceivedRoutes stops watching the routes received from BGP peers. It is nil when the received
+	// routes are not imported.
+	stopWatchingReceivedRoutes context.CancelFunc
 }
 
 type Controller struct {
@@ -139,12 +152,17 @@ type Controller struct {
 	endpointSliceLister       discoverylisters.EndpointSliceLister
 	endpointSliceListerSynced cache.InformerSynced
 
+	podInformer     cache.SharedIndexInformer
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+
 	secretInformer cache.SharedIndexInformer
 
 	bgpPolicyState      *bgpPolicyState
 	bgpPolicyStateMutex sync.RWMutex
 
 	k8sClient             kubernetes.Interface
+	routeClient           route.Interface
 	bgpPeerPasswords      map[string]string
 	bgpPeerPasswordsMutex sync.RWMutex
 
@@ -154,6 +172,7 @@ type Controller struct {
 	podIPv4CIDR  string
 	podIPv6CIDR  string
 	nodeIPv4Addr string
+	nodeIPv6Addr string
 
 	egressEnabled bool
 
@@ -167,8 +186,10 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 	egressInformer crdinformersv1b1.EgressInformer,
 	bgpPolicyInformer crdinformersv1a1.BGPPolicyInformer,
 	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
+	podInformer cache.SharedIndexInformer,
 	egressEnabled bool,
 	k8sClient kubernetes.Interface,
+	routeClient route.Interface,
 	nodeConfig *config.NodeConfig,
 	networkConfig *config.NetworkConfig) (*Controller, error) {
 	c := &Controller{
@@ -184,7 +205,11 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		endpointSliceInformer:     endpointSliceInformer.Informer(),
 		endpointSliceLister:       endpointSliceInformer.Lister(),
 		endpointSliceListerSynced: endpointSliceInformer.Informer().HasSynced,
+		podInformer:               podInformer,
+		podLister:                 corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:           podInformer.HasSynced,
 		k8sClient:                 k8sClient,
+		routeClient:               routeClient,
 		bgpPeerPasswords:          make(map[string]string),
 		nodeName:                  nodeConfig.Name,
 		enabledIPv4:               networkConfig.IPv4Enabled,
@@ -203,6 +228,9 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 			},
 		),
 	}
+	if nodeConfig.NodeIPv6Addr != nil {
+		c.nodeIPv6Addr = nodeConfig.NodeIPv6Addr.IP.String()
+	}
 	c.bgpPolicyInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.addBGPPolicy,
@@ -227,6 +255,14 @@ func NewBGPPolicyController(nodeInformer coreinformers.NodeInformer,
 		},
 		resyncPeriod,
 	)
+	c.podInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPod,
+			UpdateFunc: c.updatePod,
+			DeleteFunc: c.deletePod,
+		},
+		resyncPeriod,
+	)
 	if c.egressEnabled {
 		c.egressInformer = egressInformer.Informer()
 		c.egressLister = egressInformer.Lister()
@@ -279,6 +315,7 @@ func (c *Controller) Run(ctx context.Context) {
 		c.bgpPolicyListerSynced,
 		c.endpointSliceListerSynced,
 		c.serviceListerSynced,
+		c.podListerSynced,
 		c.secretInformer.HasSynced,
 	}
 	if c.egressEnabled {
@@ -370,7 +407,7 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 		}
 
 		// If the BGPPolicy state is not nil, stop the BGP server and reset the state to nil, then return.
-		if err := c.bgpPolicyState.bgpServer.Stop(ctx); err != nil {
+		if err := c.stopBGPServer(ctx); err != nil {
 			return err
 		}
 		c.bgpPolicyState = nil
@@ -388,22 +425,26 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 	listenPort := *effectivePolicy.Spec.ListenPort
 	localASN := effectivePolicy.Spec.LocalASN
 	confederationConfig := getConfederationConfig(effectivePolicy.Spec.Confederation)
+	addressFamilies := c.getAddressFamilies(effectivePolicy.Spec.AddressFamilies)
+	ipv4NextHop, ipv6NextHop := c.getNextHops(effectivePolicy.Spec.AddressFamilies)
 
 	// If the BGPPolicy state is nil, a new BGP server should be started, initialize the BGPPolicy state to store the
 	// new BGP server, BGP policy name, listen port, local ASN, and router ID.
-	// If the BGPPolicy is not nil, any of the listen port, local AS number, router ID or confederation configuration
-	// has changed, stop the current BGP server first and reset the BGPPolicy state to nil; then start a new BGP server
-	// and initialize the BGPPolicy state to store the new BGP server, listen port, local ASN, and router ID.
+	// If the BGPPolicy is not nil, any of the listen port, local AS number, router ID, confederation configuration or
+	// next hops has changed, stop the current BGP server first and reset the BGPPolicy state to nil; then start a new
+	// BGP server and initialize the BGPPolicy state to store the new BGP server, listen port, local ASN, and router ID.
 	needUpdateBGPServer := c.bgpPolicyState == nil ||
 		c.bgpPolicyState.listenPort != listenPort ||
 		c.bgpPolicyState.localASN != localASN ||
 		c.bgpPolicyState.routerID != routerID ||
-		!confederationConfigEqual(c.bgpPolicyState.confederationConfig, confederationConfig)
+		!confederationConfigEqual(c.bgpPolicyState.confederationConfig, confederationConfig) ||
+		c.bgpPolicyState.ipv4NextHop != ipv4NextHop ||
+		c.bgpPolicyState.ipv6NextHop != ipv6NextHop
 
 	if needUpdateBGPServer {
 		if c.bgpPolicyState != nil {
 			// Stop the current BGP server.
-			if err := c.bgpPolicyState.bgpServer.Stop(ctx); err != nil {
+			if err := c.stopBGPServer(ctx); err != nil {
 				return fmt.Errorf("failed to stop current BGP server: %w", err)
 			}
 			// Reset the BGPPolicy state to nil.
@@ -412,9 +453,11 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 
 		// Create a new BGP server.
 		bgpConfig := &bgp.GlobalConfig{
-			ASN:        uint32(localASN),
-			RouterID:   routerID,
-			ListenPort: listenPort,
+			ASN:         uint32(localASN),
+			RouterID:    routerID,
+			ListenPort:  listenPort,
+			IPv4NextHop: ipv4NextHop,
+			IPv6NextHop: ipv6NextHop,
 		}
 		if confederationConfig != nil {
 			bgpConfig.Confederation = &bgp.Confederation{
@@ -437,8 +480,11 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 			listenPort:          listenPort,
 			localASN:            localASN,
 			confederationConfig: confederationConfig,
+			ipv4NextHop:         ipv4NextHop,
+			ipv6NextHop:         ipv6NextHop,
 			routes:              make(map[bgp.Route]RouteMetadata),
 			peerConfigs:         make(map[string]bgp.PeerConfig),
+			importedRoutes:      make(map[string]bgp.ReceivedRoute),
 		}
 	} else if c.bgpPolicyState.bgpPolicyName != bgpPolicyName {
 		// It may happen that only BGP policy name has changed in effective BGP policy.
@@ -446,7 +492,7 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 	}
 
 	// Reconcile BGP peers.
-	if err := c.reconcileBGPPeers(ctx, effectivePolicy.Spec.BGPPeers); err != nil {
+	if err := c.reconcileBGPPeers(ctx, effectivePolicy.Spec.BGPPeers, addressFamilies); err != nil {
 		return err
 	}
 
@@ -455,11 +501,28 @@ func (c *Controller) syncBGPPolicy(ctx context.Context) error {
 		return err
 	}
 
+	// Reconcile the routes imported from BGP peers.
+	if err := c.reconcileBGPRouteImport(ctx, effectivePolicy.Spec.RouteImport); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (c *Controller) reconcileBGPPeers(ctx context.Context, bgpPeers []v1alpha1.BGPPeer) error {
-	curPeerConfigs := c.getPeerConfigs(bgpPeers)
+// stopBGPServer deletes the routes imported from BGP peers, then stops the current BGP server.
+func (c *Controller) stopBGPServer(ctx context.Context) error {
+	if c.bgpPolicyState.stopWatchingReceivedRoutes != nil {
+		c.bgpPolicyState.stopWatchingReceivedRoutes()
+		c.bgpPolicyState.stopWatchingReceivedRoutes = nil
+	}
+	if err := c.deleteImportedRoutes(); err != nil {
+		return err
+	}
+	return c.bgpPolicyState.bgpServer.Stop(ctx)
+}
+
+func (c *Controller) reconcileBGPPeers(ctx context.Context, bgpPeers []v1alpha1.BGPPeer, addressFamilies []v1alpha1.BGPAddressFamily) error {
+	curPeerConfigs := c.getPeerConfigs(bgpPeers, addressFamilies)
 	prePeerConfigs := c.bgpPolicyState.peerConfigs
 	prePeerKeys := sets.KeySet(prePeerConfigs)
 	curPeerKeys := sets.KeySet(curPeerConfigs)
@@ -530,6 +593,125 @@ func (c *Controller) reconcileBGPAdvertisements(ctx context.Context, bgpAdvertis
 	return nil
 }
 
+func (c *Controller) reconcileBGPRouteImport(ctx context.Context, routeImport *v1alpha1.RouteImport) error {
+	if routeImport == nil {
+		if c.bgpPolicyState.stopWatchingReceivedRoutes != nil {
+			c.bgpPolicyState.stopWatchingReceivedRoutes()
+			c.bgpPolicyState.stopWatchingReceivedRoutes = nil
+		}
+		return c.deleteImportedRoutes()
+	}
+
+	bgpServer := c.bgpPolicyState.bgpServer
+	// The received routes are synced again whenever the best routes received from BGP peers change.
+	if c.bgpPolicyState.stopWatchingReceivedRoutes == nil {
+		watchCtx, cancel := context.WithCancel(context.Background())
+		if err := bgpServer.WatchReceivedRoutes(watchCtx, func() { c.queue.Add(dummyKey) }); err != nil {
+			cancel()
+			return fmt.Errorf("failed to watch the routes received from BGP peers: %w", err)
+		}
+		c.bgpPolicyState.stopWatchingReceivedRoutes = cancel
+	}
+
+	receivedRoutes, err := bgpServer.GetBestReceivedRoutes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the routes received from BGP peers: %w", err)
+	}
+	curRoutes := make(map[string]bgp.ReceivedRoute)
+	for _, receivedRoute := range receivedRoutes {
+		if c.shouldImportRoute(receivedRoute, routeImport) {
+			curRoutes[receivedRoute.Prefix] = receivedRoute
+		}
+	}
+
+	// A route which cannot be installed, e.g., because its next hop is unreachable, should not prevent the other
+	// routes from being installed. The errors are returned after all routes have been processed, so that the sync is
+	// retried.
+	var errs []error
+	for prefix := range c.bgpPolicyState.importedRoutes {
+		if _, exists := curRoutes[prefix]; exists {
+			continue
+		}
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.DeleteBGPRoute(dst); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(c.bgpPolicyState.importedRoutes, prefix)
+	}
+	for prefix, receivedRoute := range curRoutes {
+		if importedRoute, exists := c.bgpPolicyState.importedRoutes[prefix]; exists && importedRoute.NextHop == receivedRoute.NextHop {
+			c.bgpPolicyState.importedRoutes[prefix] = receivedRoute
+			continue
+		}
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.AddBGPRoute(dst, net.ParseIP(receivedRoute.NextHop)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c.bgpPolicyState.importedRoutes[prefix] = receivedRoute
+	}
+	return errors.Join(errs...)
+}
+
+// shouldImportRoute returns whether a route received from a BGP peer should be installed on the Node.
+func (c *Controller) shouldImportRoute(receivedRoute bgp.ReceivedRoute, routeImport *v1alpha1.RouteImport) bool {
+	prefix, err := netip.ParsePrefix(receivedRoute.Prefix)
+	if err != nil {
+		return false
+	}
+	nextHop, err := netip.ParseAddr(receivedRoute.NextHop)
+	if err != nil {
+		return false
+	}
+	isIPv4 := prefix.Addr().Is4()
+	if isIPv4 && !c.enabledIPv4 || !isIPv4 && !c.enabledIPv6 {
+		return false
+	}
+	// Routes with a next hop from the other IP family are not supported.
+	if nextHop.Unmap().Is4() != isIPv4 {
+		return false
+	}
+	return matchesPrefixes(prefix, routeImport.Prefixes)
+}
+
+// matchesPrefixes returns whether the prefix matches any of the prefix matches, with the same semantics as the
+// prefixes of BGP route policies.
+func matchesPrefixes(prefix netip.Prefix, prefixMatches []v1alpha1.BGPPrefixMatch) bool {
+	for _, prefixMatch := range prefixMatches {
+		cidr, err := netip.ParsePrefix(prefixMatch.CIDR)
+		if err != nil {
+			continue
+		}
+		cidr = cidr.Masked()
+		if cidr.Addr().Is4() != prefix.Addr().Is4() || prefix.Bits() < cidr.Bits() || !cidr.Contains(prefix.Addr()) {
+			continue
+		}
+		minLength, maxLength := cidr.Bits(), cidr.Bits()
+		if prefixMatch.MinLength != nil {
+			minLength = int(*prefixMatch.MinLength)
+		}
+		if prefixMatch.MaxLength != nil {
+			maxLength = int(*prefixMatch.MaxLength)
+		}
+		if prefix.Bits() >= minLength && prefix.Bits() <= maxLength {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) deleteImportedRoutes() error {
+	for prefix := range c.bgpPolicyState.importedRoutes {
+		_, dst, _ := net.ParseCIDR(prefix)
+		if err := c.routeClient.DeleteBGPRoute(dst); err != nil {
+			return err
+		}
+		delete(c.bgpPolicyState.importedRoutes, prefix)
+	}
+	return nil
+}
+
 func hashNodeNameToIP(s string) string {
 	h := fnv.New32a() // Create a new FNV hash
 	h.Write([]byte(s))
@@ -600,10 +782,10 @@ func (c *Controller) getRoutes(advertisements v1alpha1.Advertisements) map[bgp.R
 		c.addServiceRoutes(advertisements.Service, allRoutes)
 	}
 	if c.egressEnabled && advertisements.Egress != nil {
-		c.addEgressRoutes(allRoutes)
+		c.addEgressRoutes(advertisements.Egress, allRoutes)
 	}
 	if advertisements.Pod != nil {
-		c.addPodRoutes(allRoutes)
+		c.addPodRoutes(advertisements.Pod, allRoutes)
 	}
 
 	return allRoutes
@@ -614,6 +796,9 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 	services, _ := c.serviceLister.List(labels.Everything())
 
 	for _, svc := range services {
+		if !matchesLabelSelector(advertisement.ServiceSelector, svc.Labels) {
+			continue
+		}
 		svcRef := svc.Namespace + "/" + svc.Name
 		internalLocal := svc.Spec.InternalTrafficPolicy != nil && *svc.Spec.InternalTrafficPolicy == corev1.ServiceInternalTrafficPolicyLocal
 		externalLocal := svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyLocal
@@ -658,12 +843,15 @@ func (c *Controller) addServiceRoutes(advertisement *v1alpha1.ServiceAdvertiseme
 	}
 }
 
-func (c *Controller) addEgressRoutes(allRoutes map[bgp.Route]RouteMetadata) {
+func (c *Controller) addEgressRoutes(advertisement *v1alpha1.EgressAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
 	egresses, _ := c.egressLister.List(labels.Everything())
 	for _, eg := range egresses {
 		if eg.Status.EgressNode != c.nodeName {
 			continue
 		}
+		if !matchesLabelSelector(advertisement.EgressSelector, eg.Labels) {
+			continue
+		}
 		ip := eg.Status.EgressIP
 		if c.enabledIPv4 && utilnet.IsIPv4String(ip) {
 			addRoutes(allRoutes, ip+ipv4Suffix, eg.Name, EgressIP)
@@ -673,7 +861,30 @@ func (c *Controller) addEgressRoutes(allRoutes map[bgp.Route]RouteMetadata) {
 	}
 }
 
-func (c *Controller) addPodRoutes(allRoutes map[bgp.Route]RouteMetadata) {
+func (c *Controller) addPodRoutes(advertisement *v1alpha1.PodAdvertisement, allRoutes map[bgp.Route]RouteMetadata) {
+	// When a Pod selector is specified, the IPs of the selected local Pods are advertised instead of the Pod CIDRs of
+	// the Node.
+	if advertisement.PodSelector != nil {
+		podSelector, err := metav1.LabelSelectorAsSelector(advertisement.PodSelector)
+		if err != nil {
+			return
+		}
+		pods, _ := c.podLister.List(podSelector)
+		for _, pod := range pods {
+			if !c.isLocalPodWithIPs(pod) {
+				continue
+			}
+			podRef := pod.Namespace + "/" + pod.Name
+			for _, podIP := range pod.Status.PodIPs {
+				if c.enabledIPv4 && utilnet.IsIPv4String(podIP.IP) {
+					addRoutes(allRoutes, podIP.IP+ipv4Suffix, podRef, PodIP)
+				} else if c.enabledIPv6 && utilnet.IsIPv6String(podIP.IP) {
+					addRoutes(allRoutes, podIP.IP+ipv6Suffix, podRef, PodIP)
+				}
+			}
+		}
+		return
+	}
 	if c.enabledIPv4 {
 		addRoutes(allRoutes, c.podIPv4CIDR, "", NodeIPAMPodCIDR)
 	}
@@ -702,11 +913,18 @@ func (c *Controller) hasLocalEndpoints(svc *corev1.Service) bool {
 	return false
 }
 
-func (c *Controller) getPeerConfigs(peers []v1alpha1.BGPPeer) map[string]bgp.PeerConfig {
+// getPeerConfigs returns the configurations of the BGP peers whose IP family is enabled in the cluster. The address
+// families are the ones returned by getAddressFamilies; a nil slice means that only the address family of the peer
+// address is enabled, and an empty slice means that no address family is enabled, in which case no peer is returned.
+func (c *Controller) getPeerConfigs(peers []v1alpha1.BGPPeer, addressFamilies []v1alpha1.BGPAddressFamily) map[string]bgp.PeerConfig {
 	c.bgpPeerPasswordsMutex.RLock()
 	defer c.bgpPeerPasswordsMutex.RUnlock()
 
 	peerConfigs := make(map[string]bgp.PeerConfig)
+	if addressFamilies != nil && len(addressFamilies) == 0 {
+		klog.InfoS("None of the address families of the BGPPolicy is enabled in the cluster, skipping BGP peers")
+		return peerConfigs
+	}
 	for i := range peers {
 		if c.enabledIPv4 && utilnet.IsIPv4String(peers[i].Address) ||
 			c.enabledIPv6 && utilnet.IsIPv6String(peers[i].Address) {
@@ -718,14 +936,48 @@ func (c *Controller) getPeerConfigs(peers []v1alpha1.BGPPeer) map[string]bgp.Pee
 			}
 
 			peerConfigs[peerKey] = bgp.PeerConfig{
-				BGPPeer:  &peers[i],
-				Password: password,
+				BGPPeer:         &peers[i],
+				Password:        password,
+				AddressFamilies: addressFamilies,
 			}
 		}
 	}
 	return peerConfigs
 }
 
+// getAddressFamilies returns the address families enabled on the BGP sessions, ignoring the ones of the IP families
+// which are not enabled in the cluster. It returns nil when no address family is configured.
+func (c *Controller) getAddressFamilies(addressFamilies []v1alpha1.BGPAddressFamily) []v1alpha1.BGPAddressFamily {
+	if len(addressFamilies) == 0 {
+		return nil
+	}
+	enabledAddressFamilies := make([]v1alpha1.BGPAddressFamily, 0, len(addressFamilies))
+	for _, addressFamily := range addressFamilies {
+		if addressFamily == v1alpha1.BGPAddressFamilyIPv4Unicast && c.enabledIPv4 ||
+			addressFamily == v1alpha1.BGPAddressFamilyIPv6Unicast && c.enabledIPv6 {
+			enabledAddressFamilies = append(enabledAddressFamilies, addressFamily)
+		}
+	}
+	return enabledAddressFamilies
+}
+
+// getNextHops returns the next hops of the advertised IPv4 and IPv6 routes. When address families are configured, a
+// BGP session may carry routes of the other IP family than its own, for which the local address of the session is not
+// a valid next hop, so the Node IPs are used as the next hops.
+func (c *Controller) getNextHops(addressFamilies []v1alpha1.BGPAddressFamily) (string, string) {
+	if len(addressFamilies) == 0 {
+		return "", ""
+	}
+	var ipv4NextHop, ipv6NextHop string
+	if c.enabledIPv4 {
+		ipv4NextHop = c.nodeIPv4Addr
+	}
+	if c.enabledIPv6 {
+		ipv6NextHop = c.nodeIPv6Addr
+	}
+	return ipv4NextHop, ipv6NextHop
+}
+
 func generateBGPPeerKey(address string, asn int32) string {
 	return fmt.Sprintf("%s-%d", address, asn)
 }
@@ -783,7 +1035,22 @@ func matchesNode(node *corev1.Node, bgpPolicy *v1alpha1.BGPPolicy) bool {
 	return nodeSelector.Matches(labels.Set(node.Labels))
 }
 
+// matchesLabelSelector returns whether the labels match the selector. A nil selector matches all objects.
+func matchesLabelSelector(labelSelector *metav1.LabelSelector, objLabels map[string]string) bool {
+	if labelSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(objLabels))
+}
+
 func matchesService(svc *corev1.Service, bgpPolicy *v1alpha1.BGPPolicy) bool {
+	if !matchesLabelSelector(bgpPolicy.Spec.Advertisements.Service.ServiceSelector, svc.Labels) {
+		return false
+	}
 	ipTypeMap := sets.New(bgpPolicy.Spec.Advertisements.Service.IPTypes...)
 	if ipTypeMap.Has(v1alpha1.ServiceIPTypeClusterIP) && len(svc.Spec.ClusterIPs) != 0 ||
 		ipTypeMap.Has(v1alpha1.ServiceIPTypeExternalIP) && len(svc.Spec.ExternalIPs) != 0 ||
@@ -822,7 +1089,8 @@ func (c *Controller) updateService(oldObj, obj interface{}) {
 		slices.Equal(oldSvc.Spec.ExternalIPs, svc.Spec.ExternalIPs) &&
 		slices.Equal(getIngressIPs(oldSvc), getIngressIPs(svc)) &&
 		oldSvc.Spec.ExternalTrafficPolicy == svc.Spec.ExternalTrafficPolicy &&
-		ptr.Equal(oldSvc.Spec.InternalTrafficPolicy, svc.Spec.InternalTrafficPolicy) {
+		ptr.Equal(oldSvc.Spec.InternalTrafficPolicy, svc.Spec.InternalTrafficPolicy) &&
+		reflect.DeepEqual(oldSvc.Labels, svc.Labels) {
 		return
 	}
 	if c.hasAffectedPolicyByService(oldSvc) || c.hasAffectedPolicyByService(svc) {
@@ -899,13 +1167,13 @@ func (c *Controller) deleteEndpointSlice(obj interface{}) {
 	}
 }
 
-func (c *Controller) hasAffectedPolicyByEgress() bool {
+func (c *Controller) hasAffectedPolicyByEgress(eg *v1beta1.Egress) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
 		if !c.matchesCurrentNode(policy) {
 			continue
 		}
-		if policy.Spec.Advertisements.Egress != nil {
+		if policy.Spec.Advertisements.Egress != nil && matchesLabelSelector(policy.Spec.Advertisements.Egress.EgressSelector, eg.Labels) {
 			return true
 		}
 	}
@@ -917,7 +1185,7 @@ func (c *Controller) addEgress(obj interface{}) {
 	if eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress ADD event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
@@ -929,10 +1197,11 @@ func (c *Controller) updateEgress(oldObj, obj interface{}) {
 	if oldEg.Status.EgressNode != c.nodeName && eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if oldEg.Status.EgressIP == eg.Status.EgressIP && oldEg.Status.EgressNode == eg.Status.EgressNode {
+	if oldEg.Status.EgressIP == eg.Status.EgressIP && oldEg.Status.EgressNode == eg.Status.EgressNode &&
+		reflect.DeepEqual(oldEg.Labels, eg.Labels) {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(oldEg) || c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress UPDATE event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
@@ -943,12 +1212,81 @@ func (c *Controller) deleteEgress(obj interface{}) {
 	if eg.Status.EgressNode != c.nodeName {
 		return
 	}
-	if c.hasAffectedPolicyByEgress() {
+	if c.hasAffectedPolicyByEgress(eg) {
 		klog.V(2).InfoS("Processing Egress DELETE event", "Egress", klog.KObj(eg))
 		c.queue.Add(dummyKey)
 	}
 }
 
+// isLocalPodWithIPs returns whether the Pod runs on the current Node, is not using the host network, and has been
+// allocated IPs.
+func (c *Controller) isLocalPodWithIPs(pod *corev1.Pod) bool {
+	return pod.Spec.NodeName == c.nodeName && !pod.Spec.HostNetwork && len(pod.Status.PodIPs) != 0
+}
+
+func (c *Controller) hasAffectedPolicyByPod(pod *corev1.Pod) bool {
+	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
+	for _, policy := range allPolicies {
+		if !c.matchesCurrentNode(policy) {
+			continue
+		}
+		// Pods only affect the advertised routes when a Pod selector is specified, as the Pod CIDRs of the Node are
+		// advertised otherwise.
+		podAdvertisement := policy.Spec.Advertisements.Pod
+		if podAdvertisement != nil && podAdvertisement.PodSelector != nil && matchesLabelSelector(podAdvertisement.PodSelector, pod.Labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Controller) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	if !c.isLocalPodWithIPs(pod) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod ADD event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func (c *Controller) updatePod(oldObj, obj interface{}) {
+	oldPod := oldObj.(*corev1.Pod)
+	pod := obj.(*corev1.Pod)
+	if !c.isLocalPodWithIPs(oldPod) && !c.isLocalPodWithIPs(pod) {
+		return
+	}
+	if reflect.DeepEqual(oldPod.Status.PodIPs, pod.Status.PodIPs) && reflect.DeepEqual(oldPod.Labels, pod.Labels) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(oldPod) || c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod UPDATE event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
+func (c *Controller) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			return
+		}
+	}
+	if !c.isLocalPodWithIPs(pod) {
+		return
+	}
+	if c.hasAffectedPolicyByPod(pod) {
+		klog.V(2).InfoS("Processing Pod DELETE event", "Pod", klog.KObj(pod))
+		c.queue.Add(dummyKey)
+	}
+}
+
 func (c *Controller) hasAffectedPolicyByNode(node *corev1.Node) bool {
 	allPolicies, _ := c.bgpPolicyLister.List(labels.Everything())
 	for _, policy := range allPolicies {
@@ -1072,3 +1410,19 @@ func (c *Controller) GetBGPRoutes(ctx context.Context) (map[bgp.Route]RouteMetad
 	}
 	return bgpRoutes, nil
 }
+
+// GetImportedBGPRoutes returns the routes received from BGP peers and installed on the Node.
+func (c *Controller) GetImportedBGPRoutes(ctx context.Context) ([]bgp.ReceivedRoute, error) {
+	c.bgpPolicyStateMutex.RLock()
+	defer c.bgpPolicyStateMutex.RUnlock()
+
+	if c.bgpPolicyState == nil {
+		return nil, ErrBGPPolicyNotFound
+	}
+
+	importedRoutes := make([]bgp.ReceivedRoute, 0, len(c.bgpPolicyState.importedRoutes))
+	for _, route := range c.bgpPolicyState.importedRoutes {
+		importedRoutes = append(importedRoutes, route)
+	}
+	return importedRoutes, nil
+}
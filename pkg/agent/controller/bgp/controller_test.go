@@ -17,6 +17,8 @@ package bgp
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/netip"
 	"reflect"
 	"testing"
 	"time"
@@ -41,6 +43,7 @@ import (
 	"antrea.io/antrea/pkg/agent/bgp"
 	bgptest "antrea.io/antrea/pkg/agent/bgp/testing"
 	"antrea.io/antrea/pkg/agent/config"
+	routetest "antrea.io/antrea/pkg/agent/route/testing"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -60,11 +63,13 @@ var (
 	podIPv6CIDR      = ip.MustParseCIDR("fec0:10:10::/64")
 	podIPv6CIDRRoute = bgp.Route{Prefix: podIPv6CIDR.String()}
 	nodeIPv4Addr     = ip.MustParseCIDR("192.168.77.100/24")
+	nodeIPv6Addr     = ip.MustParseCIDR("fec0::192:168:77:100/64")
 
 	testNodeConfig = &config.NodeConfig{
 		PodIPv4CIDR:  podIPv4CIDR,
 		PodIPv6CIDR:  podIPv6CIDR,
 		NodeIPv4Addr: nodeIPv4Addr,
+		NodeIPv6Addr: nodeIPv6Addr,
 		Name:         localNodeName,
 	}
 
@@ -225,6 +230,7 @@ type fakeController struct {
 	*Controller
 	mockController     *gomock.Controller
 	mockBGPServer      *bgptest.MockInterface
+	mockRouteClient    *routetest.MockInterface
 	crdClient          *fakeversioned.Clientset
 	crdInformerFactory crdinformers.SharedInformerFactory
 	client             *fake.Clientset
@@ -241,6 +247,7 @@ func (c *fakeController) startInformers(stopCh chan struct{}) {
 func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runtime.Object, ipv4Enabled, ipv6Enabled bool) *fakeController {
 	ctrl := gomock.NewController(t)
 	mockBGPServer := bgptest.NewMockInterface(ctrl)
+	mockRouteClient := routetest.NewMockInterface(ctrl)
 
 	client := fake.NewSimpleClientset(objects...)
 	crdClient := fakeversioned.NewSimpleClientset(crdObjects...)
@@ -253,14 +260,17 @@ func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runt
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
 	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
 	bgpPolicyInformer := crdInformerFactory.Crd().V1alpha1().BGPPolicies()
+	podInformer := informerFactory.Core().V1().Pods().Informer()
 
 	bgpController, _ := NewBGPPolicyController(nodeInformer,
 		serviceInformer,
 		egressInformer,
 		bgpPolicyInformer,
 		endpointSliceInformer,
+		podInformer,
 		true,
 		client,
+		mockRouteClient,
 		testNodeConfig,
 		&config.NetworkConfig{
 			IPv4Enabled: ipv4Enabled,
@@ -275,6 +285,7 @@ func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runt
 		Controller:         bgpController,
 		mockController:     ctrl,
 		mockBGPServer:      mockBGPServer,
+		mockRouteClient:    mockRouteClient,
 		crdClient:          crdClient,
 		crdInformerFactory: crdInformerFactory,
 		client:             client,
@@ -609,6 +620,129 @@ func TestBGPPolicyAdd(t *testing.T) {
 	}
 }
 
+func TestBGPPolicyAddressFamilies(t *testing.T) {
+	testCases := []struct {
+		name                string
+		ipv4Enabled         bool
+		ipv6Enabled         bool
+		addressFamilies     []v1alpha1.BGPAddressFamily
+		expectedGlobalConf  *bgp.GlobalConfig
+		expectedPeerConfigs []bgp.PeerConfig
+		expectedRoutes      []bgp.Route
+	}{
+		{
+			name:        "dual-stack without address families",
+			ipv4Enabled: true,
+			ipv6Enabled: true,
+			expectedGlobalConf: &bgp.GlobalConfig{
+				ASN:        65000,
+				RouterID:   nodeAnnotations1[types.NodeBGPRouterIDAnnotationKey],
+				ListenPort: 179,
+			},
+			expectedPeerConfigs: []bgp.PeerConfig{ipv4Peer1Config, ipv6Peer1Config},
+			expectedRoutes:      []bgp.Route{podIPv4CIDRRoute, podIPv6CIDRRoute},
+		},
+		{
+			name:            "dual-stack with IPv4 and IPv6 address families",
+			ipv4Enabled:     true,
+			ipv6Enabled:     true,
+			addressFamilies: []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv4Unicast, v1alpha1.BGPAddressFamilyIPv6Unicast},
+			expectedGlobalConf: &bgp.GlobalConfig{
+				ASN:         65000,
+				RouterID:    nodeAnnotations1[types.NodeBGPRouterIDAnnotationKey],
+				ListenPort:  179,
+				IPv4NextHop: nodeIPv4Addr.IP.String(),
+				IPv6NextHop: nodeIPv6Addr.IP.String(),
+			},
+			expectedPeerConfigs: []bgp.PeerConfig{
+				{
+					BGPPeer:         &ipv4Peer1,
+					Password:        peer1AuthPassword,
+					AddressFamilies: []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv4Unicast, v1alpha1.BGPAddressFamilyIPv6Unicast},
+				},
+				{
+					BGPPeer:         &ipv6Peer1,
+					Password:        peer1AuthPassword,
+					AddressFamilies: []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv4Unicast, v1alpha1.BGPAddressFamilyIPv6Unicast},
+				},
+			},
+			expectedRoutes: []bgp.Route{podIPv4CIDRRoute, podIPv6CIDRRoute},
+		},
+		{
+			name:            "IPv4 with IPv4 and IPv6 address families",
+			ipv4Enabled:     true,
+			addressFamilies: []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv4Unicast, v1alpha1.BGPAddressFamilyIPv6Unicast},
+			expectedGlobalConf: &bgp.GlobalConfig{
+				ASN:         65000,
+				RouterID:    nodeAnnotations1[types.NodeBGPRouterIDAnnotationKey],
+				ListenPort:  179,
+				IPv4NextHop: nodeIPv4Addr.IP.String(),
+			},
+			expectedPeerConfigs: []bgp.PeerConfig{
+				{
+					BGPPeer:         &ipv4Peer1,
+					Password:        peer1AuthPassword,
+					AddressFamilies: []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv4Unicast},
+				},
+			},
+			expectedRoutes: []bgp.Route{podIPv4CIDRRoute},
+		},
+		{
+			name:            "IPv4 with IPv6 address family",
+			ipv4Enabled:     true,
+			addressFamilies: []v1alpha1.BGPAddressFamily{v1alpha1.BGPAddressFamilyIPv6Unicast},
+			expectedGlobalConf: &bgp.GlobalConfig{
+				ASN:         65000,
+				RouterID:    nodeAnnotations1[types.NodeBGPRouterIDAnnotationKey],
+				ListenPort:  179,
+				IPv4NextHop: nodeIPv4Addr.IP.String(),
+			},
+			expectedRoutes: []bgp.Route{podIPv4CIDRRoute},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := generateBGPPolicy(bgpPolicyName1,
+				creationTimestamp,
+				nodeLabels1,
+				179,
+				65000,
+				false,
+				false,
+				false,
+				false,
+				true,
+				[]v1alpha1.BGPPeer{ipv4Peer1, ipv6Peer1},
+				nil)
+			policy.Spec.AddressFamilies = tt.addressFamilies
+			c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy}, tt.ipv4Enabled, tt.ipv6Enabled)
+			var globalConfig *bgp.GlobalConfig
+			c.newBGPServerFn = func(config *bgp.GlobalConfig) bgp.Interface {
+				globalConfig = config
+				return c.mockBGPServer
+			}
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			ctx := context.Background()
+			c.startInformers(stopCh)
+			c.bgpPeerPasswords = bgpPeerPasswords
+
+			waitAndGetDummyEvent(t, c)
+			c.mockBGPServer.EXPECT().Start(gomock.Any())
+			for _, peerConfig := range tt.expectedPeerConfigs {
+				c.mockBGPServer.EXPECT().AddPeer(gomock.Any(), peerConfig)
+			}
+			for _, route := range tt.expectedRoutes {
+				c.mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), []bgp.Route{route})
+			}
+			assert.NoError(t, c.syncBGPPolicy(ctx))
+			doneDummyEvent(t, c)
+			assert.Equal(t, tt.expectedGlobalConf, globalConfig)
+		})
+	}
+}
+
 func TestBGPPolicyUpdate(t *testing.T) {
 	effectivePolicy := generateBGPPolicy(bgpPolicyName1,
 		creationTimestamp,
@@ -1851,6 +1985,158 @@ func TestEgressLifecycle(t *testing.T) {
 	doneDummyEvent(t, c)
 }
 
+func TestGetRoutesWithSelectors(t *testing.T) {
+	selectedLabels := map[string]string{"bgp": "advertise"}
+	selector := &metav1.LabelSelector{MatchLabels: selectedLabels}
+
+	selectedSvc := generateService("svc-selected", corev1.ServiceTypeLoadBalancer, "10.96.10.1", "", "192.168.77.150", false, false)
+	selectedSvc.Labels = selectedLabels
+	svc := generateService("svc", corev1.ServiceTypeLoadBalancer, "10.96.10.2", "", "192.168.77.151", false, false)
+	selectedEgress := generateEgress("eg-selected", "192.168.77.200", localNodeName)
+	selectedEgress.Labels = selectedLabels
+	egress := generateEgress("eg", "192.168.77.201", localNodeName)
+	selectedPod := generatePod("pod-selected", localNodeName, selectedLabels, false, "10.10.0.10", "fec0:10:10::10")
+	pod := generatePod("pod", localNodeName, nil, false, "10.10.0.11", "fec0:10:10::11")
+	hostNetworkPod := generatePod("pod-host-network", localNodeName, selectedLabels, true, "192.168.77.100")
+	remotePod := generatePod("pod-remote", "remote", selectedLabels, false, "10.10.1.10")
+
+	c := newFakeController(t,
+		[]runtime.Object{node, selectedSvc, svc, selectedPod, pod, hostNetworkPod, remotePod},
+		[]runtime.Object{selectedEgress, egress},
+		true,
+		true)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.startInformers(stopCh)
+
+	testCases := []struct {
+		name           string
+		advertisements v1alpha1.Advertisements
+		expectedRoutes map[bgp.Route]RouteMetadata
+	}{
+		{
+			name: "Service selector",
+			advertisements: v1alpha1.Advertisements{
+				Service: &v1alpha1.ServiceAdvertisement{
+					IPTypes:         []v1alpha1.ServiceIPType{v1alpha1.ServiceIPTypeLoadBalancerIP},
+					ServiceSelector: selector,
+				},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "192.168.77.150/32"}: {Type: ServiceLoadBalancerIP, K8sObjRef: "default/svc-selected"},
+			},
+		},
+		{
+			name: "Egress selector",
+			advertisements: v1alpha1.Advertisements{
+				Egress: &v1alpha1.EgressAdvertisement{EgressSelector: selector},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "192.168.77.200/32"}: {Type: EgressIP, K8sObjRef: "eg-selected"},
+			},
+		},
+		{
+			name: "Pod selector",
+			advertisements: v1alpha1.Advertisements{
+				Pod: &v1alpha1.PodAdvertisement{PodSelector: selector},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "10.10.0.10/32"}:      {Type: PodIP, K8sObjRef: "default/pod-selected"},
+				{Prefix: "fec0:10:10::10/128"}: {Type: PodIP, K8sObjRef: "default/pod-selected"},
+			},
+		},
+		{
+			name: "Empty Pod selector",
+			advertisements: v1alpha1.Advertisements{
+				Pod: &v1alpha1.PodAdvertisement{PodSelector: &metav1.LabelSelector{}},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				{Prefix: "10.10.0.10/32"}:      {Type: PodIP, K8sObjRef: "default/pod-selected"},
+				{Prefix: "fec0:10:10::10/128"}: {Type: PodIP, K8sObjRef: "default/pod-selected"},
+				{Prefix: "10.10.0.11/32"}:      {Type: PodIP, K8sObjRef: "default/pod"},
+				{Prefix: "fec0:10:10::11/128"}: {Type: PodIP, K8sObjRef: "default/pod"},
+			},
+		},
+		{
+			name: "No Pod selector",
+			advertisements: v1alpha1.Advertisements{
+				Pod: &v1alpha1.PodAdvertisement{},
+			},
+			expectedRoutes: map[bgp.Route]RouteMetadata{
+				podIPv4CIDRRoute: {Type: NodeIPAMPodCIDR},
+				podIPv6CIDRRoute: {Type: NodeIPAMPodCIDR},
+			},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedRoutes, c.getRoutes(tt.advertisements))
+		})
+	}
+}
+
+func TestPodLifecycle(t *testing.T) {
+	selectedLabels := map[string]string{"bgp": "advertise"}
+	policy := generateBGPPolicy(bgpPolicyName1,
+		creationTimestamp,
+		nodeLabels1,
+		179,
+		65000,
+		false,
+		false,
+		false,
+		false,
+		false,
+		[]v1alpha1.BGPPeer{ipv4Peer1},
+		nil)
+	policy.Spec.Advertisements.Pod = &v1alpha1.PodAdvertisement{
+		PodSelector: &metav1.LabelSelector{MatchLabels: selectedLabels},
+	}
+	c := newFakeController(t, []runtime.Object{node}, []runtime.Object{policy}, true, false)
+	mockBGPServer := c.mockBGPServer
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	ctx := context.Background()
+	c.startInformers(stopCh)
+
+	// Fake the passwords of BGP peers.
+	c.bgpPeerPasswords = bgpPeerPasswords
+
+	// Wait for the dummy event triggered by BGPPolicy add events.
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().Start(gomock.Any())
+	mockBGPServer.EXPECT().AddPeer(gomock.Any(), ipv4Peer1Config)
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Create a Pod which is not selected.
+	pod := generatePod("pod1", localNodeName, nil, false, "10.10.0.10")
+	_, err := c.client.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.Never(t, func() bool { return c.queue.Len() > 0 }, 200*time.Millisecond, 10*time.Millisecond)
+
+	// Label the Pod so that it is selected.
+	pod = pod.DeepCopy()
+	pod.Labels = selectedLabels
+	_, err = c.client.CoreV1().Pods(pod.Namespace).Update(context.TODO(), pod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().AdvertiseRoutes(gomock.Any(), gomock.InAnyOrder([]bgp.Route{{Prefix: "10.10.0.10/32"}}))
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+
+	// Delete the Pod.
+	err = c.client.CoreV1().Pods(pod.Namespace).Delete(context.TODO(), pod.Name, metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	waitAndGetDummyEvent(t, c)
+	mockBGPServer.EXPECT().WithdrawRoutes(gomock.Any(), gomock.InAnyOrder([]bgp.Route{{Prefix: "10.10.0.10/32"}}))
+	require.NoError(t, c.syncBGPPolicy(ctx))
+	doneDummyEvent(t, c)
+}
+
 func TestBGPPasswordUpdate(t *testing.T) {
 	policy := generateBGPPolicy(bgpPolicyName1,
 		creationTimestamp,
@@ -2162,6 +2448,7 @@ func generateBGPPolicyState(bgpPolicyName string,
 		confederationConfig: confederationConfig,
 		routes:              routes,
 		peerConfigs:         peerConfigMap,
+		importedRoutes:      make(map[string]bgp.ReceivedRoute),
 	}
 	return state
 }
@@ -2176,6 +2463,10 @@ func deepCopyBGPPolicyState(in *bgpPolicyState) *bgpPolicyState {
 	for routeType := range in.routes {
 		routes[routeType] = in.routes[routeType]
 	}
+	importedRoutes := make(map[string]bgp.ReceivedRoute)
+	for prefix, route := range in.importedRoutes {
+		importedRoutes[prefix] = route
+	}
 	var confederationConf *confederationConfig
 	if in.confederationConfig != nil {
 		confederationConf = &confederationConfig{
@@ -2192,6 +2483,7 @@ func deepCopyBGPPolicyState(in *bgpPolicyState) *bgpPolicyState {
 		confederationConfig: confederationConf,
 		routes:              routes,
 		peerConfigs:         peerConfigMap,
+		importedRoutes:      importedRoutes,
 	}
 }
 
@@ -2205,6 +2497,7 @@ func checkBGPPolicyState(t *testing.T, expected, got *bgpPolicyState) {
 		assert.Equal(t, expected.routes, got.routes)
 		assert.Equal(t, expected.peerConfigs, got.peerConfigs)
 		assert.Equal(t, expected.confederationConfig, got.confederationConfig)
+		assert.Equal(t, expected.importedRoutes, got.importedRoutes)
 	}
 }
 
@@ -2319,6 +2612,25 @@ func generateEgress(name string, ip string, nodeName string) *crdv1b1.Egress {
 	}
 }
 
+func generatePod(name, nodeName string, labels map[string]string, hostNetwork bool, podIPs ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespaceDefault,
+			UID:       "test-uid",
+			Labels:    labels,
+		},
+		Spec: corev1.PodSpec{
+			NodeName:    nodeName,
+			HostNetwork: hostNetwork,
+		},
+	}
+	for _, podIP := range podIPs {
+		pod.Status.PodIPs = append(pod.Status.PodIPs, corev1.PodIP{IP: podIP})
+	}
+	return pod
+}
+
 func generateNode(name string, labels, annotations map[string]string) *corev1.Node {
 	return &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2574,3 +2886,155 @@ func TestGetBGPRoutes(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileBGPRouteImport(t *testing.T) {
+	ipv4Route1 := bgp.ReceivedRoute{Prefix: "172.16.0.0/16", NextHop: "192.168.77.200", Peer: "192.168.77.200"}
+	ipv4Route1Updated := bgp.ReceivedRoute{Prefix: "172.16.0.0/16", NextHop: "192.168.77.201", Peer: "192.168.77.201"}
+	ipv4Route2 := bgp.ReceivedRoute{Prefix: "172.17.0.0/24", NextHop: "192.168.77.200", Peer: "192.168.77.200"}
+	ipv4RouteNotAllowed := bgp.ReceivedRoute{Prefix: "10.10.0.0/16", NextHop: "192.168.77.200", Peer: "192.168.77.200"}
+	ipv6Route := bgp.ReceivedRoute{Prefix: "fd00:172:16::/48", NextHop: "fec0::192:168:77:200", Peer: "fec0::192:168:77:200"}
+	ipv6RouteWithIPv4NextHop := bgp.ReceivedRoute{Prefix: "fd00:172:17::/48", NextHop: "192.168.77.200", Peer: "192.168.77.200"}
+	routeImport := &v1alpha1.RouteImport{
+		Prefixes: []v1alpha1.BGPPrefixMatch{
+			{CIDR: "172.16.0.0/12", MaxLength: ptr.To[int32](24)},
+			{CIDR: "fd00::/8", MaxLength: ptr.To[int32](64)},
+		},
+	}
+	ipNet := func(prefix string) *net.IPNet {
+		_, dst, _ := net.ParseCIDR(prefix)
+		return dst
+	}
+
+	testCases := []struct {
+		name                   string
+		ipv6Enabled            bool
+		routeImport            *v1alpha1.RouteImport
+		watching               bool
+		existingImportedRoutes []bgp.ReceivedRoute
+		expectedCalls          func(mockBGPServer *bgptest.MockInterfaceMockRecorder, mockRouteClient *routetest.MockInterfaceMockRecorder)
+		expectedImportedRoutes []bgp.ReceivedRoute
+		expectedWatching       bool
+		expectedErr            string
+	}{
+		{
+			name:        "import routes",
+			ipv6Enabled: true,
+			routeImport: routeImport,
+			expectedCalls: func(mockBGPServer *bgptest.MockInterfaceMockRecorder, mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				mockBGPServer.WatchReceivedRoutes(gomock.Any(), gomock.Any())
+				mockBGPServer.GetBestReceivedRoutes(gomock.Any()).Return([]bgp.ReceivedRoute{ipv4Route1, ipv4RouteNotAllowed, ipv6Route, ipv6RouteWithIPv4NextHop}, nil)
+				mockRouteClient.AddBGPRoute(ipNet(ipv4Route1.Prefix), net.ParseIP(ipv4Route1.NextHop))
+				mockRouteClient.AddBGPRoute(ipNet(ipv6Route.Prefix), net.ParseIP(ipv6Route.NextHop))
+			},
+			expectedImportedRoutes: []bgp.ReceivedRoute{ipv4Route1, ipv6Route},
+			expectedWatching:       true,
+		},
+		{
+			name:        "IPv6 disabled",
+			routeImport: routeImport,
+			expectedCalls: func(mockBGPServer *bgptest.MockInterfaceMockRecorder, mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				mockBGPServer.WatchReceivedRoutes(gomock.Any(), gomock.Any())
+				mockBGPServer.GetBestReceivedRoutes(gomock.Any()).Return([]bgp.ReceivedRoute{ipv4Route1, ipv6Route}, nil)
+				mockRouteClient.AddBGPRoute(ipNet(ipv4Route1.Prefix), net.ParseIP(ipv4Route1.NextHop))
+			},
+			expectedImportedRoutes: []bgp.ReceivedRoute{ipv4Route1},
+			expectedWatching:       true,
+		},
+		{
+			name:                   "update and withdraw routes",
+			routeImport:            routeImport,
+			watching:               true,
+			existingImportedRoutes: []bgp.ReceivedRoute{ipv4Route1, ipv4Route2},
+			expectedCalls: func(mockBGPServer *bgptest.MockInterfaceMockRecorder, mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				mockBGPServer.GetBestReceivedRoutes(gomock.Any()).Return([]bgp.ReceivedRoute{ipv4Route1Updated}, nil)
+				mockRouteClient.DeleteBGPRoute(ipNet(ipv4Route2.Prefix))
+				mockRouteClient.AddBGPRoute(ipNet(ipv4Route1Updated.Prefix), net.ParseIP(ipv4Route1Updated.NextHop))
+			},
+			expectedImportedRoutes: []bgp.ReceivedRoute{ipv4Route1Updated},
+			expectedWatching:       true,
+		},
+		{
+			name:                   "failed to install a route",
+			routeImport:            routeImport,
+			watching:               true,
+			existingImportedRoutes: []bgp.ReceivedRoute{ipv4Route1},
+			expectedCalls: func(mockBGPServer *bgptest.MockInterfaceMockRecorder, mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				mockBGPServer.GetBestReceivedRoutes(gomock.Any()).Return([]bgp.ReceivedRoute{ipv4Route1, ipv4Route2}, nil)
+				mockRouteClient.AddBGPRoute(ipNet(ipv4Route2.Prefix), net.ParseIP(ipv4Route2.NextHop)).Return(fmt.Errorf("network is unreachable"))
+			},
+			expectedImportedRoutes: []bgp.ReceivedRoute{ipv4Route1},
+			expectedWatching:       true,
+			expectedErr:            "network is unreachable",
+		},
+		{
+			name:                   "disable route import",
+			watching:               true,
+			existingImportedRoutes: []bgp.ReceivedRoute{ipv4Route1, ipv6Route},
+			expectedCalls: func(mockBGPServer *bgptest.MockInterfaceMockRecorder, mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				mockRouteClient.DeleteBGPRoute(ipNet(ipv4Route1.Prefix))
+				mockRouteClient.DeleteBGPRoute(ipNet(ipv6Route.Prefix))
+			},
+			expectedImportedRoutes: []bgp.ReceivedRoute{},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeController(t, nil, nil, true, tt.ipv6Enabled)
+
+			// Fake the BGPPolicy state.
+			c.bgpPolicyState = generateBGPPolicyState(bgpPolicyName1, 179, 65000, "192.168.77.100", nil, nil, nil)
+			c.bgpPolicyState.bgpServer = c.mockBGPServer
+			for _, route := range tt.existingImportedRoutes {
+				c.bgpPolicyState.importedRoutes[route.Prefix] = route
+			}
+			if tt.watching {
+				c.bgpPolicyState.stopWatchingReceivedRoutes = func() {}
+			}
+			if tt.expectedCalls != nil {
+				tt.expectedCalls(c.mockBGPServer.EXPECT(), c.mockRouteClient.EXPECT())
+			}
+
+			err := c.reconcileBGPRouteImport(context.Background(), tt.routeImport)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			expectedImportedRoutes := make(map[string]bgp.ReceivedRoute)
+			for _, route := range tt.expectedImportedRoutes {
+				expectedImportedRoutes[route.Prefix] = route
+			}
+			assert.Equal(t, expectedImportedRoutes, c.bgpPolicyState.importedRoutes)
+			assert.Equal(t, tt.expectedWatching, c.bgpPolicyState.stopWatchingReceivedRoutes != nil)
+		})
+	}
+}
+
+func TestMatchesPrefixes(t *testing.T) {
+	prefixMatches := []v1alpha1.BGPPrefixMatch{
+		{CIDR: "10.10.0.0/16"},
+		{CIDR: "172.16.0.0/12", MinLength: ptr.To[int32](16), MaxLength: ptr.To[int32](24)},
+		{CIDR: "fd00::/8", MaxLength: ptr.To[int32](64)},
+	}
+	testCases := []struct {
+		prefix   string
+		expected bool
+	}{
+		{prefix: "10.10.0.0/16", expected: true},
+		{prefix: "10.10.1.0/24", expected: false},
+		{prefix: "10.0.0.0/8", expected: false},
+		{prefix: "172.16.0.0/12", expected: false},
+		{prefix: "172.17.0.0/16", expected: true},
+		{prefix: "172.17.1.0/24", expected: true},
+		{prefix: "172.17.1.0/25", expected: false},
+		{prefix: "172.32.0.0/16", expected: false},
+		{prefix: "fd00:10::/32", expected: true},
+		{prefix: "fd00:10::/96", expected: false},
+		{prefix: "fe80::/64", expected: false},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.prefix, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchesPrefixes(netip.MustParsePrefix(tt.prefix), prefixMatches))
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -900,7 +901,7 @@ func (c *EgressController) unbindPodEgress(pod, egress string) (string, bool) {
 	return "", false
 }
 
-func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP string, scheduleErr error) error {
+func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP string, standbyNodes []string, scheduleErr error) error {
 	isLocal := false
 	if egressIP != "" {
 		isLocal = c.localIPDetector.IsLocalIP(egressIP)
@@ -911,6 +912,7 @@ func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP s
 		desiredStatus.EgressNode = c.nodeName
 		desiredStatus.EgressIP = egressIP
 		if isEgressSchedulable(egress) {
+			desiredStatus.StandbyNodes = standbyNodes
 			desiredStatus.Conditions = []crdv1b1.EgressCondition{
 				{
 					Type:               crdv1b1.IPAssigned,
@@ -1014,6 +1016,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 
 	var desiredEgressIP string
 	var desiredNode string
+	var standbyNodes []string
 	var scheduleErr error
 	// Only check whether the Egress IP should be assigned to this Node when the Egress is schedulable.
 	// Otherwise, users are responsible for assigning the Egress IP to Nodes.
@@ -1022,6 +1025,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 		if scheduled {
 			desiredEgressIP = egressIP
 			desiredNode = egressNode
+			standbyNodes = c.egressIPScheduler.GetEgressStandbyNodes(egressName)
 		} else {
 			scheduleErr = err
 		}
@@ -1039,7 +1043,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 	}
 	// Do not proceed if EgressIP is empty.
 	if desiredEgressIP == "" {
-		if err := c.updateEgressStatus(egress, "", scheduleErr); err != nil {
+		if err := c.updateEgressStatus(egress, "", nil, scheduleErr); err != nil {
 			return fmt.Errorf("update Egress %s status error: %v", egressName, err)
 		}
 		return nil
@@ -1098,7 +1102,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 		eState.mark = mark
 	}
 
-	if err := c.updateEgressStatus(egress, desiredEgressIP, nil); err != nil {
+	if err := c.updateEgressStatus(egress, desiredEgressIP, standbyNodes, nil); err != nil {
 		return fmt.Errorf("update Egress %s status error: %v", egressName, err)
 	}
 
@@ -1393,6 +1397,44 @@ func (c *EgressController) GetEgress(ns, podName string) (types.EgressConfig, er
 	}, nil
 }
 
+// GetEgressOFPorts returns the ofPorts of the local Pods to which each Egress is effectively applied.
+func (c *EgressController) GetEgressOFPorts() []types.EgressOFPorts {
+	if c == nil {
+		return nil
+	}
+	egressPods := func() map[string][]string {
+		c.egressBindingsMutex.RLock()
+		defer c.egressBindingsMutex.RUnlock()
+		egressPods := make(map[string][]string)
+		for pod, binding := range c.egressBindings {
+			egressPods[binding.effectiveEgress] = append(egressPods[binding.effectiveEgress], pod)
+		}
+		return egressPods
+	}()
+	result := make([]types.EgressOFPorts, 0, len(egressPods))
+	for egressName, pods := range egressPods {
+		egress, err := c.egressLister.Get(egressName)
+		if err != nil {
+			continue
+		}
+		var ofPorts []int32
+		for _, pod := range pods {
+			podNamespace, podName := k8s.SplitNamespacedName(pod)
+			ifaces := c.ifaceStore.GetContainerInterfacesByPod(podName, podNamespace)
+			if len(ifaces) == 0 {
+				continue
+			}
+			ofPorts = append(ofPorts, ifaces[0].OFPort)
+		}
+		result = append(result, types.EgressOFPorts{
+			Name:    egressName,
+			UID:     egress.UID,
+			OFPorts: ofPorts,
+		})
+	}
+	return result
+}
+
 // An Egress is schedulable if its Egress IP is allocated from ExternalIPPool.
 func isEgressSchedulable(egress *crdv1b1.Egress) bool {
 	return egress.Spec.EgressIP != "" && egress.Spec.ExternalIPPool != ""
@@ -1409,6 +1451,9 @@ func compareEgressStatus(currentStatus, desiredStatus *crdv1b1.EgressStatus) boo
 	if currentStatus.EgressIP != desiredStatus.EgressIP || currentStatus.EgressNode != desiredStatus.EgressNode {
 		return false
 	}
+	if !slices.Equal(currentStatus.StandbyNodes, desiredStatus.StandbyNodes) {
+		return false
+	}
 	currentIPAssignedCondition := crdv1b1.GetEgressCondition(currentStatus.Conditions, crdv1b1.IPAssigned)
 	desiredIPAssignedCondition := crdv1b1.GetEgressCondition(desiredStatus.Conditions, crdv1b1.IPAssigned)
 	if currentIPAssignedCondition == nil && desiredIPAssignedCondition == nil {
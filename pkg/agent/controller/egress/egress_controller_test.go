@@ -129,6 +129,14 @@ func (c *fakeSingleNodeCluster) SelectNodeForIP(ip, externalIPPool string, filte
 	return c.node, nil
 }
 
+func (c *fakeSingleNodeCluster) SelectNodesForIP(ip, externalIPPool string, n int, filters ...func(string) bool) ([]string, error) {
+	node, err := c.SelectNodeForIP(ip, externalIPPool, filters...)
+	if err != nil {
+		return nil, err
+	}
+	return []string{node}, nil
+}
+
 func (c *fakeSingleNodeCluster) AliveNodes() sets.Set[string] {
 	return sets.New[string](c.node)
 }
@@ -1438,6 +1446,7 @@ func TestUpdateEgressStatus(t *testing.T) {
 		name                 string
 		egress               *crdv1b1.Egress
 		egressIP             string
+		standbyNodes         []string
 		scheduleErr          error
 		updateErrorNum       int
 		updateError          error
@@ -1533,6 +1542,32 @@ func TestUpdateEgressStatus(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "updating HA Egress with local IP reports standby Nodes",
+			egress: &crdv1b1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", ResourceVersion: "fake-ResourceVersion"},
+				Spec:       crdv1b1.EgressSpec{EgressIP: fakeLocalEgressIP1, ExternalIPPool: fakeExternalIPPool},
+				Status: crdv1b1.EgressStatus{
+					EgressNode:   fakeNode,
+					EgressIP:     fakeLocalEgressIP1,
+					StandbyNodes: []string{fakeNode2},
+					Conditions: []crdv1b1.EgressCondition{
+						{Type: crdv1b1.IPAssigned, Status: v1.ConditionTrue, Reason: "Assigned", Message: "EgressIP is successfully assigned to EgressNode"},
+					},
+				},
+			},
+			egressIP:             fakeLocalEgressIP1,
+			standbyNodes:         []string{fakeNode2, "node3"},
+			expectedUpdateCalled: 1,
+			expectedEgressStatus: crdv1b1.EgressStatus{
+				EgressNode:   fakeNode,
+				EgressIP:     fakeLocalEgressIP1,
+				StandbyNodes: []string{fakeNode2, "node3"},
+				Conditions: []crdv1b1.EgressCondition{
+					{Type: crdv1b1.IPAssigned, Status: v1.ConditionTrue, Reason: "Assigned", Message: "EgressIP is successfully assigned to EgressNode"},
+				},
+			},
+		},
 		{
 			name: "updating HA Egress with remote IP does nothing",
 			egress: &crdv1b1.Egress{
@@ -1639,7 +1674,7 @@ func TestUpdateEgressStatus(t *testing.T) {
 			localIPDetector := &fakeLocalIPDetector{localIPs: sets.New[string](fakeLocalEgressIP1)}
 			cluster := newFakeMemberlistCluster([]string{tt.selectedNodeForIP})
 			c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, localIPDetector: localIPDetector, cluster: cluster}
-			err := c.updateEgressStatus(tt.egress, tt.egressIP, tt.scheduleErr)
+			err := c.updateEgressStatus(tt.egress, tt.egressIP, tt.standbyNodes, tt.scheduleErr)
 			if err != tt.expectedError {
 				t.Errorf("Update Egress error not match, got: %v, expected: %v", err, tt.expectedError)
 			}
@@ -1727,6 +1762,43 @@ func TestGetEgress(t *testing.T) {
 	}
 }
 
+func TestGetEgressOFPorts(t *testing.T) {
+	egress := &crdv1b1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1b1.EgressSpec{EgressIP: fakeLocalEgressIP1},
+	}
+	egressGroup := &cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+		},
+	}
+
+	var nilController *EgressController
+	assert.Nil(t, nilController.GetEgressOFPorts())
+
+	c := newFakeController(t, []runtime.Object{egress})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	assert.Empty(t, c.GetEgressOFPorts())
+
+	c.addEgressGroup(egressGroup)
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	require.NoError(t, c.syncEgress(egress.Name))
+
+	expected := []types.EgressOFPorts{
+		{Name: "egressA", UID: "uidA", OFPorts: []int32{1}},
+	}
+	assert.Equal(t, expected, c.GetEgressOFPorts())
+}
+
 func TestGetEgressIPByMark(t *testing.T) {
 	egress := &crdv1b1.Egress{
 		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
@@ -15,15 +15,19 @@
 package egress
 
 import (
+	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	corev1informers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -38,16 +42,20 @@ import (
 const (
 	// workItem is the only item that will be enqueued, used to trigger Egress IP scheduling.
 	workItem = "key"
+	// maxStandbyNodes is the maximum number of standby Nodes computed for an Egress.
+	maxStandbyNodes = 3
 )
 
 // scheduleEventHandler is a callback when an Egress is rescheduled.
 type scheduleEventHandler func(egress string)
 
-// scheduleResult is the schedule result of an Egress, including the effective Egress IP and Node.
+// scheduleResult is the schedule result of an Egress, including the effective Egress IP and Node, and the ordered
+// standby Nodes.
 type scheduleResult struct {
-	ip   string
-	node string
-	err  error
+	ip           string
+	node         string
+	standbyNodes []string
+	err          error
 }
 
 // egressIPScheduler is responsible for scheduling Egress IPs to appropriate Nodes according to the Node selector of the
@@ -59,6 +67,9 @@ type egressIPScheduler struct {
 	egressLister       crdlisters.EgressLister
 	egressListerSynced cache.InformerSynced
 
+	// nodeLister is used to get Node labels when evaluating Egress scheduling policies.
+	nodeLister corelisters.NodeLister
+
 	// queue is used to trigger scheduling. Triggering multiple times before the item is consumed will only cause one
 	// execution of scheduling.
 	queue workqueue.TypedInterface[string]
@@ -85,6 +96,7 @@ func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinform
 		cluster:             cluster,
 		egressLister:        egressInformer.Lister(),
 		egressListerSynced:  egressInformer.Informer().HasSynced,
+		nodeLister:          nodeInformer.Lister(),
 		scheduleResults:     map[string]*scheduleResult{},
 		scheduledOnce:       &atomic.Bool{},
 		maxEgressIPsPerNode: maxEgressIPsPerNode,
@@ -102,8 +114,12 @@ func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinform
 	nodeInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: s.updateNode,
-			UpdateFunc: func(_, newObj interface{}) {
+			UpdateFunc: func(oldObj, newObj interface{}) {
 				s.updateNode(newObj)
+				// Node labels are used to evaluate Egress scheduling policies.
+				if !labels.Equals(oldObj.(*corev1.Node).Labels, newObj.(*corev1.Node).Labels) {
+					s.queue.Add(workItem)
+				}
 			},
 			DeleteFunc: s.deleteNode,
 		},
@@ -186,7 +202,8 @@ func (s *egressIPScheduler) updateEgress(old, cur interface{}) {
 	if !isEgressSchedulable(oldEgress) && !isEgressSchedulable(curEgress) {
 		return
 	}
-	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool {
+	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool &&
+		reflect.DeepEqual(oldEgress.Spec.SchedulingPolicy, curEgress.Spec.SchedulingPolicy) {
 		return
 	}
 	s.queue.Add(workItem)
@@ -263,6 +280,19 @@ func (s *egressIPScheduler) GetEgressIPAndNode(egress string) (string, string, e
 	return result.ip, result.node, nil, true
 }
 
+// GetEgressStandbyNodes returns the ordered standby Nodes of the Egress, which will take over its Egress IP in turn if
+// the Node holding it becomes unavailable.
+func (s *egressIPScheduler) GetEgressStandbyNodes(egress string) []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	result, exists := s.scheduleResults[egress]
+	if !exists {
+		return nil
+	}
+	return result.standbyNodes
+}
+
 // EgressesByCreationTimestamp sorts a list of Egresses by creation timestamp.
 type EgressesByCreationTimestamp []*crdv1b1.Egress
 
@@ -353,7 +383,21 @@ func (s *egressIPScheduler) schedule() {
 			}
 			return numIPs <= s.getMaxEgressIPsByNode(node)
 		}
-		node, err := s.cluster.SelectNodeForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool, maxEgressIPsFilter)
+		var node string
+		var standbyNodes []string
+		var err error
+		if egress.Spec.SchedulingPolicy == nil {
+			node, err = s.cluster.SelectNodeForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool, maxEgressIPsFilter)
+			if err == nil {
+				// The standby Nodes are the next Nodes in the consistent hash ring. The error can be ignored as it
+				// only means there is no other Node.
+				standbyNodes, _ = s.cluster.SelectNodesForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool, maxStandbyNodes, func(n string) bool {
+					return n != node
+				})
+			}
+		} else {
+			node, standbyNodes, err = s.selectNodesBySchedulingPolicy(egress, maxEgressIPsFilter)
+		}
 		if err != nil {
 			if err == memberlist.ErrNoNodeAvailable {
 				klog.InfoS("No Node is eligible for Egress", "egress", klog.KObj(egress))
@@ -365,8 +409,9 @@ func (s *egressIPScheduler) schedule() {
 			continue
 		}
 		result := &scheduleResult{
-			ip:   egress.Spec.EgressIP,
-			node: node,
+			ip:           egress.Spec.EgressIP,
+			node:         node,
+			standbyNodes: standbyNodes,
 		}
 		newResults[egress.Name] = result
 
@@ -386,7 +431,8 @@ func (s *egressIPScheduler) schedule() {
 		prevResults := s.scheduleResults
 		for egress, result := range newResults {
 			prevResult, exists := prevResults[egress]
-			if !exists || prevResult.ip != result.ip || prevResult.node != result.node || prevResult.err != result.err {
+			if !exists || prevResult.ip != result.ip || prevResult.node != result.node || prevResult.err != result.err ||
+				!slices.Equal(prevResult.standbyNodes, result.standbyNodes) {
 				egressesToUpdate = append(egressesToUpdate, egress)
 			}
 			delete(prevResults, egress)
@@ -407,3 +453,92 @@ func (s *egressIPScheduler) schedule() {
 
 	s.scheduledOnce.Store(true)
 }
+
+// selectNodesBySchedulingPolicy selects the Node which should hold the Egress IP of an Egress with a scheduling policy,
+// and its standby Nodes. The eligible Nodes are sorted by descending score, which is the sum of the weights of the
+// preferred Node selectors they match, and the consistent hashing order is kept for Nodes with the same score. The
+// first Node which passes the capacity filter is selected. If zone anti-affinity is requested, the standby Nodes are
+// picked from each zone in turn, starting with the zones different from the one of the selected Node. Like the default
+// consistent hashing, the standby Nodes do not take the capacity of Nodes into account.
+func (s *egressIPScheduler) selectNodesBySchedulingPolicy(egress *crdv1b1.Egress, capacityFilter func(string) bool) (string, []string, error) {
+	nodes, err := s.cluster.SelectNodesForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	policy := egress.Spec.SchedulingPolicy
+	selectors := make([]labels.Selector, len(policy.PreferredNodes))
+	for i := range policy.PreferredNodes {
+		selector, err := metav1.LabelSelectorAsSelector(&policy.PreferredNodes[i].NodeSelector)
+		if err != nil {
+			// The policy is validated by the webhook, so this should not happen.
+			klog.ErrorS(err, "Invalid preferred Node selector in Egress scheduling policy", "egress", klog.KObj(egress))
+			selector = labels.Nothing()
+		}
+		selectors[i] = selector
+	}
+	var topologyKey string
+	if policy.ZoneAntiAffinity != nil {
+		topologyKey = policy.ZoneAntiAffinity.TopologyKey
+		if topologyKey == "" {
+			topologyKey = corev1.LabelTopologyZone
+		}
+	}
+
+	scores := make(map[string]int32, len(nodes))
+	zones := make(map[string]string, len(nodes))
+	for _, nodeName := range nodes {
+		node, err := s.nodeLister.Get(nodeName)
+		if err != nil {
+			// The Node has no score and is considered to be in the same zone as Nodes without the topology label.
+			continue
+		}
+		nodeLabels := labels.Set(node.Labels)
+		for i, selector := range selectors {
+			if selector.Matches(nodeLabels) {
+				scores[nodeName] += policy.PreferredNodes[i].Weight
+			}
+		}
+		if topologyKey != "" {
+			zones[nodeName] = node.Labels[topologyKey]
+		}
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return scores[nodes[i]] > scores[nodes[j]]
+	})
+
+	idx := slices.IndexFunc(nodes, capacityFilter)
+	if idx < 0 {
+		return "", nil, memberlist.ErrNoNodeAvailable
+	}
+	selectedNode := nodes[idx]
+	// Move the selected Node to the front, the remaining Nodes are the candidate standby Nodes.
+	nodes = append([]string{selectedNode}, slices.Delete(nodes, idx, idx+1)...)
+	if topologyKey != "" {
+		nodes = spreadNodesAcrossZones(nodes, zones)
+	}
+	return selectedNode, nodes[1:min(len(nodes), maxStandbyNodes+1)], nil
+}
+
+// spreadNodesAcrossZones reorders the provided Nodes by picking one Node from each zone in turn, so that consecutive
+// Nodes are in different zones whenever possible. The relative order of Nodes in the same zone is kept, and zones are
+// visited in the order of their first Node, so the first Node is unchanged.
+func spreadNodesAcrossZones(nodes []string, zones map[string]string) []string {
+	var zoneOrder []string
+	nodesByZone := map[string][]string{}
+	for _, node := range nodes {
+		zone := zones[node]
+		if _, exists := nodesByZone[zone]; !exists {
+			zoneOrder = append(zoneOrder, zone)
+		}
+		nodesByZone[zone] = append(nodesByZone[zone], node)
+	}
+	result := make([]string, 0, len(nodes))
+	for i := 0; len(result) < len(nodes); i++ {
+		for _, zone := range zoneOrder {
+			if i < len(nodesByZone[zone]) {
+				result = append(result, nodesByZone[zone][i])
+			}
+		}
+	}
+	return result
+}
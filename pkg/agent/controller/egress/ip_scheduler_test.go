@@ -79,6 +79,14 @@ func (f *fakeMemberlistCluster) SelectNodeForIP(ip, externalIPPool string, filte
 	return node, nil
 }
 
+func (f *fakeMemberlistCluster) SelectNodesForIP(ip, externalIPPool string, n int, filters ...func(string) bool) ([]string, error) {
+	nodes := f.hashMap.GetNWithFilters(ip, n, filters...)
+	if len(nodes) == 0 {
+		return nil, memberlist.ErrNoNodeAvailable
+	}
+	return nodes, nil
+}
+
 func (f *fakeMemberlistCluster) ShouldSelectIP(ip string, pool string, filters ...func(node string) bool) (bool, error) {
 	return false, nil
 }
@@ -111,16 +119,19 @@ func TestSchedule(t *testing.T) {
 			maxEgressIPsPerNode: 3,
 			expectedResults: map[string]*scheduleResult{
 				"egressA": {
-					node: "node1",
-					ip:   "1.1.1.1",
+					node:         "node1",
+					ip:           "1.1.1.1",
+					standbyNodes: []string{"node2", "node3"},
 				},
 				"egressB": {
-					node: "node3",
-					ip:   "1.1.1.11",
+					node:         "node3",
+					ip:           "1.1.1.11",
+					standbyNodes: []string{"node2", "node1"},
 				},
 				"egressC": {
-					node: "node1",
-					ip:   "1.1.1.21",
+					node:         "node1",
+					ip:           "1.1.1.21",
+					standbyNodes: []string{"node2", "node3"},
 				},
 			},
 		},
@@ -135,12 +146,14 @@ func TestSchedule(t *testing.T) {
 			},
 			expectedResults: map[string]*scheduleResult{
 				"egressA": {
-					node: "node2",
-					ip:   "1.1.1.1",
+					node:         "node2",
+					ip:           "1.1.1.1",
+					standbyNodes: []string{"node1", "node3"},
 				},
 				"egressB": {
-					node: "node2",
-					ip:   "1.1.1.11",
+					node:         "node2",
+					ip:           "1.1.1.11",
+					standbyNodes: []string{"node3", "node1"},
 				},
 				"egressC": {
 					err: memberlist.ErrNoNodeAvailable,
@@ -152,18 +165,22 @@ func TestSchedule(t *testing.T) {
 			nodes:               []string{"node1", "node2", "node3"},
 			maxEgressIPsPerNode: 1,
 			// egressC was moved to node2 due to insufficient node capacity.
+			// Standby Nodes don't take the capacity into account.
 			expectedResults: map[string]*scheduleResult{
 				"egressA": {
-					node: "node1",
-					ip:   "1.1.1.1",
+					node:         "node1",
+					ip:           "1.1.1.1",
+					standbyNodes: []string{"node2", "node3"},
 				},
 				"egressB": {
-					node: "node3",
-					ip:   "1.1.1.11",
+					node:         "node3",
+					ip:           "1.1.1.11",
+					standbyNodes: []string{"node2", "node1"},
 				},
 				"egressC": {
-					node: "node2",
-					ip:   "1.1.1.21",
+					node:         "node2",
+					ip:           "1.1.1.21",
+					standbyNodes: []string{"node1", "node3"},
 				},
 			},
 		},
@@ -174,12 +191,14 @@ func TestSchedule(t *testing.T) {
 			// egressC was not scheduled to any Node due to insufficient node capacity.
 			expectedResults: map[string]*scheduleResult{
 				"egressA": {
-					node: "node1",
-					ip:   "1.1.1.1",
+					node:         "node1",
+					ip:           "1.1.1.1",
+					standbyNodes: []string{"node3"},
 				},
 				"egressB": {
-					node: "node3",
-					ip:   "1.1.1.11",
+					node:         "node3",
+					ip:           "1.1.1.11",
+					standbyNodes: []string{"node1"},
 				},
 				"egressC": {
 					err: memberlist.ErrNoNodeAvailable,
@@ -212,6 +231,132 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduleWithSchedulingPolicy(t *testing.T) {
+	newNode := func(name, zone string, preferred bool) *corev1.Node {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{corev1.LabelTopologyZone: zone, "rack": "rack-" + name},
+			},
+		}
+		if preferred {
+			node.Labels["egress"] = "preferred"
+		}
+		return node
+	}
+	nodes := []runtime.Object{
+		newNode("node1", "zone-a", false),
+		newNode("node2", "zone-a", true),
+		newNode("node3", "zone-b", false),
+		newNode("node4", "zone-b", true),
+		newNode("node5", "zone-c", false),
+		newNode("node6", "zone-c", false),
+	}
+	nodeNames := []string{"node1", "node2", "node3", "node4", "node5", "node6"}
+	preferredNodes := crdv1b1.EgressPreferredNodes{
+		Weight:       10,
+		NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"egress": "preferred"}},
+	}
+	zoneANodes := crdv1b1.EgressPreferredNodes{
+		Weight:       20,
+		NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelTopologyZone: "zone-a"}},
+	}
+	// Without scheduling policy, the Nodes are ordered as follows by the consistent hash for IP 1.1.1.1:
+	// node5, node1, node4, node2, node3, node6.
+	tests := []struct {
+		name               string
+		policy             *crdv1b1.EgressSchedulingPolicy
+		nodeToMaxEgressIPs map[string]int
+		expectedResult     *scheduleResult
+	}{
+		{
+			name:           "no policy",
+			expectedResult: &scheduleResult{ip: "1.1.1.1", node: "node5", standbyNodes: []string{"node1", "node4", "node2"}},
+		},
+		{
+			name: "preferred Nodes",
+			policy: &crdv1b1.EgressSchedulingPolicy{
+				PreferredNodes: []crdv1b1.EgressPreferredNodes{preferredNodes},
+			},
+			expectedResult: &scheduleResult{ip: "1.1.1.1", node: "node4", standbyNodes: []string{"node2", "node5", "node1"}},
+		},
+		{
+			name: "preferred Nodes with different weights",
+			policy: &crdv1b1.EgressSchedulingPolicy{
+				PreferredNodes: []crdv1b1.EgressPreferredNodes{preferredNodes, zoneANodes},
+			},
+			expectedResult: &scheduleResult{ip: "1.1.1.1", node: "node2", standbyNodes: []string{"node1", "node4", "node5"}},
+		},
+		{
+			name: "preferred Node without capacity",
+			policy: &crdv1b1.EgressSchedulingPolicy{
+				PreferredNodes: []crdv1b1.EgressPreferredNodes{preferredNodes},
+			},
+			nodeToMaxEgressIPs: map[string]int{"node4": 0},
+			expectedResult:     &scheduleResult{ip: "1.1.1.1", node: "node2", standbyNodes: []string{"node4", "node5", "node1"}},
+		},
+		{
+			name: "zone anti-affinity",
+			policy: &crdv1b1.EgressSchedulingPolicy{
+				ZoneAntiAffinity: &crdv1b1.EgressZoneAntiAffinity{},
+			},
+			expectedResult: &scheduleResult{ip: "1.1.1.1", node: "node5", standbyNodes: []string{"node1", "node4", "node6"}},
+		},
+		{
+			name: "preferred Nodes with zone anti-affinity",
+			policy: &crdv1b1.EgressSchedulingPolicy{
+				PreferredNodes:   []crdv1b1.EgressPreferredNodes{preferredNodes},
+				ZoneAntiAffinity: &crdv1b1.EgressZoneAntiAffinity{},
+			},
+			expectedResult: &scheduleResult{ip: "1.1.1.1", node: "node4", standbyNodes: []string{"node2", "node5", "node3"}},
+		},
+		{
+			name: "zone anti-affinity with custom topology key",
+			policy: &crdv1b1.EgressSchedulingPolicy{
+				PreferredNodes:   []crdv1b1.EgressPreferredNodes{zoneANodes},
+				ZoneAntiAffinity: &crdv1b1.EgressZoneAntiAffinity{TopologyKey: "rack"},
+			},
+			// Every Node is in its own rack, so the order is the same as without anti-affinity.
+			expectedResult: &scheduleResult{ip: "1.1.1.1", node: "node1", standbyNodes: []string{"node2", "node5", "node4"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			egress := &crdv1b1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1, 0))},
+				Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "pool1", SchedulingPolicy: tt.policy},
+			}
+			fakeCluster := newFakeMemberlistCluster(nodeNames)
+			crdClient := fakeversioned.NewSimpleClientset(egress)
+			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+			egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+			clientset := fake.NewSimpleClientset(nodes...)
+			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+			nodeInformer := informerFactory.Core().V1().Nodes()
+
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 3)
+			s.nodeToMaxEgressIPs = tt.nodeToMaxEgressIPs
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			crdInformerFactory.Start(stopCh)
+			informerFactory.Start(stopCh)
+			crdInformerFactory.WaitForCacheSync(stopCh)
+			informerFactory.WaitForCacheSync(stopCh)
+
+			s.schedule()
+			assert.Equal(t, map[string]*scheduleResult{"egressA": tt.expectedResult}, s.scheduleResults)
+			assert.Equal(t, tt.expectedResult.standbyNodes, s.GetEgressStandbyNodes("egressA"))
+		})
+	}
+}
+
+func TestSpreadNodesAcrossZones(t *testing.T) {
+	zones := map[string]string{"node1": "zone-a", "node2": "zone-a", "node3": "zone-a", "node4": "zone-b", "node5": "zone-c"}
+	nodes := []string{"node1", "node2", "node4", "node3", "node5", "node6"}
+	// node6 has no zone.
+	assert.Equal(t, []string{"node1", "node4", "node5", "node6", "node2", "node3"}, spreadNodesAcrossZones(nodes, zones))
+}
+
 func BenchmarkSchedule(b *testing.B) {
 	var egresses []runtime.Object
 	for i := 0; i < 1000; i++ {
@@ -339,8 +484,9 @@ func TestRun(t *testing.T) {
 	assertScheduleResult(t, s, "egressD", "", "", false)
 
 	// After node2 joins, egressB should be moved to node2 determined by its consistent hash result, and egressD should be assigned to node1.
+	// node2 becomes the standby Node of egressC.
 	fakeCluster.updateNodes([]string{"node1", "node2"})
-	assertReceivedItems(t, egressUpdates, sets.New[string]("egressB", "egressD"))
+	assertReceivedItems(t, egressUpdates, sets.New[string]("egressB", "egressC", "egressD"))
 	assertScheduleResult(t, s, "egressB", "1.1.1.11", "node2", true)
 	assertScheduleResult(t, s, "egressC", "1.1.1.21", "node1", true)
 	assertScheduleResult(t, s, "egressD", "1.1.1.1", "node1", true)
@@ -15,8 +15,11 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -25,31 +28,62 @@ import (
 	"time"
 
 	"antrea.io/ofnet/ofctrl"
+	"golang.org/x/time/rate"
 	"gopkg.in/natefinch/lumberjack.v2"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/logdir"
+	"antrea.io/antrea/pkg/util/syslog"
 )
 
 const (
 	logfileSubdir   string = "networkpolicy"
 	logfileName     string = "np.log"
 	nullPlaceholder        = "<nil>"
+	// ruleLogLimiterIdleTimeout is the time after which the log sampling state of a rule which has not logged any
+	// packet is removed.
+	ruleLogLimiterIdleTimeout = 5 * time.Minute
+)
+
+const (
+	// AuditLogFormatText logs each record as a single line of space-separated fields.
+	AuditLogFormatText = "text"
+	// AuditLogFormatJSON logs each record as a single line JSON object.
+	AuditLogFormatJSON = "json"
 )
 
 // AuditLogger is used for network policy audit logging.
 // Includes a lumberjack logger and a map used for log deduplication.
 type AuditLogger struct {
-	bufferLength     time.Duration
-	clock            clock.Clock // enable the use of a "virtual" clock for unit tests
-	npLogger         *log.Logger
+	bufferLength time.Duration
+	clock        clock.Clock // enable the use of a "virtual" clock for unit tests
+	format       string
+	npLogger     *log.Logger
+	// syslogWriter sends the logs to a remote syslog server. It is nil if the syslog sink is disabled.
+	syslogWriter     io.Writer
 	logDeduplication logRecordDedupMap
+	// ruleLogLimiters stores the log sampling state of the rules configured with log sampling, keyed by rule flow ID.
+	ruleLogLimitersMutex sync.Mutex
+	ruleLogLimiters      map[uint32]*ruleLogLimiter
+	lastLimitersGC       time.Time
+}
+
+// ruleLogLimiter applies the log sampling of a rule to the packets logged for the rule.
+type ruleLogLimiter struct {
+	logSampling v1beta2.LogSampling
+	// packetCount is the number of packets received for the rule, used to log one packet out of every
+	// logSampling.SamplingRate packets.
+	packetCount int64
+	// limiter limits the number of logged packets per second. It is nil if logSampling.MaxEventsPerSecond is not set.
+	limiter  *rate.Limiter
+	lastSeen time.Time
 }
 
 type AuditLoggerOptions struct {
@@ -57,6 +91,10 @@ type AuditLoggerOptions struct {
 	MaxBackups int
 	MaxAge     int
 	Compress   bool
+	// Format is either AuditLogFormatText or AuditLogFormatJSON. Defaults to AuditLogFormatText.
+	Format string
+	// Syslog is the configuration of the remote syslog sink. It is nil if the syslog sink is disabled.
+	Syslog *syslog.Config
 }
 
 // logInfo will be set by retrieving info from packetin and register.
@@ -75,10 +113,46 @@ type logInfo struct {
 	destPort     string // destination port of the traffic logged
 	pktLength    string // packet length of packetin
 	protocolStr  string // protocol of the traffic logged
+
+	// The following fields are only used by the JSON format.
+	npReference *v1beta2.NetworkPolicyReference // full Network Policy reference
+	srcPod      *v1beta2.PodReference           // source Pod of the traffic logged
+	destPod     *v1beta2.PodReference           // destination Pod of the traffic logged
+
+	// The following fields are used to apply the log sampling of the rule.
+	ruleFlowID  uint32               // OpenFlow conjunction ID of the rule, 0 for the K8s NetworkPolicy default deny
+	logSampling *v1beta2.LogSampling // log sampling of the rule, nil if all packets are logged
+}
+
+// jsonLogRecord is the representation of a log record when the JSON format is used.
+type jsonLogRecord struct {
+	Timestamp       string                          `json:"timestamp"`
+	TableName       string                          `json:"tableName"`
+	Policy          *v1beta2.NetworkPolicyReference `json:"policy,omitempty"`
+	RuleName        string                          `json:"ruleName,omitempty"`
+	Direction       string                          `json:"direction,omitempty"`
+	Disposition     string                          `json:"disposition"`
+	OFPriority      string                          `json:"ofPriority,omitempty"`
+	AppliedToPod    string                          `json:"appliedToPod,omitempty"`
+	SourceIP        string                          `json:"sourceIP"`
+	SourcePort      int                             `json:"sourcePort,omitempty"`
+	SourcePod       *v1beta2.PodReference           `json:"sourcePod,omitempty"`
+	DestinationIP   string                          `json:"destinationIP"`
+	DestinationPort int                             `json:"destinationPort,omitempty"`
+	DestinationPod  *v1beta2.PodReference           `json:"destinationPod,omitempty"`
+	Protocol        string                          `json:"protocol"`
+	PacketLength    int                             `json:"packetLength"`
+	LogLabel        string                          `json:"logLabel,omitempty"`
+	// PacketCount is the number of duplicate packets aggregated in this record.
+	PacketCount int64 `json:"packetCount"`
+	// Duration is the time elapsed between the first and the last duplicate packets. It is only set when more than
+	// one packet is aggregated in this record.
+	Duration string `json:"duration,omitempty"`
 }
 
 // logDedupRecord will be used as 1 sec buffer for log deduplication.
 type logDedupRecord struct {
+	info          *logInfo         // info of the first packet
 	count         int64            // record count of duplicate log
 	initTime      time.Time        // initial time upon receiving packet log
 	bufferTimerCh <-chan time.Time // 1 sec buffer for each log
@@ -109,23 +183,19 @@ func (l *AuditLogger) terminateLogKey(logMsg string) {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	logRecord := l.logDeduplication.logMap[logMsg]
-	if logRecord.count == 1 {
-		l.npLogger.Print(logMsg)
-	} else {
-		l.npLogger.Printf("%s [%d packets in %s]", logMsg, logRecord.count, time.Since(logRecord.initTime))
-	}
+	l.writeLog(logRecord.info, logMsg, logRecord.count, l.clock.Since(logRecord.initTime))
 	delete(l.logDeduplication.logMap, logMsg)
 }
 
 // updateLogKey initiates record or increases the count in logDeduplication corresponding to given logMsg.
-func (l *AuditLogger) updateLogKey(logMsg string, bufferLength time.Duration) bool {
+func (l *AuditLogger) updateLogKey(ob *logInfo, logMsg string, bufferLength time.Duration) bool {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	_, exists := l.logDeduplication.logMap[logMsg]
 	if exists {
 		l.logDeduplication.logMap[logMsg].count++
 	} else {
-		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength)}
+		record := logDedupRecord{ob, 1, l.clock.Now(), l.clock.After(bufferLength)}
 		l.logDeduplication.logMap[logMsg] = &record
 	}
 	return exists
@@ -150,15 +220,118 @@ func buildLogMsg(ob *logInfo) string {
 	}, " ")
 }
 
+func buildJSONLogMsg(ob *logInfo, count int64, duration time.Duration, now time.Time) (string, error) {
+	// Placeholders are omitted from the JSON representation.
+	optional := func(value string) string {
+		if value == nullPlaceholder {
+			return ""
+		}
+		return value
+	}
+	record := jsonLogRecord{
+		Timestamp:      now.Format(time.RFC3339Nano),
+		TableName:      ob.tableName,
+		Policy:         ob.npReference,
+		RuleName:       optional(ob.ruleName),
+		Direction:      optional(ob.direction),
+		Disposition:    ob.disposition,
+		OFPriority:     optional(ob.ofPriority),
+		AppliedToPod:   optional(ob.appliedToRef),
+		SourceIP:       ob.srcIP,
+		SourcePod:      ob.srcPod,
+		DestinationIP:  ob.destIP,
+		DestinationPod: ob.destPod,
+		Protocol:       ob.protocolStr,
+		LogLabel:       optional(ob.logLabel),
+		PacketCount:    count,
+	}
+	// Ports are not set for protocols other than TCP and UDP.
+	record.SourcePort, _ = strconv.Atoi(ob.srcPort)
+	record.DestinationPort, _ = strconv.Atoi(ob.destPort)
+	record.PacketLength, _ = strconv.Atoi(ob.pktLength)
+	if count > 1 {
+		record.Duration = duration.String()
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// writeLog writes a log record, aggregating count duplicate packets, to the log file and to the syslog server if
+// enabled. logMsg is the text representation of ob.
+func (l *AuditLogger) writeLog(ob *logInfo, logMsg string, count int64, duration time.Duration) {
+	msg := logMsg
+	if l.format == AuditLogFormatJSON {
+		var err error
+		if msg, err = buildJSONLogMsg(ob, count, duration, l.clock.Now()); err != nil {
+			klog.ErrorS(err, "Failed to build JSON audit log record")
+			return
+		}
+	} else if count > 1 {
+		msg = fmt.Sprintf("%s [%d packets in %s]", logMsg, count, duration)
+	}
+	l.npLogger.Print(msg)
+	if l.syslogWriter != nil {
+		if _, err := l.syslogWriter.Write([]byte(msg)); err != nil {
+			klog.V(2).ErrorS(err, "Failed to send audit log record to syslog server")
+		}
+	}
+}
+
+// allowLog returns whether the packet in ob should be logged according to the log sampling of the rule. Sampling is
+// applied first, then the rate limit, so that the logged packets are evenly spread over the matched traffic.
+func (l *AuditLogger) allowLog(ob *logInfo) bool {
+	if ob.logSampling == nil {
+		return true
+	}
+	l.ruleLogLimitersMutex.Lock()
+	defer l.ruleLogLimitersMutex.Unlock()
+	now := l.clock.Now()
+	if now.Sub(l.lastLimitersGC) >= ruleLogLimiterIdleTimeout {
+		for ruleFlowID, limiter := range l.ruleLogLimiters {
+			if now.Sub(limiter.lastSeen) >= ruleLogLimiterIdleTimeout {
+				delete(l.ruleLogLimiters, ruleFlowID)
+			}
+		}
+		l.lastLimitersGC = now
+	}
+	limiter, ok := l.ruleLogLimiters[ob.ruleFlowID]
+	// The rule flow ID may be reused by another rule, or the log sampling of the rule may have been updated.
+	if !ok || limiter.logSampling != *ob.logSampling {
+		limiter = &ruleLogLimiter{logSampling: *ob.logSampling}
+		if ob.logSampling.MaxEventsPerSecond > 0 {
+			limit := int(ob.logSampling.MaxEventsPerSecond)
+			limiter.limiter = rate.NewLimiter(rate.Limit(limit), limit)
+		}
+		l.ruleLogLimiters[ob.ruleFlowID] = limiter
+	}
+	limiter.lastSeen = now
+	limiter.packetCount++
+	if samplingRate := int64(ob.logSampling.SamplingRate); samplingRate > 1 && (limiter.packetCount-1)%samplingRate != 0 {
+		metrics.NetworkPolicyAuditLogSuppressedCount.WithLabelValues(metrics.LabelAuditLogSuppressedSampling).Inc()
+		return false
+	}
+	if limiter.limiter != nil && !limiter.limiter.AllowN(now, 1) {
+		metrics.NetworkPolicyAuditLogSuppressedCount.WithLabelValues(metrics.LabelAuditLogSuppressedRateLimit).Inc()
+		return false
+	}
+	return true
+}
+
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
 func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
+	if !l.allowLog(ob) {
+		return
+	}
 	// Deduplicate non-Allow packet log.
 	logMsg := buildLogMsg(ob)
 	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] {
-		l.npLogger.Print(logMsg)
+		l.writeLog(ob, logMsg, 1, 0)
 	} else {
 		// Increase count if duplicated within 1 sec, create buffer otherwise.
-		exists := l.updateLogKey(logMsg, l.bufferLength)
+		exists := l.updateLogKey(ob, logMsg, l.bufferLength)
 		if !exists {
 			// Go routine for logging when buffer timer stops.
 			go l.logAfterTimer(logMsg)
@@ -187,11 +360,29 @@ func newAuditLogger(options *AuditLoggerOptions) (*AuditLogger, error) {
 		Compress:   options.Compress,
 	}
 
+	format := options.Format
+	if format == "" {
+		format = AuditLogFormatText
+	}
+	// JSON records include their own timestamp, so that each line is a valid JSON object.
+	logFlags := log.Ldate | log.Lmicroseconds
+	if format == AuditLogFormatJSON {
+		logFlags = 0
+	}
 	auditLogger := &AuditLogger{
 		bufferLength:     time.Second,
 		clock:            clock.RealClock{},
-		npLogger:         log.New(logOutput, "", log.Ldate|log.Lmicroseconds),
+		format:           format,
+		npLogger:         log.New(logOutput, "", logFlags),
 		logDeduplication: logRecordDedupMap{logMap: make(map[string]*logDedupRecord)},
+		ruleLogLimiters:  make(map[uint32]*ruleLogLimiter),
+	}
+	if options.Syslog != nil {
+		syslogWriter, err := syslog.NewWriter(*options.Syslog)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating syslog writer for audit logging: %w", err)
+		}
+		auditLogger.syslogWriter = syslogWriter
 	}
 	klog.InfoS("Initialized Antrea-native Policy Logger for audit logging", "logFile", logFile, "options", options)
 	return auditLogger, nil
@@ -260,6 +451,7 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 		if isK8sDefaultDeny {
 			// For K8s NetworkPolicy implicit drop action, we cannot get Namespace/name.
 			ob.npRef = string(v1beta2.K8sNetworkPolicy)
+			ob.npReference = &v1beta2.NetworkPolicyReference{Type: v1beta2.K8sNetworkPolicy}
 			fillLogInfoPlaceholders([]*string{&ob.ruleName, &ob.logLabel, &ob.ofPriority})
 			return nil
 		}
@@ -278,9 +470,11 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 		return fmt.Errorf("networkpolicy not found for conjunction id: %v", conjID)
 	}
 	ob.npRef = npRef.ToString()
+	ob.npReference = npRef
 	ob.ofPriority = ofPriority
 	ob.ruleName = ruleName
 	ob.logLabel = logLabel
+	ob.ruleFlowID = conjID
 	// Fill in placeholders for Antrea-native policies without log labels,
 	// K8s NetworkPolicies without rule names or log labels.
 	fillLogInfoPlaceholders([]*string{&ob.ruleName, &ob.logLabel, &ob.ofPriority})
@@ -302,6 +496,20 @@ func getPacketInfo(packet *binding.Packet, ob *logInfo) {
 	}
 }
 
+// getPodInfo fills in the source and destination Pods of logInfo ob. Local Pods are retrieved from the interface
+// store, while remote Pods are retrieved from the AddressGroups of the NetworkPolicies.
+func getPodInfo(packet *binding.Packet, c *Controller, ob *logInfo) {
+	ob.srcPod = getPodReferenceByIP(packet.SourceIP, c)
+	ob.destPod = getPodReferenceByIP(packet.DestinationIP, c)
+}
+
+func getPodReferenceByIP(podIP net.IP, c *Controller) *v1beta2.PodReference {
+	if iface, ok := c.ifaceStore.GetInterfaceByIP(podIP.String()); ok && iface.Type == interfacestore.ContainerInterface {
+		return &v1beta2.PodReference{Name: iface.ContainerInterfaceConfig.PodName, Namespace: iface.ContainerInterfaceConfig.PodNamespace}
+	}
+	return c.ruleCache.getPodReferenceByIP(podIP)
+}
+
 func fillLogInfoPlaceholders(logItems []*string) {
 	for i, v := range logItems {
 		if *v == "" {
@@ -326,6 +534,16 @@ func (c *Controller) logPacket(pktIn *ofctrl.PacketIn) error {
 		return fmt.Errorf("received error while retrieving NetworkPolicy info: %v", err)
 	}
 	getPacketInfo(packet, ob)
+	if c.auditLogger.format == AuditLogFormatJSON {
+		getPodInfo(packet, c, ob)
+	}
+	// Packets dropped by K8s NetworkPolicy isolation don't match any rule (ruleFlowID is 0), so they are not
+	// sampled: the isolation flows are shared by all K8s NetworkPolicies and the policy cannot be determined.
+	if ob.ruleFlowID != 0 {
+		if rule := c.GetRuleByFlowID(ob.ruleFlowID); rule != nil {
+			ob.logSampling = rule.LogSampling
+		}
+	}
 
 	// Log the ob info to corresponding file w/ deduplication.
 	c.auditLogger.LogDedupPacket(ob)
@@ -32,10 +32,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/metrics/testutil"
 	"k8s.io/utils/clock"
 	clocktesting "k8s.io/utils/clock/testing"
 
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	openflowtesting "antrea.io/antrea/pkg/agent/openflow/testing"
 	"antrea.io/antrea/pkg/agent/util"
@@ -88,6 +91,7 @@ func newTestAuditLogger(bufferLength time.Duration, clock clock.Clock) (*AuditLo
 		clock:            clock,
 		npLogger:         log.New(mockNPLogger, "", log.Ldate),
 		logDeduplication: logRecordDedupMap{logMap: make(map[string]*logDedupRecord)},
+		ruleLogLimiters:  make(map[uint32]*ruleLogLimiter),
 	}
 	return auditLogger, mockNPLogger
 }
@@ -208,6 +212,141 @@ func TestRedirectPacketLog(t *testing.T) {
 	assert.Contains(t, actual, expected)
 }
 
+func TestJSONPacketLog(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := clocktesting.NewFakeClock(now)
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock)
+	auditLogger.format = AuditLogFormatJSON
+	auditLogger.npLogger.SetFlags(0)
+
+	ob, _ := newLogInfo(actionAllow)
+	ob.npReference = testANNPRef
+	ob.appliedToRef = "default/destPod"
+	ob.srcPod = &v1beta2.PodReference{Name: "srcPod", Namespace: "default"}
+	ob.destPod = &v1beta2.PodReference{Name: "destPod", Namespace: "default"}
+	auditLogger.LogDedupPacket(ob)
+	expected := `{"timestamp":"2026-01-02T03:04:05Z","tableName":"AntreaPolicyIngressRule",` +
+		`"policy":{"type":"AntreaNetworkPolicy","namespace":"default","name":"test"},"ruleName":"test-rule",` +
+		`"disposition":"Allow","ofPriority":"0","appliedToPod":"default/destPod",` +
+		`"sourceIP":"0.0.0.0","sourcePort":35402,"sourcePod":{"name":"srcPod","namespace":"default"},` +
+		`"destinationIP":"1.1.1.1","destinationPort":80,"destinationPod":{"name":"destPod","namespace":"default"},` +
+		`"protocol":"TCP","packetLength":60,"logLabel":"test-label","packetCount":1}` + "\n"
+	assert.Equal(t, expected, <-mockNPLogger.logged)
+
+	ob, _ = newLogInfo(actionDrop)
+	ob.npReference = testANNPRef
+	fillLogInfoPlaceholders([]*string{&ob.srcPort, &ob.destPort})
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(time.Millisecond)
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(testBufferLength)
+	expected = `{"timestamp":"2026-01-02T03:04:05.101Z","tableName":"AntreaPolicyIngressRule",` +
+		`"policy":{"type":"AntreaNetworkPolicy","namespace":"default","name":"test"},"ruleName":"test-rule",` +
+		`"disposition":"Drop","ofPriority":"0","sourceIP":"0.0.0.0","destinationIP":"1.1.1.1",` +
+		`"protocol":"TCP","packetLength":60,"logLabel":"test-label","packetCount":2,"duration":"101ms"}` + "\n"
+	assert.Equal(t, expected, <-mockNPLogger.logged)
+}
+
+func TestSyslogPacketLog(t *testing.T) {
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock.RealClock{})
+	mockSyslogWriter := &mockLogger{logged: make(chan string, 100)}
+	auditLogger.syslogWriter = mockSyslogWriter
+	ob, expected := newLogInfo(actionAllow)
+
+	auditLogger.LogDedupPacket(ob)
+	assert.Contains(t, <-mockNPLogger.logged, expected)
+	// The records sent to the syslog server do not include the timestamp added by the file logger.
+	assert.Equal(t, expected, <-mockSyslogWriter.logged)
+}
+
+func TestLogSampling(t *testing.T) {
+	metrics.InitializeNetworkPolicyMetrics()
+	getSuppressedCount := func(reason string) float64 {
+		value, err := testutil.GetCounterMetricValue(metrics.NetworkPolicyAuditLogSuppressedCount.WithLabelValues(reason))
+		require.NoError(t, err)
+		return value
+	}
+	samplingCount := getSuppressedCount(metrics.LabelAuditLogSuppressedSampling)
+	rateLimitCount := getSuppressedCount(metrics.LabelAuditLogSuppressedRateLimit)
+	clock := clocktesting.NewFakeClock(time.Now())
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock)
+	ob, expected := newLogInfo(actionAllow)
+	ob.ruleFlowID = 1
+	ob.logSampling = &v1beta2.LogSampling{SamplingRate: 2, MaxEventsPerSecond: 2}
+	consumeLogs := func(count int) {
+		for i := 0; i < count; i++ {
+			assert.Contains(t, <-mockNPLogger.logged, expected)
+		}
+		// Allow packets are logged synchronously.
+		assert.Empty(t, mockNPLogger.logged)
+	}
+
+	// One packet out of 2 is sampled, and only the first 2 sampled packets are logged because of the rate limit.
+	for i := 0; i < 8; i++ {
+		auditLogger.LogDedupPacket(ob)
+	}
+	consumeLogs(2)
+	assert.Equal(t, samplingCount+4, getSuppressedCount(metrics.LabelAuditLogSuppressedSampling))
+	assert.Equal(t, rateLimitCount+2, getSuppressedCount(metrics.LabelAuditLogSuppressedRateLimit))
+
+	clock.Step(time.Second)
+	auditLogger.LogDedupPacket(ob)
+	consumeLogs(1)
+
+	// Updating the log sampling of the rule resets its state.
+	ob.logSampling = &v1beta2.LogSampling{SamplingRate: 3}
+	for i := 0; i < 4; i++ {
+		auditLogger.LogDedupPacket(ob)
+	}
+	consumeLogs(2)
+
+	// Packets of rules without log sampling are all logged.
+	noSamplingOb, _ := newLogInfo(actionAllow)
+	for i := 0; i < 3; i++ {
+		auditLogger.LogDedupPacket(noSamplingOb)
+	}
+	consumeLogs(3)
+
+	// The state of idle rules is removed.
+	clock.Step(ruleLogLimiterIdleTimeout)
+	otherOb, _ := newLogInfo(actionAllow)
+	otherOb.ruleFlowID = 2
+	otherOb.logSampling = &v1beta2.LogSampling{MaxEventsPerSecond: 1}
+	auditLogger.LogDedupPacket(otherOb)
+	consumeLogs(1)
+	assert.Len(t, auditLogger.ruleLogLimiters, 1)
+	assert.Contains(t, auditLogger.ruleLogLimiters, uint32(2))
+}
+
+func TestGetPodInfo(t *testing.T) {
+	localPodIP := net.ParseIP("192.168.1.1")
+	remotePodIP := net.ParseIP("192.168.2.1")
+	externalIP := net.ParseIP("8.8.8.8")
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            util.GenerateContainerInterfaceName("localPod", "default", "c1"),
+		IPs:                      []net.IP{localPodIP},
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "localPod", PodNamespace: "default", ContainerID: "c1"},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: 1},
+	})
+	ruleCache, _, _, _ := newFakeRuleCache()
+	ruleCache.AddAddressGroup(&v1beta2.AddressGroup{
+		ObjectMeta:   metav1.ObjectMeta{Name: "group1"},
+		GroupMembers: []v1beta2.GroupMember{*newAddressGroupPodMember("remotePod", "ns1", remotePodIP.String())},
+	})
+	c := &Controller{ifaceStore: ifaceStore, ruleCache: ruleCache}
+
+	ob := new(logInfo)
+	getPodInfo(&binding.Packet{SourceIP: remotePodIP, DestinationIP: localPodIP}, c, ob)
+	assert.Equal(t, &v1beta2.PodReference{Name: "remotePod", Namespace: "ns1"}, ob.srcPod)
+	assert.Equal(t, &v1beta2.PodReference{Name: "localPod", Namespace: "default"}, ob.destPod)
+
+	ob = new(logInfo)
+	getPodInfo(&binding.Packet{SourceIP: localPodIP, DestinationIP: externalIP}, c, ob)
+	assert.Equal(t, &v1beta2.PodReference{Name: "localPod", Namespace: "default"}, ob.srcPod)
+	assert.Nil(t, ob.destPod)
+}
+
 func TestGetNetworkPolicyInfo(t *testing.T) {
 	prepareMockOFTablesWithCache()
 	generateMatch := func(regID int, data []byte) openflow15.MatchField {
@@ -281,11 +420,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.AntreaPolicyIngressRuleTable.GetName(),
 				disposition:  actionAllow,
 				npRef:        testANNPRef.ToString(),
+				npReference:  testANNPRef,
 				ofPriority:   testPriority,
 				ruleName:     testRule,
 				direction:    "Ingress",
 				appliedToRef: "default/destPod",
 				logLabel:     testLogLabel,
+				ruleFlowID:   0x11111111,
 			},
 		},
 		{
@@ -300,11 +441,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.AntreaPolicyEgressRuleTable.GetName(),
 				disposition:  actionAllow,
 				npRef:        testANNPRef.ToString(),
+				npReference:  testANNPRef,
 				ofPriority:   testPriority,
 				ruleName:     testRule,
 				direction:    "Egress",
 				appliedToRef: "default/srcPod",
 				logLabel:     testLogLabel,
+				ruleFlowID:   0x11111111,
 			},
 		},
 		{
@@ -319,11 +462,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.IngressRuleTable.GetName(),
 				disposition:  actionAllow,
 				npRef:        testK8sNPRef.ToString(),
+				npReference:  testK8sNPRef,
 				ofPriority:   testPriority,
 				ruleName:     nullPlaceholder,
 				direction:    "Ingress",
 				appliedToRef: "default/destPod",
 				logLabel:     nullPlaceholder,
+				ruleFlowID:   0x11111111,
 			},
 		},
 		{
@@ -338,11 +483,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.AntreaPolicyIngressRuleTable.GetName(),
 				disposition:  actionDrop,
 				npRef:        testANNPRef.ToString(),
+				npReference:  testANNPRef,
 				ofPriority:   testPriority,
 				ruleName:     testRule,
 				direction:    "Ingress",
 				appliedToRef: "default/destPod",
 				logLabel:     testLogLabel,
+				ruleFlowID:   0x11111111,
 			},
 		},
 		{
@@ -353,6 +500,7 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.IngressDefaultTable.GetName(),
 				disposition:  actionDrop,
 				npRef:        "K8sNetworkPolicy",
+				npReference:  &v1beta2.NetworkPolicyReference{Type: v1beta2.K8sNetworkPolicy},
 				ofPriority:   nullPlaceholder,
 				ruleName:     nullPlaceholder,
 				direction:    "Ingress",
@@ -372,11 +520,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.AntreaPolicyIngressRuleTable.GetName(),
 				disposition:  actionRedirect,
 				npRef:        testANNPRef.ToString(),
+				npReference:  testANNPRef,
 				ofPriority:   testPriority,
 				ruleName:     testRule,
 				direction:    "Ingress",
 				appliedToRef: "default/destPod",
 				logLabel:     testLogLabel,
+				ruleFlowID:   0x11111111,
 			},
 		},
 		{
@@ -391,11 +541,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.AntreaPolicyIngressRuleTable.GetName(),
 				disposition:  actionAllow,
 				npRef:        testANNPRef.ToString(),
+				npReference:  testANNPRef,
 				ofPriority:   testPriority,
 				ruleName:     testRule,
 				direction:    "Ingress",
 				appliedToRef: "default/destPod",
 				logLabel:     testLogLabel,
+				ruleFlowID:   0x11111111,
 			},
 			tableIDInReg: &antreaIngressRuleTableID,
 		},
@@ -411,11 +563,13 @@ func TestGetNetworkPolicyInfo(t *testing.T) {
 				tableName:    openflow.AntreaPolicyIngressRuleTable.GetName(),
 				disposition:  actionDrop,
 				npRef:        testANNPRef.ToString(),
+				npReference:  testANNPRef,
 				ofPriority:   testPriority,
 				ruleName:     testRule,
 				direction:    "Ingress",
 				appliedToRef: "default/destPod",
 				logLabel:     testLogLabel,
+				ruleFlowID:   0x11111111,
 			},
 			tableIDInReg: &antreaIngressRuleTableID,
 		},
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 
@@ -100,6 +101,8 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// LogSampling limits the logs generated for the rule when EnableLogging is true.
+	LogSampling *v1beta.LogSampling
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -205,6 +208,9 @@ type ruleCache struct {
 	// addressSetByGroup stores the AddressGroup members.
 	// It is a mapping from group name to a set of GroupMembers.
 	addressSetByGroup map[string]v1beta.GroupMemberSet
+	// podsByAddress indexes the Pod members of the AddressGroups by IP.
+	// It is a mapping from IP to the number of AddressGroups each Pod having the IP is a member of.
+	podsByAddress map[string]map[v1beta.PodReference]int
 
 	policyMapLock sync.RWMutex
 	// policyMap is a map using NetworkPolicy UID as the key.
@@ -336,6 +342,18 @@ func (c *ruleCache) GetAddressGroups() []v1beta.AddressGroup {
 	return ret
 }
 
+// getPodReferenceByIP returns the Pod which has the provided IP among the members of the AddressGroups, or nil if
+// there is no such Pod.
+func (c *ruleCache) getPodReferenceByIP(ip net.IP) *v1beta.PodReference {
+	c.addressSetLock.RLock()
+	defer c.addressSetLock.RUnlock()
+
+	for pod := range c.podsByAddress[ip.String()] {
+		return &pod
+	}
+	return nil
+}
+
 func (c *ruleCache) GetAppliedToGroups() []v1beta.AppliedToGroup {
 	var ret []v1beta.AppliedToGroup
 	c.appliedToSetLock.RLock()
@@ -428,6 +446,7 @@ func newRuleCache(dirtyRuleHandler func(string), podUpdateSubscriber channel.Sub
 	cache := &ruleCache{
 		appliedToSetByGroup: make(map[string]v1beta.GroupMemberSet),
 		addressSetByGroup:   make(map[string]v1beta.GroupMemberSet),
+		podsByAddress:       make(map[string]map[v1beta.PodReference]int),
 		policyMap:           make(map[string]*v1beta.NetworkPolicy),
 		rules:               rules,
 		dirtyRuleHandler:    dirtyRuleHandler,
@@ -523,10 +542,36 @@ func (c *ruleCache) ReplaceAddressGroups(groups []*v1beta.AddressGroup) {
 	}
 
 	for key := range oldGroupKeys {
+		c.indexAddressGroupMembersLocked(c.addressSetByGroup[key], -1)
 		delete(c.addressSetByGroup, key)
 	}
 }
 
+// indexAddressGroupMembersLocked updates podsByAddress with the Pod members of an AddressGroup. delta is 1 when the
+// members are added to the AddressGroup, and -1 when they are removed from it.
+func (c *ruleCache) indexAddressGroupMembersLocked(members v1beta.GroupMemberSet, delta int) {
+	for _, member := range members {
+		if member.Pod == nil {
+			continue
+		}
+		for _, memberIP := range member.IPs {
+			ip := net.IP(memberIP).String()
+			pods, exists := c.podsByAddress[ip]
+			if !exists {
+				pods = make(map[v1beta.PodReference]int)
+				c.podsByAddress[ip] = pods
+			}
+			pods[*member.Pod] += delta
+			if pods[*member.Pod] <= 0 {
+				delete(pods, *member.Pod)
+			}
+			if len(pods) == 0 {
+				delete(c.podsByAddress, ip)
+			}
+		}
+	}
+}
+
 // AddAddressGroup adds a new *v1beta.AddressGroup to the cache. The rules
 // referencing it will be regarded as dirty.
 // It's safe to add an AddressGroup multiple times as it only overrides the
@@ -552,6 +597,8 @@ func (c *ruleCache) addAddressGroupLocked(group *v1beta.AddressGroup) error {
 	if exists && oldGroupMemberSet.Equal(groupMemberSet) {
 		return nil
 	}
+	c.indexAddressGroupMembersLocked(oldGroupMemberSet, -1)
+	c.indexAddressGroupMembersLocked(groupMemberSet, 1)
 	c.addressSetByGroup[group.Name] = groupMemberSet
 	c.onAddressGroupUpdate(group.Name)
 	return nil
@@ -568,12 +615,21 @@ func (c *ruleCache) PatchAddressGroup(patch *v1beta.AddressGroupPatch) (*v1beta.
 	if !exists {
 		return nil, fmt.Errorf("AddressGroup %v doesn't exist in cache, can't be patched", patch.Name)
 	}
+	addedMembers, removedMembers := v1beta.GroupMemberSet{}, v1beta.GroupMemberSet{}
 	for i := range patch.AddedGroupMembers {
+		if !groupMemberSet.Has(&patch.AddedGroupMembers[i]) {
+			addedMembers.Insert(&patch.AddedGroupMembers[i])
+		}
 		groupMemberSet.Insert(&patch.AddedGroupMembers[i])
 	}
 	for i := range patch.RemovedGroupMembers {
+		if groupMemberSet.Has(&patch.RemovedGroupMembers[i]) {
+			removedMembers.Insert(&patch.RemovedGroupMembers[i])
+		}
 		groupMemberSet.Delete(&patch.RemovedGroupMembers[i])
 	}
+	c.indexAddressGroupMembersLocked(addedMembers, 1)
+	c.indexAddressGroupMembersLocked(removedMembers, -1)
 
 	c.onAddressGroupUpdate(patch.Name)
 
@@ -595,6 +651,7 @@ func (c *ruleCache) DeleteAddressGroup(group *v1beta.AddressGroup) error {
 	c.addressSetLock.Lock()
 	defer c.addressSetLock.Unlock()
 
+	c.indexAddressGroupMembersLocked(c.addressSetByGroup[group.Name], -1)
 	delete(c.addressSetByGroup, group.Name)
 	return nil
 }
@@ -717,6 +774,7 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
 		LogLabel:        r.LogLabel,
+		LogSampling:     r.LogSampling,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
@@ -287,6 +287,47 @@ func TestRuleCacheAddAddressGroup(t *testing.T) {
 	}
 }
 
+func TestRuleCacheGetPodReferenceByIP(t *testing.T) {
+	c, _, _, _ := newFakeRuleCache()
+	c.AddAddressGroup(&v1beta2.AddressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "group1"},
+		GroupMembers: []v1beta2.GroupMember{
+			*newAddressGroupMember("1.1.1.1"),
+			*newAddressGroupPodMember("pod1", "ns1", "2.2.2.2", "2001::2"),
+		},
+	})
+	c.AddAddressGroup(&v1beta2.AddressGroup{
+		ObjectMeta:   metav1.ObjectMeta{Name: "group2"},
+		GroupMembers: []v1beta2.GroupMember{*newAddressGroupPodMember("pod2", "ns2", "3.3.3.3")},
+	})
+
+	assert.Equal(t, &v1beta2.PodReference{Name: "pod1", Namespace: "ns1"}, c.getPodReferenceByIP(net.ParseIP("2.2.2.2")))
+	assert.Equal(t, &v1beta2.PodReference{Name: "pod1", Namespace: "ns1"}, c.getPodReferenceByIP(net.ParseIP("2001::2")))
+	assert.Equal(t, &v1beta2.PodReference{Name: "pod2", Namespace: "ns2"}, c.getPodReferenceByIP(net.ParseIP("3.3.3.3")))
+	assert.Nil(t, c.getPodReferenceByIP(net.ParseIP("1.1.1.1")))
+	assert.Nil(t, c.getPodReferenceByIP(net.ParseIP("4.4.4.4")))
+
+	// pod2 is also a member of group1, removing it from group2 must not remove it from the index.
+	_, err := c.PatchAddressGroup(&v1beta2.AddressGroupPatch{
+		ObjectMeta:        metav1.ObjectMeta{Name: "group1"},
+		AddedGroupMembers: []v1beta2.GroupMember{*newAddressGroupPodMember("pod2", "ns2", "3.3.3.3"), *newAddressGroupPodMember("pod3", "ns3", "4.4.4.4")},
+	})
+	assert.NoError(t, err)
+	_, err = c.PatchAddressGroup(&v1beta2.AddressGroupPatch{
+		ObjectMeta:          metav1.ObjectMeta{Name: "group2"},
+		RemovedGroupMembers: []v1beta2.GroupMember{*newAddressGroupPodMember("pod2", "ns2", "3.3.3.3")},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &v1beta2.PodReference{Name: "pod2", Namespace: "ns2"}, c.getPodReferenceByIP(net.ParseIP("3.3.3.3")))
+	assert.Equal(t, &v1beta2.PodReference{Name: "pod3", Namespace: "ns3"}, c.getPodReferenceByIP(net.ParseIP("4.4.4.4")))
+
+	assert.NoError(t, c.DeleteAddressGroup(&v1beta2.AddressGroup{ObjectMeta: metav1.ObjectMeta{Name: "group1"}}))
+	assert.Nil(t, c.getPodReferenceByIP(net.ParseIP("2.2.2.2")))
+	assert.Nil(t, c.getPodReferenceByIP(net.ParseIP("3.3.3.3")))
+	assert.Nil(t, c.getPodReferenceByIP(net.ParseIP("4.4.4.4")))
+	assert.Empty(t, c.podsByAddress)
+}
+
 func newFakeRuleCache() (*ruleCache, *dirtyRuleRecorder, *channel.SubscribableChannel, chan string) {
 	recorder := newDirtyRuleRecorder()
 	podUpdateChannel := channel.NewSubscribableChannel("PodUpdate", 100)
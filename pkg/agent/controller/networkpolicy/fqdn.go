@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"os"
 	"regexp"
 	"strings"
@@ -46,6 +47,9 @@ const (
 
 	ruleRealizationTimeout = 2 * time.Second
 	dnsRequestTimeout      = 10 * time.Second
+	// dnsEDNS0UDPSize is the UDP payload size advertised with EDNS0 in the DNS requests made by the fqdnController, so
+	// that responses with many records are not truncated.
+	dnsEDNS0UDPSize = 4096
 )
 
 // fqdnSelectorItem is a selector that selects FQDNs,
@@ -128,12 +132,20 @@ type fqdnController struct {
 	// dnsServerAddr stores the coreDNS server address, or the user provided DNS server address.
 	dnsServerAddr string
 	minTTL        uint32
+	// trustedDNSServers stores the IP addresses of the DNS servers whose responses are used to resolve FQDNs. If it is
+	// empty, the responses from all DNS servers are used.
+	trustedDNSServers sets.Set[netip.Addr]
+	// tcpDNSAssembler reassembles the DNS responses sent over TCP.
+	tcpDNSAssembler *tcpDNSAssembler
+	// cacheStore checkpoints dnsEntryCache so that it can be restored after the antrea-agent restarts. It is nil if
+	// checkpointing is disabled.
+	cacheStore *fqdnCacheStore
 
 	// dirtyRuleHandler is a callback that is run upon finding a rule out-of-sync.
 	dirtyRuleHandler func(string)
 	// A single instance of ruleSyncTracker.
 	ruleSyncTracker *ruleSyncTracker
-	// FQDN names this controller is tracking, with their corresponding dnsMeta.
+	// FQDN names this controller is tracking, with their corresponding dnsMeta. It is protected by fqdnSelectorMutex.
 	dnsEntryCache map[string]dnsMeta
 	// FQDN names that needs to be re-queried after their respective TTLs.
 	dnsQueryQueue workqueue.TypedRateLimitingInterface[string]
@@ -161,7 +173,7 @@ type fqdnController struct {
 	clock clock.Clock
 }
 
-func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool, gwPort uint32, clock clock.WithTicker, fqdnCacheMinTTL uint32) (*fqdnController, error) {
+func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool, gwPort uint32, clock clock.WithTicker, fqdnCacheMinTTL uint32, trustedDNSServers []netip.Addr) (*fqdnController, error) {
 	controller := &fqdnController{
 		ofClient:         client,
 		dirtyRuleHandler: dirtyRuleHandler,
@@ -184,6 +196,14 @@ func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServer
 		gwPort:                 gwPort,
 		clock:                  clock,
 		minTTL:                 fqdnCacheMinTTL,
+		trustedDNSServers:      sets.New[netip.Addr](),
+		tcpDNSAssembler:        newTCPDNSAssembler(clock),
+	}
+	for _, server := range trustedDNSServers {
+		controller.trustedDNSServers.Insert(server.Unmap())
+	}
+	if controller.trustedDNSServers.Len() > 0 {
+		klog.InfoS("Only DNS responses from trusted DNS servers will be used for FQDN policies", "trustedDNSServers", trustedDNSServers)
 	}
 	if controller.ofClient != nil {
 		if err := controller.ofClient.NewDNSPacketInConjunction(dnsInterceptRuleID); err != nil {
@@ -632,14 +652,21 @@ func (f *fqdnController) runRuleSyncTracker(stopCh <-chan struct{}) {
 }
 
 // parseDNSResponse returns the FQDN, IP query result and lowest applicable TTL of a DNS response.
+// Only the A and AAAA records of the queried FQDN, or of the names it is an alias of through a chain of CNAME
+// records, are used.
 func (f *fqdnController) parseDNSResponse(msg *dns.Msg) (string, map[string]ipWithExpiration, error) {
 	if len(msg.Question) == 0 {
 		return "", nil, fmt.Errorf("invalid DNS message")
 	}
 	fqdn := strings.ToLower(msg.Question[0].Name)
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	aliases := getDNSAliases(fqdn, msg.Answer)
 	responseIPs := map[string]ipWithExpiration{}
 	currentTime := f.clock.Now()
 	for _, ans := range msg.Answer {
+		if !aliases.Has(normalizeDNSName(ans.Header().Name)) {
+			continue
+		}
 		switch r := ans.(type) {
 		case *dns.A:
 			if f.ipv4Enabled {
@@ -661,10 +688,50 @@ func (f *fqdnController) parseDNSResponse(msg *dns.Msg) (string, map[string]ipWi
 	if len(responseIPs) > 0 {
 		klog.V(4).InfoS("Received DNS Packet with valid Answer", "IPs", responseIPs)
 	}
-	fqdn = strings.TrimSuffix(fqdn, ".")
 	return fqdn, responseIPs, nil
 }
 
+func normalizeDNSName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// getDNSAliases returns the FQDN and the names which the FQDN is an alias of, following the CNAME records in the
+// answer section of a DNS response.
+func getDNSAliases(fqdn string, answer []dns.RR) sets.Set[string] {
+	aliases := sets.New[string](fqdn)
+	// The CNAME records are not necessarily ordered, so iterate until no new name is found. The number of iterations
+	// is bounded by the number of CNAME records, which also prevents loops.
+	for updated := true; updated; {
+		updated = false
+		for _, ans := range answer {
+			cname, ok := ans.(*dns.CNAME)
+			if !ok || !aliases.Has(normalizeDNSName(cname.Hdr.Name)) {
+				continue
+			}
+			if target := normalizeDNSName(cname.Target); !aliases.Has(target) {
+				aliases.Insert(target)
+				updated = true
+			}
+		}
+	}
+	return aliases
+}
+
+// validateDNSResponse checks that an intercepted DNS message is a response to a standard query, with a single
+// question.
+func validateDNSResponse(msg *dns.Msg) error {
+	if !msg.Response {
+		return fmt.Errorf("DNS message is not a response")
+	}
+	if msg.Opcode != dns.OpcodeQuery {
+		return fmt.Errorf("unsupported DNS opcode %s", dns.OpcodeToString[msg.Opcode])
+	}
+	if len(msg.Question) != 1 {
+		return fmt.Errorf("unexpected number of questions %d in DNS response", len(msg.Question))
+	}
+	return nil
+}
+
 func (f *fqdnController) worker() {
 	for f.processNextWorkItem() {
 	}
@@ -744,10 +811,19 @@ func (f *fqdnController) makeDNSRequest(ctx context.Context, fqdn string) error
 	query := func(qtype uint16) (*dns.Msg, error) {
 		m := &dns.Msg{}
 		m.SetQuestion(fqdnToQuery, qtype)
+		m.SetEdns0(dnsEDNS0UDPSize, false)
 		r, _, err := dnsClient.ExchangeContext(ctx, m, f.dnsServerAddr)
 		if err != nil {
 			return nil, err
 		}
+		if r.Truncated {
+			// The response doesn't fit in the advertised UDP payload size, retry over TCP to get all the records.
+			klog.V(2).InfoS("DNS response is truncated, retrying over TCP", "fqdn", fqdn, "dnsServer", f.dnsServerAddr)
+			tcpClient := dns.Client{Net: "tcp", SingleInflight: true}
+			if r, _, err = tcpClient.ExchangeContext(ctx, m, f.dnsServerAddr); err != nil {
+				return nil, err
+			}
+		}
 		return r, nil
 	}
 	var errs []error
@@ -768,46 +844,67 @@ func (f *fqdnController) makeDNSRequest(ctx context.Context, fqdn string) error
 	return errors.NewAggregate(errs)
 }
 
+// onInterceptedDNSResponseMsg handles a DNS response message intercepted from a DNS server. The response is ignored
+// if it is invalid, or if the DNS server is not trusted.
+func (f *fqdnController) onInterceptedDNSResponseMsg(dnsMsg *dns.Msg, srcIP netip.Addr, waitCh chan error) {
+	if err := validateDNSResponse(dnsMsg); err != nil {
+		klog.V(2).InfoS("Ignoring invalid DNS response", "dnsServer", srcIP, "err", err)
+		waitCh <- nil
+		return
+	}
+	if f.trustedDNSServers.Len() > 0 && !f.trustedDNSServers.Has(srcIP.Unmap()) {
+		klog.V(2).InfoS("Ignoring DNS response from untrusted DNS server", "dnsServer", srcIP)
+		waitCh <- nil
+		return
+	}
+	f.onDNSResponseMsg(dnsMsg, waitCh)
+}
+
 // HandlePacketIn implements openflow.PacketInHandler
 func (f *fqdnController) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 	klog.V(4).InfoS("Received a packetIn for DNS response")
 	waitCh := make(chan error, 1)
-	handleUDP := func(udp *protocol.UDP) {
+	handleUDP := func(srcIP netip.Addr, udp *protocol.UDP) {
 		dnsMsg := dns.Msg{}
 		if err := dnsMsg.Unpack(udp.Data); err != nil {
-			// A non-DNS response packet or a fragmented DNS response is received. Forward it to the Pod.
-			waitCh <- nil
-			return
+			// This is likely the first fragment of a large DNS response, e.g. when EDNS0 is used. Usually the first
+			// fragment contains the question and answer sections, from which we can get FQDN <-> IP mapping. So we
+			// try to partially unpack it. Otherwise, it is a non-DNS response packet. Forward it to the Pod.
+			dnsMsg = dns.Msg{}
+			if err := dnsutil.UnpackDNSMsgPartially(udp.Data, &dnsMsg); err != nil {
+				klog.V(2).InfoS("Unable to unpack the DNS response, skipping it", "err", err)
+				waitCh <- nil
+				return
+			}
+			klog.V(2).InfoS("Received a fragmented DNS response, partially unpacked it", "dnsServer", srcIP)
 		}
-		f.onDNSResponseMsg(&dnsMsg, waitCh)
+		f.onInterceptedDNSResponseMsg(&dnsMsg, srcIP, waitCh)
 	}
-	handleTCP := func(tcpPkt *protocol.TCP) {
-		dnsData, dataLength, err := binding.GetTCPDNSData(tcpPkt)
+	handleTCP := func(srcIP, dstIP netip.Addr, tcpPkt *protocol.TCP) {
+		payload, err := binding.GetTCPPayload(tcpPkt)
 		if err != nil {
-			// The packet doesn't contain a valid DNS length field and data. Forward it to the Pod.
-			klog.V(4).InfoS("Unable to get DNS data from the packet, skipping it", "err", err)
+			// Can't parse the packet. Forward it to the Pod.
+			klog.V(4).InfoS("Unable to get TCP payload from the packet, skipping it", "err", err)
 			waitCh <- nil
 			return
 		}
-		dnsMsg := dns.Msg{}
-		if dataLength > len(dnsData) {
-			// This is likely the first fragment containing the length field and partial message of a DNS response.
-			// Usually the first fragment contains the question and answer sections, from which we can get FQDN <-> IP
-			// mapping. So we try to partially unpack it.
-			klog.InfoS("Received a fragmented DNS response, partially unpacking it", "lengthField", dataLength, "actualLength", len(dnsData))
-			if err := dnsutil.UnpackDNSMsgPartially(dnsData, &dnsMsg); err != nil {
-				klog.InfoS("Unable to unpack the DNS response partially, skipping it", "err", err)
-				waitCh <- nil
+		key := tcpFlowKey{srcIP: srcIP, dstIP: dstIP, srcPort: tcpPkt.PortSrc, dstPort: tcpPkt.PortDst}
+		// Only the segment completing a DNS message must wait for the rules to be synced, as the Pod cannot use the
+		// DNS response before receiving it entirely.
+		for _, data := range f.tcpDNSAssembler.addSegment(key, tcpPkt.SeqNum, tcpPkt.Code, payload) {
+			dnsMsg := dns.Msg{}
+			if err := dnsMsg.Unpack(data); err != nil {
+				klog.V(2).InfoS("Unable to unpack the DNS response, skipping it", "err", err)
+				continue
+			}
+			msgWaitCh := make(chan error, 1)
+			f.onInterceptedDNSResponseMsg(&dnsMsg, srcIP, msgWaitCh)
+			if err := <-msgWaitCh; err != nil {
+				waitCh <- err
 				return
 			}
-		} else if err := dnsMsg.Unpack(dnsData); err != nil {
-			// This is likely a non-DNS response packet or a non-first-DNS response packet containing partial message.
-			// Set verbose level to 2 as normally we are not interested in it.
-			klog.V(2).InfoS("Unable to unpack the DNS response, skipping it", "err", err)
-			waitCh <- nil
-			return
 		}
-		f.onDNSResponseMsg(&dnsMsg, waitCh)
+		waitCh <- nil
 	}
 	go func() {
 		ethernetPkt, err := openflow.GetEthernetPacket(pktIn)
@@ -818,10 +915,12 @@ func (f *fqdnController) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 		}
 		switch ipPkt := ethernetPkt.Data.(type) {
 		case *protocol.IPv4:
+			srcIP, _ := netip.AddrFromSlice(ipPkt.NWSrc)
+			dstIP, _ := netip.AddrFromSlice(ipPkt.NWDst)
 			proto := ipPkt.Protocol
 			switch proto {
 			case protocol.Type_UDP:
-				handleUDP(ipPkt.Data.(*protocol.UDP))
+				handleUDP(srcIP.Unmap(), ipPkt.Data.(*protocol.UDP))
 			case protocol.Type_TCP:
 				tcpPkt, err := binding.GetTCPPacketFromIPMessage(ipPkt)
 				if err != nil {
@@ -829,13 +928,15 @@ func (f *fqdnController) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 					waitCh <- nil
 					return
 				}
-				handleTCP(tcpPkt)
+				handleTCP(srcIP.Unmap(), dstIP.Unmap(), tcpPkt)
 			}
 		case *protocol.IPv6:
+			srcIP, _ := netip.AddrFromSlice(ipPkt.NWSrc)
+			dstIP, _ := netip.AddrFromSlice(ipPkt.NWDst)
 			proto := ipPkt.NextHeader
 			switch proto {
 			case protocol.Type_UDP:
-				handleUDP(ipPkt.Data.(*protocol.UDP))
+				handleUDP(srcIP, ipPkt.Data.(*protocol.UDP))
 			case protocol.Type_TCP:
 				tcpPkt, err := binding.GetTCPPacketFromIPMessage(ipPkt)
 				if err != nil {
@@ -843,7 +944,7 @@ func (f *fqdnController) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 					waitCh <- nil
 					return
 				}
-				handleTCP(tcpPkt)
+				handleTCP(srcIP, dstIP, tcpPkt)
 			}
 		}
 	}()
@@ -0,0 +1,200 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	fqdnCacheFile = "fqdn-cache.json"
+	// fqdnCacheCheckpointVersion is the version of the checkpoint format. It must be increased when the format is
+	// changed in an incompatible way, in which case checkpoints with a different version are ignored.
+	fqdnCacheCheckpointVersion = 1
+	// fqdnCacheCheckpointInterval is the interval at which the FQDN cache is written to disk if it has changed.
+	fqdnCacheCheckpointInterval = 30 * time.Second
+)
+
+type fqdnCacheCheckpoint struct {
+	Version int                        `json:"version"`
+	Entries []fqdnCacheCheckpointEntry `json:"entries"`
+}
+
+type fqdnCacheCheckpointEntry struct {
+	FQDN string                  `json:"fqdn"`
+	IPs  []fqdnCacheCheckpointIP `json:"ips"`
+}
+
+type fqdnCacheCheckpointIP struct {
+	IP             string    `json:"ip"`
+	ExpirationTime time.Time `json:"expirationTime"`
+}
+
+// fqdnCacheStore checkpoints the FQDN cache of the fqdnController in a file, so that the IPs resolved for FQDNs can be
+// restored after the antrea-agent restarts, instead of waiting for Pods to resolve the FQDNs again.
+type fqdnCacheStore struct {
+	fs   afero.Fs
+	path string
+	// lastSaved is the content of the last checkpoint written, used to avoid rewriting the file when the cache has not
+	// changed.
+	lastSaved []byte
+}
+
+func newFQDNCacheStore(fs afero.Fs, path string) *fqdnCacheStore {
+	return &fqdnCacheStore{
+		fs:   fs,
+		path: path,
+	}
+}
+
+// save writes the given FQDN cache to the file if it differs from the last checkpoint. The file is replaced atomically
+// so that a crash while writing does not corrupt the existing checkpoint.
+func (s *fqdnCacheStore) save(cache map[string]dnsMeta) error {
+	checkpoint := fqdnCacheCheckpoint{Version: fqdnCacheCheckpointVersion}
+	for fqdn, meta := range cache {
+		entry := fqdnCacheCheckpointEntry{FQDN: fqdn}
+		for ipStr, ipMeta := range meta.responseIPs {
+			entry.IPs = append(entry.IPs, fqdnCacheCheckpointIP{IP: ipStr, ExpirationTime: ipMeta.expirationTime.UTC()})
+		}
+		sort.Slice(entry.IPs, func(i, j int) bool { return entry.IPs[i].IP < entry.IPs[j].IP })
+		checkpoint.Entries = append(checkpoint.Entries, entry)
+	}
+	// Sort the entries so that an unchanged cache always produces the same content.
+	sort.Slice(checkpoint.Entries, func(i, j int) bool { return checkpoint.Entries[i].FQDN < checkpoint.Entries[j].FQDN })
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("error encoding FQDN cache: %w", err)
+	}
+	if s.lastSaved != nil && bytes.Equal(data, s.lastSaved) {
+		return nil
+	}
+	tmpPath := s.path + ".tmp"
+	if err := afero.WriteFile(s.fs, tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("error writing FQDN cache to file: %w", err)
+	}
+	if err := s.fs.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("error renaming FQDN cache file: %w", err)
+	}
+	s.lastSaved = data
+	klog.V(4).InfoS("Saved FQDN cache to file", "path", s.path, "fqdns", len(checkpoint.Entries))
+	return nil
+}
+
+// load reads the FQDN cache from the file. IPs which have expired at the given time are pruned, as well as the FQDNs
+// without any remaining IP. An empty cache is returned if the file doesn't exist.
+func (s *fqdnCacheStore) load(now time.Time) (map[string]dnsMeta, error) {
+	cache := map[string]dnsMeta{}
+	data, err := afero.ReadFile(s.fs, s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cache, nil
+		}
+		return nil, fmt.Errorf("error reading FQDN cache file: %w", err)
+	}
+	var checkpoint fqdnCacheCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, fmt.Errorf("error decoding FQDN cache: %w", err)
+	}
+	if checkpoint.Version != fqdnCacheCheckpointVersion {
+		return nil, fmt.Errorf("unsupported FQDN cache version %d", checkpoint.Version)
+	}
+	for _, entry := range checkpoint.Entries {
+		responseIPs := map[string]ipWithExpiration{}
+		for _, ipEntry := range entry.IPs {
+			ip := net.ParseIP(ipEntry.IP)
+			if ip == nil || !ipEntry.ExpirationTime.After(now) {
+				continue
+			}
+			responseIPs[ip.String()] = ipWithExpiration{ip: ip, expirationTime: ipEntry.ExpirationTime}
+		}
+		if len(responseIPs) > 0 {
+			cache[entry.FQDN] = dnsMeta{responseIPs: responseIPs}
+		}
+	}
+	return cache, nil
+}
+
+// restoreDNSEntryCache sets the store used to checkpoint the FQDN cache, and restores the FQDN cache from it. It must
+// be called before any FQDN rule is added, so that the restored IPs are used when the rules are realized for the first
+// time. A DNS query is scheduled for each restored FQDN when its first IP expires.
+func (f *fqdnController) restoreDNSEntryCache(store *fqdnCacheStore) {
+	f.cacheStore = store
+	cache, err := store.load(f.clock.Now())
+	if err != nil {
+		klog.ErrorS(err, "Failed to restore FQDN cache, starting with an empty cache")
+		return
+	}
+	f.fqdnSelectorMutex.Lock()
+	defer f.fqdnSelectorMutex.Unlock()
+	currentTime := f.clock.Now()
+	for fqdn, meta := range cache {
+		var timeToRequery time.Time
+		for _, ipMeta := range meta.responseIPs {
+			if timeToRequery.IsZero() || ipMeta.expirationTime.Before(timeToRequery) {
+				timeToRequery = ipMeta.expirationTime
+			}
+		}
+		f.dnsEntryCache[fqdn] = meta
+		f.dnsQueryQueue.AddAfter(fqdn, timeToRequery.Sub(currentTime))
+	}
+	klog.InfoS("Restored FQDN cache", "fqdns", len(cache))
+}
+
+// deleteUnselectedFQDNs removes the restored FQDNs which are not selected by any FQDN rule. It should be called once
+// all the FQDN rules have been added after the antrea-agent starts.
+func (f *fqdnController) deleteUnselectedFQDNs() {
+	f.fqdnSelectorMutex.Lock()
+	defer f.fqdnSelectorMutex.Unlock()
+	for fqdn := range f.dnsEntryCache {
+		if len(f.fqdnToSelectorItem[fqdn]) == 0 {
+			klog.V(2).InfoS("Deleting restored FQDN not selected by any rule from cache", "fqdn", fqdn)
+			delete(f.dnsEntryCache, fqdn)
+		}
+	}
+}
+
+// checkpointDNSEntryCache writes the FQDN cache to the store.
+func (f *fqdnController) checkpointDNSEntryCache() {
+	f.fqdnSelectorMutex.Lock()
+	// The dnsMeta values are never mutated in place, so a shallow copy is enough.
+	cache := make(map[string]dnsMeta, len(f.dnsEntryCache))
+	for fqdn, meta := range f.dnsEntryCache {
+		cache[fqdn] = meta
+	}
+	f.fqdnSelectorMutex.Unlock()
+	if err := f.cacheStore.save(cache); err != nil {
+		klog.ErrorS(err, "Failed to checkpoint FQDN cache")
+	}
+}
+
+// runCacheCheckpointer periodically writes the FQDN cache to the store, and writes it one last time when stopCh is
+// closed.
+func (f *fqdnController) runCacheCheckpointer(stopCh <-chan struct{}) {
+	if f.cacheStore == nil {
+		return
+	}
+	wait.Until(f.checkpointDNSEntryCache, fqdnCacheCheckpointInterval, stopCh)
+	f.checkpointDNSEntryCache()
+}
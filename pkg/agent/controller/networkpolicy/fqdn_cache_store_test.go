@@ -0,0 +1,147 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestFQDNCacheStore(t *testing.T) {
+	// Use a time without monotonic clock reading and location, as they are not preserved by the checkpoint.
+	currentTime := time.Now().UTC().Round(0)
+	cache := map[string]dnsMeta{
+		"www.example.com": {
+			responseIPs: map[string]ipWithExpiration{
+				"192.168.1.1": {ip: net.ParseIP("192.168.1.1"), expirationTime: currentTime.Add(10 * time.Second)},
+				"192.168.1.2": {ip: net.ParseIP("192.168.1.2"), expirationTime: currentTime.Add(-10 * time.Second)},
+				"2001:db8::1": {ip: net.ParseIP("2001:db8::1"), expirationTime: currentTime.Add(20 * time.Second)},
+			},
+		},
+		"expired.example.com": {
+			responseIPs: map[string]ipWithExpiration{
+				"192.168.1.3": {ip: net.ParseIP("192.168.1.3"), expirationTime: currentTime},
+			},
+		},
+	}
+	fs := afero.NewMemMapFs()
+	store := newFQDNCacheStore(fs, fqdnCacheFile)
+
+	restored, err := store.load(currentTime)
+	require.NoError(t, err)
+	assert.Empty(t, restored, "Cache should be empty when the file doesn't exist")
+
+	require.NoError(t, store.save(cache))
+	exists, err := afero.Exists(fs, fqdnCacheFile+".tmp")
+	require.NoError(t, err)
+	assert.False(t, exists, "Temporary file should have been renamed")
+
+	// A new store is used to simulate an antrea-agent restart.
+	restored, err = newFQDNCacheStore(fs, fqdnCacheFile).load(currentTime)
+	require.NoError(t, err)
+	expected := map[string]dnsMeta{
+		"www.example.com": {
+			responseIPs: map[string]ipWithExpiration{
+				"192.168.1.1": {ip: net.ParseIP("192.168.1.1"), expirationTime: currentTime.Add(10 * time.Second)},
+				"2001:db8::1": {ip: net.ParseIP("2001:db8::1"), expirationTime: currentTime.Add(20 * time.Second)},
+			},
+		},
+	}
+	assert.Equal(t, expected, restored)
+
+	// The file is not rewritten if the cache has not changed.
+	require.NoError(t, fs.Remove(fqdnCacheFile))
+	require.NoError(t, store.save(cache))
+	exists, err = afero.Exists(fs, fqdnCacheFile)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestFQDNCacheStoreInvalidFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		expectedErr string
+	}{
+		{
+			name:        "corrupted file",
+			content:     "{\"version\":1,",
+			expectedErr: "error decoding FQDN cache: unexpected end of JSON input",
+		},
+		{
+			name:        "unsupported version",
+			content:     "{\"version\":2,\"entries\":[]}",
+			expectedErr: "unsupported FQDN cache version 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, fqdnCacheFile, []byte(tt.content), 0o600))
+			_, err := newFQDNCacheStore(fs, fqdnCacheFile).load(time.Now())
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestRestoreDNSEntryCache(t *testing.T) {
+	currentTime := time.Now().UTC().Round(0)
+	fs := afero.NewMemMapFs()
+	cache := map[string]dnsMeta{
+		"www.example.com": {
+			responseIPs: map[string]ipWithExpiration{
+				"192.168.1.1": {ip: net.ParseIP("192.168.1.1"), expirationTime: currentTime.Add(10 * time.Second)},
+			},
+		},
+		"api.example.com": {
+			responseIPs: map[string]ipWithExpiration{
+				"192.168.1.2": {ip: net.ParseIP("192.168.1.2"), expirationTime: currentTime.Add(10 * time.Second)},
+			},
+		},
+		"www.unselected.com": {
+			responseIPs: map[string]ipWithExpiration{
+				"192.168.1.3": {ip: net.ParseIP("192.168.1.3"), expirationTime: currentTime.Add(10 * time.Second)},
+			},
+		},
+	}
+	require.NoError(t, newFQDNCacheStore(fs, fqdnCacheFile).save(cache))
+
+	controller := gomock.NewController(t)
+	fakeClock := newFakeClock(currentTime)
+	f, _ := newMockFQDNController(t, controller, nil, fakeClock, 0)
+	f.restoreDNSEntryCache(newFQDNCacheStore(fs, fqdnCacheFile))
+	assert.Equal(t, cache, f.dnsEntryCache)
+
+	// The restored FQDNs are queried again when their IPs expire.
+	require.Eventually(t, func() bool { return fakeClock.TimersAdded() > 0 }, 1*time.Second, 10*time.Millisecond)
+	fakeClock.Step(10 * time.Second)
+	require.Eventually(t, func() bool { return f.dnsQueryQueue.Len() == 3 }, 1*time.Second, 10*time.Millisecond)
+
+	// The restored IPs are used when the rules are realized.
+	f.addFQDNSelector("rule1", []string{"www.example.com"})
+	f.addFQDNSelector("rule2", []string{"*.example.com"})
+	assert.ElementsMatch(t, []net.IP{net.ParseIP("192.168.1.1")}, f.getIPsForFQDNSelectors([]string{"www.example.com"}))
+	assert.ElementsMatch(t, []net.IP{net.ParseIP("192.168.1.1"), net.ParseIP("192.168.1.2")}, f.getIPsForFQDNSelectors([]string{"*.example.com"}))
+
+	f.deleteUnselectedFQDNs()
+	assert.Equal(t, sets.New[string]("www.example.com", "api.example.com"), sets.KeySet(f.dnsEntryCache))
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// tcpDNSStreamTimeout is the time after which the data buffered for a TCP connection is discarded if no new
+	// segment is received for the connection.
+	tcpDNSStreamTimeout = 10 * time.Second
+	// maxTCPDNSStreams is the maximum number of TCP connections for which DNS responses are reassembled at the same
+	// time.
+	maxTCPDNSStreams = 1024
+	// maxTCPDNSPendingBytes is the maximum number of bytes of out-of-order segments buffered for a TCP connection. It
+	// is large enough for a DNS message of maximum size and its length field.
+	maxTCPDNSPendingBytes = 2 + 65535
+
+	tcpFlagFIN uint8 = 0x01
+	tcpFlagRST uint8 = 0x04
+)
+
+// tcpFlowKey identifies the TCP connection used by a DNS server to send DNS responses.
+type tcpFlowKey struct {
+	srcIP   netip.Addr
+	dstIP   netip.Addr
+	srcPort uint16
+	dstPort uint16
+}
+
+// tcpDNSStream stores the data received from a DNS server over a TCP connection which doesn't form a complete DNS
+// message yet.
+type tcpDNSStream struct {
+	// nextSeq is the sequence number of the next expected segment.
+	nextSeq uint32
+	buffer  []byte
+	// pending stores the out-of-order segments received after a missing segment, indexed by sequence number. They
+	// are added to buffer once the missing data is received.
+	pending      map[uint32][]byte
+	pendingBytes int
+	lastUpdate   time.Time
+}
+
+// tcpDNSAssembler reassembles DNS messages sent over TCP. Each DNS message is prefixed with a two-octet length field
+// (RFC 1035 and RFC 7766), and large messages span multiple TCP segments. A TCP connection can also be used for
+// multiple DNS messages. Retransmitted data is ignored. When a segment is missing, the segments received after it are
+// buffered until the DNS server retransmits the missing data.
+type tcpDNSAssembler struct {
+	mutex   sync.Mutex
+	streams map[tcpFlowKey]*tcpDNSStream
+	clock   clock.Clock
+}
+
+func newTCPDNSAssembler(clock clock.Clock) *tcpDNSAssembler {
+	return &tcpDNSAssembler{
+		streams: map[tcpFlowKey]*tcpDNSStream{},
+		clock:   clock,
+	}
+}
+
+// addSegment adds the payload of a TCP segment sent by a DNS server, and returns the DNS messages completed by the
+// segment, without the length fields.
+func (a *tcpDNSAssembler) addSegment(key tcpFlowKey, seq uint32, flags uint8, payload []byte) [][]byte {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	now := a.clock.Now()
+	a.removeStaleStreams(now)
+
+	closed := flags&(tcpFlagFIN|tcpFlagRST) != 0
+	if closed {
+		defer delete(a.streams, key)
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+
+	stream, exists := a.streams[key]
+	if !exists {
+		if len(a.streams) >= maxTCPDNSStreams {
+			klog.V(2).InfoS("Too many TCP connections with incomplete DNS responses, skipping segment", "srcIP", key.srcIP, "dstIP", key.dstIP, "dstPort", key.dstPort)
+			return nil
+		}
+		// The first segment received for a connection is expected to start with the length field of a DNS message.
+		stream = &tcpDNSStream{nextSeq: seq}
+		a.streams[key] = stream
+	}
+	stream.lastUpdate = now
+	// Sequence numbers wrap around, so they are compared with serial number arithmetic.
+	if int32(stream.nextSeq-seq) < 0 {
+		// A segment is missing: keep this one until the missing data is received.
+		if _, ok := stream.pending[seq]; ok {
+			return nil
+		}
+		if stream.pendingBytes+len(payload) > maxTCPDNSPendingBytes {
+			klog.V(2).InfoS("Too much out-of-order data for DNS response, skipping segment", "srcIP", key.srcIP, "dstIP", key.dstIP, "dstPort", key.dstPort)
+			return nil
+		}
+		if stream.pending == nil {
+			stream.pending = map[uint32][]byte{}
+		}
+		stream.pending[seq] = payload
+		stream.pendingBytes += len(payload)
+		return nil
+	}
+	if !stream.appendData(seq, payload) {
+		// The data has been received already.
+		return nil
+	}
+	// The segment may have filled a gap, in which case buffered out-of-order segments can now be appended.
+	for progress := true; progress && len(stream.pending) > 0; {
+		progress = false
+		for pendingSeq, pendingPayload := range stream.pending {
+			if int32(stream.nextSeq-pendingSeq) < 0 {
+				continue
+			}
+			delete(stream.pending, pendingSeq)
+			stream.pendingBytes -= len(pendingPayload)
+			if stream.appendData(pendingSeq, pendingPayload) {
+				progress = true
+			}
+		}
+	}
+
+	var msgs [][]byte
+	for len(stream.buffer) >= 2 {
+		msgLen := int(binary.BigEndian.Uint16(stream.buffer[:2]))
+		if len(stream.buffer) < 2+msgLen {
+			break
+		}
+		msgs = append(msgs, stream.buffer[2:2+msgLen])
+		stream.buffer = stream.buffer[2+msgLen:]
+	}
+	if len(stream.buffer) == 0 {
+		// Release the memory of the completed messages.
+		stream.buffer = nil
+	}
+	return msgs
+}
+
+// appendData appends the data of a segment which does not start after nextSeq to the buffer, ignoring the part which has
+// been received already. It returns false if all the data has been received already.
+func (s *tcpDNSStream) appendData(seq uint32, payload []byte) bool {
+	offset := int(int32(s.nextSeq - seq))
+	if offset >= len(payload) {
+		return false
+	}
+	s.buffer = append(s.buffer, payload[offset:]...)
+	s.nextSeq += uint32(len(payload) - offset)
+	return true
+}
+
+func (a *tcpDNSAssembler) removeStaleStreams(now time.Time) {
+	for key, stream := range a.streams {
+		if now.Sub(stream.lastUpdate) > tcpDNSStreamTimeout {
+			delete(a.streams, key)
+		}
+	}
+}
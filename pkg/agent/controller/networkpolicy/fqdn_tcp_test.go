@@ -0,0 +1,152 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestTCPDNSAssembler(t *testing.T) {
+	key := tcpFlowKey{
+		srcIP:   netip.MustParseAddr("10.96.0.10"),
+		dstIP:   netip.MustParseAddr("10.10.0.2"),
+		srcPort: 53,
+		dstPort: 34567,
+	}
+	type segment struct {
+		seq          uint32
+		flags        uint8
+		payload      []byte
+		expectedMsgs [][]byte
+	}
+	tests := []struct {
+		name            string
+		segments        []segment
+		expectedStreams int
+	}{
+		{
+			name: "single segment",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 3, 1, 2, 3}, expectedMsgs: [][]byte{{1, 2, 3}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "message split across segments",
+			segments: []segment{
+				{seq: 100, payload: []byte{0}},
+				{seq: 101, payload: []byte{4, 1, 2}},
+				{seq: 104, payload: []byte{3, 4}, expectedMsgs: [][]byte{{1, 2, 3, 4}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "multiple messages",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 1, 1, 0, 2}, expectedMsgs: [][]byte{{1}}},
+				{seq: 105, payload: []byte{2, 2, 0, 1, 3}, expectedMsgs: [][]byte{{2, 2}, {3}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "retransmitted segments",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 3, 1}},
+				{seq: 100, payload: []byte{0, 3, 1}},
+				{seq: 101, payload: []byte{3, 1, 2}},
+				{seq: 104, payload: []byte{3}, expectedMsgs: [][]byte{{1, 2, 3}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "missing segment retransmitted",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 3, 1}},
+				// The segment with seq 103 is missing.
+				{seq: 104, payload: []byte{3, 0, 1}},
+				{seq: 107, payload: []byte{4}},
+				// Retransmission of the missing segment.
+				{seq: 103, payload: []byte{2}, expectedMsgs: [][]byte{{1, 2, 3}, {4}}},
+				// Retransmission of a segment which was buffered.
+				{seq: 104, payload: []byte{3, 0, 1}},
+				{seq: 108, payload: []byte{0, 1, 5}, expectedMsgs: [][]byte{{5}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "missing segment retransmitted with overlapping data",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 4}},
+				{seq: 104, payload: []byte{3, 4}},
+				// The retransmitted segment overlaps with the buffered one.
+				{seq: 102, payload: []byte{1, 2, 3}, expectedMsgs: [][]byte{{1, 2, 3, 4}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "sequence number wrap around",
+			segments: []segment{
+				{seq: 0xfffffffe, payload: []byte{0, 2}},
+				{seq: 0, payload: []byte{1, 2}, expectedMsgs: [][]byte{{1, 2}}},
+			},
+			expectedStreams: 1,
+		},
+		{
+			name: "connection closed",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 3, 1}},
+				{seq: 103, flags: tcpFlagFIN, payload: []byte{2, 3}, expectedMsgs: [][]byte{{1, 2, 3}}},
+			},
+			expectedStreams: 0,
+		},
+		{
+			name: "connection reset",
+			segments: []segment{
+				{seq: 100, payload: []byte{0, 3, 1}},
+				{seq: 103, flags: tcpFlagRST},
+			},
+			expectedStreams: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTCPDNSAssembler(clocktesting.NewFakeClock(time.Now()))
+			for _, s := range tt.segments {
+				assert.Equal(t, s.expectedMsgs, a.addSegment(key, s.seq, s.flags, s.payload))
+			}
+			assert.Len(t, a.streams, tt.expectedStreams)
+		})
+	}
+}
+
+func TestTCPDNSAssemblerStaleStreams(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	a := newTCPDNSAssembler(fakeClock)
+	key1 := tcpFlowKey{srcIP: netip.MustParseAddr("10.96.0.10"), dstIP: netip.MustParseAddr("10.10.0.2"), srcPort: 53, dstPort: 34567}
+	key2 := tcpFlowKey{srcIP: netip.MustParseAddr("10.96.0.10"), dstIP: netip.MustParseAddr("10.10.0.3"), srcPort: 53, dstPort: 34567}
+
+	assert.Empty(t, a.addSegment(key1, 100, 0, []byte{0, 3, 1}))
+	fakeClock.Step(tcpDNSStreamTimeout + time.Second)
+	assert.Empty(t, a.addSegment(key2, 200, 0, []byte{0, 3, 1}))
+	assert.Len(t, a.streams, 1)
+	assert.Contains(t, a.streams, key2)
+	// The buffered data of key1 has been discarded, so the segment is considered as the start of a new message.
+	assert.Equal(t, [][]byte{{2, 3}}, a.addSegment(key1, 103, 0, []byte{0, 2, 2, 3}))
+}
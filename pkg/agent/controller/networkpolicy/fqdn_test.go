@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -55,6 +56,7 @@ func newMockFQDNController(t *testing.T, controller *gomock.Controller, dnsServe
 		config.DefaultHostGatewayOFPort,
 		clockToInject,
 		fqdnCacheMinTTL,
+		nil,
 	)
 	require.NoError(t, err)
 	return f, mockOFClient
@@ -826,3 +828,133 @@ func TestParseDNSResponseOnFQDNCacheMinTTL(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDNSResponseWithCNAME(t *testing.T) {
+	testFQDN := "www.example.com"
+	msg := &dns.Msg{
+		Question: []dns.Question{{Name: "www.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}},
+		Answer: []dns.RR{
+			// The records are deliberately not ordered.
+			&dns.A{Hdr: dns.RR_Header{Name: "edge.cdn.test.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("192.168.1.1")},
+			&dns.CNAME{Hdr: dns.RR_Header{Name: "www.example.com.", Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60}, Target: "www.example.com.cdn.test."},
+			&dns.CNAME{Hdr: dns.RR_Header{Name: "WWW.example.com.cdn.test.", Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60}, Target: "edge.cdn.test."},
+			&dns.A{Hdr: dns.RR_Header{Name: "edge.cdn.test.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("192.168.1.2")},
+			// Records which are not related to the queried name must be ignored.
+			&dns.A{Hdr: dns.RR_Header{Name: "attacker.test.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("192.168.1.3")},
+		},
+	}
+	controller := gomock.NewController(t)
+	f, _ := newMockFQDNController(t, controller, nil, nil, 0)
+	fqdn, responseIPs, err := f.parseDNSResponse(msg)
+	require.NoError(t, err)
+	assert.Equal(t, testFQDN, fqdn)
+	assert.ElementsMatch(t, []string{"192.168.1.1", "192.168.1.2"}, sets.List(sets.KeySet(responseIPs)))
+}
+
+func TestValidateDNSResponse(t *testing.T) {
+	question := dns.Question{Name: "www.example.com.", Qtype: dns.TypeA, Qclass: dns.ClassINET}
+	tests := []struct {
+		name        string
+		msg         *dns.Msg
+		expectedErr string
+	}{
+		{
+			name: "valid response",
+			msg:  &dns.Msg{MsgHdr: dns.MsgHdr{Response: true, Opcode: dns.OpcodeQuery}, Question: []dns.Question{question}},
+		},
+		{
+			name:        "query",
+			msg:         &dns.Msg{MsgHdr: dns.MsgHdr{Opcode: dns.OpcodeQuery}, Question: []dns.Question{question}},
+			expectedErr: "DNS message is not a response",
+		},
+		{
+			name:        "unsupported opcode",
+			msg:         &dns.Msg{MsgHdr: dns.MsgHdr{Response: true, Opcode: dns.OpcodeUpdate}, Question: []dns.Question{question}},
+			expectedErr: "unsupported DNS opcode UPDATE",
+		},
+		{
+			name:        "no question",
+			msg:         &dns.Msg{MsgHdr: dns.MsgHdr{Response: true, Opcode: dns.OpcodeQuery}},
+			expectedErr: "unexpected number of questions 0 in DNS response",
+		},
+		{
+			name:        "multiple questions",
+			msg:         &dns.Msg{MsgHdr: dns.MsgHdr{Response: true, Opcode: dns.OpcodeQuery}, Question: []dns.Question{question, question}},
+			expectedErr: "unexpected number of questions 2 in DNS response",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDNSResponse(tt.msg)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestOnInterceptedDNSResponseMsgTrustedDNSServers(t *testing.T) {
+	testFQDN := "fqdn-test-pod.lfx.test"
+	dnsMsg := &dns.Msg{
+		MsgHdr:   dns.MsgHdr{Response: true, Opcode: dns.OpcodeQuery},
+		Question: []dns.Question{{Name: testFQDN + ".", Qtype: dns.TypeA, Qclass: dns.ClassINET}},
+		Answer: []dns.RR{
+			&dns.A{Hdr: dns.RR_Header{Name: testFQDN + ".", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("192.1.1.1")},
+		},
+	}
+	tests := []struct {
+		name              string
+		trustedDNSServers []netip.Addr
+		dnsServer         netip.Addr
+		expectedCached    bool
+	}{
+		{
+			name:           "no trusted DNS servers",
+			dnsServer:      netip.MustParseAddr("10.96.0.10"),
+			expectedCached: true,
+		},
+		{
+			name:              "trusted DNS server",
+			trustedDNSServers: []netip.Addr{netip.MustParseAddr("10.96.0.10")},
+			dnsServer:         netip.MustParseAddr("10.96.0.10"),
+			expectedCached:    true,
+		},
+		{
+			name:              "IPv4-mapped IPv6 address of trusted DNS server",
+			trustedDNSServers: []netip.Addr{netip.MustParseAddr("10.96.0.10")},
+			dnsServer:         netip.MustParseAddr("::ffff:10.96.0.10"),
+			expectedCached:    true,
+		},
+		{
+			name:              "untrusted DNS server",
+			trustedDNSServers: []netip.Addr{netip.MustParseAddr("10.96.0.10")},
+			dnsServer:         netip.MustParseAddr("10.10.0.5"),
+			expectedCached:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			f, _ := newMockFQDNController(t, controller, nil, newFakeClock(time.Now()), 0)
+			f.trustedDNSServers = sets.New(tt.trustedDNSServers...)
+			f.selectorItemToRuleIDs = map[fqdnSelectorItem]sets.Set[string]{
+				{matchName: testFQDN}: sets.New[string]("mockRule1"),
+			}
+			waitCh := make(chan error, 1)
+			f.onInterceptedDNSResponseMsg(dnsMsg, tt.dnsServer, waitCh)
+			_, cached := f.dnsEntryCache[testFQDN]
+			assert.Equal(t, tt.expectedCached, cached)
+			if tt.expectedCached {
+				// The response is forwarded once the rule selecting the FQDN is synced.
+				assert.True(t, f.ruleSyncTracker.getDirtyRules().Has("mockRule1"))
+				assert.Empty(t, waitCh)
+			} else {
+				// The response is forwarded immediately without being processed.
+				require.Len(t, waitCh, 1)
+				assert.NoError(t, <-waitCh)
+			}
+		})
+	}
+}
@@ -22,10 +22,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/miekg/dns"
 	"github.com/spf13/afero"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -48,8 +50,10 @@ const (
 
 	suricataCommandSocket = "/var/run/suricata/suricata-command.socket"
 
-	protocolHTTP = "http"
-	protocolTLS  = "tls"
+	protocolHTTP  = "http"
+	protocolTLS   = "tls"
+	protocolHTTP2 = "http2"
+	protocolDNS   = "dns"
 
 	scCmdOK = "OK"
 )
@@ -85,6 +89,8 @@ outputs:
             extended: yes
         - tls:
             extended: yes
+        - http2
+        - dns
   - eve-log:
       enabled: yes
       filetype: unix_stream
@@ -185,6 +191,15 @@ func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Se
 	rulesData.WriteString(rule)
 	sid++
 
+	// The default reject rule doesn't match the first packet of a UDP flow, as the flow is not established yet. Generate
+	// a reject rule for all DNS queries so that the DNS queries over UDP which are not allowed are rejected too.
+	if _, ok := protoKeywords[protocolDNS]; ok {
+		allKeywords = fmt.Sprintf(`msg: "Reject dns by %s"; flow: to_server; sid: %d;`, policyName, sid)
+		rule = fmt.Sprintf("reject %s any any -> any any (%s)\n", protocolDNS, allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
+
 	// Generate rules.
 	for proto, keywordsSet := range protoKeywords {
 		for keywords := range keywordsSet {
@@ -244,10 +259,39 @@ func convertContent(content string) string {
 	return fmt.Sprintf(`content:"%s";%s%s`, content, startsWith, endsWith)
 }
 
+// convertWildcard converts a string with the same wildcard semantics as convertContent to a regular expression. The
+// wildcards are replaced with the provided expression.
+func convertWildcard(content, wildcard string) string {
+	prefix, suffix := "", ""
+	if strings.HasPrefix(content, "*") {
+		prefix = wildcard
+		content = content[1:]
+	}
+	if strings.HasSuffix(content, "*") {
+		suffix = wildcard
+		content = content[:len(content)-1]
+	}
+	return prefix + regexp.QuoteMeta(content) + suffix
+}
+
+// convertPCRE generates a pcre keyword. Double quotes and semicolons must be escaped in Suricata rule options.
+func convertPCRE(expr, modifiers string) string {
+	expr = strings.NewReplacer(`"`, `\"`, `;`, `\;`).Replace(expr)
+	return fmt.Sprintf(`pcre:"/%s/%s";`, expr, modifiers)
+}
+
 func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	var keywords []string
 	if http.Path != "" {
-		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(http.Path)))
+		path := http.Path
+		// The URI contains the query string after the path, which must be matched too.
+		if len(http.QueryParams) > 0 && !strings.HasSuffix(path, "*") {
+			path += "?*"
+		}
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(path)))
+	}
+	if http.PathRegex != "" {
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertPCRE(fmt.Sprintf(`^(?:%s)(?:\?|$)`, http.PathRegex), "")))
 	}
 	if http.Method != "" {
 		keywords = append(keywords, fmt.Sprintf(`http.method; content:"%s";`, http.Method))
@@ -255,6 +299,21 @@ func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
 	if http.Host != "" {
 		keywords = append(keywords, fmt.Sprintf("http.host; %s", convertContent(http.Host)))
 	}
+	// Each header is a line "<name>: <value>" in the http.header buffer. The header names are case-insensitive.
+	for _, header := range http.Headers {
+		expr := fmt.Sprintf(`^(?i:%s):`, regexp.QuoteMeta(header.Name))
+		if header.Value != "" {
+			expr += fmt.Sprintf(`[ \t]*%s\r?$`, convertWildcard(header.Value, ".*"))
+		}
+		keywords = append(keywords, fmt.Sprintf("http.header; %s", convertPCRE(expr, "m")))
+	}
+	for _, param := range http.QueryParams {
+		expr := fmt.Sprintf(`[?&]%s(?:[=&]|$)`, regexp.QuoteMeta(param.Name))
+		if param.Value != "" {
+			expr = fmt.Sprintf(`[?&]%s=%s(?:&|$)`, regexp.QuoteMeta(param.Name), convertWildcard(param.Value, "[^&]*"))
+		}
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertPCRE(expr, "")))
+	}
 	return strings.Join(keywords, " ")
 }
 
@@ -266,6 +325,29 @@ func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
 	return strings.Join(keywords, " ")
 }
 
+// gRPC requests are HTTP/2 requests whose path is "/<service>/<method>" and whose content type starts with
+// "application/grpc".
+func convertProtocolGRPC(grpc *v1beta.GRPCProtocol) string {
+	keywords := []string{`http.request_header; content:"content-type|3a 20|application/grpc"; startswith;`}
+	if grpc.Method != "" {
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(fmt.Sprintf("/%s/%s", grpc.Service, grpc.Method))))
+	} else if grpc.Service != "" {
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(fmt.Sprintf("/%s/*", grpc.Service))))
+	}
+	return strings.Join(keywords, " ")
+}
+
+func convertProtocolDNS(dnsProtocol *v1beta.DNSProtocol) string {
+	var keywords []string
+	if dnsProtocol.QueryName != "" {
+		keywords = append(keywords, fmt.Sprintf("dns.query; %s nocase;", convertContent(dnsProtocol.QueryName)))
+	}
+	if dnsProtocol.QueryType != "" {
+		keywords = append(keywords, fmt.Sprintf("dns.rrtype:%d;", dns.StringToType[dnsProtocol.QueryType]))
+	}
+	return strings.Join(keywords, " ")
+}
+
 func (r *Reconciler) StartSuricataOnce() error {
 	return r.startSuricataOnce.Do(r.startSuricata)
 }
@@ -292,20 +374,24 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 
 	// Generate the keyword part used in Suricata rules.
 	protoKeywords := make(map[string]sets.Set[string])
+	addKeywords := func(proto, keywords string) {
+		if _, ok := protoKeywords[proto]; !ok {
+			protoKeywords[proto] = sets.New[string]()
+		}
+		protoKeywords[proto].Insert(keywords)
+	}
 	for _, protocol := range l7Protocols {
 		if protocol.HTTP != nil {
-			httpKeywords := convertProtocolHTTP(protocol.HTTP)
-			if _, ok := protoKeywords[protocolHTTP]; !ok {
-				protoKeywords[protocolHTTP] = sets.New[string]()
-			}
-			protoKeywords[protocolHTTP].Insert(httpKeywords)
+			addKeywords(protocolHTTP, convertProtocolHTTP(protocol.HTTP))
 		}
 		if protocol.TLS != nil {
-			tlsKeywords := convertProtocolTLS(protocol.TLS)
-			if _, ok := protoKeywords[protocolTLS]; !ok {
-				protoKeywords[protocolTLS] = sets.New[string]()
-			}
-			protoKeywords[protocolTLS].Insert(tlsKeywords)
+			addKeywords(protocolTLS, convertProtocolTLS(protocol.TLS))
+		}
+		if protocol.GRPC != nil {
+			addKeywords(protocolHTTP2, convertProtocolGRPC(protocol.GRPC))
+		}
+		if protocol.DNS != nil {
+			addKeywords(protocolDNS, convertProtocolDNS(protocol.DNS))
 		}
 	}
 
@@ -87,6 +87,36 @@ func TestConvertProtocolHTTP(t *testing.T) {
 			},
 			expected: `http.host; content:".foo.";`,
 		},
+		{
+			name: "with path regex",
+			http: &v1beta.HTTPProtocol{
+				PathRegex: "/api/v[0-9]+/pods",
+			},
+			expected: `http.uri; pcre:"/^(?:/api/v[0-9]+/pods)(?:\?|$)/";`,
+		},
+		{
+			name: "with exact path, query parameters",
+			http: &v1beta.HTTPProtocol{
+				Path: "/search",
+				QueryParams: []v1beta.HTTPQueryParamMatch{
+					{Name: "q"},
+					{Name: "version", Value: "v*"},
+				},
+			},
+			expected: `http.uri; content:"/search?"; startswith; http.uri; pcre:"/[?&]q(?:[=&]|$)/"; http.uri; pcre:"/[?&]version=v[^&]*(?:&|$)/";`,
+		},
+		{
+			name: "with method, headers",
+			http: &v1beta.HTTPProtocol{
+				Method: "GET",
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: "X-Tenant", Value: "tenant-a"},
+					{Name: "X-Debug"},
+					{Name: "User-Agent", Value: `*curl;"*`},
+				},
+			},
+			expected: `http.method; content:"GET"; http.header; pcre:"/^(?i:X-Tenant):[ \t]*tenant-a\r?$/m"; http.header; pcre:"/^(?i:X-Debug):/m"; http.header; pcre:"/^(?i:User-Agent):[ \t]*.*curl\;\".*\r?$/m";`,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -121,6 +151,81 @@ func TestConvertProtocolTLS(t *testing.T) {
 	}
 }
 
+func TestConvertProtocolGRPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grpc     *v1beta.GRPCProtocol
+		expected string
+	}{
+		{
+			name:     "without service,method",
+			grpc:     &v1beta.GRPCProtocol{},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith;`,
+		},
+		{
+			name: "with service",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+			},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/"; startswith;`,
+		},
+		{
+			name: "with service,method",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+				Method:  "SayHello",
+			},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolGRPC(tc.grpc))
+		})
+	}
+}
+
+func TestConvertProtocolDNS(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dns      *v1beta.DNSProtocol
+		expected string
+	}{
+		{
+			name:     "without query name,type",
+			dns:      &v1beta.DNSProtocol{},
+			expected: "",
+		},
+		{
+			name: "with exact query name",
+			dns: &v1beta.DNSProtocol{
+				QueryName: "www.google.com",
+			},
+			expected: `dns.query; content:"www.google.com"; startswith; endswith; nocase;`,
+		},
+		{
+			name: "with query name suffix,type",
+			dns: &v1beta.DNSProtocol{
+				QueryName: "*.google.com",
+				QueryType: "AAAA",
+			},
+			expected: `dns.query; content:".google.com"; endswith; nocase; dns.rrtype:28;`,
+		},
+		{
+			name: "with query type",
+			dns: &v1beta.DNSProtocol{
+				QueryType: "SRV",
+			},
+			expected: `dns.rrtype:33;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolDNS(tc.dns))
+		})
+	}
+}
+
 func TestStartSuricata(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defer func() {
@@ -177,6 +282,66 @@ func TestRuleLifecycle(t *testing.T) {
 			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
 			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
 		},
+		{
+			name: "protocol TLS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					TLS: &v1beta.TLSProtocol{
+						SNI: "www.google.com",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					TLS: &v1beta.TLSProtocol{},
+				},
+			},
+			// The default reject rule is the only rule added to the rules of the L7 protocol.
+			expectedRules: `reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; sid: 1;)
+pass tls any any -> any any (msg: "Allow tls by AntreaNetworkPolicy:test-l7"; tls.sni; content:"www.google.com"; startswith; endswith; sid: 2;)
+`,
+			expectedUpdatedRules: `reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; sid: 1;)
+pass tls any any -> any any (msg: "Allow tls by AntreaNetworkPolicy:test-l7"; sid: 2;)
+`,
+		},
+		{
+			name: "protocol gRPC",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{
+						Service: "helloworld.Greeter",
+						Method:  "SayHello",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{},
+				},
+			},
+			expectedRules:        `pass http2 any any -> any any (msg: "Allow http2 by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http2 any any -> any any (msg: "Allow http2 by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type|3a 20|application/grpc"; startswith; sid: 2;)`,
+		},
+		{
+			name: "protocol DNS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						QueryName: "www.google.com",
+						QueryType: "A",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{},
+				},
+			},
+			expectedRules: `reject dns any any -> any any (msg: "Reject dns by AntreaNetworkPolicy:test-l7"; flow: to_server; sid: 2;)
+pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; dns.query; content:"www.google.com"; startswith; endswith; nocase; dns.rrtype:1; sid: 3;)`,
+			expectedUpdatedRules: `reject dns any any -> any any (msg: "Reject dns by AntreaNetworkPolicy:test-l7"; flow: to_server; sid: 2;)
+pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; sid: 3;)`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -217,6 +382,9 @@ func TestRuleLifecycle(t *testing.T) {
 
 			// Update the added L7 NetworkPolicy.
 			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.updatedL7Protocols))
+			ok, err = afero.FileContainsBytes(defaultFS, rulesPath, []byte(tc.expectedUpdatedRules))
+			assert.NoError(t, err)
+			assert.True(t, ok)
 			expectedScCommands.Insert("reload-tenant 1 /etc/suricata/antrea-tenant-1.yaml")
 			assert.Equal(t, expectedScCommands, fs.calledScCommands)
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/netip"
 	"reflect"
 	"sync"
 	"time"
@@ -197,7 +198,8 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 	nodeConfig *config.NodeConfig,
 	podNetworkWait *utilwait.Group,
 	l7Reconciler *l7engine.Reconciler,
-	fqdnCacheMinTTL uint32) (*Controller, error) {
+	fqdnCacheMinTTL uint32,
+	fqdnTrustedDNSServers []netip.Addr) (*Controller, error) {
 	idAllocator := newIDAllocator(asyncRuleDeleteInterval, dnsInterceptRuleID)
 	c := &Controller{
 		antreaClientProvider: antreaClientGetter,
@@ -228,7 +230,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 	var err error
 	if antreaPolicyEnabled {
-		if c.fqdnController, err = newFQDNController(ofClient, idAllocator, dnsServerOverride, c.enqueueRule, v4Enabled, v6Enabled, gwPort, clock.RealClock{}, fqdnCacheMinTTL); err != nil {
+		if c.fqdnController, err = newFQDNController(ofClient, idAllocator, dnsServerOverride, c.enqueueRule, v4Enabled, v6Enabled, gwPort, clock.RealClock{}, fqdnCacheMinTTL, fqdnTrustedDNSServers); err != nil {
 			return nil, err
 		}
 
@@ -259,6 +261,11 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 	if err != nil {
 		return nil, fmt.Errorf("error creating file store for AddressGroup: %w", err)
 	}
+	if c.fqdnController != nil {
+		// Restore the FQDN cache before any rule is realized, so that existing connections to FQDNs are not dropped
+		// until Pods resolve the FQDNs again.
+		c.fqdnController.restoreDNSEntryCache(newFQDNCacheStore(fs, fqdnCacheFile))
+	}
 
 	if statusManagerEnabled {
 		c.statusManager = newStatusController(antreaClientGetter, nodeName, c.ruleCache)
@@ -656,6 +663,11 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	klog.Infof("All watchers have completed full sync, installing flows for init events")
 	// Batch install all rules in queue after fullSync is finished.
 	c.processAllItemsInQueue()
+	if c.antreaPolicyEnabled {
+		// All the FQDN rules have been added, the restored FQDNs which are no longer selected can be removed.
+		c.fqdnController.deleteUnselectedFQDNs()
+		go c.fqdnController.runCacheCheckpointer(stopCh)
+	}
 	c.podNetworkWait.Done()
 
 	klog.Infof("Starting NetworkPolicy workers now")
@@ -107,7 +107,8 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 		&config.NodeConfig{},
 		wait.NewGroup(),
 		l7reconciler,
-		0)
+		0,
+		nil)
 	reconciler := newMockReconciler()
 	controller.podReconciler = reconciler
 	controller.auditLogger = nil
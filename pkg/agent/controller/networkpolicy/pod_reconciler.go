@@ -541,6 +541,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 			PolicyRef:     rule.SourceRef,
 			EnableLogging: rule.EnableLogging,
 			LogLabel:      rule.LogLabel,
+			LogSampling:   rule.LogSampling,
 		}
 		return ofRuleByServicesMap, lastRealized
 	} else if isIGMP {
@@ -584,6 +585,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
 				LogLabel:      rule.LogLabel,
+				LogSampling:   rule.LogSampling,
 			}
 		}
 	} else {
@@ -615,6 +617,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
 				LogLabel:      rule.LogLabel,
+				LogSampling:   rule.LogSampling,
 			}
 		}
 
@@ -640,6 +643,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 					PolicyRef:     rule.SourceRef,
 					EnableLogging: rule.EnableLogging,
 					LogLabel:      rule.LogLabel,
+					LogSampling:   rule.LogSampling,
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -740,6 +744,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 				PolicyRef:     newRule.SourceRef,
 				EnableLogging: newRule.EnableLogging,
 				LogLabel:      newRule.LogLabel,
+				LogSampling:   newRule.LogSampling,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -798,6 +803,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
 					LogLabel:      newRule.LogLabel,
+					LogSampling:   newRule.LogSampling,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -870,6 +876,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
 					LogLabel:      newRule.LogLabel,
+					LogSampling:   newRule.LogSampling,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// podReconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
@@ -101,6 +101,29 @@ func (f *fakeMemberlistCluster) SelectNodeForIP(ip, externalIPPool string, filte
 	return selectNode, nil
 }
 
+func (f *fakeMemberlistCluster) SelectNodesForIP(ip, externalIPPool string, n int, filters ...func(string) bool) ([]string, error) {
+	var nodes []string
+	for _, node := range f.hashFn(f.nodes) {
+		passed := true
+		for _, f := range filters {
+			if !f(node) {
+				passed = false
+				break
+			}
+		}
+		if passed {
+			nodes = append(nodes, node)
+		}
+		if n > 0 && len(nodes) == n {
+			break
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no Node available for IP %s and externalIPPool %s", ip, externalIPPool)
+	}
+	return nodes, nil
+}
+
 func (f *fakeMemberlistCluster) ShouldSelectIP(ip string, pool string, filters ...func(node string) bool) (bool, error) {
 	return false, nil
 }
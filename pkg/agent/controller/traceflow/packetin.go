@@ -41,7 +41,7 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 	if !c.traceflowListerSynced() {
 		return errors.New("Traceflow controller is not started")
 	}
-	oldTf, nodeResult, packet, err := c.parsePacketIn(pktIn)
+	oldTf, nodeResult, packet, isReply, err := c.parsePacketIn(pktIn)
 	if err == errSkipTraceflowUpdate {
 		return nil
 	}
@@ -56,7 +56,11 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 			return fmt.Errorf("get Traceflow failed: %w", err)
 		}
 		update := tf.DeepCopy()
-		update.Status.Results = append(update.Status.Results, *nodeResult)
+		if isReply {
+			update.Status.ReplyResults = append(update.Status.ReplyResults, *nodeResult)
+		} else {
+			update.Status.Results = append(update.Status.Results, *nodeResult)
+		}
 		if packet != nil {
 			update.Status.CapturedPacket = packet
 		}
@@ -73,7 +77,11 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 	return nil
 }
 
-func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflow, *crdv1beta1.NodeResult, *crdv1beta1.Packet, error) {
+// parsePacketIn parses a Traceflow packet-in message and returns the observations made on the local Node. The returned
+// boolean is true if the packet is the reply packet of a round-trip Traceflow.
+func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflow, *crdv1beta1.NodeResult, *crdv1beta1.Packet, bool, error) {
+	// Record the observation time as early as possible, as it is used to compute per-hop latency.
+	observedTime := time.Now()
 	matchers := pktIn.GetMatches()
 
 	// Get data plane tag.
@@ -83,43 +91,43 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 	var ctNwDst, ctNwSrc, ipDst, ipSrc, ns, srcPod string
 	etherData := new(protocol.Ethernet)
 	if err := etherData.UnmarshalBinary(pktIn.Data.(*util.Buffer).Bytes()); err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to parse Ethernet packet from packet-in message: %v", err)
+		return nil, nil, nil, false, fmt.Errorf("failed to parse Ethernet packet from packet-in message: %v", err)
 	}
 	switch etherData.Ethertype {
 	case protocol.IPv4_MSG:
 		ipPacket, ok := etherData.Data.(*protocol.IPv4)
 		if !ok {
-			return nil, nil, nil, errors.New("invalid traceflow IPv4 packet")
+			return nil, nil, nil, false, errors.New("invalid traceflow IPv4 packet")
 		}
 		tag = ipPacket.DSCP
 		ctNwDst, err = getCTDstValue(matchers, false)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, false, err
 		}
 		ctNwSrc, err = getCTSrcValue(matchers, false)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, false, err
 		}
 		ipDst = ipPacket.NWDst.String()
 		ipSrc = ipPacket.NWSrc.String()
 	case protocol.IPv6_MSG:
 		ipv6Packet, ok := etherData.Data.(*protocol.IPv6)
 		if !ok {
-			return nil, nil, nil, errors.New("invalid traceflow IPv6 packet")
+			return nil, nil, nil, false, errors.New("invalid traceflow IPv6 packet")
 		}
 		tag = ipv6Packet.TrafficClass >> 2
 		ctNwDst, err = getCTDstValue(matchers, true)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, false, err
 		}
 		ctNwSrc, err = getCTSrcValue(matchers, true)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, false, err
 		}
 		ipDst = ipv6Packet.NWDst.String()
 		ipSrc = ipv6Packet.NWSrc.String()
 	default:
-		return nil, nil, nil, fmt.Errorf("unsupported traceflow packet Ethertype: %d", etherData.Ethertype)
+		return nil, nil, nil, false, fmt.Errorf("unsupported traceflow packet Ethertype: %d", etherData.Ethertype)
 	}
 
 	firstPacket := false
@@ -131,8 +139,9 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 	}
 	c.runningTraceflowsMutex.RUnlock()
 	if !exists {
-		return nil, nil, nil, fmt.Errorf("Traceflow for dataplane tag %d not found in cache", tag)
+		return nil, nil, nil, false, fmt.Errorf("Traceflow for dataplane tag %d not found in cache", tag)
 	}
+	isReply := tfState.replyTag != 0 && int8(tag) == tfState.replyTag
 
 	var capturedPacket *crdv1beta1.Packet
 	if tfState.liveTraffic {
@@ -146,7 +155,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		// request does not specify source / destination ports.
 		if !firstPacket {
 			klog.InfoS("An additional Traceflow packet was received unexpectedly for Live Traceflow, ignoring it")
-			return nil, nil, nil, errSkipTraceflowUpdate
+			return nil, nil, nil, false, errSkipTraceflowUpdate
 		}
 		// Uninstall the OVS flows after receiving the first packet, to
 		// avoid capturing too many matched packets.
@@ -163,14 +172,14 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 
 	tf, err := c.traceflowLister.Get(tfState.name)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("failed to get Traceflow %s CRD: %v", tfState.name, err)
+		return nil, nil, nil, false, fmt.Errorf("failed to get Traceflow %s CRD: %v", tfState.name, err)
 	}
 	ns = tf.Spec.Source.Namespace
 	srcPod = tf.Spec.Source.Pod
 
 	obs := []crdv1beta1.Observation{}
 	tableID := pktIn.TableId
-	if tfState.isSender {
+	if tfState.isSender && !isReply {
 		ob := new(crdv1beta1.Observation)
 		ob.Component = crdv1beta1.ComponentSpoofGuard
 		ob.Action = crdv1beta1.ActionForwarded
@@ -197,7 +206,9 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 	// - For packet is DNATed only, the final state is that ipDst != ctNwDst (in DNAT CT zone).
 	// - For packet is both DNATed and SNATed, the first state is also ipDst != ctNwDst (in DNAT CT zone), but the final
 	//   state is that ipSrc != ctNwSrc (in SNAT CT zone). The state in DNAT CT zone cannot be recognized in SNAT CT zone.
-	if !tfState.receiverOnly {
+	// The reply packet of a round-trip Traceflow is not subject to egress NetworkPolicy rules as it belongs to an
+	// established connection.
+	if !tfState.receiverOnly && !isReply {
 		if isValidCtNw(ctNwDst) && ipDst != ctNwDst || isValidCtNw(ctNwSrc) && ipSrc != ctNwSrc {
 			ob := &crdv1beta1.Observation{
 				Component:       crdv1beta1.ComponentLB,
@@ -213,7 +224,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		if match := getMatchRegField(matchers, openflow.TFEgressConjIDField); match != nil {
 			egressInfo, err := getRegValue(match, nil)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, false, err
 			}
 			ob := getNetworkPolicyObservation(tableID, false)
 			npRef := c.networkPolicyQuerier.GetNetworkPolicyByRuleFlowID(egressInfo)
@@ -227,12 +238,63 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 			obs = append(obs, *ob)
 		}
 	}
+	// Collect the reversed Service connections of the reply packet. On the Node where the request packet was DNATed /
+	// SNATed, the reply packet is un-DNATed / un-SNATed by conntrack, and its addresses are restored to the reverse of
+	// the original direction of the connection, i.e. the source is ctNwDst and the destination is ctNwSrc.
+	if isReply {
+		var ctMark uint32
+		if match := getMatchCTMarkField(matchers); match != nil {
+			ctMark, err = getMarkValue(match)
+			if err != nil {
+				return nil, nil, nil, false, err
+			}
+		}
+		isDNATed := ctMark&openflow.ServiceCTMark.GetRange().ToNXRange().ToUint32Mask() == openflow.ServiceCTMark.GetValue()
+		isSNATed := ctMark&openflow.ConnSNATCTMark.GetRange().ToNXRange().ToUint32Mask() == openflow.ConnSNATCTMark.GetValue()
+		if isDNATed || isSNATed {
+			ob := &crdv1beta1.Observation{
+				Component: crdv1beta1.ComponentLB,
+				Action:    crdv1beta1.ActionForwarded,
+			}
+			// As for the request packet, ctNwSrc may be invalid for ICMPv6 packets, the addresses of the packet are
+			// used instead.
+			if isDNATed {
+				ob.TranslatedSrcIP = ipSrc
+				if isValidCtNw(ctNwDst) {
+					ob.TranslatedSrcIP = ctNwDst
+				}
+			}
+			if isSNATed {
+				ob.TranslatedDstIP = ipDst
+				if isValidCtNw(ctNwSrc) {
+					ob.TranslatedDstIP = ctNwSrc
+				}
+			}
+			obs = append(obs, *ob)
+		}
+		// On the Egress Node, the reply packet of a connection SNATed by Egress is un-SNATed by the Node network stack
+		// before entering OVS, so the reverse translation cannot be observed in OVS. It is reported with the Egress of
+		// the request packet, which was observed by this Node.
+		c.runningTraceflowsMutex.RLock()
+		egressSNAT := tfState.egressSNAT
+		c.runningTraceflowsMutex.RUnlock()
+		if egressSNAT != nil {
+			obs = append(obs, crdv1beta1.Observation{
+				Component:       crdv1beta1.ComponentEgress,
+				Action:          crdv1beta1.ActionForwarded,
+				Egress:          egressSNAT.Egress,
+				EgressIP:        egressSNAT.EgressIP,
+				EgressNode:      egressSNAT.EgressNode,
+				TranslatedDstIP: ipDst,
+			})
+		}
+	}
 
 	// Collect ingress conjunctionID and get NetworkPolicy from cache.
 	if match := getMatchRegField(matchers, openflow.TFIngressConjIDField); match != nil {
 		ingressInfo, err := getRegValue(match, nil)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, false, err
 		}
 		ob := getNetworkPolicyObservation(tableID, true)
 		npRef := c.networkPolicyQuerier.GetNetworkPolicyByRuleFlowID(ingressInfo)
@@ -252,7 +314,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		if match := getMatchRegField(matchers, openflow.APConjIDField); match != nil {
 			notAllowConjInfo, err := getRegValue(match, nil)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, false, err
 			}
 			if ruleRef := c.networkPolicyQuerier.GetRuleByFlowID(notAllowConjInfo); ruleRef != nil {
 				if npRef := ruleRef.PolicyRef; npRef != nil {
@@ -279,14 +341,14 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		if match := getMatchTunnelDstField(matchers, isIPv6); match != nil {
 			tunnelDstIP, err = getTunnelDstValue(match)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, false, err
 			}
 		}
 		var outputPort uint32
 		if match := getMatchRegField(matchers, openflow.TargetOFPortField); match != nil {
 			outputPort, err = getRegValue(match, nil)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, false, err
 			}
 		}
 		gatewayIP := c.nodeConfig.GatewayConfig.IPv4
@@ -300,13 +362,13 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 			if match := getMatchRegField(matchers, openflow.RemoteSNATRegMark.GetField()); match != nil {
 				isRemoteEgress, err = getRegValue(match, openflow.RemoteSNATRegMark.GetField().GetRange().ToNXRange())
 				if err != nil {
-					return nil, nil, nil, err
+					return nil, nil, nil, false, err
 				}
 			}
 			if isRemoteEgress == 1 { // an Egress packet, currently on source Node and forwarded to Egress Node.
 				egressConfig, err := c.egressQuerier.GetEgress(ns, srcPod)
 				if err != nil {
-					return nil, nil, nil, err
+					return nil, nil, nil, false, err
 				}
 				obEgress := getEgressObservation(false, egressConfig.EgressIP, egressConfig.Name, egressConfig.EgressNode)
 				obs = append(obs, *obEgress)
@@ -320,7 +382,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 			if match := getMatchPktMarkField(matchers); match != nil {
 				pktMark, err = getMarkValue(match)
 				if err != nil {
-					return nil, nil, nil, err
+					return nil, nil, nil, false, err
 				}
 			}
 			if pktMark != 0 { // Egress packet on Egress Node
@@ -328,7 +390,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				if tunnelDstIP == "" { // Egress Node is Source Node of this Egress packet
 					egressConfig, err := c.egressQuerier.GetEgress(ns, srcPod)
 					if err != nil {
-						return nil, nil, nil, err
+						return nil, nil, nil, false, err
 					}
 					egressName = egressConfig.Name
 					egressIP = egressConfig.EgressIP
@@ -336,11 +398,17 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				} else {
 					egressIP, err = c.egressQuerier.GetEgressIPByMark(pktMark)
 					if err != nil {
-						return nil, nil, nil, err
+						return nil, nil, nil, false, err
 					}
 				}
 				obEgress := getEgressObservation(true, egressIP, egressName, egressNode)
 				obs = append(obs, *obEgress)
+				if tfState.replyTag != 0 && !isReply {
+					// Remember the Egress, which is needed to report the reversed SNAT of the reply packet.
+					c.runningTraceflowsMutex.Lock()
+					tfState.egressSNAT = obEgress
+					c.runningTraceflowsMutex.Unlock()
+				}
 			}
 			ob.Action = crdv1beta1.ActionForwardedOutOfOverlay
 		} else if outputPort == gwPort { // noEncap
@@ -354,14 +422,23 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		obs = append(obs, *ob)
 	}
 
-	nodeResult := crdv1beta1.NodeResult{Node: c.nodeConfig.Name, Timestamp: time.Now().Unix(), Observations: obs}
-	return tf, &nodeResult, capturedPacket, nil
+	nodeResult := crdv1beta1.NodeResult{
+		Node:           c.nodeConfig.Name,
+		Timestamp:      observedTime.Unix(),
+		TimestampNanos: observedTime.UnixNano(),
+		Observations:   obs,
+	}
+	return tf, &nodeResult, capturedPacket, isReply, nil
 }
 
 func getMatchPktMarkField(matchers *ofctrl.Matchers) *ofctrl.MatchField {
 	return matchers.GetMatchByName("NXM_NX_PKT_MARK")
 }
 
+func getMatchCTMarkField(matchers *ofctrl.Matchers) *ofctrl.MatchField {
+	return matchers.GetMatchByName("NXM_NX_CT_MARK")
+}
+
 func getMatchRegField(matchers *ofctrl.Matchers, field *binding.RegField) *ofctrl.MatchField {
 	return openflow.GetMatchFieldByRegID(matchers, field.GetRegID())
 }
@@ -702,7 +702,7 @@ func TestParsePacketIn(t *testing.T) {
 			tfc.runningTraceflows[tt.expectedTf.Status.DataplaneTag] = tt.tfState
 			tt.expectedCalls(tfc.networkPolicyQuerier, tfc.egressQuerier)
 
-			tf, nodeResult, _, err := tfc.parsePacketIn(tt.pktIn)
+			tf, nodeResult, _, _, err := tfc.parsePacketIn(tt.pktIn)
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedNodeResult.Observations, nodeResult.Observations)
 			assert.Equal(t, tt.expectedTf, tf)
@@ -737,6 +737,244 @@ func TestParsePacketInLiveDuplicates(t *testing.T) {
 	tfc := newFakeTraceflowController(t, nil, networkConfig, nodeConfig)
 	tfc.runningTraceflows[tfState.tag] = tfState
 
-	_, _, _, err := tfc.parsePacketIn(pktIn)
+	_, _, _, _, err := tfc.parsePacketIn(pktIn)
 	assert.ErrorIs(t, err, errSkipTraceflowUpdate)
 }
+
+func TestParsePacketInRoundTripReply(t *testing.T) {
+	networkConfig := &config.NetworkConfig{
+		TrafficEncapMode: 0,
+	}
+	nodeConfig := &config.NodeConfig{
+		TunnelOFPort: 1,
+		GatewayConfig: &config.GatewayConfig{
+			OFPort: 2,
+		},
+	}
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "traceflow-round-trip",
+		},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source: crdv1beta1.Source{
+				Namespace: pod1.Namespace,
+				Pod:       pod1.Name,
+			},
+			Destination: crdv1beta1.Destination{
+				IP: dstIPv4,
+			},
+			RoundTrip: true,
+		},
+		Status: crdv1beta1.TraceflowStatus{
+			Phase:             crdv1beta1.Running,
+			DataplaneTag:      2,
+			ReplyDataplaneTag: 1,
+		},
+	}
+	tfState := &traceflowState{
+		name:     tf.Name,
+		tag:      2,
+		replyTag: 1,
+		isSender: true,
+	}
+	// The test packet carries DSCP 1, which is the reply dataplane tag.
+	pktIn := &ofctrl.PacketIn{
+		PacketIn: &openflow15.PacketIn{
+			TableId: openflow.OutputTable.GetID(),
+			Data:    util.NewBuffer(getTestPacketBytes(dstIPv4)),
+		},
+	}
+
+	tfc := newFakeTraceflowController(t, []runtime.Object{tf}, networkConfig, nodeConfig)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+	tfc.runningTraceflows[tfState.tag] = tfState
+	tfc.runningTraceflows[tfState.replyTag] = tfState
+
+	_, nodeResult, _, isReply, err := tfc.parsePacketIn(pktIn)
+	require.NoError(t, err)
+	assert.True(t, isReply)
+	assert.NotZero(t, nodeResult.TimestampNanos)
+	// No SpoofGuard observation is expected for the reply packet, even on the sender Node.
+	assert.Equal(t, []crdv1beta1.Observation{{
+		Component:     crdv1beta1.ComponentForwarding,
+		ComponentInfo: openflow.OutputTable.GetName(),
+		Action:        crdv1beta1.ActionDelivered,
+	}}, nodeResult.Observations)
+
+	// The reply packet of a Service connection is un-DNATed by conntrack, and the Service IP is restored as its source.
+	svcIP := "10.96.0.10"
+	pktIn = &ofctrl.PacketIn{
+		PacketIn: &openflow15.PacketIn{
+			TableId: openflow.OutputTable.GetID(),
+			Match: openflow15.Match{
+				Fields: []openflow15.MatchField{
+					{
+						Class: openflow15.OXM_CLASS_NXM_1,
+						Field: openflow15.NXM_NX_CT_MARK,
+						Value: &openflow15.Uint32Message{Data: openflow.ServiceCTMark.GetValue()},
+					},
+					{
+						Class: openflow15.OXM_CLASS_NXM_1,
+						Field: openflow15.NXM_NX_CT_NW_DST,
+						Value: &openflow15.Ipv4DstField{Ipv4Dst: net.ParseIP(svcIP)},
+					},
+				},
+			},
+			Data: util.NewBuffer(getTestPacketBytes(dstIPv4)),
+		},
+	}
+	_, nodeResult, _, isReply, err = tfc.parsePacketIn(pktIn)
+	require.NoError(t, err)
+	assert.True(t, isReply)
+	assert.Equal(t, []crdv1beta1.Observation{
+		{
+			Component:       crdv1beta1.ComponentLB,
+			Action:          crdv1beta1.ActionForwarded,
+			TranslatedSrcIP: svcIP,
+		},
+		{
+			Component:     crdv1beta1.ComponentForwarding,
+			ComponentInfo: openflow.OutputTable.GetName(),
+			Action:        crdv1beta1.ActionDelivered,
+		},
+	}, nodeResult.Observations)
+}
+
+func TestParsePacketInRoundTripReplyEgress(t *testing.T) {
+	networkConfig := &config.NetworkConfig{
+		TrafficEncapMode: 0,
+	}
+	nodeConfig := &config.NodeConfig{
+		TunnelOFPort: 1,
+		GatewayConfig: &config.GatewayConfig{
+			OFPort: 2,
+		},
+	}
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "traceflow-round-trip-egress",
+		},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source: crdv1beta1.Source{
+				Namespace: pod1.Namespace,
+				Pod:       pod1.Name,
+			},
+			Destination: crdv1beta1.Destination{
+				IP: dstIPv4,
+			},
+			RoundTrip: true,
+		},
+		Status: crdv1beta1.TraceflowStatus{
+			Phase:             crdv1beta1.Running,
+			DataplaneTag:      1,
+			ReplyDataplaneTag: 2,
+		},
+	}
+	// The Node is the Egress Node, but not the source Node.
+	tfState := &traceflowState{
+		name:     tf.Name,
+		tag:      1,
+		replyTag: 2,
+	}
+	getOutPortMatch := func(outPort uint32) openflow15.MatchField {
+		xreg0 := make([]byte, 8)
+		binary.BigEndian.PutUint32(xreg0[4:8], outPort) // outputPort in 32bit reg1
+		return openflow15.MatchField{
+			Class: openflow15.OXM_CLASS_PACKET_REGS,
+			Field: openflow15.NXM_NX_REG0,
+			Value: &openflow15.ByteArrayField{Data: xreg0},
+		}
+	}
+	matchPktMark := openflow15.MatchField{
+		Class: openflow15.OXM_CLASS_NXM_1,
+		Field: openflow15.NXM_NX_PKT_MARK,
+		Value: &openflow15.Uint32Message{Data: 1},
+	}
+	// The request packet is marked for SNAT and forwarded to the gateway.
+	pktIn := &ofctrl.PacketIn{
+		PacketIn: &openflow15.PacketIn{
+			TableId: openflow.OutputTable.GetID(),
+			Match: openflow15.Match{
+				Fields: []openflow15.MatchField{getOutPortMatch(2), *openflow15.NewTunnelIpv4DstField(net.ParseIP(egressIP), nil), matchPktMark},
+			},
+			Data: util.NewBuffer(getTestPacketBytes(dstIPv4)),
+		},
+	}
+	// The reply packet is un-SNATed by the Node and forwarded to the source Node through the tunnel.
+	replyIPPacket := &protocol.IPv4{
+		Version:  0x4,
+		IHL:      5,
+		Protocol: uint8(8),
+		DSCP:     2,
+		Length:   20,
+		NWSrc:    net.ParseIP(dstIPv4),
+		NWDst:    net.ParseIP(pod1IPv4),
+	}
+	replyEthernetPkt := protocol.NewEthernet()
+	replyEthernetPkt.Ethertype = protocol.IPv4_MSG
+	replyEthernetPkt.Data = replyIPPacket
+	replyPktBytes, err := replyEthernetPkt.MarshalBinary()
+	require.NoError(t, err)
+	replyPktIn := &ofctrl.PacketIn{
+		PacketIn: &openflow15.PacketIn{
+			TableId: openflow.OutputTable.GetID(),
+			Match: openflow15.Match{
+				Fields: []openflow15.MatchField{getOutPortMatch(1)},
+			},
+			Data: util.NewBuffer(replyPktBytes),
+		},
+	}
+
+	tfc := newFakeTraceflowController(t, []runtime.Object{tf}, networkConfig, nodeConfig)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	tfc.crdInformerFactory.Start(stopCh)
+	tfc.crdInformerFactory.WaitForCacheSync(stopCh)
+	tfc.runningTraceflows[tfState.tag] = tfState
+	tfc.runningTraceflows[tfState.replyTag] = tfState
+	tfc.egressQuerier.EXPECT().GetEgressIPByMark(uint32(1)).Return(egressIP, nil)
+
+	_, nodeResult, _, isReply, err := tfc.parsePacketIn(pktIn)
+	require.NoError(t, err)
+	assert.False(t, isReply)
+	assert.Equal(t, []crdv1beta1.Observation{
+		{
+			Component: crdv1beta1.ComponentForwarding,
+			Action:    crdv1beta1.ActionReceived,
+		},
+		{
+			Component: crdv1beta1.ComponentEgress,
+			Action:    crdv1beta1.ActionMarkedForSNAT,
+			EgressIP:  egressIP,
+		},
+		{
+			Component:     crdv1beta1.ComponentForwarding,
+			ComponentInfo: openflow.OutputTable.GetName(),
+			Action:        crdv1beta1.ActionForwardedOutOfOverlay,
+		},
+	}, nodeResult.Observations)
+
+	_, nodeResult, _, isReply, err = tfc.parsePacketIn(replyPktIn)
+	require.NoError(t, err)
+	assert.True(t, isReply)
+	assert.Equal(t, []crdv1beta1.Observation{
+		{
+			Component: crdv1beta1.ComponentForwarding,
+			Action:    crdv1beta1.ActionReceived,
+		},
+		{
+			Component:       crdv1beta1.ComponentEgress,
+			Action:          crdv1beta1.ActionForwarded,
+			EgressIP:        egressIP,
+			TranslatedDstIP: pod1IPv4,
+		},
+		{
+			Component:     crdv1beta1.ComponentForwarding,
+			ComponentInfo: openflow.OutputTable.GetName(),
+			Action:        crdv1beta1.ActionForwarded,
+		},
+	}, nodeResult.Observations)
+}
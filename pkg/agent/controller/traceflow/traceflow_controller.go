@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"sync"
 	"time"
@@ -66,6 +67,14 @@ const (
 	icmpEchoRequestType   uint8 = 8
 	icmpv6EchoRequestType uint8 = 128
 	icmpEchoRequestCode   uint8 = 0
+	// ICMP Echo Reply type.
+	icmpEchoReplyType   uint8 = 0
+	icmpv6EchoReplyType uint8 = 129
+
+	// Range of the source ports picked for the request packet of a round-trip Traceflow, when the source port is not
+	// specified.
+	roundTripMinSourcePort = 49152
+	roundTripNumSourcePort = 16384
 
 	defaultTTL uint8 = 64
 )
@@ -73,8 +82,10 @@ const (
 type traceflowState struct {
 	name string
 	// Used to uniquely identify Traceflow.
-	uid         types.UID
-	tag         int8
+	uid types.UID
+	tag int8
+	// Tag of the reply packet for round-trip Traceflow, 0 otherwise.
+	replyTag    int8
 	liveTraffic bool
 	droppedOnly bool
 	// Live-traffic Traceflow with only destination Pod specified.
@@ -82,6 +93,8 @@ type traceflowState struct {
 	isSender     bool
 	// Agent received the first Traceflow packet from OVS.
 	receivedPacket bool
+	// The Egress observation of the request packet of a round-trip Traceflow, if it was SNATed by Egress on this Node.
+	egressSNAT *crdv1beta1.Observation
 }
 
 // Controller is responsible for setting up Openflow entries and injecting traceflow packet into
@@ -104,7 +117,8 @@ type Controller struct {
 	queue                  workqueue.TypedRateLimitingInterface[string]
 	runningTraceflowsMutex sync.RWMutex
 	// runningTraceflows is a map for storing the running Traceflow state
-	// with dataplane tag to be the key.
+	// with dataplane tag to be the key. A round-trip Traceflow is stored
+	// with both its request and reply dataplane tags.
 	runningTraceflows map[int8]*traceflowState
 	enableAntreaProxy bool
 }
@@ -340,6 +354,17 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 		klog.V(2).Infof("Traceflow packet %v", *packet)
 	}
 
+	var replyPacket *binding.Packet
+	if tf.Spec.RoundTrip {
+		if tf.Status.ReplyDataplaneTag == 0 {
+			return fmt.Errorf("invalid reply data plane tag for round-trip Traceflow")
+		}
+		replyPacket, err = c.prepareReplyPacket(tf, packet)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Store Traceflow to cache.
 	c.runningTraceflowsMutex.Lock()
 	tfState := traceflowState{
@@ -347,6 +372,10 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 		liveTraffic: liveTraffic, droppedOnly: tf.Spec.DroppedOnly && liveTraffic,
 		receiverOnly: receiverOnly, isSender: isSender}
 	c.runningTraceflows[tfState.tag] = &tfState
+	if replyPacket != nil {
+		tfState.replyTag = tf.Status.ReplyDataplaneTag
+		c.runningTraceflows[tfState.replyTag] = &tfState
+	}
 	c.runningTraceflowsMutex.Unlock()
 
 	// Install flow entries for traceflow.
@@ -359,6 +388,13 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	if err != nil {
 		return err
 	}
+	if replyPacket != nil {
+		// The flows are installed on every Node, as the reply packet may enter OVS on any Node.
+		klog.V(2).InfoS("Installing flow entries for the reply of Traceflow", "Traceflow", tf.Name, "replyPacket", *replyPacket)
+		if err = c.ofClient.InstallTraceflowReplyFlows(uint8(tfState.replyTag), replyPacket, uint16(timeout)); err != nil {
+			return err
+		}
+	}
 
 	// Skip packet injection if the source Pod is not found on the local Node.
 	if !liveTraffic && isSender {
@@ -576,10 +612,60 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 			packet.ICMPCode = icmpEchoRequestCode
 		}
 	}
+	// The source port must be known in advance to match the reply packet of a round-trip Traceflow.
+	if tf.Spec.RoundTrip && packet.SourcePort == 0 && (packet.IPProto == protocol.Type_TCP || packet.IPProto == protocol.Type_UDP) {
+		packet.SourcePort = roundTripSourcePort(tf.UID)
+	}
 
 	return packet, nil
 }
 
+// roundTripSourcePort returns the source port used for the request packet of a round-trip Traceflow when it is not
+// specified. It is derived from the Traceflow UID so that all the Nodes can compute it independently.
+func roundTripSourcePort(uid types.UID) uint16 {
+	h := fnv.New32a()
+	h.Write([]byte(uid))
+	return uint16(roundTripMinSourcePort + h.Sum32()%roundTripNumSourcePort)
+}
+
+// prepareReplyPacket returns the packet used to match the reply to the request packet of a round-trip Traceflow. The
+// reply packet is matched on its destination (the source Pod) and on its transport header. It is not matched on its
+// source IP, which can be the IP of the selected Endpoint or the Service IP, depending on where the reply is observed.
+// packet is the request packet, which is only available on the sender Node. On other Nodes, the request packet is
+// computed from the source Pod IPs set in the Traceflow status by the Antrea Controller.
+func (c *Controller) prepareReplyPacket(tf *crdv1beta1.Traceflow, packet *binding.Packet) (*binding.Packet, error) {
+	if packet == nil {
+		if len(tf.Status.SourcePodIPs) == 0 {
+			return nil, fmt.Errorf("the IPs of the source Pod are unknown")
+		}
+		var podIPs []net.IP
+		for _, ip := range tf.Status.SourcePodIPs {
+			podIPs = append(podIPs, net.ParseIP(ip))
+		}
+		var err error
+		packet, err = c.preparePacket(tf, &interfacestore.InterfaceConfig{IPs: podIPs}, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	replyPacket := &binding.Packet{
+		IsIPv6:          packet.IsIPv6,
+		DestinationIP:   packet.SourceIP,
+		IPProto:         packet.IPProto,
+		DestinationPort: packet.SourcePort,
+	}
+	switch packet.IPProto {
+	case protocol.Type_ICMP:
+		replyPacket.ICMPType = icmpEchoReplyType
+	case protocol.Type_IPv6ICMP:
+		replyPacket.ICMPType = icmpv6EchoReplyType
+	case protocol.Type_TCP, protocol.Type_UDP:
+	default:
+		return nil, fmt.Errorf("round-trip is not supported for IP protocol %d", packet.IPProto)
+	}
+	return replyPacket, nil
+}
+
 func (c *Controller) errorTraceflowCRD(tf *crdv1beta1.Traceflow, reason string) (*crdv1beta1.Traceflow, error) {
 	tf.Status.Phase = crdv1beta1.Failed
 
@@ -595,6 +681,7 @@ func (c *Controller) errorTraceflowCRD(tf *crdv1beta1.Traceflow, reason string)
 func (c *Controller) cleanupTraceflow(tfName string) {
 	c.runningTraceflowsMutex.Lock()
 	defer c.runningTraceflowsMutex.Unlock()
+	// A round-trip Traceflow is stored with 2 tags.
 	for tag, tfState := range c.runningTraceflows {
 		if tfName == tfState.name {
 			// This must be executed before deleting the tag from runningTraceflows, otherwise it may uninstall another
@@ -603,7 +690,6 @@ func (c *Controller) cleanupTraceflow(tfName string) {
 				klog.ErrorS(err, "Failed to uninstall Traceflow flows", "Traceflow", tfName, "state", tfState)
 			}
 			delete(c.runningTraceflows, tag)
-			break
 		}
 	}
 }
@@ -992,3 +992,111 @@ func TestValidateTraceflow(t *testing.T) {
 		})
 	}
 }
+
+func TestPrepareReplyPacket(t *testing.T) {
+	roundTripTF := func(protocol int32, transportHeader crdv1beta1.TransportHeader) *crdv1beta1.Traceflow {
+		return &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf-round-trip", UID: "uid-round-trip"},
+			Spec: crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: pod1.Namespace,
+					Pod:       pod1.Name,
+				},
+				Destination: crdv1beta1.Destination{
+					Namespace: pod2.Namespace,
+					Pod:       pod2.Name,
+				},
+				Packet: crdv1beta1.Packet{
+					IPHeader:        &crdv1beta1.IPHeader{Protocol: protocol},
+					TransportHeader: transportHeader,
+				},
+				RoundTrip: true,
+			},
+			Status: crdv1beta1.TraceflowStatus{
+				SourcePodIPs: []string{pod1IPv4},
+			},
+		}
+	}
+	tfWithoutSourcePodIPs := roundTripTF(1, crdv1beta1.TransportHeader{})
+	tfWithoutSourcePodIPs.Status.SourcePodIPs = nil
+	tcs := []struct {
+		name          string
+		tf            *crdv1beta1.Traceflow
+		isSender      bool
+		expectedReply *binding.Packet
+		expectedErr   string
+	}{
+		{
+			name:     "ICMP on sender Node",
+			tf:       roundTripTF(1, crdv1beta1.TransportHeader{}),
+			isSender: true,
+			expectedReply: &binding.Packet{
+				DestinationIP: net.ParseIP(pod1IPv4),
+				IPProto:       protocol.Type_ICMP,
+				ICMPType:      icmpEchoReplyType,
+			},
+		},
+		{
+			name: "TCP on non-sender Node",
+			tf: roundTripTF(6, crdv1beta1.TransportHeader{
+				TCP: &crdv1beta1.TCPHeader{DstPort: 80},
+			}),
+			expectedReply: &binding.Packet{
+				DestinationIP:   net.ParseIP(pod1IPv4),
+				IPProto:         protocol.Type_TCP,
+				DestinationPort: roundTripSourcePort("uid-round-trip"),
+			},
+		},
+		{
+			name: "UDP with source port",
+			tf: roundTripTF(17, crdv1beta1.TransportHeader{
+				UDP: &crdv1beta1.UDPHeader{SrcPort: 12345, DstPort: 53},
+			}),
+			isSender: true,
+			expectedReply: &binding.Packet{
+				DestinationIP:   net.ParseIP(pod1IPv4),
+				IPProto:         protocol.Type_UDP,
+				DestinationPort: 12345,
+			},
+		},
+		{
+			name:        "non-sender Node without source Pod IPs",
+			tf:          tfWithoutSourcePodIPs,
+			expectedErr: "the IPs of the source Pod are unknown",
+		},
+	}
+
+	for _, tt := range tcs {
+		t.Run(tt.name, func(t *testing.T) {
+			tfc := newFakeTraceflowController(t, []runtime.Object{tt.tf}, nil, nil)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			tfc.informerFactory.Start(stopCh)
+			tfc.informerFactory.WaitForCacheSync(stopCh)
+			var packet *binding.Packet
+			if tt.isSender {
+				podInterfaces := tfc.interfaceStore.GetContainerInterfacesByPod(pod1.Name, pod1.Namespace)
+				var err error
+				packet, err = tfc.preparePacket(tt.tf, podInterfaces[0], false)
+				require.NoError(t, err)
+			}
+			replyPacket, err := tfc.prepareReplyPacket(tt.tf, packet)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expectedReply.DestinationIP.Equal(replyPacket.DestinationIP))
+			assert.Equal(t, tt.expectedReply.IPProto, replyPacket.IPProto)
+			assert.Equal(t, tt.expectedReply.ICMPType, replyPacket.ICMPType)
+			assert.Equal(t, tt.expectedReply.DestinationPort, replyPacket.DestinationPort)
+		})
+	}
+}
+
+func TestRoundTripSourcePort(t *testing.T) {
+	port := roundTripSourcePort("uid1")
+	assert.Equal(t, port, roundTripSourcePort("uid1"))
+	assert.GreaterOrEqual(t, int(port), roundTripMinSourcePort)
+	assert.Less(t, int(port), roundTripMinSourcePort+roundTripNumSourcePort)
+}
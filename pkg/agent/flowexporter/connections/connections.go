@@ -20,12 +20,14 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/flowexporter/connection"
 	"antrea.io/antrea/pkg/agent/flowexporter/options"
 	"antrea.io/antrea/pkg/agent/flowexporter/priorityqueue"
 	"antrea.io/antrea/pkg/agent/proxy"
+	"antrea.io/antrea/pkg/util/flowfilter"
 	"antrea.io/antrea/pkg/util/objectstore"
 )
 
@@ -39,6 +41,7 @@ type connectionStore struct {
 	antreaProxier          proxy.Proxier
 	expirePriorityQueue    *priorityqueue.ExpirePriorityQueue
 	staleConnectionTimeout time.Duration
+	flowFilter             *flowfilter.Matcher
 	mutex                  sync.Mutex
 }
 
@@ -52,6 +55,7 @@ func NewConnectionStore(
 		antreaProxier:          proxier,
 		expirePriorityQueue:    priorityqueue.NewExpirePriorityQueue(o.ActiveFlowTimeout, o.IdleFlowTimeout),
 		staleConnectionTimeout: o.StaleConnectionTimeout,
+		flowFilter:             o.FlowFilter,
 	}
 }
 
@@ -98,10 +102,12 @@ func (cs *connectionStore) AddConnToMap(connKey *connection.ConnectionKey, conn
 	cs.connections[*connKey] = conn
 }
 
-func (cs *connectionStore) fillPodInfo(conn *connection.Connection) {
+// fillPodInfo fills the local Pods information of the connection, and returns the source and destination Pods. The
+// returned Pods are nil if they are not local Pods.
+func (cs *connectionStore) fillPodInfo(conn *connection.Connection) (*corev1.Pod, *corev1.Pod) {
 	if cs.podStore == nil {
 		klog.V(4).Info("Pod store is not available to retrieve local Pods information.")
-		return
+		return nil, nil
 	}
 	// sourceIP/destinationIP are mapped only to local pods and not remote pods.
 	srcIP := conn.FlowKey.SourceAddress.String()
@@ -119,6 +125,38 @@ func (cs *connectionStore) fillPodInfo(conn *connection.Connection) {
 		conn.DestinationPodNamespace = dstPod.Namespace
 		conn.DestinationPodUID = string(dstPod.UID)
 	}
+	return srcPod, dstPod
+}
+
+// filterAllows returns true if the connection is selected by the configured flow filters. It must be called after
+// the K8s metadata of the connection has been resolved. srcPod and dstPod are the local Pods returned by fillPodInfo.
+func (cs *connectionStore) filterAllows(conn *connection.Connection, srcPod, dstPod *corev1.Pod) bool {
+	if cs.flowFilter.Empty() {
+		return true
+	}
+	flow := &flowfilter.Flow{
+		SourceIP:                       conn.FlowKey.SourceAddress,
+		DestinationIP:                  conn.FlowKey.DestinationAddress,
+		Protocol:                       conn.FlowKey.Protocol,
+		SourcePort:                     conn.FlowKey.SourcePort,
+		DestinationPort:                conn.FlowKey.DestinationPort,
+		SourcePodNamespace:             conn.SourcePodNamespace,
+		SourcePodName:                  conn.SourcePodName,
+		DestinationPodNamespace:        conn.DestinationPodNamespace,
+		DestinationPodName:             conn.DestinationPodName,
+		DestinationServicePortName:     conn.DestinationServicePortName,
+		IngressNetworkPolicyRuleAction: conn.IngressNetworkPolicyRuleAction,
+		EgressNetworkPolicyRuleAction:  conn.EgressNetworkPolicyRuleAction,
+		Direction:                      flowfilter.DirectionFromPods(conn.SourcePodName != "", conn.DestinationPodName != ""),
+		OnlyLocalPods:                  true,
+	}
+	if srcPod != nil {
+		flow.SourcePodLabels = labels.Set(srcPod.Labels)
+	}
+	if dstPod != nil {
+		flow.DestinationPodLabels = labels.Set(dstPod.Labels)
+	}
+	return cs.flowFilter.Matches(flow)
 }
 
 func (cs *connectionStore) fillServiceInfo(conn *connection.Connection, serviceStr string) {
@@ -273,7 +273,7 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *connection.Connection)
 		}
 		klog.V(4).InfoS("Antrea flow updated", "connection", existingConn)
 	} else {
-		cs.fillPodInfo(conn)
+		srcPod, dstPod := cs.fillPodInfo(conn)
 		if conn.SourcePodName == "" && conn.DestinationPodName == "" {
 			// We don't add connections to connection map or expirePriorityQueue if we can't find the pod
 			// information for both srcPod and dstPod
@@ -292,6 +292,12 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *connection.Connection)
 			}
 		}
 		cs.addNetworkPolicyMetadata(conn)
+		if !cs.filterAllows(conn, srcPod, dstPod) {
+			// The connection is not added to the connection map, so it is evaluated again during the next poll.
+			// This is required because the K8s metadata used by filters can change over time.
+			klog.V(5).InfoS("Skip this connection as it is not selected by the flow filters", "connection", conn)
+			return
+		}
 		if conn.StartTime.IsZero() {
 			conn.StartTime = time.Now()
 			conn.StopTime = time.Now()
@@ -104,7 +104,7 @@ func (ds *DenyConnectionStore) AddOrUpdateConn(conn *connection.Connection, time
 		conn.LastExportTime = timeSeen
 		conn.OriginalBytes = bytes
 		conn.OriginalPackets = uint64(1)
-		ds.fillPodInfo(conn)
+		srcPod, dstPod := ds.fillPodInfo(conn)
 		if conn.SourcePodName == "" && conn.DestinationPodName == "" {
 			// We don't add connections to connection map or expirePriorityQueue if we can't find the pod
 			// information for both srcPod and dstPod
@@ -116,6 +116,10 @@ func (ds *DenyConnectionStore) AddOrUpdateConn(conn *connection.Connection, time
 		if conn.Mark&openflow.ServiceCTMark.GetRange().ToNXRange().ToUint32Mask() == openflow.ServiceCTMark.GetValue() {
 			ds.fillServiceInfo(conn, serviceStr)
 		}
+		if !ds.filterAllows(conn, srcPod, dstPod) {
+			klog.V(5).InfoS("Skip this deny connection as it is not selected by the flow filters", "connection", conn)
+			return
+		}
 		metrics.TotalDenyConnections.Inc()
 		conn.IsActive = true
 		ds.connections[connKey] = conn
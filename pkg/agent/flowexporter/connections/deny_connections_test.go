@@ -21,8 +21,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"antrea.io/antrea/pkg/agent/flowexporter/connection"
@@ -30,6 +32,7 @@ import (
 	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	proxytest "antrea.io/antrea/pkg/agent/proxy/testing"
+	"antrea.io/antrea/pkg/util/flowfilter"
 	objectstoretest "antrea.io/antrea/pkg/util/objectstore/testing"
 	k8sproxy "antrea.io/antrea/third_party/proxy"
 )
@@ -165,3 +168,72 @@ func TestDenyConnectionStore_AddOrUpdateConn(t *testing.T) {
 		})
 	}
 }
+
+func TestDenyConnectionStore_FlowFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	refTime := time.Now()
+	tuple := connection.Tuple{SourceAddress: netip.MustParseAddr("1.2.3.4"), DestinationAddress: netip.MustParseAddr("4.3.2.1"), Protocol: 6, SourcePort: 65280, DestinationPort: 255}
+	srcPod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "frontend", Name: "web", Labels: map[string]string{"app": "web"}}}
+	tcs := []struct {
+		name          string
+		filters       []flowfilter.FlowFilter
+		expectedFound bool
+	}{
+		{
+			name:          "no filter",
+			expectedFound: true,
+		},
+		{
+			name: "matching filter",
+			filters: []flowfilter.FlowFilter{{
+				SourcePodSelector: "app=web",
+				Protocols:         []string{"TCP"},
+				DestinationPorts:  []string{"200-300"},
+				Directions:        []flowfilter.FlowDirection{flowfilter.FlowDirectionEgress},
+			}},
+			expectedFound: true,
+		},
+		{
+			name: "non-matching filter",
+			filters: []flowfilter.FlowFilter{{
+				SourceNamespaces: []string{"backend"},
+			}},
+			expectedFound: false,
+		},
+		{
+			// The destination is not a local Pod, the conditions on the destination Pod are left to the Flow
+			// Aggregator.
+			name: "filter on non-local destination Pod",
+			filters: []flowfilter.FlowFilter{{
+				SourcePodSelector:      "app=web",
+				DestinationNamespaces:  []string{"backend"},
+				DestinationPodSelector: "app=db",
+			}},
+			expectedFound: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics.TotalDenyConnections.Set(0)
+			mockPodStore := objectstoretest.NewMockPodStore(ctrl)
+			mockPodStore.EXPECT().GetPodByIPAndTime(tuple.SourceAddress.String(), gomock.Any()).Return(srcPod, true)
+			mockPodStore.EXPECT().GetPodByIPAndTime(tuple.DestinationAddress.String(), gomock.Any()).Return(nil, false)
+			flowFilter, err := flowfilter.NewMatcher(tc.filters)
+			require.NoError(t, err)
+			o := *testFlowExporterOptions
+			o.FlowFilter = flowFilter
+			denyConnStore := NewDenyConnectionStore(mockPodStore, nil, &o, filter.NewProtocolFilter(nil))
+
+			conn := &connection.Connection{
+				FlowKey:                        tuple,
+				OriginalDestinationAddress:     tuple.DestinationAddress,
+				OriginalDestinationPort:        tuple.DestinationPort,
+				EgressNetworkPolicyRuleAction:  2,
+				IngressNetworkPolicyRuleAction: 0,
+			}
+			denyConnStore.AddOrUpdateConn(conn, refTime, uint64(60))
+			_, found := denyConnStore.GetConnByKey(connection.NewConnectionKey(conn))
+			assert.Equal(t, tc.expectedFound, found)
+		})
+	}
+}
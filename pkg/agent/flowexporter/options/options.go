@@ -14,7 +14,11 @@
 
 package options
 
-import "time"
+import (
+	"time"
+
+	"antrea.io/antrea/pkg/util/flowfilter"
+)
 
 type FlowExporterOptions struct {
 	FlowCollectorAddr      string
@@ -25,4 +29,7 @@ type FlowExporterOptions struct {
 	PollInterval           time.Duration
 	ConnectUplinkToBridge  bool
 	ProtocolFilter         []string
+	// FlowFilter selects the connections to export. It is evaluated for each new connection, once its K8s
+	// metadata has been resolved. A nil FlowFilter selects all connections.
+	FlowFilter *flowfilter.Matcher
 }
@@ -89,6 +89,7 @@ type ClusterNodeEventHandler func(objName string)
 type Interface interface {
 	ShouldSelectIP(ip string, pool string, filters ...func(node string) bool) (bool, error)
 	SelectNodeForIP(ip, externalIPPool string, filters ...func(string) bool) (string, error)
+	SelectNodesForIP(ip, externalIPPool string, n int, filters ...func(string) bool) ([]string, error)
 	AliveNodes() sets.Set[string]
 	AddClusterEventHandler(handler ClusterNodeEventHandler)
 }
@@ -573,6 +574,23 @@ func (c *Cluster) SelectNodeForIP(ip, externalIPPool string, filters ...func(str
 	return node, nil
 }
 
+// SelectNodesForIP returns up to n items (Node names) in the hash which pass all filters, ordered by their distance to
+// the provided key (IP) for the ExternalIPPool. The first Node is the one returned by SelectNodeForIP. If n is not
+// positive, all Nodes which pass all filters are returned.
+func (c *Cluster) SelectNodesForIP(ip, externalIPPool string, n int, filters ...func(string) bool) ([]string, error) {
+	c.consistentHashRWMutex.RLock()
+	defer c.consistentHashRWMutex.RUnlock()
+	consistentHash, ok := c.consistentHashMap[externalIPPool]
+	if !ok {
+		return nil, fmt.Errorf("local Node consistentHashMap has not synced, ExternalIPPool %s", externalIPPool)
+	}
+	nodes := consistentHash.GetNWithFilters(ip, n, filters...)
+	if len(nodes) == 0 {
+		return nil, ErrNoNodeAvailable
+	}
+	return nodes, nil
+}
+
 func (c *Cluster) notify(objName string) {
 	for _, handler := range c.clusterNodeEventHandlers {
 		handler(objName)
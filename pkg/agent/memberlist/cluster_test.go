@@ -679,3 +679,33 @@ func TestCluster_RejoinNodes(t *testing.T) {
 	mockMemberlist.EXPECT().Join([]string{"10.0.0.2"})
 	fakeCluster.cluster.RejoinNodes()
 }
+
+func TestCluster_SelectNodesForIP(t *testing.T) {
+	fakeEIPName := "fakeExternalIPPool"
+	consistentHashMap := NewNodeConsistentHashMap()
+	consistentHashMap.Add(genNodes(10)...)
+	fakeCluster := &Cluster{
+		consistentHashMap: map[string]*consistenthash.Map{fakeEIPName: consistentHashMap},
+	}
+
+	selectedNode, err := fakeCluster.SelectNodeForIP("1.1.1.1", fakeEIPName)
+	require.NoError(t, err)
+	nodes, err := fakeCluster.SelectNodesForIP("1.1.1.1", fakeEIPName, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, genNodes(10), nodes)
+	assert.Equal(t, selectedNode, nodes[0])
+
+	nodes, err = fakeCluster.SelectNodesForIP("1.1.1.1", fakeEIPName, 3, func(node string) bool {
+		return node != selectedNode
+	})
+	require.NoError(t, err)
+	assert.Len(t, nodes, 3)
+	assert.NotContains(t, nodes, selectedNode)
+
+	_, err = fakeCluster.SelectNodesForIP("1.1.1.1", fakeEIPName, 3, func(node string) bool {
+		return false
+	})
+	assert.ErrorIs(t, err, ErrNoNodeAvailable)
+	_, err = fakeCluster.SelectNodesForIP("1.1.1.1", "unknownExternalIPPool", 3)
+	assert.Error(t, err)
+}
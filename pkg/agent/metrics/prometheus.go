@@ -27,6 +27,12 @@ const (
 	LabelPacketInMeterNetworkPolicy   = "PacketInMeterNetworkPolicy"
 	LabelPacketInMeterTraceflow       = "PacketInMeterTraceflow"
 	LabelPacketInMeterDNSInterception = "PacketInMeterDNSInterception"
+	// LabelPacketInMeterNetworkPolicyRules is used for the sum of the packets dropped by the meters of the
+	// NetworkPolicy rules configured with a logging rate limit.
+	LabelPacketInMeterNetworkPolicyRules = "PacketInMeterNetworkPolicyRules"
+
+	LabelAuditLogSuppressedSampling  = "sampling"
+	LabelAuditLogSuppressedRateLimit = "rate_limit"
 )
 
 var (
@@ -70,6 +76,17 @@ var (
 		},
 	)
 
+	NetworkPolicyAuditLogSuppressedCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "networkpolicy_audit_log_suppressed_count",
+			Help:           "Number of NetworkPolicy audit log events suppressed by the Antrea Agent because of the log sampling of the rules, partitioned by reason (sampling and rate_limit).",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
 	OVSTotalFlowCount = metrics.NewGauge(&metrics.GaugeOpts{
 		Namespace:      metricNamespaceAntrea,
 		Subsystem:      metricSubsystemAgent,
@@ -183,6 +200,38 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		},
 	)
+
+	NodePortLocalAllocatedPortCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "nodeportlocal_allocated_port_count",
+			Help:           "Number of Node ports allocated by NodePortLocal on local Node, by protocol.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"protocol"},
+	)
+
+	NodePortLocalPortRangeExhaustedCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "nodeportlocal_port_range_exhausted_count",
+			Help:           "Number of times a Node port could not be allocated by NodePortLocal on local Node because all the ports in the range were in use, by protocol.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"protocol"},
+	)
+
+	NodePortLocalPortRangeSize = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "nodeportlocal_port_range_size",
+			Help:           "Number of Node ports in the NodePortLocal port range on local Node.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
 )
 
 func InitializePrometheusMetrics() {
@@ -192,6 +241,7 @@ func InitializePrometheusMetrics() {
 	InitializeNetworkPolicyMetrics()
 	InitializeOVSMetrics()
 	InitializeConnectionMetrics()
+	InitializeNodePortLocalMetrics()
 }
 
 func InitializePodMetrics() {
@@ -212,6 +262,13 @@ func InitializeNetworkPolicyMetrics() {
 	if err := legacyregistry.Register(NetworkPolicyCount); err != nil {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_count")
 	}
+
+	if err := legacyregistry.Register(NetworkPolicyAuditLogSuppressedCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_audit_log_suppressed_count")
+	}
+	for _, reason := range []string{LabelAuditLogSuppressedSampling, LabelAuditLogSuppressedRateLimit} {
+		NetworkPolicyAuditLogSuppressedCount.WithLabelValues(reason)
+	}
 }
 
 func InitializeOVSMetrics() {
@@ -241,7 +298,7 @@ func InitializeOVSMetrics() {
 		OVSFlowOpsErrorCount.WithLabelValues(ops)
 		OVSFlowOpsLatency.WithLabelValues(ops)
 	}
-	for _, label := range []string{LabelPacketInMeterNetworkPolicy, LabelPacketInMeterTraceflow, LabelPacketInMeterDNSInterception} {
+	for _, label := range []string{LabelPacketInMeterNetworkPolicy, LabelPacketInMeterTraceflow, LabelPacketInMeterDNSInterception, LabelPacketInMeterNetworkPolicyRules} {
 		OVSMeterPacketDroppedCount.WithLabelValues(label)
 	}
 }
@@ -263,3 +320,19 @@ func InitializeConnectionMetrics() {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_conntrack_max_connection_count")
 	}
 }
+
+func InitializeNodePortLocalMetrics() {
+	if err := legacyregistry.Register(NodePortLocalAllocatedPortCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_nodeportlocal_allocated_port_count")
+	}
+	if err := legacyregistry.Register(NodePortLocalPortRangeExhaustedCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_nodeportlocal_port_range_exhausted_count")
+	}
+	if err := legacyregistry.Register(NodePortLocalPortRangeSize); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_nodeportlocal_port_range_size")
+	}
+	for _, protocol := range []string{"tcp", "udp", "sctp"} {
+		NodePortLocalAllocatedPortCount.WithLabelValues(protocol)
+		NodePortLocalPortRangeExhaustedCount.WithLabelValues(protocol)
+	}
+}
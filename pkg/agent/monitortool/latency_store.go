@@ -17,6 +17,8 @@ package monitortool
 import (
 	"errors"
 	"net"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -43,6 +46,16 @@ type LatencyStore struct {
 	// If the agent is running in networkPolicyOnly mode, the value will be the transport IP of the Node.
 	// Otherwise, the value will be the gateway IP of the Node
 	nodeTargetIPsMap map[string][]net.IP
+	// The map of probe target name to the latency entries of the target, it will be changed
+	// by the probers of the additional targets configured in NodeLatencyMonitor.
+	probeTargetLatencyMap map[string]*probeTargetLatency
+}
+
+// probeTargetLatency stores the latency entries of an additional probe target.
+type probeTargetLatency struct {
+	probeType v1alpha1.LatencyProbeType
+	// The map of target IP to latency entry.
+	ipLatencyMap map[string]*NodeIPLatencyEntry
 }
 
 // NodeIPLatencyEntry is the entry of the latency map.
@@ -58,9 +71,10 @@ type NodeIPLatencyEntry struct {
 // NewLatencyStore creates a new LatencyStore.
 func NewLatencyStore(isNetworkPolicyOnly bool) *LatencyStore {
 	store := &LatencyStore{
-		nodeIPLatencyMap:    make(map[string]*NodeIPLatencyEntry),
-		nodeTargetIPsMap:    make(map[string][]net.IP),
-		isNetworkPolicyOnly: isNetworkPolicyOnly,
+		nodeIPLatencyMap:      make(map[string]*NodeIPLatencyEntry),
+		nodeTargetIPsMap:      make(map[string][]net.IP),
+		probeTargetLatencyMap: make(map[string]*probeTargetLatency),
+		isNetworkPolicyOnly:   isNetworkPolicyOnly,
 	}
 
 	return store
@@ -109,6 +123,107 @@ func (s *LatencyStore) SetNodeIPLatencyEntry(nodeIP string, mutator func(entry *
 	mutator(entry)
 }
 
+// UpdateNodeIPLatencyEntry updates the NodeIPLatencyEntry for the given IP if it is the IP of a
+// known Node, and returns whether the entry was updated. It is used when receiving ICMP echo
+// replies, which can also come from the additional ICMP probe targets.
+func (s *LatencyStore) UpdateNodeIPLatencyEntry(nodeIP string, mutator func(entry *NodeIPLatencyEntry)) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.isNodeIP(nodeIP) {
+		return false
+	}
+	entry, ok := s.nodeIPLatencyMap[nodeIP]
+	if !ok {
+		entry = &NodeIPLatencyEntry{}
+		s.nodeIPLatencyMap[nodeIP] = entry
+	}
+
+	mutator(entry)
+	return true
+}
+
+// isNodeIP returns whether the given IP is one of the target IPs of a Node.
+func (s *LatencyStore) isNodeIP(ip string) bool {
+	for _, nodeIPs := range s.nodeTargetIPsMap {
+		for _, nodeIP := range nodeIPs {
+			if nodeIP.String() == ip {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetProbeTargetLatencyEntry sets the NodeIPLatencyEntry for the given IP of a probe target.
+func (s *LatencyStore) SetProbeTargetLatencyEntry(targetName string, probeType v1alpha1.LatencyProbeType, targetIP string, mutator func(entry *NodeIPLatencyEntry)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	target, ok := s.probeTargetLatencyMap[targetName]
+	if !ok || target.probeType != probeType {
+		target = &probeTargetLatency{
+			probeType:    probeType,
+			ipLatencyMap: make(map[string]*NodeIPLatencyEntry),
+		}
+		s.probeTargetLatencyMap[targetName] = target
+	}
+	entry, ok := target.ipLatencyMap[targetIP]
+	if !ok {
+		entry = &NodeIPLatencyEntry{}
+		target.ipLatencyMap[targetIP] = entry
+	}
+
+	mutator(entry)
+}
+
+// SetICMPProbeTargetLatencyEntries updates the NodeIPLatencyEntry of all the ICMP probe targets
+// which have been probed at the given IP. It is used when receiving ICMP echo replies, which
+// cannot be associated with a specific target.
+func (s *LatencyStore) SetICMPProbeTargetLatencyEntries(targetIP string, mutator func(entry *NodeIPLatencyEntry)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, target := range s.probeTargetLatencyMap {
+		if target.probeType != v1alpha1.LatencyProbeTypeICMP {
+			continue
+		}
+		if entry, ok := target.ipLatencyMap[targetIP]; ok {
+			mutator(entry)
+		}
+	}
+}
+
+// DeleteStaleProbeTargetIPs deletes the entries of the given probe target whose IP is not in
+// targetIPs, e.g. because a hostname resolved to different addresses or a sampled Endpoint was
+// removed.
+func (s *LatencyStore) DeleteStaleProbeTargetIPs(targetName string, targetIPs sets.Set[string]) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	target, ok := s.probeTargetLatencyMap[targetName]
+	if !ok {
+		return
+	}
+	for targetIP := range target.ipLatencyMap {
+		if !targetIPs.Has(targetIP) {
+			delete(target.ipLatencyMap, targetIP)
+		}
+	}
+}
+
+// DeleteStaleProbeTargets deletes the probe targets which are not in targetNames from the store.
+func (s *LatencyStore) DeleteStaleProbeTargets(targetNames sets.Set[string]) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for targetName := range s.probeTargetLatencyMap {
+		if !targetNames.Has(targetName) {
+			delete(s.probeTargetLatencyMap, targetName)
+		}
+	}
+}
+
 // addNode adds a Node to the latency store
 func (s *LatencyStore) addNode(node *corev1.Node) {
 	s.mutex.Lock()
@@ -290,3 +405,39 @@ func (l *LatencyStore) ConvertList(currentNodeName string) []statsv1alpha1.PeerN
 
 	return peerNodeLatencyStatsList
 }
+
+// ConvertProbeTargetList converts the probe target entries of the latency store to a list of
+// ProbeTargetLatencyStats, sorted by target name.
+func (l *LatencyStore) ConvertProbeTargetList() []statsv1alpha1.ProbeTargetLatencyStats {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+
+	if len(l.probeTargetLatencyMap) == 0 {
+		return nil
+	}
+	probeTargetLatencyStatsList := make([]statsv1alpha1.ProbeTargetLatencyStats, 0, len(l.probeTargetLatencyMap))
+	for targetName, target := range l.probeTargetLatencyMap {
+		targetIPLatencyStats := make([]statsv1alpha1.TargetIPLatencyStats, 0, len(target.ipLatencyMap))
+		for targetIP, latencyEntry := range target.ipLatencyMap {
+			targetIPLatencyStats = append(targetIPLatencyStats, statsv1alpha1.TargetIPLatencyStats{
+				TargetIP:                   targetIP,
+				LastSendTime:               metav1.NewTime(latencyEntry.LastSendTime),
+				LastRecvTime:               metav1.NewTime(latencyEntry.LastRecvTime),
+				LastMeasuredRTTNanoseconds: latencyEntry.LastMeasuredRTT.Nanoseconds(),
+			})
+		}
+		slices.SortFunc(targetIPLatencyStats, func(a, b statsv1alpha1.TargetIPLatencyStats) int {
+			return strings.Compare(a.TargetIP, b.TargetIP)
+		})
+		probeTargetLatencyStatsList = append(probeTargetLatencyStatsList, statsv1alpha1.ProbeTargetLatencyStats{
+			Name:                 targetName,
+			ProbeType:            string(target.probeType),
+			TargetIPLatencyStats: targetIPLatencyStats,
+		})
+	}
+	slices.SortFunc(probeTargetLatencyStatsList, func(a, b statsv1alpha1.ProbeTargetLatencyStats) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return probeTargetLatencyStatsList
+}
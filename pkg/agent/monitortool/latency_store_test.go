@@ -20,8 +20,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 )
 
 var (
@@ -115,6 +120,22 @@ func TestLatencyStore_SetNodeIPLatencyEntry(t *testing.T) {
 	}
 }
 
+func TestLatencyStore_UpdateNodeIPLatencyEntry(t *testing.T) {
+	latencyStore := NewLatencyStore(false)
+	latencyStore.addNode(node2)
+	mutator := func(entry *NodeIPLatencyEntry) {
+		entry.LastMeasuredRTT = time.Second
+	}
+
+	assert.True(t, latencyStore.UpdateNodeIPLatencyEntry("10.0.2.1", mutator))
+	// The IPs which don't belong to a Node are ignored.
+	assert.False(t, latencyStore.UpdateNodeIPLatencyEntry("8.8.8.8", mutator))
+	assert.ElementsMatch(t, []string{"10.0.2.1"}, latencyStore.getNodeIPLatencyKeys())
+	entry, ok := latencyStore.getNodeIPLatencyEntry("10.0.2.1")
+	require.True(t, ok)
+	assert.Equal(t, time.Second, entry.LastMeasuredRTT)
+}
+
 func TestLatencyStore_DeleteStaleNodeIPs(t *testing.T) {
 	testKey := "10.244.2.1"
 
@@ -173,3 +194,87 @@ func TestLatencyStore_ListNodeIPs(t *testing.T) {
 		})
 	}
 }
+
+func TestLatencyStore_ProbeTargets(t *testing.T) {
+	latencyStore := NewLatencyStore(false)
+	sendTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	recvTime := sendTime.Add(2 * time.Second)
+	setSendTime := func(entry *NodeIPLatencyEntry) {
+		entry.LastSendTime = sendTime
+	}
+	setRecvTime := func(entry *NodeIPLatencyEntry) {
+		entry.LastRecvTime = recvTime
+		entry.LastMeasuredRTT = 2 * time.Second
+	}
+
+	latencyStore.SetProbeTargetLatencyEntry("web", crdv1alpha1.LatencyProbeTypeHTTP, "10.10.0.2", setSendTime)
+	latencyStore.SetProbeTargetLatencyEntry("web", crdv1alpha1.LatencyProbeTypeHTTP, "10.10.0.1", setSendTime)
+	latencyStore.SetProbeTargetLatencyEntry("web", crdv1alpha1.LatencyProbeTypeHTTP, "10.10.0.1", setRecvTime)
+	latencyStore.SetProbeTargetLatencyEntry("dns", crdv1alpha1.LatencyProbeTypeICMP, "8.8.8.8", setSendTime)
+	latencyStore.SetProbeTargetLatencyEntry("gateway", crdv1alpha1.LatencyProbeTypeICMP, "10.10.0.1", setSendTime)
+	// ICMP replies only update the ICMP targets which have been probed at the replying IP.
+	latencyStore.SetICMPProbeTargetLatencyEntries("10.10.0.1", setRecvTime)
+	latencyStore.SetICMPProbeTargetLatencyEntries("10.10.0.3", setRecvTime)
+
+	sentStats := func(ip string) statsv1alpha1.TargetIPLatencyStats {
+		return statsv1alpha1.TargetIPLatencyStats{
+			TargetIP:     ip,
+			LastSendTime: metav1.NewTime(sendTime),
+			LastRecvTime: metav1.NewTime(time.Time{}),
+		}
+	}
+	receivedStats := func(ip string) statsv1alpha1.TargetIPLatencyStats {
+		return statsv1alpha1.TargetIPLatencyStats{
+			TargetIP:                   ip,
+			LastSendTime:               metav1.NewTime(sendTime),
+			LastRecvTime:               metav1.NewTime(recvTime),
+			LastMeasuredRTTNanoseconds: 2 * time.Second.Nanoseconds(),
+		}
+	}
+	assert.Equal(t, []statsv1alpha1.ProbeTargetLatencyStats{
+		{
+			Name:                 "dns",
+			ProbeType:            "ICMP",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{sentStats("8.8.8.8")},
+		},
+		{
+			Name:                 "gateway",
+			ProbeType:            "ICMP",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{receivedStats("10.10.0.1")},
+		},
+		{
+			Name:                 "web",
+			ProbeType:            "HTTP",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{receivedStats("10.10.0.1"), sentStats("10.10.0.2")},
+		},
+	}, latencyStore.ConvertProbeTargetList())
+
+	latencyStore.DeleteStaleProbeTargetIPs("web", sets.New[string]("10.10.0.2"))
+	latencyStore.DeleteStaleProbeTargets(sets.New[string]("web", "gateway"))
+	assert.Equal(t, []statsv1alpha1.ProbeTargetLatencyStats{
+		{
+			Name:                 "gateway",
+			ProbeType:            "ICMP",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{receivedStats("10.10.0.1")},
+		},
+		{
+			Name:                 "web",
+			ProbeType:            "HTTP",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{sentStats("10.10.0.2")},
+		},
+	}, latencyStore.ConvertProbeTargetList())
+
+	// Changing the probe type of a target resets its entries.
+	latencyStore.SetProbeTargetLatencyEntry("web", crdv1alpha1.LatencyProbeTypeTCP, "10.10.0.3", setSendTime)
+	latencyStore.DeleteStaleProbeTargets(sets.New[string]("web"))
+	assert.Equal(t, []statsv1alpha1.ProbeTargetLatencyStats{
+		{
+			Name:                 "web",
+			ProbeType:            "TCP",
+			TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{sentStats("10.10.0.3")},
+		},
+	}, latencyStore.ConvertProbeTargetList())
+
+	latencyStore.DeleteStaleProbeTargets(nil)
+	assert.Nil(t, latencyStore.ConvertProbeTargetList())
+}
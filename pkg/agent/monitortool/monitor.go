@@ -18,6 +18,7 @@ import (
 	"context"
 	"math/rand/v2"
 	"net"
+	"net/http"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,6 +29,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
@@ -80,8 +84,18 @@ type NodeLatencyMonitor struct {
 	nodeInformerSynced cache.InformerSynced
 	nlmInformerSynced  cache.InformerSynced
 
+	// serviceLister and endpointSliceLister are used to resolve the Service and ServiceEndpoints probe targets.
+	serviceLister             corelisters.ServiceLister
+	serviceListerSynced       cache.InformerSynced
+	endpointSliceLister       discoverylisters.EndpointSliceLister
+	endpointSliceListerSynced cache.InformerSynced
+
 	clock    clock.WithTicker
 	listener PacketListener
+	// resolver is used to resolve the hostnames of Host probe targets.
+	resolver Resolver
+	// httpClient is used by HTTP probes.
+	httpClient *http.Client
 
 	icmpSeqNum atomic.Uint32
 }
@@ -92,6 +106,8 @@ type latencyConfig struct {
 	Enable bool
 	// Interval is the interval time to ping all Nodes.
 	Interval time.Duration
+	// Targets is the list of additional targets to probe.
+	Targets []*probeTarget
 }
 
 // NewNodeLatencyMonitor creates a new NodeLatencyMonitor.
@@ -99,18 +115,35 @@ func NewNodeLatencyMonitor(
 	antreaClientProvider client.AntreaClientProvider,
 	nodeInformer coreinformers.NodeInformer,
 	nlmInformer crdinformers.NodeLatencyMonitorInformer,
+	serviceInformer coreinformers.ServiceInformer,
+	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
 	nodeConfig *config.NodeConfig,
 	trafficEncapMode config.TrafficEncapModeType,
 ) *NodeLatencyMonitor {
 	m := &NodeLatencyMonitor{
-		latencyStore:         NewLatencyStore(trafficEncapMode.IsNetworkPolicyOnly()),
-		latencyConfigChanged: make(chan latencyConfig),
-		antreaClientProvider: antreaClientProvider,
-		nodeInformerSynced:   nodeInformer.Informer().HasSynced,
-		nlmInformerSynced:    nlmInformer.Informer().HasSynced,
-		nodeName:             nodeConfig.Name,
-		clock:                clock.RealClock{},
-		listener:             &ICMPListener{},
+		latencyStore:              NewLatencyStore(trafficEncapMode.IsNetworkPolicyOnly()),
+		latencyConfigChanged:      make(chan latencyConfig),
+		antreaClientProvider:      antreaClientProvider,
+		nodeInformerSynced:        nodeInformer.Informer().HasSynced,
+		nlmInformerSynced:         nlmInformer.Informer().HasSynced,
+		serviceLister:             serviceInformer.Lister(),
+		serviceListerSynced:       serviceInformer.Informer().HasSynced,
+		endpointSliceLister:       endpointSliceInformer.Lister(),
+		endpointSliceListerSynced: endpointSliceInformer.Informer().HasSynced,
+		nodeName:                  nodeConfig.Name,
+		clock:                     clock.RealClock{},
+		listener:                  &ICMPListener{},
+		resolver:                  net.DefaultResolver,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				// Each probe should establish a new connection, and probes should not go through a proxy.
+				DisableKeepAlives: true,
+				Proxy:             nil,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
 	}
 
 	m.isIPv4Enabled, _ = config.IsIPv4Enabled(nodeConfig, trafficEncapMode)
@@ -211,6 +244,7 @@ func (m *NodeLatencyMonitor) updateLatencyConfig(nlm *v1alpha1.NodeLatencyMonito
 	latencyConfig := latencyConfig{
 		Enable:   true,
 		Interval: pingInterval,
+		Targets:  newProbeTargets(&nlm.Spec),
 	}
 
 	m.latencyConfigChanged <- latencyConfig
@@ -224,8 +258,24 @@ func (m *NodeLatencyMonitor) onNodeLatencyMonitorDelete(obj interface{}) {
 	m.latencyConfigChanged <- latencyConfig
 }
 
-// sendPing sends an ICMP message to the target IP address.
+// sendPing sends an ICMP message to the target Node IP address.
 func (m *NodeLatencyMonitor) sendPing(socket net.PacketConn, addr net.IP) error {
+	timeStart, err := m.sendICMPEcho(socket, addr)
+	if err != nil {
+		return err
+	}
+
+	// Create or update the latency store
+	mutator := func(entry *NodeIPLatencyEntry) {
+		entry.LastSendTime = timeStart
+	}
+	m.latencyStore.SetNodeIPLatencyEntry(addr.String(), mutator)
+
+	return nil
+}
+
+// sendICMPEcho sends an ICMP echo request to the target IP address, and returns the send timestamp.
+func (m *NodeLatencyMonitor) sendICMPEcho(socket net.PacketConn, addr net.IP) (time.Time, error) {
 	var requestType icmp.Type
 
 	ip := &net.IPAddr{IP: addr}
@@ -253,21 +303,15 @@ func (m *NodeLatencyMonitor) sendPing(socket net.PacketConn, addr net.IP) error
 	// Serialize the ICMP message
 	msgBytes, err := msg.Marshal(nil)
 	if err != nil {
-		return err
+		return time.Time{}, err
 	}
 
 	// Send the ICMP message
 	if _, err = socket.WriteTo(msgBytes, ip); err != nil {
-		return err
-	}
-
-	// Create or update the latency store
-	mutator := func(entry *NodeIPLatencyEntry) {
-		entry.LastSendTime = timeStart
+		return time.Time{}, err
 	}
-	m.latencyStore.SetNodeIPLatencyEntry(addr.String(), mutator)
 
-	return nil
+	return timeStart, nil
 }
 
 func (m *NodeLatencyMonitor) handlePing(buffer []byte, peerIP string, isIPv4 bool) {
@@ -336,7 +380,10 @@ func (m *NodeLatencyMonitor) handlePing(buffer []byte, peerIP string, isIPv4 boo
 		entry.LastRecvTime = end
 		entry.LastMeasuredRTT = rtt
 	}
-	m.latencyStore.SetNodeIPLatencyEntry(peerIP, mutator)
+	// The peer may be a Node, an additional ICMP probe target, or both. The results of the probe
+	// targets are stored separately, so that they are not reported as Node latency.
+	m.latencyStore.UpdateNodeIPLatencyEntry(peerIP, mutator)
+	m.latencyStore.SetICMPProbeTargetLatencyEntries(peerIP, mutator)
 }
 
 // recvPings receives ICMP messages.
@@ -383,7 +430,8 @@ func (m *NodeLatencyMonitor) getSummary() *statsv1alpha1.NodeLatencyStats {
 		ObjectMeta: metav1.ObjectMeta{
 			Name: m.nodeName,
 		},
-		PeerNodeLatencyStats: m.latencyStore.ConvertList(m.nodeName),
+		PeerNodeLatencyStats:    m.latencyStore.ConvertList(m.nodeName),
+		ProbeTargetLatencyStats: m.latencyStore.ConvertProbeTargetList(),
 	}
 }
 
@@ -401,7 +449,7 @@ func (m *NodeLatencyMonitor) report() {
 
 // Run starts the NodeLatencyMonitor.
 func (m *NodeLatencyMonitor) Run(stopCh <-chan struct{}) {
-	if !cache.WaitForNamedCacheSync("NodeLatencyMonitor", stopCh, m.nodeInformerSynced, m.nlmInformerSynced) {
+	if !cache.WaitForNamedCacheSync("NodeLatencyMonitor", stopCh, m.nodeInformerSynced, m.nlmInformerSynced, m.serviceListerSynced, m.endpointSliceListerSynced) {
 		return
 	}
 
@@ -418,7 +466,36 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 	var ipv4Socket, ipv6Socket net.PacketConn
 	var err error
 
+	// proberStopCh is used to stop the probers of the additional targets, and proberWG to wait
+	// for them to return.
+	var proberStopCh chan struct{}
+	var proberWG sync.WaitGroup
+	stopProbers := func() {
+		if proberStopCh != nil {
+			close(proberStopCh)
+			proberWG.Wait()
+			proberStopCh = nil
+		}
+	}
+	startProbers := func(targets []*probeTarget) {
+		stopProbers()
+		m.latencyStore.DeleteStaleProbeTargets(probeTargetNames(targets))
+		if len(targets) == 0 {
+			return
+		}
+		proberStopCh = make(chan struct{})
+		for _, target := range targets {
+			proberWG.Add(1)
+			go func(stopCh <-chan struct{}) {
+				defer proberWG.Done()
+				m.runProber(target, ipv4Socket, ipv6Socket, stopCh)
+			}(proberStopCh)
+		}
+	}
+
 	defer func() {
+		// Stop the probers before closing the sockets they use.
+		stopProbers()
 		if ipv4Socket != nil {
 			ipv4Socket.Close()
 		}
@@ -472,7 +549,7 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 		case <-stopCh:
 			return
 		case latencyConfig := <-m.latencyConfigChanged:
-			klog.InfoS("NodeLatencyMonitor configuration has changed", "enabled", latencyConfig.Enable, "interval", latencyConfig.Interval)
+			klog.InfoS("NodeLatencyMonitor configuration has changed", "enabled", latencyConfig.Enable, "interval", latencyConfig.Interval, "targets", len(latencyConfig.Targets))
 			// Start or stop the pingAll goroutine based on the latencyConfig
 			if latencyConfig.Enable {
 				// latencyConfig changed
@@ -507,6 +584,9 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 						m.recvPings(ipv6Socket, false)
 					}()
 				}
+				// The probers are restarted every time the config changes, as the targets
+				// may have been updated.
+				startProbers(latencyConfig.Targets)
 			} else {
 				if pingTicker != nil {
 					pingTicker.Stop()
@@ -517,10 +597,13 @@ func (m *NodeLatencyMonitor) monitorLoop(stopCh <-chan struct{}) {
 					reportTicker = nil
 				}
 				pingTickerCh, reportTickerCh = nil, nil
+				stopProbers()
+				m.latencyStore.DeleteStaleProbeTargets(nil)
 
 				// We close the sockets as a signal to recvPing that it needs to stop.
 				// Note that at that point, we are guaranteed that there is no ongoing Write
-				// to the socket, because pingAll runs in the same goroutine as this code.
+				// to the socket, because pingAll runs in the same goroutine as this code, and
+				// the probers have been stopped.
 				if ipv4Socket != nil {
 					ipv4Socket.Close()
 				}
@@ -201,7 +201,9 @@ func newTestMonitor(
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClientset, 0)
 	nlmInformer := crdInformerFactory.Crd().V1alpha1().NodeLatencyMonitors()
 	antreaClientProvider := &antreaClientGetter{crdClientset}
-	m := NewNodeLatencyMonitor(antreaClientProvider, nodeInformer, nlmInformer, nodeConfig, trafficEncapMode)
+	serviceInformer := informerFactory.Core().V1().Services()
+	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
+	m := NewNodeLatencyMonitor(antreaClientProvider, nodeInformer, nlmInformer, serviceInformer, endpointSliceInformer, nodeConfig, trafficEncapMode)
 	fakeClock := newFakeClock(t, clockT)
 	m.clock = fakeClock
 	mockListener := monitortesting.NewMockPacketListener(ctrl)
@@ -533,6 +535,7 @@ func TestSendPing(t *testing.T) {
 func TestRecvPings(t *testing.T) {
 	now := time.Now()
 	m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, now, nil, nil)
+	m.latencyStore.addNode(node2)
 	inCh := make(chan *nettest.Packet, 1)
 	pConn := nettest.NewPacketConn(testAddrIPv4, inCh, nil)
 	doneCh := make(chan struct{})
@@ -693,6 +696,7 @@ func TestHandlePing(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, now, nil, nil)
+			m.latencyStore.addNode(node2)
 			peerIP := "10.0.2.1"
 			if !tc.isIPv4 {
 				peerIP = "2001:ab03:cd04:55ee:100b::1"
@@ -0,0 +1,294 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+const (
+	defaultProbeTimeout = 5 * time.Second
+	defaultHTTPPath     = "/"
+)
+
+// Resolver resolves hostnames to IP addresses. It is implemented by net.Resolver.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// probeTarget is an additional target configured in NodeLatencyMonitor, with defaults applied.
+type probeTarget struct {
+	v1alpha1.LatencyProbeTarget
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// newProbeTargets converts the targets of the NodeLatencyMonitor spec to probeTargets, applying
+// the defaults of optional fields.
+func newProbeTargets(spec *v1alpha1.NodeLatencyMonitorSpec) []*probeTarget {
+	targets := make([]*probeTarget, 0, len(spec.Targets))
+	for _, t := range spec.Targets {
+		target := &probeTarget{
+			LatencyProbeTarget: *t.DeepCopy(),
+			interval:           time.Duration(spec.PingIntervalSeconds) * time.Second,
+			timeout:            defaultProbeTimeout,
+		}
+		if t.IntervalSeconds > 0 {
+			target.interval = time.Duration(t.IntervalSeconds) * time.Second
+		}
+		if t.TimeoutSeconds > 0 {
+			target.timeout = time.Duration(t.TimeoutSeconds) * time.Second
+		}
+		if target.Type == "" {
+			target.Type = v1alpha1.LatencyProbeTypeICMP
+		}
+		if target.Type == v1alpha1.LatencyProbeTypeHTTP && target.Path == "" {
+			target.Path = defaultHTTPPath
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// probeTargetNames returns the names of the given targets.
+func probeTargetNames(targets []*probeTarget) sets.Set[string] {
+	names := sets.New[string]()
+	for _, target := range targets {
+		names.Insert(target.Name)
+	}
+	return names
+}
+
+// runProber probes the target periodically, until stopCh is closed.
+func (m *NodeLatencyMonitor) runProber(target *probeTarget, ipv4Socket, ipv6Socket net.PacketConn, stopCh <-chan struct{}) {
+	ticker := m.clock.NewTicker(target.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			m.probe(target, ipv4Socket, ipv6Socket)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// probe resolves the target and probes all its IPs once. ICMP probes are asynchronous: the
+// latency store is updated when receiving the replies in recvPings. TCP and HTTP probes are
+// run in parallel, and probe returns once all of them have completed.
+func (m *NodeLatencyMonitor) probe(target *probeTarget, ipv4Socket, ipv6Socket net.PacketConn) {
+	targetIPs, err := m.resolveProbeTarget(target)
+	if err != nil {
+		klog.ErrorS(err, "Failed to resolve NodeLatencyMonitor probe target", "target", target.Name)
+		return
+	}
+	klog.V(4).InfoS("Probing NodeLatencyMonitor target", "target", target.Name, "type", target.Type, "IPs", targetIPs)
+
+	targetIPSet := sets.New[string]()
+	var wg sync.WaitGroup
+	for _, targetIP := range targetIPs {
+		targetIPSet.Insert(targetIP.String())
+		switch target.Type {
+		case v1alpha1.LatencyProbeTypeICMP:
+			socket := ipv4Socket
+			if targetIP.To4() == nil {
+				socket = ipv6Socket
+			}
+			if socket == nil {
+				klog.V(3).InfoS("Cannot send ICMP message to target IP because socket is not initialized for IP family", "target", target.Name, "IP", targetIP)
+				continue
+			}
+			timeStart, err := m.sendICMPEcho(socket, targetIP)
+			if err != nil {
+				klog.ErrorS(err, "Cannot send ICMP message to target IP", "target", target.Name, "IP", targetIP)
+				continue
+			}
+			m.latencyStore.SetProbeTargetLatencyEntry(target.Name, target.Type, targetIP.String(), func(entry *NodeIPLatencyEntry) {
+				entry.LastSendTime = timeStart
+			})
+		case v1alpha1.LatencyProbeTypeTCP, v1alpha1.LatencyProbeTypeHTTP:
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m.probeConnection(target, targetIP)
+			}()
+		}
+	}
+	wg.Wait()
+	m.latencyStore.DeleteStaleProbeTargetIPs(target.Name, targetIPSet)
+}
+
+// probeConnection runs a TCP or HTTP probe against the given IP of the target, and updates the
+// latency store with the result. The receive timestamp and the RTT are only updated on success.
+func (m *NodeLatencyMonitor) probeConnection(target *probeTarget, targetIP net.IP) {
+	ctx, cancel := context.WithTimeout(context.Background(), target.timeout)
+	defer cancel()
+
+	timeStart := m.clock.Now()
+	m.latencyStore.SetProbeTargetLatencyEntry(target.Name, target.Type, targetIP.String(), func(entry *NodeIPLatencyEntry) {
+		entry.LastSendTime = timeStart
+	})
+	var err error
+	if target.Type == v1alpha1.LatencyProbeTypeTCP {
+		err = m.probeTCP(ctx, target, targetIP)
+	} else {
+		err = m.probeHTTP(ctx, target, targetIP)
+	}
+	if err != nil {
+		klog.V(2).InfoS("NodeLatencyMonitor probe failed", "target", target.Name, "type", target.Type, "IP", targetIP, "err", err)
+		return
+	}
+	end := m.clock.Now()
+	rtt := end.Sub(timeStart)
+	klog.V(4).InfoS("Updating latency entry for target IP", "target", target.Name, "IP", targetIP, "lastSendTime", timeStart, "lastRecvTime", end, "RTT", rtt)
+	m.latencyStore.SetProbeTargetLatencyEntry(target.Name, target.Type, targetIP.String(), func(entry *NodeIPLatencyEntry) {
+		entry.LastRecvTime = end
+		entry.LastMeasuredRTT = rtt
+	})
+}
+
+// probeTCP establishes a TCP connection to the target IP and closes it immediately.
+func (m *NodeLatencyMonitor) probeTCP(ctx context.Context, target *probeTarget, targetIP net.IP) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(targetIP.String(), strconv.Itoa(int(target.Port))))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// probeHTTP sends an HTTP GET request to the target IP. Redirects are not followed, and the probe
+// succeeds if the response status code is between 200 and 399.
+func (m *NodeLatencyMonitor) probeHTTP(ctx context.Context, target *probeTarget, targetIP net.IP) error {
+	url := "http://" + net.JoinHostPort(targetIP.String(), strconv.Itoa(int(target.Port))) + target.Path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	// Use the hostname as the Host header, so that virtual hosts can be probed.
+	if target.Host != "" && net.ParseIP(target.Host) == nil {
+		req.Host = target.Host
+	}
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected HTTP status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// resolveProbeTarget returns the list of IPs to probe for the target.
+func (m *NodeLatencyMonitor) resolveProbeTarget(target *probeTarget) ([]net.IP, error) {
+	switch {
+	case target.Host != "":
+		if ip := net.ParseIP(target.Host); ip != nil {
+			return []net.IP{ip}, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), target.timeout)
+		defer cancel()
+		addrs, err := m.resolver.LookupIPAddr(ctx, target.Host)
+		if err != nil {
+			return nil, err
+		}
+		ips := make([]net.IP, 0, len(addrs))
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+		return ips, nil
+	case target.Service != nil:
+		svc, err := m.serviceLister.Services(target.Service.Namespace).Get(target.Service.Name)
+		if err != nil {
+			return nil, err
+		}
+		return getServiceClusterIPs(svc), nil
+	case target.ServiceEndpoints != nil:
+		selector := labels.SelectorFromSet(labels.Set{discovery.LabelServiceName: target.ServiceEndpoints.Name})
+		endpointSlices, err := m.endpointSliceLister.EndpointSlices(target.ServiceEndpoints.Namespace).List(selector)
+		if err != nil {
+			return nil, err
+		}
+		return sampleEndpointIPsPerNode(endpointSlices), nil
+	}
+	return nil, fmt.Errorf("target has no destination")
+}
+
+// getServiceClusterIPs returns the ClusterIPs of the Service, ignoring headless Services.
+func getServiceClusterIPs(svc *corev1.Service) []net.IP {
+	var ips []net.IP
+	for _, clusterIP := range svc.Spec.ClusterIPs {
+		if ip := net.ParseIP(clusterIP); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// sampleEndpointIPsPerNode returns one ready Endpoint IP per Node and per IP family. The smallest
+// address is selected, so that the same Endpoint keeps being probed as long as it is ready.
+func sampleEndpointIPsPerNode(endpointSlices []*discovery.EndpointSlice) []net.IP {
+	type nodeFamily struct {
+		nodeName string
+		isIPv6   bool
+	}
+	sampled := make(map[nodeFamily]string)
+	for _, endpointSlice := range endpointSlices {
+		if endpointSlice.AddressType != discovery.AddressTypeIPv4 && endpointSlice.AddressType != discovery.AddressTypeIPv6 {
+			continue
+		}
+		key := nodeFamily{isIPv6: endpointSlice.AddressType == discovery.AddressTypeIPv6}
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.NodeName == nil || len(endpoint.Addresses) == 0 {
+				continue
+			}
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			key.nodeName = *endpoint.NodeName
+			if current, ok := sampled[key]; !ok || endpoint.Addresses[0] < current {
+				sampled[key] = endpoint.Addresses[0]
+			}
+		}
+	}
+	addresses := make([]string, 0, len(sampled))
+	for _, address := range sampled {
+		addresses = append(addresses, address)
+	}
+	slices.Sort(addresses)
+	ips := make([]net.IP, 0, len(addresses))
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
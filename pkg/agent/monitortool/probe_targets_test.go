@@ -0,0 +1,320 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitortool
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/util/nettest"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+type fakeResolver struct {
+	addrs map[string][]net.IPAddr
+}
+
+func (r *fakeResolver) LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error) {
+	addrs, ok := r.addrs[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func makeEndpointSlice(name string, addressType discovery.AddressType, endpoints ...discovery.Endpoint) *discovery.EndpointSlice {
+	return &discovery.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{discovery.LabelServiceName: "web"},
+		},
+		AddressType: addressType,
+		Endpoints:   endpoints,
+	}
+}
+
+func makeEndpoint(address, nodeName string, ready bool) discovery.Endpoint {
+	return discovery.Endpoint{
+		Addresses:  []string{address},
+		NodeName:   ptr.To(nodeName),
+		Conditions: discovery.EndpointConditions{Ready: ptr.To(ready)},
+	}
+}
+
+func TestNewProbeTargets(t *testing.T) {
+	spec := &crdv1alpha1.NodeLatencyMonitorSpec{
+		PingIntervalSeconds: 60,
+		Targets: []crdv1alpha1.LatencyProbeTarget{
+			{
+				Name: "dns",
+				Host: "8.8.8.8",
+			},
+			{
+				Name:            "web",
+				Service:         &crdv1alpha1.NamespacedName{Namespace: "default", Name: "web"},
+				Type:            crdv1alpha1.LatencyProbeTypeHTTP,
+				Port:            80,
+				IntervalSeconds: 10,
+				TimeoutSeconds:  2,
+			},
+		},
+	}
+	targets := newProbeTargets(spec)
+	require.Len(t, targets, 2)
+	assert.Equal(t, crdv1alpha1.LatencyProbeTypeICMP, targets[0].Type)
+	assert.Equal(t, 60*time.Second, targets[0].interval)
+	assert.Equal(t, defaultProbeTimeout, targets[0].timeout)
+	assert.Equal(t, "/", targets[1].Path)
+	assert.Equal(t, 10*time.Second, targets[1].interval)
+	assert.Equal(t, 2*time.Second, targets[1].timeout)
+	assert.Equal(t, []string{"dns", "web"}, sets.List(probeTargetNames(targets)))
+}
+
+func TestSampleEndpointIPsPerNode(t *testing.T) {
+	endpointSlices := []*discovery.EndpointSlice{
+		makeEndpointSlice("web-ipv4-1", discovery.AddressTypeIPv4,
+			makeEndpoint("10.0.1.12", "node1", true),
+			makeEndpoint("10.0.1.11", "node1", true),
+			makeEndpoint("10.0.2.10", "node2", false),
+			discovery.Endpoint{Addresses: []string{"10.0.4.10"}},
+		),
+		makeEndpointSlice("web-ipv4-2", discovery.AddressTypeIPv4,
+			makeEndpoint("10.0.1.13", "node1", true),
+			makeEndpoint("10.0.3.10", "node3", true),
+		),
+		makeEndpointSlice("web-ipv6", discovery.AddressTypeIPv6,
+			makeEndpoint("2001:ab03:cd04:55ee:100a::10", "node1", true),
+		),
+		makeEndpointSlice("web-fqdn", discovery.AddressTypeFQDN,
+			makeEndpoint("web.example.com", "node1", true),
+		),
+	}
+	ips := sampleEndpointIPsPerNode(endpointSlices)
+	assert.Equal(t, []net.IP{net.ParseIP("10.0.1.11"), net.ParseIP("10.0.3.10"), net.ParseIP("2001:ab03:cd04:55ee:100a::10")}, ips)
+}
+
+func TestResolveProbeTarget(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:  "10.96.0.10",
+			ClusterIPs: []string{"10.96.0.10", "fd00:10:96::10"},
+		},
+	}
+	endpointSlice := makeEndpointSlice("web-ipv4", discovery.AddressTypeIPv4,
+		makeEndpoint("10.0.1.10", "node1", true),
+		makeEndpoint("10.0.2.10", "node2", true),
+	)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	m := newTestMonitor(t, nodeConfigDualStack, config.TrafficEncapModeEncap, time.Now(), []runtime.Object{svc, endpointSlice}, nil)
+	m.resolver = &fakeResolver{addrs: map[string][]net.IPAddr{
+		"www.example.com": {{IP: net.ParseIP("93.184.215.14")}, {IP: net.ParseIP("2606:2800:21f:cb07:6820:80da:af6b:8b2c")}},
+	}}
+	m.informerFactory.Start(stopCh)
+	m.informerFactory.WaitForCacheSync(stopCh)
+
+	testCases := []struct {
+		name        string
+		target      crdv1alpha1.LatencyProbeTarget
+		expectedIPs []net.IP
+		expectedErr bool
+	}{
+		{
+			name:        "IP",
+			target:      crdv1alpha1.LatencyProbeTarget{Host: "1.1.1.1"},
+			expectedIPs: []net.IP{net.ParseIP("1.1.1.1")},
+		},
+		{
+			name:        "hostname",
+			target:      crdv1alpha1.LatencyProbeTarget{Host: "www.example.com"},
+			expectedIPs: []net.IP{net.ParseIP("93.184.215.14"), net.ParseIP("2606:2800:21f:cb07:6820:80da:af6b:8b2c")},
+		},
+		{
+			name:        "unknown hostname",
+			target:      crdv1alpha1.LatencyProbeTarget{Host: "unknown.example.com"},
+			expectedErr: true,
+		},
+		{
+			name:        "Service",
+			target:      crdv1alpha1.LatencyProbeTarget{Service: &crdv1alpha1.NamespacedName{Namespace: "default", Name: "web"}},
+			expectedIPs: []net.IP{net.ParseIP("10.96.0.10"), net.ParseIP("fd00:10:96::10")},
+		},
+		{
+			name:        "unknown Service",
+			target:      crdv1alpha1.LatencyProbeTarget{Service: &crdv1alpha1.NamespacedName{Namespace: "default", Name: "db"}},
+			expectedErr: true,
+		},
+		{
+			name:        "ServiceEndpoints",
+			target:      crdv1alpha1.LatencyProbeTarget{ServiceEndpoints: &crdv1alpha1.NamespacedName{Namespace: "default", Name: "web"}},
+			expectedIPs: []net.IP{net.ParseIP("10.0.1.10"), net.ParseIP("10.0.2.10")},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			targets := newProbeTargets(&crdv1alpha1.NodeLatencyMonitorSpec{
+				PingIntervalSeconds: 60,
+				Targets:             []crdv1alpha1.LatencyProbeTarget{tc.target},
+			})
+			ips, err := m.resolveProbeTarget(targets[0])
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedIPs, ips)
+			}
+		})
+	}
+}
+
+func TestProbeConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	serverPort, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+	// Find a port with no listener by closing a listener right after creating it.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	testCases := []struct {
+		name            string
+		probeType       crdv1alpha1.LatencyProbeType
+		port            int
+		path            string
+		expectedSuccess bool
+	}{
+		{
+			name:            "TCP success",
+			probeType:       crdv1alpha1.LatencyProbeTypeTCP,
+			port:            serverPort,
+			expectedSuccess: true,
+		},
+		{
+			name:            "TCP failure",
+			probeType:       crdv1alpha1.LatencyProbeTypeTCP,
+			port:            closedPort,
+			expectedSuccess: false,
+		},
+		{
+			name:            "HTTP success",
+			probeType:       crdv1alpha1.LatencyProbeTypeHTTP,
+			port:            serverPort,
+			path:            "/healthz",
+			expectedSuccess: true,
+		},
+		{
+			name:            "HTTP error status code",
+			probeType:       crdv1alpha1.LatencyProbeTypeHTTP,
+			port:            serverPort,
+			path:            "/",
+			expectedSuccess: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Now()
+			m := newTestMonitor(t, nodeConfigIPv4, config.TrafficEncapModeEncap, now, nil, nil)
+			targets := newProbeTargets(&crdv1alpha1.NodeLatencyMonitorSpec{
+				PingIntervalSeconds: 60,
+				Targets: []crdv1alpha1.LatencyProbeTarget{
+					{Name: "local", Host: "127.0.0.1", Type: tc.probeType, Port: int32(tc.port), Path: tc.path},
+				},
+			})
+			m.probe(targets[0], nil, nil)
+
+			stats := m.latencyStore.ConvertProbeTargetList()
+			require.Len(t, stats, 1)
+			assert.Equal(t, "local", stats[0].Name)
+			assert.Equal(t, string(tc.probeType), stats[0].ProbeType)
+			require.Len(t, stats[0].TargetIPLatencyStats, 1)
+			ipStats := stats[0].TargetIPLatencyStats[0]
+			assert.Equal(t, "127.0.0.1", ipStats.TargetIP)
+			assert.True(t, ipStats.LastSendTime.Time.Equal(now))
+			assert.Equal(t, tc.expectedSuccess, ipStats.LastRecvTime.Time.Equal(now))
+		})
+	}
+}
+
+func TestProbeICMPTarget(t *testing.T) {
+	sendTime := time.Now()
+	m := newTestMonitor(t, nodeConfigIPv4, config.TrafficEncapModeEncap, sendTime, nil, nil)
+	m.latencyStore.addNode(node2)
+	targets := newProbeTargets(&crdv1alpha1.NodeLatencyMonitorSpec{
+		PingIntervalSeconds: 60,
+		Targets: []crdv1alpha1.LatencyProbeTarget{
+			{Name: "gateway", Host: "172.16.0.1"},
+		},
+	})
+	outCh := make(chan *nettest.Packet, 1)
+	pConn := nettest.NewPacketConn(testAddrIPv4, nil, outCh)
+	m.probe(targets[0], pConn, nil)
+
+	var request *nettest.Packet
+	select {
+	case request = <-outCh:
+		assert.Equal(t, "172.16.0.1", request.Addr.String())
+	case <-time.After(1 * time.Second):
+		require.Fail(t, "ICMP message was not sent")
+	}
+	// The probe target IP is not a Node IP, so the Node latency entries are not updated.
+	assert.Empty(t, m.latencyStore.getNodeIPLatencyKeys())
+
+	m.clock.Step(1 * time.Second)
+	msg, err := icmp.ParseMessage(protocolICMP, request.Bytes)
+	require.NoError(t, err)
+	m.handlePing(MustMarshal(&icmp.Message{Type: ipv4.ICMPTypeEchoReply, Body: msg.Body}), "172.16.0.1", true)
+	// The reply of the probe target is not reported as Node latency.
+	assert.Empty(t, m.latencyStore.getNodeIPLatencyKeys())
+	assert.Equal(t, []statsv1alpha1.PeerNodeLatencyStats{
+		{NodeName: "node2", TargetIPLatencyStats: []statsv1alpha1.TargetIPLatencyStats{}},
+	}, m.getSummary().PeerNodeLatencyStats)
+
+	stats := m.latencyStore.ConvertProbeTargetList()
+	require.Len(t, stats, 1)
+	require.Len(t, stats[0].TargetIPLatencyStats, 1)
+	assert.Equal(t, "ICMP", stats[0].ProbeType)
+	assert.Equal(t, time.Second.Nanoseconds(), stats[0].TargetIPLatencyStats[0].LastMeasuredRTTNanoseconds)
+}
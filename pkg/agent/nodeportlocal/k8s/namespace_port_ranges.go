@@ -0,0 +1,138 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/nodeportlocal/portcache"
+	"antrea.io/antrea/pkg/agent/nodeportlocal/types"
+	"antrea.io/antrea/pkg/agent/nodeportlocal/util"
+)
+
+func (c *NPLController) enqueueNamespace(obj interface{}) {
+	ns, isNs := obj.(*corev1.Namespace)
+	if !isNs {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		ns, ok = deletedState.Obj.(*corev1.Namespace)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown object is not of type Namespace: %v", deletedState.Obj)
+			return
+		}
+	}
+	if _, ok := ns.Annotations[types.NPLPortRangeAnnotationKey]; !ok {
+		return
+	}
+	c.handleNamespacePortRangeChange()
+}
+
+func (c *NPLController) enqueueNamespaceUpdate(oldObj, newObj interface{}) {
+	oldNs := oldObj.(*corev1.Namespace)
+	newNs := newObj.(*corev1.Namespace)
+	oldPortRange, oldExists := oldNs.Annotations[types.NPLPortRangeAnnotationKey]
+	newPortRange, newExists := newNs.Annotations[types.NPLPortRangeAnnotationKey]
+	if oldExists == newExists && oldPortRange == newPortRange {
+		return
+	}
+	c.handleNamespacePortRangeChange()
+}
+
+// handleNamespacePortRangeChange updates the port range pools of the port table and, if they have
+// changed, enqueues all the Pods running on the Node. Pods in all Namespaces can be affected, as
+// ports in a Namespace pool cannot be allocated to Pods in other Namespaces.
+func (c *NPLController) handleNamespacePortRangeChange() {
+	if !c.syncNamespacePortRanges() {
+		return
+	}
+	pods, err := c.podLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Error when listing Pods after NodePortLocal port range change")
+		return
+	}
+	for _, pod := range pods {
+		c.queue.Add(podKeyFunc(pod))
+	}
+}
+
+// syncNamespacePortRanges computes the port range pools from the NPLPortRangeAnnotationKey
+// annotation of all Namespaces and updates the port table accordingly. Pools are first-come,
+// first-served: the existing pools are kept, and new pools are processed in Namespace creation
+// order. Invalid port ranges, including those which overlap with the pool of another Namespace, are
+// rejected with an Event on the Namespace: the Pods in the Namespace are allocated ports from the
+// default pool. The Event is only generated when the port range of the Namespace is rejected for the
+// first time or for a different reason, not every time the pools are computed. It returns whether
+// the pools have changed.
+func (c *NPLController) syncNamespacePortRanges() bool {
+	c.namespacePortRangesMutex.Lock()
+	defer c.namespacePortRangesMutex.Unlock()
+	namespaces, err := c.nsLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Error when listing Namespaces")
+		return false
+	}
+	slices.SortFunc(namespaces, func(a, b *corev1.Namespace) int {
+		if r := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); r != 0 {
+			return r
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	var portRanges []portcache.NamespacePortRange
+	rejectedPortRanges := make(map[string]string)
+	namespacesByName := make(map[string]*corev1.Namespace)
+	for _, ns := range namespaces {
+		value, ok := ns.Annotations[types.NPLPortRangeAnnotationKey]
+		if !ok {
+			continue
+		}
+		start, end, err := util.ParsePortRange(value)
+		if err != nil {
+			c.rejectNamespacePortRange(ns, err, rejectedPortRanges)
+			continue
+		}
+		portRanges = append(portRanges, portcache.NamespacePortRange{Namespace: ns.Name, PortRange: portcache.PortRange{Start: start, End: end}})
+		namespacesByName[ns.Name] = ns
+	}
+	changed, errs := c.portTable.SetNamespacePortRanges(portRanges)
+	for namespace, err := range errs {
+		c.rejectNamespacePortRange(namespacesByName[namespace], err, rejectedPortRanges)
+	}
+	c.rejectedPortRanges = rejectedPortRanges
+	if changed {
+		klog.InfoS("NodePortLocal port ranges for Namespaces have changed", "portRanges", portRanges)
+	}
+	return changed
+}
+
+// rejectNamespacePortRange records the rejection of the port range of a Namespace in
+// rejectedPortRanges, and reports it if the port range was not rejected with the same error in the
+// previous sync.
+func (c *NPLController) rejectNamespacePortRange(ns *corev1.Namespace, err error, rejectedPortRanges map[string]string) {
+	rejectedPortRanges[ns.Name] = err.Error()
+	if previousErr, ok := c.rejectedPortRanges[ns.Name]; ok && previousErr == err.Error() {
+		return
+	}
+	klog.ErrorS(err, "Invalid NodePortLocal port range for Namespace, ignoring it", "namespace", ns.Name)
+	c.record.Eventf(ns, corev1.EventTypeWarning, "NodePortLocalPortRangeRejected", "NodePortLocal port range of the Namespace is ignored on Node %s: %v", c.nodeName, err)
+}
@@ -16,8 +16,10 @@ package k8s
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"antrea.io/antrea/pkg/agent/nodeportlocal/portcache"
@@ -33,8 +35,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 )
@@ -51,27 +56,48 @@ const (
 )
 
 type NPLController struct {
-	portTable   *portcache.PortTable
-	kubeClient  clientset.Interface
-	queue       workqueue.TypedRateLimitingInterface[string]
-	podInformer cache.SharedIndexInformer
-	podLister   corelisters.PodLister
-	svcInformer cache.SharedIndexInformer
-	nodeName    string
+	portTable        *portcache.PortTable
+	kubeClient       clientset.Interface
+	queue            workqueue.TypedRateLimitingInterface[string]
+	podInformer      cache.SharedIndexInformer
+	podLister        corelisters.PodLister
+	svcInformer      cache.SharedIndexInformer
+	nsInformer       cache.SharedIndexInformer
+	nsLister         corelisters.NamespaceLister
+	nodeName         string
+	eventBroadcaster record.EventBroadcaster
+	record           record.EventRecorder
+
+	// namespacePortRangesMutex protects rejectedPortRanges and serializes the syncs of the port
+	// range pools of Namespaces.
+	namespacePortRangesMutex sync.Mutex
+	// rejectedPortRanges stores the error of the rejected port range of each Namespace, from the
+	// last sync of the port range pools.
+	rejectedPortRanges map[string]string
 }
 
 func NewNPLController(kubeClient clientset.Interface,
 	podInformer cache.SharedIndexInformer,
 	svcInformer cache.SharedIndexInformer,
+	nsInformer cache.SharedIndexInformer,
 	pt *portcache.PortTable,
 	nodeName string) *NPLController {
+	eventBroadcaster := record.NewBroadcaster()
+	recorder := eventBroadcaster.NewRecorder(
+		scheme.Scheme,
+		corev1.EventSource{Component: controllerName, Host: nodeName},
+	)
 	c := NPLController{
-		kubeClient:  kubeClient,
-		portTable:   pt,
-		podInformer: podInformer,
-		podLister:   corelisters.NewPodLister(podInformer.GetIndexer()),
-		svcInformer: svcInformer,
-		nodeName:    nodeName,
+		kubeClient:       kubeClient,
+		portTable:        pt,
+		podInformer:      podInformer,
+		podLister:        corelisters.NewPodLister(podInformer.GetIndexer()),
+		svcInformer:      svcInformer,
+		nsInformer:       nsInformer,
+		nsLister:         corelisters.NewNamespaceLister(nsInformer.GetIndexer()),
+		nodeName:         nodeName,
+		eventBroadcaster: eventBroadcaster,
+		record:           recorder,
 	}
 
 	podInformer.AddEventHandlerWithResyncPeriod(
@@ -91,6 +117,15 @@ func NewNPLController(kubeClient clientset.Interface,
 		},
 		resyncPeriod,
 	)
+	nsInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueNamespace,
+			DeleteFunc: c.enqueueNamespace,
+			UpdateFunc: c.enqueueNamespaceUpdate,
+		},
+		resyncPeriod,
+	)
+
 	svcInformer.AddIndexers(
 		cache.Indexers{
 			NPLEnabledAnnotationIndex: func(obj interface{}) ([]string, error) {
@@ -127,11 +162,18 @@ func (c *NPLController) Run(stopCh <-chan struct{}) {
 		c.queue.ShutDown()
 	}()
 
+	c.eventBroadcaster.StartStructuredLogging(0)
+	c.eventBroadcaster.StartRecordingToSink(&v1.EventSinkImpl{
+		Interface: c.kubeClient.CoreV1().Events(""),
+	})
+	defer c.eventBroadcaster.Shutdown()
+
 	klog.Infof("Starting %s", controllerName)
-	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.podInformer.HasSynced, c.svcInformer.HasSynced) {
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.podInformer.HasSynced, c.svcInformer.HasSynced, c.nsInformer.HasSynced) {
 		return
 	}
 
+	c.syncNamespacePortRanges()
 	c.waitForRulesInitialization()
 
 	for i := 0; i < numWorkers; i++ {
@@ -204,9 +246,12 @@ func validateNPLService(svc *corev1.Service) {
 		klog.InfoS("Service does not have a selector, the NodePortLocal annotation will have no effect", "service", klog.KObj(svc))
 		return
 	}
+	if portcache.SCTPSupported {
+		return
+	}
 	for _, port := range svc.Spec.Ports {
 		if port.Protocol == corev1.ProtocolSCTP {
-			klog.InfoS("Service has NodePortLocal enabled but it includes a SCTP Service port, which will be ignored", "service", klog.KObj(svc))
+			klog.InfoS("Service has NodePortLocal enabled but it includes a SCTP Service port, which is not supported on this Node and will be ignored", "service", klog.KObj(svc))
 		}
 	}
 }
@@ -317,9 +362,8 @@ func (c *NPLController) getTargetPortsForServicesOfPod(pod *corev1.Pod) (sets.Se
 		}
 		if pod.Namespace == svc.Namespace && matchSvcSelectorPodLabels(svc.Spec.Selector, pod.GetLabels()) {
 			for _, port := range svc.Spec.Ports {
-				if port.Protocol == corev1.ProtocolSCTP {
-					// Not supported yet. A message is logged when the
-					// Service is processed.
+				if port.Protocol == corev1.ProtocolSCTP && !portcache.SCTPSupported {
+					// A message is logged when the Service is processed.
 					continue
 				}
 				switch port.TargetPort.Type {
@@ -458,6 +502,16 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 			}
 			portData = nil
 		}
+		// The Node port may no longer be in the range of ports which can be allocated to
+		// the Pod, if the port range pool of its Namespace (or of another Namespace) has
+		// changed. In that case, a new Node port needs to be allocated.
+		if portData != nil && !c.portTable.IsPortInRangeForPod(key, portData.NodePort) {
+			klog.InfoS("Deleting NodePortLocal rule for Pod as the Node port is not in the port range for the Pod", "pod", klog.KObj(pod), "podIP", podIP, "port", port, "protocol", protocol, "nodePort", portData.NodePort)
+			if err := c.portTable.DeleteRule(key, port, protocol); err != nil {
+				return fmt.Errorf("failed to delete rule for Pod %s, Pod Port %d, Protocol %s: %w", key, port, protocol, err)
+			}
+			portData = nil
+		}
 		if portData == nil {
 			if hport, ok := hostPorts[targetPortProto]; ok {
 				nodePort = hport
@@ -465,6 +519,7 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 				klog.InfoS("Adding NodePortLocal rule", "pod", klog.KObj(pod), "podIP", podIP, "port", port, "protocol", protocol)
 				nodePort, err = c.portTable.AddRule(key, port, protocol, podIP)
 				if err != nil {
+					c.recordAllocationFailure(pod, port, protocol, err)
 					return fmt.Errorf("failed to add rule for Pod %s: %v", key, err)
 				}
 			}
@@ -597,3 +652,14 @@ func (c *NPLController) cleanupNPLAnnotationForPod(pod *corev1.Pod) error {
 	}
 	return patchPod(nil, pod, c.kubeClient)
 }
+
+// recordAllocationFailure generates a Warning Event for the Pod when a Node port cannot be
+// allocated for one of its ports, so that users can tell why the Pod is missing from the
+// NodePortLocal annotation.
+func (c *NPLController) recordAllocationFailure(pod *corev1.Pod, podPort int, protocol string, err error) {
+	if errors.Is(err, portcache.ErrNoFreePort) {
+		c.record.Eventf(pod, corev1.EventTypeWarning, "NodePortLocalPortRangeExhausted", "Failed to allocate NodePortLocal Node port for port %d/%s on Node %s: %v", podPort, protocol, c.nodeName, err)
+		return
+	}
+	c.record.Eventf(pod, corev1.EventTypeWarning, "NodePortLocalAllocationFailed", "Failed to allocate NodePortLocal Node port for port %d/%s on Node %s: %v", podPort, protocol, c.nodeName, err)
+}
@@ -31,6 +31,7 @@ import (
 func InitializeNPLAgent(
 	kubeClient clientset.Interface,
 	serviceInformer coreinformers.ServiceInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
 	podInformer cache.SharedIndexInformer,
 	startPort int,
 	endPort int,
@@ -41,5 +42,5 @@ func InitializeNPLAgent(
 		return nil, fmt.Errorf("error when initializing NodePortLocal port table: %v", err)
 	}
 
-	return nplk8s.NewNPLController(kubeClient, podInformer, serviceInformer.Informer(), portTable, nodeName), nil
+	return nplk8s.NewNPLController(kubeClient, podInformer, serviceInformer.Informer(), namespaceInformer.Informer(), portTable, nodeName), nil
 }
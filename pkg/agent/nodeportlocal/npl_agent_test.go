@@ -61,6 +61,7 @@ const (
 	defaultAppSelectorVal = "test-pod"
 	protocolTCP           = "tcp"
 	protocolUDP           = "udp"
+	protocolSCTP          = "sctp"
 	defaultStartPort      = 61000
 	defaultEndPort        = 65000
 )
@@ -253,8 +254,9 @@ func setUp(t *testing.T, tc *testConfig, objects ...runtime.Object) *testData {
 		listOptions,
 	)
 	svcInformer := informerFactory.Core().V1().Services().Informer()
+	nsInformer := informerFactory.Core().V1().Namespaces().Informer()
 
-	c := k8s.NewNPLController(k8sClient, localPodInformer, svcInformer, portTable, defaultNodeName)
+	c := k8s.NewNPLController(k8sClient, localPodInformer, svcInformer, nsInformer, portTable, defaultNodeName)
 
 	data := &testData{
 		T:           t,
@@ -985,3 +987,173 @@ func TestPreventDefunctRuleReuse(t *testing.T) {
 
 	assert.Eventually(t, testData.ctrl.Satisfied, 2*time.Second, 50*time.Millisecond)
 }
+
+func TestSCTPService(t *testing.T) {
+	testSvc := getTestSvc()
+	testSvc.Spec.Ports[0].Protocol = corev1.ProtocolSCTP
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	expectedAnnotations := newExpectedNPLAnnotations().Add(nil, defaultPort, protocolSCTP)
+	expectedAnnotations.Check(t, value)
+	assert.True(t, testData.portTable.RuleExists(defaultPodKey, defaultPort, protocolSCTP))
+}
+
+// TestNamespacePortRange verifies that Pods in a Namespace with a port range annotation are
+// allocated Node ports from that range, and that the Node ports are re-allocated when the
+// annotation is updated or removed.
+func TestNamespacePortRange(t *testing.T) {
+	testNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        defaultNS,
+			Annotations: map[string]string{types.NPLPortRangeAnnotationKey: "62000-62099"},
+		},
+	}
+	testSvc := getTestSvc()
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testNS, testSvc, testPod)
+	defer testData.tearDown()
+
+	nodePort := 62000
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	newExpectedNPLAnnotations().Add(&nodePort, defaultPort, protocolTCP).Check(t, value)
+
+	testNS.Annotations[types.NPLPortRangeAnnotationKey] = "63000-63099"
+	_, err = testData.k8sClient.CoreV1().Namespaces().Update(context.TODO(), testNS, metav1.UpdateOptions{})
+	require.NoError(t, err, "Namespace update failed")
+	nodePort = 63000
+	value, err = testData.pollForPodAnnotationWithCondition(testPod.Name, func(value []types.NPLAnnotation) bool {
+		return len(value) == 1 && value[0].NodePort == nodePort
+	})
+	require.NoError(t, err, "Poll for annotation check failed")
+	newExpectedNPLAnnotations().Add(&nodePort, defaultPort, protocolTCP).Check(t, value)
+
+	// When the annotation is removed, the Node port is still valid and is not re-allocated.
+	delete(testNS.Annotations, types.NPLPortRangeAnnotationKey)
+	_, err = testData.k8sClient.CoreV1().Namespaces().Update(context.TODO(), testNS, metav1.UpdateOptions{})
+	require.NoError(t, err, "Namespace update failed")
+	value, err = testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	newExpectedNPLAnnotations().Add(&nodePort, defaultPort, protocolTCP).Check(t, value)
+}
+
+// TestNamespacePortRangeOverlap verifies that the port range of a Namespace which overlaps with the
+// existing port range of another Namespace is rejected with a Warning Event, even if the name of
+// the new Namespace comes first. The Event is not generated again when the pools are recomputed.
+func TestNamespacePortRangeOverlap(t *testing.T) {
+	testNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        defaultNS,
+			Annotations: map[string]string{types.NPLPortRangeAnnotationKey: "62000-62099"},
+		},
+	}
+	testSvc := getTestSvc()
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testNS, testSvc, testPod)
+	defer testData.tearDown()
+
+	nodePort := 62000
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	newExpectedNPLAnnotations().Add(&nodePort, defaultPort, protocolTCP).Check(t, value)
+
+	overlappingNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "aaa",
+			Annotations: map[string]string{types.NPLPortRangeAnnotationKey: "62050-62149"},
+		},
+	}
+	_, err = testData.k8sClient.CoreV1().Namespaces().Create(context.TODO(), overlappingNS, metav1.CreateOptions{})
+	require.NoError(t, err, "Namespace creation failed")
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		events, err := testData.k8sClient.CoreV1().Events(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+		if !assert.NoError(c, err) {
+			return
+		}
+		var reasons []string
+		for _, event := range events.Items {
+			if event.InvolvedObject.Name == overlappingNS.Name {
+				reasons = append(reasons, event.Reason)
+			}
+		}
+		assert.Contains(c, reasons, "NodePortLocalPortRangeRejected")
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// The existing pool is kept, and the Pods of the new Namespace use the default pool.
+	assert.True(t, testData.portTable.IsPortInRangeForPod(defaultPodKey, nodePort))
+	assert.False(t, testData.portTable.IsPortInRangeForPod(overlappingNS.Name+"/pod", 62050))
+	assert.True(t, testData.portTable.IsPortInRangeForPod(overlappingNS.Name+"/pod", 62100))
+	value, err = testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	newExpectedNPLAnnotations().Add(&nodePort, defaultPort, protocolTCP).Check(t, value)
+
+	// Adding the pool of another Namespace recomputes the pools, and the port range of the
+	// overlapping Namespace is still rejected.
+	otherNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "other",
+			Annotations: map[string]string{types.NPLPortRangeAnnotationKey: "63000-63099"},
+		},
+	}
+	_, err = testData.k8sClient.CoreV1().Namespaces().Create(context.TODO(), otherNS, metav1.CreateOptions{})
+	require.NoError(t, err, "Namespace creation failed")
+	assert.Eventually(t, func() bool {
+		return !testData.portTable.IsPortInRangeForPod(overlappingNS.Name+"/pod", 63000)
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.True(t, testData.portTable.IsPortInRangeForPod(otherNS.Name+"/pod", 63000))
+	assert.False(t, testData.portTable.IsPortInRangeForPod(overlappingNS.Name+"/pod", 62050))
+	assert.Never(t, func() bool {
+		events, err := testData.k8sClient.CoreV1().Events(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+		if !assert.NoError(t, err) {
+			return false
+		}
+		var count int32
+		for _, event := range events.Items {
+			if event.InvolvedObject.Name == overlappingNS.Name && event.Reason == "NodePortLocalPortRangeRejected" {
+				count += max(event.Count, 1)
+			}
+		}
+		return count > 1
+	}, time.Second, 100*time.Millisecond, "The rejection of the port range should only be reported once")
+}
+
+// TestPortRangeExhausted verifies that a Warning Event is generated for a Pod when there is no
+// Node port left in the port range of its Namespace.
+func TestPortRangeExhausted(t *testing.T) {
+	testNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        defaultNS,
+			Annotations: map[string]string{types.NPLPortRangeAnnotationKey: "62000-62000"},
+		},
+	}
+	testSvc := getTestSvc()
+	testPod1 := getTestPod()
+	testPod1.Name = "pod1"
+	testPod1.Status.PodIP = "192.168.32.1"
+	testPod2 := getTestPod()
+	testPod2.Name = "pod2"
+	testPod2.Status.PodIP = "192.168.32.2"
+	testData := setUp(t, newTestConfig(), testNS, testSvc, testPod1, testPod2)
+	defer testData.tearDown()
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		events, err := testData.k8sClient.CoreV1().Events(defaultNS).List(context.TODO(), metav1.ListOptions{})
+		if !assert.NoError(c, err) {
+			return
+		}
+		var reasons []string
+		for _, event := range events.Items {
+			reasons = append(reasons, event.Reason)
+		}
+		assert.Contains(c, reasons, "NodePortLocalPortRangeExhausted")
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Only one of the Pods can be allocated the single Node port of the range.
+	assert.NotEqual(t, testData.portTable.RuleExists(defaultNS+"/"+testPod1.Name, defaultPort, protocolTCP),
+		testData.portTable.RuleExists(defaultNS+"/"+testPod2.Name, defaultPort, protocolTCP))
+}
@@ -15,11 +15,15 @@
 package portcache
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net"
+	"strings"
 	"sync"
 
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/nodeportlocal/rules"
 
 	"k8s.io/client-go/tools/cache"
@@ -32,6 +36,38 @@ const (
 	PodKeyIndex      = "podKeyIndex"
 )
 
+// ErrNoFreePort is returned when all the Node ports which can be allocated to a Pod are already in
+// use.
+var ErrNoFreePort = errors.New("no free port found")
+
+// errProtocolNotSupported is returned when Node ports cannot be reserved for a protocol on this
+// Node. There is no point in trying other ports when it is returned.
+var errProtocolNotSupported = errors.New("protocol not supported")
+
+// PortRange is a range of Node ports. Both bounds are included in the range.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+func (r PortRange) contains(port int) bool {
+	return port >= r.Start && port <= r.End
+}
+
+func (r PortRange) overlaps(other PortRange) bool {
+	return r.Start <= other.End && other.Start <= r.End
+}
+
+func (r PortRange) String() string {
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// NamespacePortRange is the pool of Node ports reserved for the Pods of a Namespace.
+type NamespacePortRange struct {
+	Namespace string
+	PortRange
+}
+
 type ProtocolSocketData struct {
 	Protocol string
 	socket   io.Closer
@@ -67,6 +103,12 @@ type PortTable struct {
 	PodPortRules    rules.PodPortRules
 	LocalPortOpener LocalPortOpener
 	tableLock       sync.RWMutex
+	// namespacePortRanges stores the pools of Node ports reserved for the Pods of a given
+	// Namespace. Pods in other Namespaces cannot be allocated ports from these pools.
+	namespacePortRanges map[string]PortRange
+	// namespacePortSearchStarts stores the port from which the next search should start, for
+	// each Namespace pool. PortSearchStart is used for Pods which do not belong to a pool.
+	namespacePortSearchStarts map[string]int
 }
 
 func GetPortTableKey(obj interface{}) (string, error) {
@@ -79,6 +121,7 @@ func (pt *PortTable) addPortTableCache(npData *NodePortData) error {
 	if err := pt.PortTableCache.Add(npData); err != nil {
 		return err
 	}
+	metrics.NodePortLocalAllocatedPortCount.WithLabelValues(npData.Protocol.Protocol).Inc()
 	return nil
 }
 
@@ -86,6 +129,7 @@ func (pt *PortTable) deletePortTableCache(npData *NodePortData) error {
 	if err := pt.PortTableCache.Delete(npData); err != nil {
 		return err
 	}
+	metrics.NodePortLocalAllocatedPortCount.WithLabelValues(npData.Protocol.Protocol).Dec()
 	return nil
 }
 
@@ -160,9 +204,148 @@ func NewPortTable(start, end int) (*PortTable, error) {
 	if err := ptable.PodPortRules.Init(); err != nil {
 		return nil, err
 	}
+	metrics.NodePortLocalPortRangeSize.Set(float64(end - start + 1))
 	return &ptable, nil
 }
 
+// podNamespace returns the Namespace from the namespaced name of a Pod.
+func podNamespace(podKey string) string {
+	namespace, _, _ := strings.Cut(podKey, "/")
+	return namespace
+}
+
+// SetNamespacePortRanges replaces the pools of Node ports reserved for the Pods of Namespaces. Each
+// pool must be included in the port range of the PortTable and must not overlap with another pool.
+// Pools are first-come, first-served: the current pools which are unchanged are kept, then the
+// other pools are processed in the provided order, and pools which are not valid are ignored: an
+// error is returned for each of them. The first return value indicates whether the set of valid
+// pools has changed. Ports which have already been allocated are not affected: it is up to the
+// caller to release the ones which no longer fall into the correct range (see IsPortInRangeForPod).
+func (pt *PortTable) SetNamespacePortRanges(portRanges []NamespacePortRange) (bool, map[string]error) {
+	pt.tableLock.Lock()
+	defer pt.tableLock.Unlock()
+	orderedPortRanges := make([]NamespacePortRange, 0, len(portRanges))
+	for _, portRange := range portRanges {
+		if oldPortRange, ok := pt.namespacePortRanges[portRange.Namespace]; ok && oldPortRange == portRange.PortRange {
+			orderedPortRanges = append(orderedPortRanges, portRange)
+		}
+	}
+	for _, portRange := range portRanges {
+		if oldPortRange, ok := pt.namespacePortRanges[portRange.Namespace]; !ok || oldPortRange != portRange.PortRange {
+			orderedPortRanges = append(orderedPortRanges, portRange)
+		}
+	}
+	errs := make(map[string]error)
+	validPortRanges := make(map[string]PortRange)
+	for _, nsPortRange := range orderedPortRanges {
+		namespace, portRange := nsPortRange.Namespace, nsPortRange.PortRange
+		if portRange.Start > portRange.End || portRange.Start < pt.StartPort || portRange.End > pt.EndPort {
+			errs[namespace] = fmt.Errorf("port range %s is not included in the NodePortLocal port range %d-%d", portRange, pt.StartPort, pt.EndPort)
+			continue
+		}
+		for otherNamespace, otherPortRange := range validPortRanges {
+			if portRange.overlaps(otherPortRange) {
+				errs[namespace] = fmt.Errorf("port range %s overlaps with port range %s of Namespace %s", portRange, otherPortRange, otherNamespace)
+				break
+			}
+		}
+		if _, ok := errs[namespace]; !ok {
+			validPortRanges[namespace] = portRange
+		}
+	}
+	if maps.Equal(validPortRanges, pt.namespacePortRanges) {
+		return false, errs
+	}
+	searchStarts := make(map[string]int, len(validPortRanges))
+	for namespace, portRange := range validPortRanges {
+		if oldPortRange, ok := pt.namespacePortRanges[namespace]; ok && oldPortRange == portRange {
+			searchStarts[namespace] = pt.namespacePortSearchStarts[namespace]
+		} else {
+			searchStarts[namespace] = portRange.Start
+		}
+	}
+	pt.namespacePortRanges = validPortRanges
+	pt.namespacePortSearchStarts = searchStarts
+	return true, errs
+}
+
+// IsPortInRangeForPod returns whether the Node port can be allocated to the Pod: it must belong to
+// the pool of the Pod's Namespace if there is one, or to the port range of the PortTable but not to
+// the pool of any Namespace otherwise.
+func (pt *PortTable) IsPortInRangeForPod(podKey string, port int) bool {
+	pt.tableLock.RLock()
+	defer pt.tableLock.RUnlock()
+	if portRange, ok := pt.namespacePortRanges[podNamespace(podKey)]; ok {
+		return portRange.contains(port)
+	}
+	return port >= pt.StartPort && port <= pt.EndPort && !pt.isPortInNamespacePool(port)
+}
+
+func (pt *PortTable) isPortInNamespacePool(port int) bool {
+	for _, portRange := range pt.namespacePortRanges {
+		if portRange.contains(port) {
+			return true
+		}
+	}
+	return false
+}
+
+// findFreePort looks for a Node port which can be allocated to the Pod for the provided protocol,
+// starting from the current search position of the Pod's pool. reservePort is called for each
+// candidate port which is not in use yet, and the first port for which it succeeds is returned.
+// ErrNoFreePort is returned if no port can be reserved.
+func (pt *PortTable) findFreePort(podKey string, protocol string, reservePort func(port int) (ProtocolSocketData, error)) (int, ProtocolSocketData, error) {
+	namespace := podNamespace(podKey)
+	portRange, inPool := pt.namespacePortRanges[namespace]
+	searchStart := pt.PortSearchStart
+	if inPool {
+		searchStart = pt.namespacePortSearchStarts[namespace]
+	} else {
+		portRange = PortRange{Start: pt.StartPort, End: pt.EndPort}
+	}
+	if !portRange.contains(searchStart) {
+		searchStart = portRange.Start
+	}
+	numPorts := portRange.End - portRange.Start + 1
+	for i := 0; i < numPorts; i++ {
+		port := searchStart + i
+		if port > portRange.End {
+			// handle wrap around
+			port = port - numPorts
+		}
+		if !inPool && pt.isPortInNamespacePool(port) {
+			// port is reserved for the Pods of another Namespace
+			continue
+		}
+		if _, ok := pt.getPortTableCacheFromNodePortIndex(NodePortProtoFormat(port, protocol)); ok {
+			// port is already taken
+			continue
+		}
+
+		protocolData, err := reservePort(port)
+		if err != nil {
+			if errors.Is(err, errProtocolNotSupported) {
+				return 0, ProtocolSocketData{}, err
+			}
+			klog.V(4).InfoS("Port cannot be reserved, moving on to the next one", "port", port)
+			continue
+		}
+
+		nextPort := port + 1
+		if nextPort > portRange.End {
+			nextPort = portRange.Start
+		}
+		if inPool {
+			pt.namespacePortSearchStarts[namespace] = nextPort
+		} else {
+			pt.PortSearchStart = nextPort
+		}
+		return port, protocolData, nil
+	}
+	metrics.NodePortLocalPortRangeExhaustedCount.WithLabelValues(protocol).Inc()
+	return 0, ProtocolSocketData{}, fmt.Errorf("%w in port range %s", ErrNoFreePort, portRange)
+}
+
 func (pt *PortTable) CleanupAllEntries() {
 	pt.tableLock.Lock()
 	defer pt.tableLock.Unlock()
@@ -223,7 +406,7 @@ func NodePortProtoFormat(nodeport int, protocol string) string {
 // This is inspired by the openLocalPort function in kube-proxy:
 // https://github.com/kubernetes/kubernetes/blob/86f8c3ee91b6faec437f97e3991107747d7fc5e8/pkg/proxy/iptables/proxier.go#L1664
 func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer, error) {
-	// For now, NodePortLocal only supports IPv4 and TCP/UDP.
+	// For now, NodePortLocal only supports IPv4.
 	var network string
 	var socket io.Closer
 	switch protocol {
@@ -245,6 +428,14 @@ func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer,
 			return nil, err
 		}
 		socket = conn
+	case "sctp":
+		listener, err := openLocalSCTPPort(port)
+		if err != nil {
+			return nil, err
+		}
+		socket = listener
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s: %w", protocol, errProtocolNotSupported)
 	}
 	klog.V(2).InfoS("Opened local port", "port", port)
 	return socket, nil
@@ -41,33 +41,11 @@ func openSocketsForPort(localPortOpener LocalPortOpener, port int, protocol stri
 	return protocolData, nil
 }
 
-func (pt *PortTable) getFreePort(podIP string, podPort int, protocol string) (int, ProtocolSocketData, error) {
+func (pt *PortTable) getFreePort(podKey string, podIP string, podPort int, protocol string) (int, ProtocolSocketData, error) {
 	klog.V(2).InfoS("Looking for free Node port", "podIP", podIP, "podPort", podPort)
-	numPorts := pt.EndPort - pt.StartPort + 1
-	for i := 0; i < numPorts; i++ {
-		port := pt.PortSearchStart + i
-		if port > pt.EndPort {
-			// handle wrap around
-			port = port - numPorts
-		}
-		if _, ok := pt.getPortTableCacheFromNodePortIndex(NodePortProtoFormat(port, protocol)); ok {
-			// port is already taken
-			continue
-		}
-
-		protocolData, err := openSocketsForPort(pt.LocalPortOpener, port, protocol)
-		if err != nil {
-			klog.V(4).InfoS("Port cannot be reserved, moving on to the next one", "port", port)
-			continue
-		}
-
-		pt.PortSearchStart = port + 1
-		if pt.PortSearchStart > pt.EndPort {
-			pt.PortSearchStart = pt.StartPort
-		}
-		return port, protocolData, nil
-	}
-	return 0, ProtocolSocketData{}, fmt.Errorf("no free port found")
+	return pt.findFreePort(podKey, protocol, func(port int) (ProtocolSocketData, error) {
+		return openSocketsForPort(pt.LocalPortOpener, port, protocol)
+	})
 }
 
 func (pt *PortTable) AddRule(podKey string, podPort int, protocol string, podIP string) (int, error) {
@@ -76,7 +54,7 @@ func (pt *PortTable) AddRule(podKey string, podPort int, protocol string, podIP
 	npData := pt.getEntryByPodKeyPortProto(podKey, podPort, protocol)
 	exists := (npData != nil)
 	if !exists {
-		nodePort, protocolData, err := pt.getFreePort(podIP, podPort, protocol)
+		nodePort, protocolData, err := pt.getFreePort(podKey, podIP, podPort, protocol)
 		if err != nil {
 			return 0, err
 		}
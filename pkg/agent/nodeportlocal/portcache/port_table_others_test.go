@@ -130,3 +130,46 @@ func TestDeleteRule(t *testing.T) {
 	// removed from the cache.
 	assert.NoError(t, portTable.DeleteRule(podKey, podPort, protocol))
 }
+
+func TestAddRuleWithNamespacePortRange(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockIPTables := rulestesting.NewMockPodPortRules(mockCtrl)
+	mockPortOpener := portcachetesting.NewMockLocalPortOpener(mockCtrl)
+	portTable := newPortTable(mockIPTables, mockPortOpener)
+	_, errs := portTable.SetNamespacePortRanges([]NamespacePortRange{
+		{Namespace: "ns1", PortRange: PortRange{Start: startPort, End: startPort + 1}},
+	})
+	require.Empty(t, errs)
+
+	const podPort = 1001
+	mockIPTables.EXPECT().AddRule(gomock.Any(), podIP, podPort, "tcp").Times(3)
+	mockPortOpener.EXPECT().OpenLocalPort(gomock.Any(), "tcp").Return(&mockCloser{}, nil).Times(3)
+
+	// Pods outside of the Namespace cannot be allocated ports from its pool.
+	nodePort, err := portTable.AddRule("default/pod", podPort, "tcp", podIP)
+	require.NoError(t, err)
+	assert.Equal(t, startPort+2, nodePort)
+
+	nodePort, err = portTable.AddRule("ns1/pod1", podPort, "tcp", podIP)
+	require.NoError(t, err)
+	assert.Equal(t, startPort, nodePort)
+	nodePort, err = portTable.AddRule("ns1/pod2", podPort, "tcp", podIP)
+	require.NoError(t, err)
+	assert.Equal(t, startPort+1, nodePort)
+
+	_, err = portTable.AddRule("ns1/pod3", podPort, "tcp", podIP)
+	assert.ErrorIs(t, err, ErrNoFreePort)
+	assert.ErrorContains(t, err, fmt.Sprintf("no free port found in port range %d-%d", startPort, startPort+1))
+}
+
+func TestAddRuleProtocolNotSupported(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockIPTables := rulestesting.NewMockPodPortRules(mockCtrl)
+	mockPortOpener := portcachetesting.NewMockLocalPortOpener(mockCtrl)
+	portTable := newPortTable(mockIPTables, mockPortOpener)
+
+	// The search for a free port is aborted after the first failure.
+	mockPortOpener.EXPECT().OpenLocalPort(startPort, "sctp").Return(nil, fmt.Errorf("SCTP is not available on this Node: %w", errProtocolNotSupported))
+	_, err := portTable.AddRule(podKey, 1001, "sctp", podIP)
+	assert.ErrorIs(t, err, errProtocolNotSupported)
+}
@@ -15,6 +15,9 @@
 package portcache
 
 import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
 	"k8s.io/client-go/tools/cache"
 
 	"antrea.io/antrea/pkg/agent/nodeportlocal/rules"
@@ -43,3 +46,69 @@ func newPortTable(mockIPTables rules.PodPortRules, mockPortOpener LocalPortOpene
 		LocalPortOpener: mockPortOpener,
 	}
 }
+
+func TestSetNamespacePortRanges(t *testing.T) {
+	portTable := newPortTable(nil, nil)
+
+	changed, errs := portTable.SetNamespacePortRanges([]NamespacePortRange{
+		{Namespace: "ns1", PortRange: PortRange{Start: 61000, End: 61099}},
+		// overlaps with the pool of ns1
+		{Namespace: "ns2", PortRange: PortRange{Start: 61050, End: 61149}},
+		// not included in the port range of the PortTable
+		{Namespace: "ns3", PortRange: PortRange{Start: 60000, End: 60099}},
+		{Namespace: "ns4", PortRange: PortRange{Start: 62000, End: 62000}},
+	})
+	assert.True(t, changed)
+	assert.Len(t, errs, 2)
+	assert.ErrorContains(t, errs["ns2"], "overlaps with port range 61000-61099 of Namespace ns1")
+	assert.ErrorContains(t, errs["ns3"], "is not included in the NodePortLocal port range")
+	assert.Equal(t, map[string]PortRange{"ns1": {Start: 61000, End: 61099}, "ns4": {Start: 62000, End: 62000}}, portTable.namespacePortRanges)
+
+	assert.True(t, portTable.IsPortInRangeForPod("ns1/pod", 61000))
+	assert.False(t, portTable.IsPortInRangeForPod("ns1/pod", 61100))
+	assert.True(t, portTable.IsPortInRangeForPod("ns4/pod", 62000))
+	assert.False(t, portTable.IsPortInRangeForPod("ns2/pod", 61000))
+	assert.True(t, portTable.IsPortInRangeForPod("ns2/pod", 61100))
+	assert.False(t, portTable.IsPortInRangeForPod("ns2/pod", 62000))
+	assert.False(t, portTable.IsPortInRangeForPod("ns2/pod", 65001))
+
+	changed, errs = portTable.SetNamespacePortRanges([]NamespacePortRange{
+		{Namespace: "ns1", PortRange: PortRange{Start: 61000, End: 61099}},
+		{Namespace: "ns4", PortRange: PortRange{Start: 62000, End: 62000}},
+	})
+	assert.False(t, changed)
+	assert.Empty(t, errs)
+
+	changed, _ = portTable.SetNamespacePortRanges(nil)
+	assert.True(t, changed)
+	assert.True(t, portTable.IsPortInRangeForPod("ns2/pod", 61000))
+}
+
+func TestSetNamespacePortRangesFirstComeFirstServed(t *testing.T) {
+	portTable := newPortTable(nil, nil)
+
+	changed, errs := portTable.SetNamespacePortRanges([]NamespacePortRange{
+		{Namespace: "ns2", PortRange: PortRange{Start: 61000, End: 61099}},
+	})
+	assert.True(t, changed)
+	assert.Empty(t, errs)
+
+	// The pool of ns2 is kept even though ns1 comes first in the provided order, and the pool of
+	// ns1 which overlaps with it is rejected.
+	changed, errs = portTable.SetNamespacePortRanges([]NamespacePortRange{
+		{Namespace: "ns1", PortRange: PortRange{Start: 61050, End: 61149}},
+		{Namespace: "ns2", PortRange: PortRange{Start: 61000, End: 61099}},
+	})
+	assert.False(t, changed)
+	assert.Len(t, errs, 1)
+	assert.ErrorContains(t, errs["ns1"], "overlaps with port range 61000-61099 of Namespace ns2")
+	assert.Equal(t, map[string]PortRange{"ns2": {Start: 61000, End: 61099}}, portTable.namespacePortRanges)
+
+	// The pool of ns1 is accepted once the pool of ns2 is removed.
+	changed, errs = portTable.SetNamespacePortRanges([]NamespacePortRange{
+		{Namespace: "ns1", PortRange: PortRange{Start: 61050, End: 61149}},
+	})
+	assert.True(t, changed)
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]PortRange{"ns1": {Start: 61050, End: 61149}}, portTable.namespacePortRanges)
+}
@@ -40,33 +40,11 @@ func addRuleForPort(podPortRules rules.PodPortRules, port int, podIP string, pod
 	return protocolData, nil
 }
 
-func (pt *PortTable) addRuleforFreePort(podIP string, podPort int, protocol string) (int, ProtocolSocketData, error) {
+func (pt *PortTable) addRuleforFreePort(podKey string, podIP string, podPort int, protocol string) (int, ProtocolSocketData, error) {
 	klog.V(2).InfoS("Looking for free Node port on Windows", "podIP", podIP, "podPort", podPort, "protocol", protocol)
-	numPorts := pt.EndPort - pt.StartPort + 1
-	for i := 0; i < numPorts; i++ {
-		port := pt.PortSearchStart + i
-		if port > pt.EndPort {
-			// handle wrap around
-			port = port - numPorts
-		}
-		if _, ok := pt.getPortTableCacheFromNodePortIndex(NodePortProtoFormat(port, protocol)); ok {
-			// protocol port is already taken
-			continue
-		}
-
-		protocolData, err := addRuleForPort(pt.PodPortRules, port, podIP, podPort, protocol)
-		if err != nil {
-			klog.ErrorS(err, "Port cannot be reserved, moving on to the next one", "port", port)
-			continue
-		}
-
-		pt.PortSearchStart = port + 1
-		if pt.PortSearchStart > pt.EndPort {
-			pt.PortSearchStart = pt.StartPort
-		}
-		return port, protocolData, nil
-	}
-	return 0, ProtocolSocketData{}, fmt.Errorf("no free port found")
+	return pt.findFreePort(podKey, protocol, func(port int) (ProtocolSocketData, error) {
+		return addRuleForPort(pt.PodPortRules, port, podIP, podPort, protocol)
+	})
 }
 
 func (pt *PortTable) AddRule(podKey string, podPort int, protocol string, podIP string) (int, error) {
@@ -75,7 +53,7 @@ func (pt *PortTable) AddRule(podKey string, podPort int, protocol string, podIP
 	npData := pt.getEntryByPodKeyPortProto(podKey, podPort, protocol)
 	exists := (npData != nil)
 	if !exists {
-		nodePort, protocolData, err := pt.addRuleforFreePort(podIP, podPort, protocol)
+		nodePort, protocolData, err := pt.addRuleforFreePort(podKey, podIP, podPort, protocol)
 		//success means port, protocol available.
 		if err != nil {
 			return 0, err
@@ -0,0 +1,54 @@
+//go:build linux
+// +build linux
+
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portcache
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// SCTPSupported indicates whether NodePortLocal can allocate Node ports for SCTP.
+const SCTPSupported = true
+
+type sctpSocket struct {
+	fd int
+}
+
+func (s *sctpSocket) Close() error {
+	return unix.Close(s.fd)
+}
+
+// openLocalSCTPPort binds a SCTP socket to the provided port. The Go standard library does not
+// support SCTP, so the socket is created with raw syscalls. Binding is enough to reserve the port:
+// the socket is never used to accept associations.
+func openLocalSCTPPort(port int) (*sctpSocket, error) {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, unix.IPPROTO_SCTP)
+	if err != nil {
+		if errors.Is(err, unix.EPROTONOSUPPORT) {
+			return nil, fmt.Errorf("SCTP is not available on this Node: %w", errProtocolNotSupported)
+		}
+		return nil, err
+	}
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Port: port}); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return &sctpSocket{fd: fd}, nil
+}
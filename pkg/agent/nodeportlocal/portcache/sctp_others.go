@@ -0,0 +1,30 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portcache
+
+import (
+	"fmt"
+	"io"
+)
+
+// SCTPSupported indicates whether NodePortLocal can allocate Node ports for SCTP.
+const SCTPSupported = false
+
+func openLocalSCTPPort(port int) (io.Closer, error) {
+	return nil, fmt.Errorf("SCTP is not supported on this platform: %w", errProtocolNotSupported)
+}
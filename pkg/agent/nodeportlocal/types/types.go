@@ -17,6 +17,9 @@ package types
 const (
 	NPLAnnotationKey        = "nodeportlocal.antrea.io"
 	NPLEnabledAnnotationKey = "nodeportlocal.antrea.io/enabled"
+	// NPLPortRangeAnnotationKey is the Namespace annotation used to reserve a pool of Node
+	// ports, included in the NodePortLocal port range, for the Pods in the Namespace.
+	NPLPortRangeAnnotationKey = "nodeportlocal.antrea.io/port-range"
 )
 
 // NPLAnnotation is the structure used for setting NodePortLocal annotation on the Pods.
@@ -39,3 +39,23 @@ func ParsePortProto(portProtocol string) (int, string, error) {
 	protocol := portProtoSlice[1]
 	return port, protocol, err
 }
+
+// ParsePortRange parses a port range of the form <start>-<end>, with both bounds included.
+func ParsePortRange(portRange string) (int, int, error) {
+	startStr, endStr, found := strings.Cut(portRange, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid format for port range '%s'", portRange)
+	}
+	start, err := strconv.Atoi(strings.TrimSpace(startStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start port in port range '%s': %v", portRange, err)
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(endStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end port in port range '%s': %v", portRange, err)
+	}
+	if start <= 0 || end > 65535 || start > end {
+		return 0, 0, fmt.Errorf("invalid port range '%s'", portRange)
+	}
+	return start, end, nil
+}
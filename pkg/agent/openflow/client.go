@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"math/rand/v2"
 	"net"
+	"strconv"
+	"strings"
 
 	"antrea.io/libOpenflow/openflow15"
 	"antrea.io/libOpenflow/protocol"
@@ -28,6 +30,7 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
+	proxymetrics "antrea.io/antrea/pkg/agent/proxy/metrics"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -98,8 +101,13 @@ type Client interface {
 	UninstallPodFlows(interfaceName string) error
 
 	// InstallServiceGroup installs a group for Service LB. Each endpoint
-	// is a bucket of the group. For now, each bucket has the same weight.
-	InstallServiceGroup(groupID binding.GroupIDType, withSessionAffinity bool, endpoints []proxy.Endpoint) error
+	// is a bucket of the group. The weight of each bucket is taken from
+	// weights, which is keyed by the Endpoint string, and defaults to
+	// types.DefaultEndpointWeight.
+	// If consistentHashing is true, the buckets are identified by a hash of
+	// their Endpoints, and they are selected with a hash of the connection
+	// so that all Nodes select the same Endpoint for a given connection.
+	InstallServiceGroup(groupID binding.GroupIDType, withSessionAffinity, consistentHashing bool, endpoints []proxy.Endpoint, weights map[string]uint16) error
 	// UninstallServiceGroup removes the group and its buckets that are
 	// installed by InstallServiceGroup.
 	UninstallServiceGroup(groupID binding.GroupIDType) error
@@ -122,6 +130,15 @@ type Client interface {
 	// UninstallServiceFlows removes flows installed by InstallServiceFlows.
 	UninstallServiceFlows(svcIP net.IP, svcPort uint16, protocol binding.Protocol) error
 
+	// InstallServiceConnectionLimits installs the OVS meter limiting the rate of new connections and configures the
+	// CT zone limiting the number of concurrent connections for the given connection limits of a Service. It must be
+	// called before installing the Service flows referencing the limits. It can be called again with the same ID to
+	// update the limits.
+	InstallServiceConnectionLimits(limits *types.ServiceConnectionLimits, isIPv6 bool) error
+	// UninstallServiceConnectionLimits removes the meter and the CT zone limit installed by
+	// InstallServiceConnectionLimits.
+	UninstallServiceConnectionLimits(id uint32) error
+
 	// GetFlowTableStatus should return an array of flow table status, all existing flow tables should be included in the list.
 	GetFlowTableStatus() []binding.TableStatus
 
@@ -176,6 +193,10 @@ type Client interface {
 	// UninstallPodSNATFlows removes the SNAT flows for the local Pod.
 	UninstallPodSNATFlows(ofPort uint32) error
 
+	// EgressMetrics returns the traffic metrics of the SNAT flows of local
+	// Pods, keyed by the ofPort of the Pods.
+	EgressMetrics() map[uint32]*types.RuleMetric
+
 	// InstallEgressQoS installs an OF meter with specific meterID, rate
 	// and burst used for QoS of Egress and a QoS flow that direct packets
 	// into the meter.
@@ -234,6 +255,10 @@ type Client interface {
 	// InstallTraceflowFlows installs flows for a Traceflow request.
 	InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly bool, packet *binding.Packet, ofPort uint32, timeoutSeconds uint16) error
 
+	// InstallTraceflowReplyFlows installs flows to trace the reply packet of a round-trip Traceflow request.
+	// replyPacket is used to match the reply packet, which is marked with replyDataplaneTag.
+	InstallTraceflowReplyFlows(replyDataplaneTag uint8, replyPacket *binding.Packet, timeoutSeconds uint16) error
+
 	// UninstallTraceflowFlows uninstalls flows for a Traceflow request.
 	UninstallTraceflowFlows(dataplaneTag uint8) error
 
@@ -710,11 +735,11 @@ func (c *client) GetPodFlowKeys(interfaceName string) []string {
 	return c.getFlowKeysFromCache(c.featurePodConnectivity.podCachedFlows, interfaceName)
 }
 
-func (c *client) InstallServiceGroup(groupID binding.GroupIDType, withSessionAffinity bool, endpoints []proxy.Endpoint) error {
+func (c *client) InstallServiceGroup(groupID binding.GroupIDType, withSessionAffinity, consistentHashing bool, endpoints []proxy.Endpoint, weights map[string]uint16) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
 
-	group := c.featureService.serviceEndpointGroup(groupID, withSessionAffinity, endpoints...)
+	group := c.featureService.serviceEndpointGroup(groupID, withSessionAffinity, consistentHashing, weights, endpoints...)
 	_, installed := c.featureService.groupCache.Load(groupID)
 	if !installed {
 		if err := c.ofEntryOperations.AddOFEntries([]binding.OFEntry{group}); err != nil {
@@ -805,6 +830,9 @@ func (c *client) InstallServiceFlows(config *types.ServiceConfig) error {
 	if config.IsDSR {
 		flows = append(flows, c.featureService.dsrServiceMarkFlow(config))
 	}
+	if config.ConnectionLimits != nil && config.ConnectionLimits.MaxConnections != 0 {
+		flows = append(flows, c.featureService.serviceConnectionLimitFlows(config)...)
+	}
 	cacheKey := generateServicePortFlowCacheKey(config.ServiceIP, config.ServicePort, config.Protocol)
 	return c.addFlows(c.featureService.cachedFlows, cacheKey, flows)
 }
@@ -816,6 +844,78 @@ func (c *client) UninstallServiceFlows(svcIP net.IP, svcPort uint16, protocol bi
 	return c.deleteFlows(c.featureService.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallServiceConnectionLimits(limits *types.ServiceConnectionLimits, isIPv6 bool) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	if limits.ID == 0 || limits.ID > types.MaxServiceConnectionLimitsID {
+		return fmt.Errorf("ID %d of the connection limits is not in the range [1, %d]", limits.ID, types.MaxServiceConnectionLimitsID)
+	}
+	var installed *serviceConnectionLimits
+	if obj, ok := c.featureService.cachedConnectionLimits.Load(limits.ID); ok {
+		installed = obj.(*serviceConnectionLimits)
+	}
+	// Install the meter limiting the rate of new connections.
+	var meter binding.Meter
+	if limits.MaxNewConnectionsPerSecond != 0 {
+		meter = c.featureService.serviceConnectionRateMeter(limits.ID, limits.MaxNewConnectionsPerSecond)
+		if installed == nil || installed.meter == nil {
+			if err := meter.Add(); err != nil {
+				return fmt.Errorf("error when installing Service connection rate OF Meter %d: %w", serviceConnectionRateMeterID(limits.ID), err)
+			}
+		} else {
+			if err := meter.Modify(); err != nil {
+				return fmt.Errorf("error when modifying Service connection rate OF Meter %d: %w", serviceConnectionRateMeterID(limits.ID), err)
+			}
+		}
+	} else if installed != nil && installed.meter != nil {
+		if err := installed.meter.Delete(); err != nil {
+			return fmt.Errorf("error when deleting Service connection rate OF Meter %d: %w", serviceConnectionRateMeterID(limits.ID), err)
+		}
+		c.featureService.connectionRateMeterDrops.Delete(limits.ID)
+	}
+	// Configure the limit of the CT zone in the datapath. Unlike OF entries, the limit is kept when OVS restarts.
+	zone := serviceConnectionLimitCtZone(limits.ID)
+	if limits.MaxConnections != 0 {
+		if _, err := c.ovsctlClient.RunAppctlCmd("dpctl/ct-set-limits", false, fmt.Sprintf("zone=%d,limit=%d", zone, limits.MaxConnections)); err != nil {
+			return fmt.Errorf("error when setting the limit of CT zone %d: %w", zone, err)
+		}
+	} else if installed != nil && installed.limits.MaxConnections != 0 {
+		if _, err := c.ovsctlClient.RunAppctlCmd("dpctl/ct-del-limits", false, fmt.Sprintf("zone=%d", zone)); err != nil {
+			return fmt.Errorf("error when deleting the limit of CT zone %d: %w", zone, err)
+		}
+		c.featureService.connectionLimitDrops.Delete(limits.ID)
+	}
+	c.featureService.cachedConnectionLimits.Store(limits.ID, &serviceConnectionLimits{limits: *limits, isIPv6: isIPv6, meter: meter})
+	return nil
+}
+
+func (c *client) UninstallServiceConnectionLimits(id uint32) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	obj, ok := c.featureService.cachedConnectionLimits.Load(id)
+	if !ok {
+		return nil
+	}
+	installed := obj.(*serviceConnectionLimits)
+	if installed.meter != nil {
+		if err := installed.meter.Delete(); err != nil {
+			return fmt.Errorf("error when deleting Service connection rate OF Meter %d: %w", serviceConnectionRateMeterID(id), err)
+		}
+		c.featureService.connectionRateMeterDrops.Delete(id)
+	}
+	if installed.limits.MaxConnections != 0 {
+		zone := serviceConnectionLimitCtZone(id)
+		if _, err := c.ovsctlClient.RunAppctlCmd("dpctl/ct-del-limits", false, fmt.Sprintf("zone=%d", zone)); err != nil {
+			return fmt.Errorf("error when deleting the limit of CT zone %d: %w", zone, err)
+		}
+		c.featureService.connectionLimitDrops.Delete(id)
+	}
+	c.featureService.cachedConnectionLimits.Delete(id)
+	return nil
+}
+
 func (c *client) GetServiceFlowKeys(svcIP net.IP, svcPort uint16, protocol binding.Protocol, endpoints []proxy.Endpoint) []string {
 	cacheKey := generateServicePortFlowCacheKey(svcIP, svcPort, protocol)
 	flowKeys := c.getFlowKeysFromCache(c.featureService.cachedFlows, cacheKey)
@@ -1084,6 +1184,34 @@ func (c *client) UninstallPodSNATFlows(ofPort uint32) error {
 	return c.deleteFlows(c.featureEgress.cachedFlows, cacheKey)
 }
 
+func (c *client) EgressMetrics() map[uint32]*types.RuleMetric {
+	result := map[uint32]*types.RuleMetric{}
+	flows, _ := c.ovsctlClient.DumpTableFlows(EgressMarkTable.ofTable.GetID())
+	for _, flow := range flows {
+		// Only the SNAT flows of local Pods match the in_port with normal priority. A dual-stack Pod has one flow
+		// for each IP family, their metrics are merged.
+		if !strings.Contains(flow, metricFlowIdentifier) {
+			continue
+		}
+		flowMap := parseFlowToMap(flow)
+		inPort, ok := flowMap["in_port"]
+		if !ok {
+			continue
+		}
+		ofPort, err := strconv.ParseUint(inPort, 10, 32)
+		if err != nil {
+			continue
+		}
+		metric := parseFlowMetric(flowMap)
+		if accMetric, ok := result[uint32(ofPort)]; ok {
+			accMetric.Merge(&metric)
+		} else {
+			result[uint32(ofPort)] = &metric
+		}
+	}
+	return result
+}
+
 func (c *client) InstallEgressQoS(meterID, rate, burst uint32) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
@@ -1256,6 +1384,26 @@ func (c *client) InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedO
 	return c.addFlows(c.featureTraceflow.cachedFlows, cacheKey, flows)
 }
 
+func (c *client) InstallTraceflowReplyFlows(replyDataplaneTag uint8, replyPacket *binding.Packet, timeoutSeconds uint16) error {
+	if c.featurePodConnectivity == nil {
+		return fmt.Errorf("round-trip Traceflow is not supported on this Node")
+	}
+	cacheKey := fmt.Sprintf("%x", replyDataplaneTag)
+	flows := []binding.Flow{c.featurePodConnectivity.traceflowReplyMarkFlow(replyDataplaneTag, replyPacket, timeoutSeconds)}
+	// The reply packet is traced like an injected packet.
+	for _, f := range c.traceableFeatures {
+		flows = append(flows, f.flowsToTrace(replyDataplaneTag,
+			c.ovsMetersAreSupported,
+			false,
+			false,
+			false,
+			nil,
+			0,
+			timeoutSeconds)...)
+	}
+	return c.addFlows(c.featureTraceflow.cachedFlows, cacheKey, flows)
+}
+
 func (c *client) UninstallTraceflowFlows(dataplaneTag uint8) error {
 	cacheKey := fmt.Sprintf("%x", dataplaneTag)
 	return c.deleteFlows(c.featureTraceflow.cachedFlows, cacheKey)
@@ -1693,6 +1841,23 @@ func (c *client) getMeterStats() {
 		PacketInMeterIDDNS: metrics.LabelPacketInMeterDNSInterception,
 	}
 	handleMeterStatsReply := func(meterID int, packetCount int64) {
+		// The packets dropped by the meters of the Services are reported by the metrics of AntreaProxy.
+		if meterID >= serviceConnectionRateMeterIDBase {
+			c.updateServiceConnectionRateMeterStats(uint32(meterID-serviceConnectionRateMeterIDBase), packetCount)
+			return
+		}
+		// The packets dropped by the meters of the NetworkPolicy rules are expected, as the meters enforce the
+		// logging rate limits configured by users. Only the sum for all the installed rule meters is reported.
+		if meterID >= PacketInMeterIDNPRuleBase {
+			c.ruleMeterPacketDrops.Store(meterID, packetCount)
+			var totalDrops int64
+			c.ruleMeterPacketDrops.Range(func(_, value interface{}) bool {
+				totalDrops += value.(int64)
+				return true
+			})
+			metrics.OVSMeterPacketDroppedCount.WithLabelValues(metrics.LabelPacketInMeterNetworkPolicyRules).Set(float64(totalDrops))
+			return
+		}
 		label, exists := labels[meterID]
 		if !exists {
 			klog.V(4).InfoS("Received unexpected meterID", "meterID", meterID)
@@ -1712,6 +1877,139 @@ func (c *client) getMeterStats() {
 	}
 }
 
+// updateServiceConnectionRateMeterStats increases the number of rate-limited Service connections by the number of
+// packets dropped by the meter of a Service since the last collection.
+func (c *client) updateServiceConnectionRateMeterStats(id uint32, packetCount int64) {
+	if c.featureService == nil {
+		return
+	}
+	obj, ok := c.featureService.cachedConnectionLimits.Load(id)
+	if !ok {
+		return
+	}
+	newDrops := packetCount
+	if previousCount, ok := c.featureService.connectionRateMeterDrops.Swap(id, packetCount); ok && packetCount >= previousCount.(int64) {
+		newDrops = packetCount - previousCount.(int64)
+	}
+	if obj.(*serviceConnectionLimits).isIPv6 {
+		proxymetrics.RateLimitedConnectionsTotalV6.Add(float64(newDrops))
+	} else {
+		proxymetrics.RateLimitedConnectionsTotal.Add(float64(newDrops))
+	}
+}
+
+// getServiceConnectionLimitStats updates the number of Services which have reached their limits of concurrent
+// connections.
+func (c *client) getServiceConnectionLimitStats() {
+	var zones []string
+	c.featureService.cachedConnectionLimits.Range(func(id, value interface{}) bool {
+		if value.(*serviceConnectionLimits).limits.MaxConnections != 0 {
+			zones = append(zones, strconv.Itoa(serviceConnectionLimitCtZone(id.(uint32))))
+		}
+		return true
+	})
+	var limitedServices, limitedServicesV6 int
+	if len(zones) > 0 {
+		output, err := c.ovsctlClient.RunAppctlCmd("dpctl/ct-get-limits", false, "zone="+strings.Join(zones, ","))
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the limits of Service CT zones")
+			return
+		}
+		zoneLimits, err := parseCtZoneLimits(string(output))
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse the limits of Service CT zones")
+			return
+		}
+		c.featureService.cachedConnectionLimits.Range(func(id, value interface{}) bool {
+			zoneLimit, ok := zoneLimits[serviceConnectionLimitCtZone(id.(uint32))]
+			if !ok || zoneLimit.limit == 0 || zoneLimit.count < zoneLimit.limit {
+				return true
+			}
+			if value.(*serviceConnectionLimits).isIPv6 {
+				limitedServicesV6++
+			} else {
+				limitedServices++
+			}
+			return true
+		})
+	}
+	proxymetrics.ConnectionLimitedServices.Set(float64(limitedServices))
+	proxymetrics.ConnectionLimitedServicesV6.Set(float64(limitedServicesV6))
+	if len(zones) > 0 {
+		c.updateServiceConnectionLimitDropStats()
+	}
+}
+
+// updateServiceConnectionLimitDropStats increases the number of Service connections dropped because of the limits of
+// concurrent connections by the number of packets dropped by the datapath since the last collection. The CT zone limits
+// don't provide drop counters, so the dropped packets are computed from the stats of the flows committing the
+// connections to the CT zones of the Services, see serviceConnectionLimitFlows.
+func (c *client) updateServiceConnectionLimitDropStats() {
+	// The flows are dumped with ovs-ofctl to get the cookies, which identify the connection limits of the flows.
+	output, err := c.ovsctlClient.RunOfctlCmd("dump-flows", fmt.Sprintf("table=%d", UnSNATTable.GetID()))
+	if err != nil {
+		klog.ErrorS(err, "Failed to dump the flows of Service connection limits")
+		return
+	}
+	drops := parseServiceConnectionLimitDrops(strings.Split(string(output), "\n"), c.featureService.category)
+	c.featureService.cachedConnectionLimits.Range(func(key, value interface{}) bool {
+		id := key.(uint32)
+		dropCount, ok := drops[id]
+		if !ok {
+			return true
+		}
+		newDrops := dropCount
+		if previousCount, ok := c.featureService.connectionLimitDrops.Swap(id, dropCount); ok && dropCount >= previousCount.(uint64) {
+			newDrops = dropCount - previousCount.(uint64)
+		}
+		if value.(*serviceConnectionLimits).isIPv6 {
+			proxymetrics.ConnectionLimitDroppedConnectionsTotalV6.Add(float64(newDrops))
+		} else {
+			proxymetrics.ConnectionLimitDroppedConnectionsTotal.Add(float64(newDrops))
+		}
+		return true
+	})
+}
+
+// parseServiceConnectionLimitDrops parses the flows in UnSNATTable generated by serviceConnectionLimitFlows, and returns
+// the number of packets dropped by the datapath for each ID of connection limits, which is the difference between the
+// packets matched by the flows committing the connections and the packets matched by the flows forwarding the
+// committed packets. Example flows:
+//
+//	cookie=0x1030000000064, table=UnSNAT, n_packets=15, n_bytes=900, priority=190,tcp,nw_dst=10.96.0.100,tp_dst=80 actions=set_field:0x20000000/0x20000000->reg4,ct(commit,table=UnSNAT,zone=16484)
+//	cookie=0x1030000000064, table=UnSNAT, n_packets=12, n_bytes=720, priority=191,tcp,reg4=0x20000000/0x20000000,nw_dst=10.96.0.100,tp_dst=80 actions=goto_table:ConntrackZone
+func parseServiceConnectionLimitDrops(flows []string, category cookie.Category) map[uint32]uint64 {
+	committedPackets := map[uint32]uint64{}
+	forwardedPackets := map[uint32]uint64{}
+	for _, flow := range flows {
+		flowMap := parseFlowToMap(flow)
+		cookieID, err := strconv.ParseUint(flowMap["cookie"], 0, 64)
+		if err != nil || cookie.ID(cookieID).Category() != category {
+			continue
+		}
+		// The ID of the connection limits is the object ID of the cookie, other Service flows don't have an object ID.
+		id := uint32(cookieID)
+		if id == 0 {
+			continue
+		}
+		packets, _ := strconv.ParseUint(flowMap["n_packets"], 10, 64)
+		if strings.Contains(flow, "ct(commit") {
+			committedPackets[id] += packets
+		} else {
+			forwardedPackets[id] += packets
+		}
+	}
+	drops := make(map[uint32]uint64, len(committedPackets))
+	for id, packets := range committedPackets {
+		// The stats of the flows are not read atomically, the packets being processed may not be counted yet.
+		drops[id] = 0
+		if forwarded := forwardedPackets[id]; packets > forwarded {
+			drops[id] = packets - forwarded
+		}
+	}
+	return drops
+}
+
 func (c *client) SubscribeOFPortStatusMessage(statusCh chan *openflow15.PortStatus) {
 	c.bridge.SubscribePortStatusConsumer(statusCh)
 }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -40,6 +41,7 @@ import (
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	ovsoftest "antrea.io/antrea/pkg/ovs/openflow/testing"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
+	ovsctltest "antrea.io/antrea/pkg/ovs/ovsctl/testing"
 	utilip "antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/runtime"
 	"antrea.io/antrea/third_party/proxy"
@@ -1030,6 +1032,7 @@ func Test_client_InstallServiceGroup(t *testing.T) {
 		name                 string
 		withSessionAffinity  bool
 		endpoints            []proxy.Endpoint
+		weights              map[string]uint16
 		expectedGroup        string
 		deleteOFEntriesError error
 	}{
@@ -1043,6 +1046,17 @@ func Test_client_InstallServiceGroup(t *testing.T) {
 				"bucket=bucket_id:0,weight:100,actions=set_field:0x4000000/0x4000000->reg4,set_field:0xa0a0064->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT," +
 				"bucket=bucket_id:1,weight:100,actions=set_field:0xa0a0065->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT",
 		},
+		{
+			name: "IPv4 Endpoints,Weights",
+			endpoints: []proxy.Endpoint{
+				proxy.NewBaseEndpointInfo("10.10.0.100", "node1", "", 80, false, true, false, false, nil),
+				proxy.NewBaseEndpointInfo("10.10.0.101", "node2", "", 80, true, true, false, false, nil),
+			},
+			weights: map[string]uint16{"10.10.0.101:80": 20},
+			expectedGroup: "group_id=100,type=select," +
+				"bucket=bucket_id:0,weight:100,actions=set_field:0x4000000/0x4000000->reg4,set_field:0xa0a0064->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT," +
+				"bucket=bucket_id:1,weight:20,actions=set_field:0xa0a0065->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT",
+		},
 		{
 			name: "IPv6 Endpoints",
 			endpoints: []proxy.Endpoint{
@@ -1104,7 +1118,7 @@ func Test_client_InstallServiceGroup(t *testing.T) {
 
 			m.EXPECT().AddOFEntries(gomock.Any()).Return(nil).Times(1)
 			m.EXPECT().DeleteOFEntries(gomock.Any()).Return(tc.deleteOFEntriesError).Times(1)
-			assert.NoError(t, fc.InstallServiceGroup(groupID, tc.withSessionAffinity, tc.endpoints))
+			assert.NoError(t, fc.InstallServiceGroup(groupID, tc.withSessionAffinity, false, tc.endpoints, tc.weights))
 			gCacheI, ok := fc.featureService.groupCache.Load(groupID)
 			require.True(t, ok)
 			group := getGroupFromCache(gCacheI.(binding.Group))
@@ -1123,6 +1137,50 @@ func Test_client_InstallServiceGroup(t *testing.T) {
 	}
 }
 
+func Test_client_InstallServiceGroupConsistentHashing(t *testing.T) {
+	groupID := binding.GroupIDType(100)
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+
+	endpoints := []proxy.Endpoint{
+		proxy.NewBaseEndpointInfo("10.10.0.100", "node1", "", 80, false, true, false, false, nil),
+		proxy.NewBaseEndpointInfo("10.10.0.101", "node2", "", 80, true, true, false, false, nil),
+	}
+	getGroupBuckets := func() []string {
+		gCacheI, ok := fc.featureService.groupCache.Load(groupID)
+		require.True(t, ok)
+		group := getGroupFromCache(gCacheI.(binding.Group))
+		require.True(t, strings.HasPrefix(group, "group_id=100,type=select,selection_method=hash,bucket="))
+		return strings.Split(group, ",bucket=")[1:]
+	}
+	m.EXPECT().AddOFEntries(gomock.Any()).Return(nil).Times(1)
+	require.NoError(t, fc.InstallServiceGroup(groupID, false, true, endpoints, nil))
+	// Each Endpoint has a bucket, whose ID is a hash of the Endpoint.
+	bucket1 := "bucket_id:1262467570,weight:100,actions=set_field:0x4000000/0x4000000->reg4,set_field:0xa0a0064->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT"
+	bucket2 := "bucket_id:3061596391,weight:100,actions=set_field:0xa0a0065->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT"
+	assert.Equal(t, []string{bucket1, bucket2}, getGroupBuckets())
+
+	// The buckets are the same regardless of the order of the Endpoints, so that all Nodes select the same Endpoints.
+	m.EXPECT().ModifyOFEntries(gomock.Any()).Return(nil).Times(1)
+	require.NoError(t, fc.InstallServiceGroup(groupID, false, true, []proxy.Endpoint{endpoints[1], endpoints[0]}, nil))
+	assert.ElementsMatch(t, []string{bucket1, bucket2}, getGroupBuckets())
+
+	// The buckets of the existing Endpoints are unchanged when an Endpoint is added.
+	m.EXPECT().ModifyOFEntries(gomock.Any()).Return(nil).Times(1)
+	endpoint3 := proxy.NewBaseEndpointInfo("10.10.0.102", "node2", "", 80, true, true, false, false, nil)
+	require.NoError(t, fc.InstallServiceGroup(groupID, false, true, append(endpoints, endpoint3), nil))
+	assert.ElementsMatch(t, []string{bucket1, bucket2,
+		"bucket_id:4207455336,weight:100,actions=set_field:0xa0a0066->reg3,set_field:0x50/0xffff->reg4,resubmit:EndpointDNAT",
+	}, getGroupBuckets())
+
+	// The weights of the Endpoints are the weights of their buckets.
+	m.EXPECT().ModifyOFEntries(gomock.Any()).Return(nil).Times(1)
+	require.NoError(t, fc.InstallServiceGroup(groupID, false, true, endpoints, map[string]uint16{"10.10.0.101:80": 50}))
+	assert.Equal(t, []string{bucket1, strings.Replace(bucket2, "weight:100", "weight:50", 1)}, getGroupBuckets())
+}
+
 func Test_client_InstallEndpointFlows(t *testing.T) {
 	ep1IPv4 := "10.10.0.100"
 	ep2IPv4 := "10.10.0.101"
@@ -1264,6 +1322,7 @@ func Test_client_InstallServiceFlows(t *testing.T) {
 		isNested           bool
 		isDSR              bool
 		enableMulticluster bool
+		connectionLimits   *types.ServiceConnectionLimits
 		expectedFlows      []string
 	}{
 		{
@@ -1397,6 +1456,27 @@ func Test_client_InstallServiceFlows(t *testing.T) {
 				"cookie=0x1030000000065, table=ServiceLB, priority=190,sctp,reg4=0x30000/0x70000,nw_dst=10.96.0.100,tp_dst=80 actions=learn(table=SessionAffinity,hard_timeout=100,priority=200,delete_learned,cookie=0x1030000000065,eth_type=0x800,nw_proto=0x84,OXM_OF_SCTP_DST[],NXM_OF_IP_DST[],NXM_OF_IP_SRC[],load:NXM_NX_REG4[0..15]->NXM_NX_REG4[0..15],load:NXM_NX_REG4[26]->NXM_NX_REG4[26],load:NXM_NX_REG3[]->NXM_NX_REG3[],load:0x2->NXM_NX_REG4[16..18],load:0x1->NXM_NX_REG0[9],load:0x1->NXM_NX_REG4[21]),set_field:0x20000/0x70000->reg4,goto_table:EndpointDNAT",
 			},
 		},
+		{
+			name:             "Service ClusterIP,ConnectionLimits",
+			protocol:         binding.ProtocolTCP,
+			svcIP:            svcIPv4,
+			connectionLimits: &types.ServiceConnectionLimits{ID: uint32(clusterGroupID), MaxConnections: 1000, MaxNewConnectionsPerSecond: 100},
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=200,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.100,tp_dst=80 actions=meter:1073741924,set_field:0x200/0x200->reg0,set_field:0x20000/0x70000->reg4,set_field:0x64->reg7,group:100",
+				"cookie=0x1030000000064, table=UnSNAT, priority=190,tcp,nw_dst=10.96.0.100,tp_dst=80 actions=set_field:0x20000000/0x20000000->reg4,ct(commit,table=UnSNAT,zone=16484)",
+				"cookie=0x1030000000064, table=UnSNAT, priority=191,tcp,reg4=0x20000000/0x20000000,nw_dst=10.96.0.100,tp_dst=80 actions=goto_table:ConntrackZone",
+				"cookie=0x1030000000064, table=Output, priority=201,tcp,reg0=0x200000/0x600000,nw_src=10.96.0.100,tp_src=80 actions=ct(zone=16484),output:NXM_NX_REG1[]",
+			},
+		},
+		{
+			name:             "Service ClusterIP,IPv6,ConnectionRateLimit",
+			protocol:         binding.ProtocolUDPv6,
+			svcIP:            svcIPv6,
+			connectionLimits: &types.ServiceConnectionLimits{ID: uint32(clusterGroupID), MaxNewConnectionsPerSecond: 100},
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=200,udp6,reg4=0x10000/0x70000,ipv6_dst=fec0:10:96::100,tp_dst=80 actions=meter:1073741924,set_field:0x200/0x200->reg0,set_field:0x20000/0x70000->reg4,set_field:0x64->reg7,group:100",
+			},
+		},
 		{
 			name:       "Service LoadBalancer,DSR",
 			protocol:   binding.ProtocolTCP,
@@ -1482,6 +1562,7 @@ func Test_client_InstallServiceFlows(t *testing.T) {
 				IsNodePort:         tc.isNodePort,
 				IsNested:           tc.isNested,
 				IsDSR:              tc.isDSR,
+				ConnectionLimits:   tc.connectionLimits,
 			}))
 			fCacheI, ok := fc.featureService.cachedFlows.Load(cacheKey)
 			require.True(t, ok)
@@ -1740,6 +1821,31 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 	}
 }
 
+func Test_client_EgressMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+	mockOVSClient := ovsctltest.NewMockOVSCtlClient(ctrl)
+	fc.ovsctlClient = mockOVSClient
+
+	mockOVSClient.EXPECT().DumpTableFlows(EgressMarkTable.GetID()).Return([]string{
+		"table=EgressMark, n_packets=10, n_bytes=1000, priority=210,ip,nw_dst=192.168.77.101 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"table=EgressMark, n_packets=5, n_bytes=500, priority=200,ct_state=+trk,ip,tun_dst=192.168.77.100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"table=EgressMark, n_packets=12, n_bytes=1280, priority=200,ct_state=+trk,ip,in_port=100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"table=EgressMark, n_packets=3, n_bytes=360, priority=200,ct_state=+trk,ipv6,in_port=100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"table=EgressMark, n_packets=7, n_bytes=700, priority=200,ip,in_port=101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+		"table=EgressMark, n_packets=0, n_bytes=0, priority=200,ct_state=+trk,ip,in_port=102 actions=set_field:0x65/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+		"table=EgressMark, n_packets=100, n_bytes=10000, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+	}, nil)
+	expected := map[uint32]*types.RuleMetric{
+		100: {Packets: 15, Bytes: 1640},
+		101: {Packets: 7, Bytes: 700},
+		102: {},
+	}
+	assert.Equal(t, expected, fc.EgressMetrics())
+}
+
 func Test_client_InstallEgressQoS(t *testing.T) {
 	meterID := uint32(100)
 	meterRate := uint32(100)
@@ -1778,6 +1884,81 @@ func Test_client_InstallEgressQoS(t *testing.T) {
 	require.False(t, ok)
 }
 
+func Test_client_InstallServiceConnectionLimits(t *testing.T) {
+	id := uint32(100)
+	meterID := binding.MeterIDType(serviceConnectionRateMeterIDBase + 100)
+
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	bridge := ovsoftest.NewMockBridge(ctrl)
+	fc := newFakeClientWithBridge(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, bridge)
+	defer resetPipelines()
+	mockOVSClient := ovsctltest.NewMockOVSCtlClient(ctrl)
+	fc.ovsctlClient = mockOVSClient
+
+	expectMeter := func(rate uint32) *ovsoftest.MockMeter {
+		meter := ovsoftest.NewMockMeter(ctrl)
+		meterBuilder := ovsoftest.NewMockMeterBandBuilder(ctrl)
+		bridge.EXPECT().NewMeter(meterID, ofctrl.MeterBurst|ofctrl.MeterPktps).Return(meter).Times(1)
+		meter.EXPECT().MeterBand().Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().MeterType(ofctrl.MeterDrop).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Rate(rate).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Burst(rate).Return(meterBuilder).Times(1)
+		meterBuilder.EXPECT().Done().Return(meter).Times(1)
+		return meter
+	}
+
+	meter := expectMeter(100)
+	meter.EXPECT().Add().Return(nil).Times(1)
+	mockOVSClient.EXPECT().RunAppctlCmd("dpctl/ct-set-limits", false, "zone=16484,limit=1000").Return(nil, nil).Times(1)
+	require.NoError(t, fc.InstallServiceConnectionLimits(&types.ServiceConnectionLimits{ID: id, MaxConnections: 1000, MaxNewConnectionsPerSecond: 100}, false))
+	meter.EXPECT().Reset().Times(1)
+	assert.Equal(t, []binding.OFEntry{meter}, fc.featureService.replayMeters())
+
+	// The meter is modified when the rate is updated, and the CT zone limit is deleted when it's removed.
+	updatedMeter := expectMeter(200)
+	updatedMeter.EXPECT().Modify().Return(nil).Times(1)
+	mockOVSClient.EXPECT().RunAppctlCmd("dpctl/ct-del-limits", false, "zone=16484").Return(nil, nil).Times(1)
+	require.NoError(t, fc.InstallServiceConnectionLimits(&types.ServiceConnectionLimits{ID: id, MaxNewConnectionsPerSecond: 200}, false))
+
+	updatedMeter.EXPECT().Delete().Return(nil).Times(1)
+	require.NoError(t, fc.UninstallServiceConnectionLimits(id))
+	_, ok := fc.featureService.cachedConnectionLimits.Load(id)
+	assert.False(t, ok)
+
+	assert.ErrorContains(t, fc.InstallServiceConnectionLimits(&types.ServiceConnectionLimits{ID: 0x8000, MaxConnections: 1000}, false), "is not in the range [1, 32767]")
+}
+
+func Test_parseCtZoneLimits(t *testing.T) {
+	output := `default limit=0
+zone=16484,limit=1000,count=1000
+zone=16485,limit=10,count=3
+`
+	zoneLimits, err := parseCtZoneLimits(output)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]ctZoneLimit{
+		16484: {limit: 1000, count: 1000},
+		16485: {limit: 10, count: 3},
+	}, zoneLimits)
+
+	_, err = parseCtZoneLimits("zone=16484,limit=x,count=0")
+	assert.EqualError(t, err, `invalid value of limit in CT zone limit "zone=16484,limit=x,count=0"`)
+}
+
+func Test_parseServiceConnectionLimitDrops(t *testing.T) {
+	flows := []string{
+		" cookie=0x1030000000064, duration=10.1s, table=UnSNAT, n_packets=15, n_bytes=900, idle_age=1, priority=190,tcp,nw_dst=10.96.0.100,tp_dst=80 actions=set_field:0x20000000/0x20000000->reg4,ct(commit,table=UnSNAT,zone=16484)",
+		" cookie=0x1030000000064, duration=10.1s, table=UnSNAT, n_packets=12, n_bytes=720, idle_age=1, priority=191,tcp,reg4=0x20000000/0x20000000,nw_dst=10.96.0.100,tp_dst=80 actions=goto_table:ConntrackZone",
+		" cookie=0x1030000000064, duration=10.1s, table=UnSNAT, n_packets=5, n_bytes=300, idle_age=1, priority=190,tcp,nw_dst=192.168.77.100,tp_dst=30001 actions=set_field:0x20000000/0x20000000->reg4,ct(commit,table=UnSNAT,zone=16484)",
+		" cookie=0x1030000000064, duration=10.1s, table=UnSNAT, n_packets=5, n_bytes=300, idle_age=1, priority=191,tcp,reg4=0x20000000/0x20000000,nw_dst=192.168.77.100,tp_dst=30001 actions=goto_table:ConntrackZone",
+		" cookie=0x1030000000065, duration=10.1s, table=UnSNAT, n_packets=7, n_bytes=420, idle_age=1, priority=190,tcp,nw_dst=10.96.0.101,tp_dst=80 actions=set_field:0x20000000/0x20000000->reg4,ct(commit,table=UnSNAT,zone=16485)",
+		" cookie=0x1030000000065, duration=10.1s, table=UnSNAT, n_packets=7, n_bytes=420, idle_age=1, priority=191,tcp,reg4=0x20000000/0x20000000,nw_dst=10.96.0.101,tp_dst=80 actions=goto_table:ConntrackZone",
+		" cookie=0x1030000000000, duration=10.1s, table=UnSNAT, n_packets=100, n_bytes=6000, idle_age=1, priority=200,ip,nw_dst=169.254.0.253 actions=ct(table=ConntrackZone,zone=65521,nat)",
+		" cookie=0x1050000000000, duration=10.1s, table=UnSNAT, n_packets=100, n_bytes=6000, idle_age=1, priority=0 actions=goto_table:ConntrackZone",
+	}
+	assert.Equal(t, map[uint32]uint64{100: 3, 101: 0}, parseServiceConnectionLimitDrops(flows, cookie.Service))
+}
+
 func Test_client_InstallTraceflowFlows(t *testing.T) {
 	type fields struct {
 	}
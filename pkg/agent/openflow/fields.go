@@ -155,6 +155,9 @@ var (
 	FromExternalRegMark = binding.NewOneBitRegMark(4, 27)
 	// reg4[28]: Mark to indicate that whether the traffic's source is a local Pod or the Node.
 	FromLocalRegMark = binding.NewOneBitRegMark(4, 28)
+	// reg4[29]: Mark to indicate that the packet has been committed to the CT zone limiting the number of concurrent
+	// connections to a Service.
+	SvcConnLimitCommittedRegMark = binding.NewOneBitRegMark(4, 29)
 
 	// reg5(NXM_NX_REG5)
 	// Field to cache the Egress conjunction ID hit by TraceFlow packet.
@@ -16,12 +16,14 @@ package openflow
 
 import (
 	"fmt"
+	"math"
 	"net"
 	"strconv"
 	"strings"
 	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/ofnet/ofctrl"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -674,6 +676,10 @@ type policyRuleConjunction struct {
 	ruleName     string
 	ruleTableID  uint8
 	ruleLogLabel string
+	// logMeter and logFlows limit the rate of the packets sent to the controller for logging by the rule. They are
+	// nil if the rule is not configured with a maximum number of logged events per second.
+	logMeter binding.Meter
+	logFlows []*openflow15.FlowMod
 }
 
 // clause groups conjunctive match flows. Matches in a clause represent source addresses(for fromClause), or destination
@@ -1170,6 +1176,13 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 
 	var flowMessages []*openflow15.FlowMod
 	flowMessages = append(flowMessages, append(conj.metricFlows, conj.actionFlows...)...)
+	flowMessages = append(flowMessages, conj.logFlows...)
+	if conj.logMeter != nil {
+		// Openflow bundle message doesn't support meter, the meter is added individually before the flows using it.
+		if err := conj.logMeter.Add(); err != nil {
+			return fmt.Errorf("error when installing logging OF Meter for rule %d: %w", conj.id, err)
+		}
+	}
 	if err := c.ofEntryOperations.AddAll(flowMessages); err != nil {
 		return err
 	}
@@ -1222,10 +1235,36 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		}
 		conj.actionFlows = GetFlowModMessages(actionFlows, binding.AddMessage)
 		conj.metricFlows = GetFlowModMessages(metricFlows, binding.AddMessage)
+		isDeny := rule.IsAntreaNetworkPolicyRule() && (*rule.Action == crdv1beta1.RuleActionDrop || *rule.Action == crdv1beta1.RuleActionReject)
+		// The packets sent to the controller for other operations than logging, i.e. to reject the connection or to
+		// track the denied connection, must not be dropped. The rate of their logs is only limited by the agent.
+		onlyLogging := !isDeny || (!f.enableDenyTracking && *rule.Action != crdv1beta1.RuleActionReject)
+		if rate := getRuleLoggingMeterRate(rule.LogSampling); rule.EnableLogging && f.ovsMetersAreSupported && onlyLogging && rate > 0 {
+			conj.logMeter = f.ruleLoggingMeter(ruleOfID, rate)
+			conj.logFlows = GetFlowModMessages([]binding.Flow{f.ruleLoggingRateLimitFlow(ruleOfID, ruleTable.GetID(), isDeny)}, binding.AddMessage)
+		}
 	}
 	return conj
 }
 
+// getRuleLoggingMeterRate returns the rate of the meter limiting the packets sent to the controller for logging by a
+// rule, or 0 if the rate is not limited. As the packets are sampled by the agent after being rate limited by the
+// meter, the rate of the meter is the maximum number of logged events per second multiplied by the sampling rate.
+func getRuleLoggingMeterRate(logSampling *v1beta2.LogSampling) uint32 {
+	if logSampling == nil || logSampling.MaxEventsPerSecond <= 0 {
+		return 0
+	}
+	rate := uint64(logSampling.MaxEventsPerSecond)
+	if logSampling.SamplingRate > 1 {
+		rate *= uint64(logSampling.SamplingRate)
+	}
+	// The burst size of the meter is twice the rate.
+	if rate > math.MaxUint32/2 {
+		rate = math.MaxUint32 / 2
+	}
+	return uint32(rate)
+}
+
 // calculateMatchFlowChangesForRule calculates the contextChanges for the policyRule, and updates the context status in case of batch install.
 func (f *featureNetworkPolicy) calculateMatchFlowChangesForRule(conj *policyRuleConjunction, rule *types.PolicyRule) []*conjMatchFlowContextChange {
 	// Calculate the conjMatchFlowContext changes. The changed Openflow entries are included in the conjMatchFlowContext change.
@@ -1317,6 +1356,7 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
 		c.featureNetworkPolicy.addRuleToConjunctiveMatch(conj, rule)
 		allFlowMessages = append(allFlowMessages, append(conj.actionFlows, conj.metricFlows...)...)
+		allFlowMessages = append(allFlowMessages, conj.logFlows...)
 		conjunctions = append(conjunctions, conj)
 	}
 
@@ -1337,6 +1377,16 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		}
 	}
 
+	// Openflow bundle message doesn't support meter, the meters are added individually before the flows using them.
+	for _, conj := range conjunctions {
+		if conj.logMeter == nil {
+			continue
+		}
+		if err := conj.logMeter.Add(); err != nil {
+			c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+			return fmt.Errorf("error when installing logging OF Meter for rule %d: %w", conj.id, err)
+		}
+	}
 	// Send the changed Openflow entries to the OVS bridge.
 	if err := c.ofEntryOperations.AddAll(allFlowMessages); err != nil {
 		// Reset the global conjunctive match flow cache since the OpenFlow bundle, which contains
@@ -1519,6 +1569,9 @@ func (c *policyRuleConjunction) getAllFlowKeys() []string {
 	for _, flow := range c.actionFlows {
 		flowKeys = append(flowKeys, getFlowDumpKey(flow))
 	}
+	for _, flow := range c.logFlows {
+		flowKeys = append(flowKeys, getFlowDumpKey(flow))
+	}
 
 	addClauseFlowKeys := func(clause *clause) {
 		if clause == nil {
@@ -1575,9 +1628,15 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 	}
 	staleOFPriorities := c.featureNetworkPolicy.getStalePriorities(conj)
 	// Delete action flows from the OVS bridge.
-	if err := c.ofEntryOperations.DeleteAll(append(conj.actionFlows, conj.metricFlows...)); err != nil {
+	if err := c.ofEntryOperations.DeleteAll(append(append(conj.actionFlows, conj.metricFlows...), conj.logFlows...)); err != nil {
 		return nil, err
 	}
+	if conj.logMeter != nil {
+		if err := conj.logMeter.Delete(); err != nil {
+			return nil, fmt.Errorf("error when deleting logging OF Meter for rule %d: %w", conj.id, err)
+		}
+		c.ruleMeterPacketDrops.Delete(int(PacketInMeterIDNPRuleBase + conj.id))
+	}
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	// Get the conjMatchFlowContext changes.
@@ -1632,6 +1691,7 @@ func (f *featureNetworkPolicy) replayFlows() []*openflow15.FlowMod {
 	for _, conj := range f.policyCache.List() {
 		addActionFlows(conj.(*policyRuleConjunction))
 		addMetricFlows(conj.(*policyRuleConjunction))
+		flows = append(flows, conj.(*policyRuleConjunction).logFlows...)
 	}
 
 	addMatchFlows := func(ctx *conjMatchFlowContext) {
@@ -1802,6 +1862,8 @@ func (f *featureNetworkPolicy) updateConjunctionActionFlows(conj *policyRuleConj
 		ruleName:      conj.ruleName,
 		ruleTableID:   conj.ruleTableID,
 		ruleLogLabel:  conj.ruleLogLabel,
+		logMeter:      conj.logMeter,
+		logFlows:      conj.logFlows,
 	}
 	return newConj
 }
@@ -2337,6 +2399,40 @@ func (f *featureNetworkPolicy) loggingNPPacketFlowWithOperations(cookieID uint64
 		Done()
 }
 
+// ruleLoggingMeter generates the meter used to limit the rate of the packets sent to the controller for logging by a
+// NetworkPolicy rule.
+func (f *featureNetworkPolicy) ruleLoggingMeter(conjunctionID uint32, rate uint32) binding.Meter {
+	return f.bridge.NewMeter(binding.MeterIDType(PacketInMeterIDNPRuleBase+conjunctionID), ofctrl.MeterBurst|ofctrl.MeterPktps).
+		MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(rate).
+		Burst(2 * rate).
+		Done()
+}
+
+// ruleLoggingRateLimitFlow generates the flow to limit, with the meter of a NetworkPolicy rule, the rate of the packets
+// sent to the controller only for logging by the rule. It takes precedence over the flow generated by
+// loggingNPPacketFlowWithOperations for the same operation, and the packets are still limited by the meter shared by
+// all the rules.
+func (f *featureNetworkPolicy) ruleLoggingRateLimitFlow(conjunctionID uint32, tableID uint8, isDeny bool) binding.Flow {
+	conjReg := APConjIDField
+	if !isDeny {
+		conjReg = TFIngressConjIDField
+		if _, ok := f.egressTables[tableID]; ok {
+			conjReg = TFEgressConjIDField
+		}
+	}
+	return OutputTable.ofTable.BuildFlow(priorityNormal+1).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchRegMark(OutputToControllerRegMark, binding.NewRegMark(PacketInOperationField, PacketInNPLoggingOperation)).
+		MatchRegFieldWithValue(PacketInTableField, uint32(tableID)).
+		MatchRegFieldWithValue(conjReg, conjunctionID).
+		Action().Meter(PacketInMeterIDNPRuleBase+conjunctionID).
+		Action().Meter(PacketInMeterIDNP).
+		Action().SendToController([]byte{uint8(PacketInCategoryNP), PacketInNPLoggingOperation}, false).
+		Done()
+}
+
 func (f *featureNetworkPolicy) initLoggingFlows() []binding.Flow {
 	maxOperationValue := PacketInNPLoggingOperation + PacketInNPStoreDenyOperation + PacketInNPRejectOperation
 	flows := make([]binding.Flow, 0, maxOperationValue)
@@ -2380,7 +2476,14 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 }
 
 func (f *featureNetworkPolicy) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	for _, obj := range f.policyCache.List() {
+		if conj := obj.(*policyRuleConjunction); conj.logMeter != nil {
+			conj.logMeter.Reset()
+			meters = append(meters, conj.logMeter)
+		}
+	}
+	return meters
 }
 
 func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) binding.GroupIDType {
@@ -17,6 +17,7 @@ package openflow
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"sort"
 	"strconv"
@@ -1547,3 +1548,41 @@ func Test_NewDNSPacketInConjunction(t *testing.T) {
 	t.Run("With OVS meters", func(t *testing.T) { runTests(t, true) })
 	t.Run("Without OVS meters", func(t *testing.T) { runTests(t, false) })
 }
+
+func TestGetRuleLoggingMeterRate(t *testing.T) {
+	tests := []struct {
+		name         string
+		logSampling  *v1beta2.LogSampling
+		expectedRate uint32
+	}{
+		{
+			name:         "no log sampling",
+			expectedRate: 0,
+		},
+		{
+			name:         "sampling rate only",
+			logSampling:  &v1beta2.LogSampling{SamplingRate: 10},
+			expectedRate: 0,
+		},
+		{
+			name:         "max events per second only",
+			logSampling:  &v1beta2.LogSampling{MaxEventsPerSecond: 100},
+			expectedRate: 100,
+		},
+		{
+			name:         "sampling rate and max events per second",
+			logSampling:  &v1beta2.LogSampling{SamplingRate: 10, MaxEventsPerSecond: 100},
+			expectedRate: 1000,
+		},
+		{
+			name:         "rate capped",
+			logSampling:  &v1beta2.LogSampling{SamplingRate: 1 << 30, MaxEventsPerSecond: 100},
+			expectedRate: math.MaxUint32 / 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedRate, getRuleLoggingMeterRate(tt.logSampling))
+		})
+	}
+}
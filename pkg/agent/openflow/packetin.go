@@ -84,6 +84,10 @@ const (
 	PacketInMeterIDNP  = 256
 	PacketInMeterIDTF  = 257
 	PacketInMeterIDDNS = 258
+	// PacketInMeterIDNPRuleBase is the base of the meter IDs used to limit the rate of the packets sent to the
+	// controller for logging by the NetworkPolicy rules configured with a maximum number of logged events per second.
+	// The meter ID of a rule is the sum of the base and the conjunction ID of the rule.
+	PacketInMeterIDNPRuleBase = 1 << 16
 )
 
 // RegisterPacketInHandler stores controller handler in a map with category as keys.
@@ -17,6 +17,7 @@ package openflow
 import (
 	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"net"
 	"sort"
 	"sync"
@@ -442,6 +443,9 @@ type client struct {
 	ovsMetersAreSupported bool
 	// ovsMeterPacketDrops tracks the number of packets dropped by each OVS meter, keyed by meter ID.
 	ovsMeterPacketDrops map[int]*atomic.Int64
+	// ruleMeterPacketDrops tracks the number of packets dropped by the meters of the NetworkPolicy rules, keyed by
+	// meter ID.
+	ruleMeterPacketDrops sync.Map
 	// packetInRate defines the OVS controller packet rate limits for different
 	// features. All features will apply this rate-limit individually on packet-in
 	// messages sent to antrea-agent. The number stands for the rate as packets per
@@ -467,6 +471,9 @@ func (c *client) Run(stopCh <-chan struct{}) {
 		if c.ovsMetersAreSupported {
 			klog.Info("Start collecting OVS meter stats")
 			go wait.Until(c.getMeterStats, time.Second*30, stopCh)
+			if c.enableProxy && c.featureService != nil {
+				go wait.Until(c.getServiceConnectionLimitStats, time.Second*30, stopCh)
+			}
 		}
 	}
 }
@@ -1045,6 +1052,44 @@ func (f *featurePodConnectivity) flowsToTrace(dataplaneTag uint8,
 	return flows
 }
 
+// traceflowReplyMarkFlow generates the flow in ConntrackStateTable to mark the reply packet of a round-trip Traceflow
+// with replyDataplaneTag. The reply packet of an injected Traceflow packet is either not marked or, for ICMP echo reply,
+// marked with the dataplane tag of the request packet, which is dropped by the flows generated by flowsToTrace. This
+// flow must have a higher priority than those flows. The reply packet can be matched on any Node since the dataplane tag
+// is preserved in the inner IP header across tunnels.
+func (f *featurePodConnectivity) traceflowReplyMarkFlow(replyDataplaneTag uint8, replyPacket *binding.Packet, timeout uint16) binding.Flow {
+	cookieID := f.cookieAllocator.Request(cookie.Traceflow).Raw()
+	flowBuilder := ConntrackStateTable.ofTable.BuildFlow(priorityLow + 3).
+		Cookie(cookieID).
+		MatchCTStateTrk(true).
+		MatchCTStateRpl(true).
+		MatchDstIP(replyPacket.DestinationIP).
+		SetHardTimeout(timeout).
+		Action().LoadIPDSCP(replyDataplaneTag).
+		Action().GotoStage(stagePreRouting)
+	switch replyPacket.IPProto {
+	case protocol.Type_ICMP:
+		flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolICMP).MatchICMPType(replyPacket.ICMPType)
+	case protocol.Type_IPv6ICMP:
+		flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolICMPv6).MatchICMPv6Type(replyPacket.ICMPType)
+	case protocol.Type_TCP:
+		if replyPacket.IsIPv6 {
+			flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolTCPv6)
+		} else {
+			flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolTCP)
+		}
+		flowBuilder = flowBuilder.MatchDstPort(replyPacket.DestinationPort, nil)
+	case protocol.Type_UDP:
+		if replyPacket.IsIPv6 {
+			flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolUDPv6)
+		} else {
+			flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolUDP)
+		}
+		flowBuilder = flowBuilder.MatchDstPort(replyPacket.DestinationPort, nil)
+	}
+	return flowBuilder.Done()
+}
+
 // flowsToTrace is used to generate flows for Traceflow in featureService.
 func (f *featureService) flowsToTrace(dataplaneTag uint8,
 	ovsMetersAreSupported,
@@ -2408,6 +2453,11 @@ func (f *featureService) serviceLBFlows(config *types.ServiceConfig) []binding.F
 		if config.IsNested {
 			regMarksToLoad = append(regMarksToLoad, NestedServiceRegMark)
 		}
+		// The meter limits the rate of new connections to the Service, as only the first packet of a connection is
+		// load-balanced.
+		if config.ConnectionLimits != nil && config.ConnectionLimits.MaxNewConnectionsPerSecond != 0 {
+			flowBuilder = flowBuilder.Action().Meter(uint32(serviceConnectionRateMeterID(config.ConnectionLimits.ID)))
+		}
 		return flowBuilder.
 			Action().LoadRegMark(regMarksToLoad...).
 			Action().Group(groupID).Done()
@@ -2551,7 +2601,7 @@ func (f *featureService) dsrServiceNoDNATFlows() []binding.Flow {
 // will resubmit packets back to ServiceLBTable to trigger the learn flow, the learn flow will then send packets to
 // EndpointDNATTable. Otherwise, buckets will resubmit packets to EndpointDNATTable directly.
 // IMPORTANT: Ensure any changes to this function are tested in TestServiceEndpointGroupMaxBuckets.
-func (f *featureService) serviceEndpointGroup(groupID binding.GroupIDType, withSessionAffinity bool, endpoints ...proxy.Endpoint) binding.Group {
+func (f *featureService) serviceEndpointGroup(groupID binding.GroupIDType, withSessionAffinity, consistentHashing bool, weights map[string]uint16, endpoints ...proxy.Endpoint) binding.Group {
 	group := f.bridge.NewGroup(groupID)
 
 	if len(endpoints) == 0 {
@@ -2567,12 +2617,21 @@ func (f *featureService) serviceEndpointGroup(groupID binding.GroupIDType, withS
 	} else {
 		resubmitTableID = ServiceLBTable.GetNext() // It will be EndpointDNATTable if DSR is not enabled, otherwise DSRServiceMarkTable.
 	}
-	for _, endpoint := range endpoints {
+	getWeight := func(endpoint proxy.Endpoint) uint16 {
+		if weight, ok := weights[endpoint.String()]; ok {
+			return weight
+		}
+		return types.DefaultEndpointWeight
+	}
+	addBucket := func(endpoint proxy.Endpoint, weight uint16, bucketID *uint32) {
 		endpointPort, _ := endpoint.Port()
 		endpointIP := net.ParseIP(endpoint.IP())
 		portVal := util.PortToUint16(endpointPort)
 		ipProtocol := getIPProtocol(endpointIP)
-		bucketBuilder := group.Bucket().Weight(100)
+		bucketBuilder := group.Bucket().Weight(weight)
+		if bucketID != nil {
+			bucketBuilder = bucketBuilder.BucketID(*bucketID)
+		}
 		// Load RemoteEndpointRegMark for remote non-hostNetwork Endpoints.
 		if !endpoint.GetIsLocal() && endpoint.GetNodeName() != "" && !f.nodeIPChecker.IsNodeIP(endpoint.IP()) {
 			bucketBuilder = bucketBuilder.LoadRegMark(RemoteEndpointRegMark)
@@ -2590,9 +2649,52 @@ func (f *featureService) serviceEndpointGroup(groupID binding.GroupIDType, withS
 			ResubmitToTable(resubmitTableID).
 			Done()
 	}
+	if consistentHashing {
+		// The bucket is selected with the highest random weight computed from a hash of the connection and the bucket
+		// IDs, so the bucket ID of an Endpoint must only depend on the Endpoint for all Nodes to select the same
+		// Endpoint for a connection. Adding or removing an Endpoint then only affects the connections to this Endpoint.
+		group = group.HashSelectionMethod(0)
+		bucketIDs := serviceEndpointBucketIDs(endpoints)
+		for i, endpoint := range endpoints {
+			addBucket(endpoint, getWeight(endpoint), &bucketIDs[i])
+		}
+		return group
+	}
+	for _, endpoint := range endpoints {
+		addBucket(endpoint, getWeight(endpoint), nil)
+	}
 	return group
 }
 
+// serviceEndpointBucketIDs returns the bucket IDs of the given Endpoints, which are computed from a hash of the
+// Endpoints' IPs and ports. Hash collisions are resolved by incrementing the IDs in the order of the Endpoint strings, so
+// that the bucket IDs only depend on the set of Endpoints.
+func serviceEndpointBucketIDs(endpoints []proxy.Endpoint) []uint32 {
+	indexes := make([]int, len(endpoints))
+	for i := range endpoints {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return endpoints[indexes[i]].String() < endpoints[indexes[j]].String()
+	})
+	bucketIDs := make([]uint32, len(endpoints))
+	usedIDs := make(map[uint32]struct{}, len(endpoints))
+	for _, i := range indexes {
+		h := fnv.New32a()
+		h.Write([]byte(endpoints[i].String()))
+		id := h.Sum32() % (binding.MaxBucketID + 1)
+		for {
+			if _, ok := usedIDs[id]; !ok {
+				break
+			}
+			id = (id + 1) % (binding.MaxBucketID + 1)
+		}
+		usedIDs[id] = struct{}{}
+		bucketIDs[i] = id
+	}
+	return bucketIDs
+}
+
 // decTTLFlows generates the flow to process TTL. For the packets forwarded across Nodes, TTL should be decremented by one;
 // for packets which enter OVS pipeline from the Antrea gateway, as the host IP stack should have decremented the TTL
 // already for such packets, TTL should not be decremented again.
@@ -245,8 +245,9 @@ func TestServiceEndpointGroupMaxBuckets(t *testing.T) {
 
 	// Test the Endpoint associated with a bucket containing all available actions.
 	testCases := []struct {
-		name           string
-		sampleEndpoint proxy.Endpoint
+		name              string
+		sampleEndpoint    proxy.Endpoint
+		consistentHashing bool
 	}{
 		{
 			name:           "IPv6, remote, non-hostNetwork",
@@ -256,6 +257,16 @@ func TestServiceEndpointGroupMaxBuckets(t *testing.T) {
 			name:           "IPv4, remote, non-hostNetwork",
 			sampleEndpoint: proxy.NewBaseEndpointInfo("192.168.1.1", "node1", "", 80, false, true, false, false, nil),
 		},
+		{
+			name:              "IPv6, remote, non-hostNetwork, consistent hashing",
+			sampleEndpoint:    proxy.NewBaseEndpointInfo("2001::1", "node1", "", 80, false, true, false, false, nil),
+			consistentHashing: true,
+		},
+		{
+			name:              "IPv4, remote, non-hostNetwork, consistent hashing",
+			sampleEndpoint:    proxy.NewBaseEndpointInfo("192.168.1.1", "node1", "", 80, false, true, false, false, nil),
+			consistentHashing: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -273,17 +284,31 @@ func TestServiceEndpointGroupMaxBuckets(t *testing.T) {
 			}
 
 			fakeOfTable.EXPECT().GetID().Return(uint8(1)).Times(1)
-			group := fs.serviceEndpointGroup(binding.GroupIDType(100), true, endpoints...)
+			group := fs.serviceEndpointGroup(binding.GroupIDType(100), true, tc.consistentHashing, nil, endpoints...)
 			messages, err := group.GetBundleMessages(binding.AddMessage)
 			require.NoError(t, err)
 			require.Equal(t, 1, len(messages))
 			groupMod := messages[0].GetMessage().(*openflow15.GroupMod)
+			require.Len(t, groupMod.Buckets, binding.MaxBucketsPerMessage)
 			errorMsg := fmt.Sprintf("The GroupMod size with %d buckets exceeds the OpenFlow message's maximum allowable size, please consider setting binding.MaxBucketsPerMessage to a smaller value.", binding.MaxBucketsPerMessage)
 			require.LessOrEqual(t, getGroupModLen(groupMod), uint32(openflow15.MSG_MAX_LEN), errorMsg)
 		})
 	}
 }
 
+func TestServiceEndpointBucketIDs(t *testing.T) {
+	ep1 := proxy.NewBaseEndpointInfo("10.10.0.100", "", "", 80, false, true, false, false, nil)
+	ep2 := proxy.NewBaseEndpointInfo("10.10.0.101", "", "", 80, false, true, false, false, nil)
+	ep3 := proxy.NewBaseEndpointInfo("2001::1", "", "", 80, false, true, false, false, nil)
+
+	bucketIDs := serviceEndpointBucketIDs([]proxy.Endpoint{ep1, ep2, ep3})
+	assert.Equal(t, []uint32{1262467570, 3061596391, bucketIDs[2]}, bucketIDs)
+	// The bucket ID of an Endpoint doesn't depend on the other Endpoints and their order.
+	assert.Equal(t, []uint32{bucketIDs[2], bucketIDs[0]}, serviceEndpointBucketIDs([]proxy.Endpoint{ep3, ep1}))
+	// Hash collisions are resolved in the order of the Endpoint strings.
+	assert.Equal(t, []uint32{1262467570, 1262467571, 1262467572}, serviceEndpointBucketIDs([]proxy.Endpoint{ep1, ep1, ep1}))
+}
+
 // For openflow15.GroupMod, it provides a built-in method for calculating the message length. However,considering that
 // the GroupMod size we test might exceed the maximum uint16 value, we use uint32 as the return value type.
 func getGroupModLen(g *openflow15.GroupMod) uint32 {
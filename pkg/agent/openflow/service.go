@@ -15,17 +15,41 @@
 package openflow
 
 import (
+	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/ofnet/ofctrl"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/nodeip"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
+	"antrea.io/antrea/pkg/agent/types"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
+const (
+	// serviceConnectionRateMeterIDBase is the base of the IDs of the meters limiting the rate of new connections to
+	// Services. The meter ID of a Service is the sum of the base and the ID of its connection limits. The base is
+	// greater than the meter IDs of the NetworkPolicy rules, which start from PacketInMeterIDNPRuleBase.
+	serviceConnectionRateMeterIDBase = 1 << 30
+	// serviceConnectionLimitCtZoneBase is the base of the CT zones limiting the number of concurrent connections to
+	// Services. The CT zone of a Service is the sum of the base and the ID of its connection limits. The zones in
+	// [0x4000, 0xbfff] are not used by other features.
+	serviceConnectionLimitCtZoneBase = 0x4000
+)
+
+// serviceConnectionLimits is the cache of the connection limits installed for a Service.
+type serviceConnectionLimits struct {
+	limits types.ServiceConnectionLimits
+	isIPv6 bool
+	// meter limits the rate of new connections, nil if the rate is unlimited.
+	meter binding.Meter
+}
+
 type featureService struct {
 	cookieAllocator cookie.Allocator
 	nodeIPChecker   nodeip.Checker
@@ -34,6 +58,15 @@ type featureService struct {
 
 	cachedFlows *flowCategoryCache
 	groupCache  sync.Map
+	// cachedConnectionLimits caches the connection limits of Services, keyed by the ID of the limits.
+	cachedConnectionLimits sync.Map
+	// connectionRateMeterDrops tracks the number of packets dropped by the meters limiting the rate of new connections,
+	// keyed by the ID of the limits. It's used to compute the number of packets dropped since the last collection.
+	connectionRateMeterDrops sync.Map
+	// connectionLimitDrops tracks the number of packets dropped by the datapath because the limits of the CT zones of
+	// the Services were reached, keyed by the ID of the limits. It's used to compute the number of packets dropped since
+	// the last collection.
+	connectionLimitDrops sync.Map
 
 	gatewayIPs             map[binding.Protocol]net.IP
 	virtualIPs             map[binding.Protocol]net.IP
@@ -191,5 +224,131 @@ func (f *featureService) initGroups() []binding.OFEntry {
 }
 
 func (f *featureService) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	f.cachedConnectionLimits.Range(func(_, value interface{}) bool {
+		if meter := value.(*serviceConnectionLimits).meter; meter != nil {
+			meter.Reset()
+			meters = append(meters, meter)
+		}
+		return true
+	})
+	return meters
+}
+
+func serviceConnectionRateMeterID(id uint32) binding.MeterIDType {
+	return binding.MeterIDType(serviceConnectionRateMeterIDBase + id)
+}
+
+func serviceConnectionLimitCtZone(id uint32) int {
+	return serviceConnectionLimitCtZoneBase + int(id)
+}
+
+// serviceConnectionRateMeter generates the meter used to limit the rate of new connections to a Service. As only the
+// first packet of a connection is load-balanced, the meter is applied to the flows in ServiceLBTable and counts
+// connections.
+func (f *featureService) serviceConnectionRateMeter(id uint32, rate uint32) binding.Meter {
+	return f.bridge.NewMeter(serviceConnectionRateMeterID(id), ofctrl.MeterBurst|ofctrl.MeterPktps).
+		MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(rate).
+		Burst(rate).
+		Done()
+}
+
+// serviceConnectionLimitFlows generates the flows tracking the connections of a Service entrypoint in the CT zone of
+// the Service, in which the number of connections is limited by the datapath:
+//  1. The request packets are committed in the CT zone before they are processed in ConntrackTable. The first packet
+//     of a new connection is dropped by the datapath if the limit of the CT zone has been reached. The committed
+//     packets are marked with SvcConnLimitCommittedRegMark and recirculated to UnSNATTable, where they are
+//     forwarded to ConntrackTable. The difference between the packets of the two flows is the number of packets
+//     dropped by the datapath, which is reported by getServiceConnectionLimitStats.
+//  2. The reply packets, whose source has been restored to the Service address, are tracked in the CT zone before they
+//     are output, so that the connections are removed from the CT zone when they are closed.
+//
+// The ID of the connection limits is used as the object ID of the cookie of the flows, to identify them in the flow
+// stats.
+func (f *featureService) serviceConnectionLimitFlows(config *types.ServiceConfig) []binding.Flow {
+	cookieID := f.cookieAllocator.RequestWithObjectID(f.category, config.ConnectionLimits.ID).Raw()
+	zone := serviceConnectionLimitCtZone(config.ConnectionLimits.ID)
+	svcIPs := []net.IP{config.ServiceIP}
+	if config.IsNodePort {
+		// The Service IP of a NodePort is the virtual NodePort DNAT IP, and the NodePort can also be accessed from
+		// local Pods with the NodePort addresses, like in nodePortMarkFlows.
+		for _, ip := range f.nodePortAddresses[getIPProtocol(config.ServiceIP)] {
+			if !ip.IsLoopback() {
+				svcIPs = append(svcIPs, ip)
+			}
+		}
+	}
+	var flows []binding.Flow
+	for _, svcIP := range svcIPs {
+		flows = append(flows,
+			UnSNATTable.ofTable.BuildFlow(priorityLow).
+				Cookie(cookieID).
+				MatchProtocol(config.Protocol).
+				MatchDstIP(svcIP).
+				MatchDstPort(config.ServicePort, nil).
+				Action().LoadRegMark(SvcConnLimitCommittedRegMark).
+				Action().CT(true, UnSNATTable.GetID(), zone, nil).
+				CTDone().
+				Done(),
+			UnSNATTable.ofTable.BuildFlow(priorityLow+1).
+				Cookie(cookieID).
+				MatchProtocol(config.Protocol).
+				MatchRegMark(SvcConnLimitCommittedRegMark).
+				MatchDstIP(svcIP).
+				MatchDstPort(config.ServicePort, nil).
+				Action().GotoTable(ConntrackTable.GetID()).
+				Done(),
+			// The packets are not recirculated after being tracked, and they are output like in l2ForwardOutputFlow.
+			OutputTable.ofTable.BuildFlow(priorityNormal+1).
+				Cookie(cookieID).
+				MatchRegMark(OutputToOFPortRegMark).
+				MatchProtocol(config.Protocol).
+				MatchSrcIP(svcIP).
+				MatchSrcPort(config.ServicePort, nil).
+				Action().CT(false, binding.LastTableID, zone, nil).
+				CTDone().
+				Action().OutputToRegField(TargetOFPortField).
+				Done(),
+		)
+	}
+	return flows
+}
+
+// ctZoneLimit is the limit and the number of connections of a CT zone.
+type ctZoneLimit struct {
+	limit uint32
+	count uint32
+}
+
+// parseCtZoneLimits parses the output of "ovs-appctl dpctl/ct-get-limits", in which every zone is described by a line
+// in the format "zone=<zone>,limit=<limit>,count=<count>".
+func parseCtZoneLimits(output string) (map[int]ctZoneLimit, error) {
+	zoneLimits := map[int]ctZoneLimit{}
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "zone=") {
+			continue
+		}
+		zone := -1
+		var zoneLimit ctZoneLimit
+		for _, field := range strings.Split(line, ",") {
+			key, valueStr, _ := strings.Cut(field, "=")
+			value, err := strconv.ParseUint(valueStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of %s in CT zone limit %q", key, line)
+			}
+			switch key {
+			case "zone":
+				zone = int(value)
+			case "limit":
+				zoneLimit.limit = uint32(value)
+			case "count":
+				zoneLimit.count = uint32(value)
+			}
+		}
+		zoneLimits[zone] = zoneLimit
+	}
+	return zoneLimits, nil
 }
@@ -165,6 +165,20 @@ func (mr *MockClientMockRecorder) Disconnect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockClient)(nil).Disconnect))
 }
 
+// EgressMetrics mocks base method.
+func (m *MockClient) EgressMetrics() map[uint32]*types.RuleMetric {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressMetrics")
+	ret0, _ := ret[0].(map[uint32]*types.RuleMetric)
+	return ret0
+}
+
+// EgressMetrics indicates an expected call of EgressMetrics.
+func (mr *MockClientMockRecorder) EgressMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressMetrics", reflect.TypeOf((*MockClient)(nil).EgressMetrics))
+}
+
 // GetFlowTableStatus mocks base method.
 func (m *MockClient) GetFlowTableStatus() []openflow0.TableStatus {
 	m.ctrl.T.Helper()
@@ -520,6 +534,20 @@ func (mr *MockClientMockRecorder) InstallSNATMarkFlows(snatIP, mark any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallSNATMarkFlows", reflect.TypeOf((*MockClient)(nil).InstallSNATMarkFlows), snatIP, mark)
 }
 
+// InstallServiceConnectionLimits mocks base method.
+func (m *MockClient) InstallServiceConnectionLimits(limits *types.ServiceConnectionLimits, isIPv6 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallServiceConnectionLimits", limits, isIPv6)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallServiceConnectionLimits indicates an expected call of InstallServiceConnectionLimits.
+func (mr *MockClientMockRecorder) InstallServiceConnectionLimits(limits, isIPv6 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceConnectionLimits", reflect.TypeOf((*MockClient)(nil).InstallServiceConnectionLimits), limits, isIPv6)
+}
+
 // InstallServiceFlows mocks base method.
 func (m *MockClient) InstallServiceFlows(config *types.ServiceConfig) error {
 	m.ctrl.T.Helper()
@@ -535,17 +563,17 @@ func (mr *MockClientMockRecorder) InstallServiceFlows(config any) *gomock.Call {
 }
 
 // InstallServiceGroup mocks base method.
-func (m *MockClient) InstallServiceGroup(groupID openflow0.GroupIDType, withSessionAffinity bool, endpoints []proxy.Endpoint) error {
+func (m *MockClient) InstallServiceGroup(groupID openflow0.GroupIDType, withSessionAffinity, consistentHashing bool, endpoints []proxy.Endpoint, weights map[string]uint16) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallServiceGroup", groupID, withSessionAffinity, endpoints)
+	ret := m.ctrl.Call(m, "InstallServiceGroup", groupID, withSessionAffinity, consistentHashing, endpoints, weights)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InstallServiceGroup indicates an expected call of InstallServiceGroup.
-func (mr *MockClientMockRecorder) InstallServiceGroup(groupID, withSessionAffinity, endpoints any) *gomock.Call {
+func (mr *MockClientMockRecorder) InstallServiceGroup(groupID, withSessionAffinity, consistentHashing, endpoints, weights any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceGroup", reflect.TypeOf((*MockClient)(nil).InstallServiceGroup), groupID, withSessionAffinity, endpoints)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceGroup", reflect.TypeOf((*MockClient)(nil).InstallServiceGroup), groupID, withSessionAffinity, consistentHashing, endpoints, weights)
 }
 
 // InstallTraceflowFlows mocks base method.
@@ -562,6 +590,20 @@ func (mr *MockClientMockRecorder) InstallTraceflowFlows(dataplaneTag, liveTraffi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallTraceflowFlows", reflect.TypeOf((*MockClient)(nil).InstallTraceflowFlows), dataplaneTag, liveTraffic, droppedOnly, receiverOnly, packet, ofPort, timeoutSeconds)
 }
 
+// InstallTraceflowReplyFlows mocks base method.
+func (m *MockClient) InstallTraceflowReplyFlows(replyDataplaneTag uint8, replyPacket *openflow0.Packet, timeoutSeconds uint16) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallTraceflowReplyFlows", replyDataplaneTag, replyPacket, timeoutSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallTraceflowReplyFlows indicates an expected call of InstallTraceflowReplyFlows.
+func (mr *MockClientMockRecorder) InstallTraceflowReplyFlows(replyDataplaneTag, replyPacket, timeoutSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallTraceflowReplyFlows", reflect.TypeOf((*MockClient)(nil).InstallTraceflowReplyFlows), replyDataplaneTag, replyPacket, timeoutSeconds)
+}
+
 // InstallTrafficControlMarkFlows mocks base method.
 func (m *MockClient) InstallTrafficControlMarkFlows(name string, sourceOFPorts []uint32, targetOFPort uint32, direction v1alpha2.Direction, action v1alpha2.TrafficControlAction, priority types.TrafficControlFlowPriority) error {
 	m.ctrl.T.Helper()
@@ -1045,6 +1087,20 @@ func (mr *MockClientMockRecorder) UninstallSNATMarkFlows(mark any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallSNATMarkFlows", reflect.TypeOf((*MockClient)(nil).UninstallSNATMarkFlows), mark)
 }
 
+// UninstallServiceConnectionLimits mocks base method.
+func (m *MockClient) UninstallServiceConnectionLimits(id uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallServiceConnectionLimits", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallServiceConnectionLimits indicates an expected call of UninstallServiceConnectionLimits.
+func (mr *MockClientMockRecorder) UninstallServiceConnectionLimits(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallServiceConnectionLimits", reflect.TypeOf((*MockClient)(nil).UninstallServiceConnectionLimits), id)
+}
+
 // UninstallServiceFlows mocks base method.
 func (m *MockClient) UninstallServiceFlows(svcIP net.IP, svcPort uint16, protocol openflow0.Protocol) error {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"maps"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
@@ -28,8 +29,6 @@ import (
 	"time"
 
 	"github.com/gopacket/gopacket"
-	"github.com/gopacket/gopacket/layers"
-	"github.com/gopacket/gopacket/pcapgo"
 	"github.com/spf13/afero"
 	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
@@ -54,13 +53,7 @@ import (
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	"antrea.io/antrea/pkg/util/auth"
 	"antrea.io/antrea/pkg/util/env"
-	"antrea.io/antrea/pkg/util/sftp"
-)
-
-type storageProtocolType string
-
-const (
-	sftpProtocol storageProtocolType = "sftp"
+	"antrea.io/antrea/pkg/util/upload"
 )
 
 const (
@@ -104,10 +97,23 @@ type packetCaptureState struct {
 	// targetCapturedPacketsNum is the target number limit for a PacketCapture. When numCapturedPackets == targetCapturedPacketsNum, it means
 	// the PacketCapture is done successfully.
 	targetCapturedPacketsNum int32
+	// capturedBytes records how many bytes have been captured. Like capturedPacketsNum, this may not be the real-time
+	// data.
+	capturedBytes int64
+	// targetCapturedBytes is the byte limit for a Bounded PacketCapture. When capturedBytes >= targetCapturedBytes, it
+	// means the PacketCapture is done successfully.
+	targetCapturedBytes int64
+	// ringBufferRotations and ringBufferFileIndex record the rotation state of a RingBuffer PacketCapture.
+	ringBufferRotations int32
+	ringBufferFileIndex int32
+	// triggerTime is the time when the trigger of a RingBuffer PacketCapture fired.
+	triggerTime *metav1.Time
 	// phase is the phase of the PacketCapture.
 	phase packetCapturePhase
 	// filePath is the final path shown in PacketCapture's status.
 	filePath string
+	// filePaths is the final file set of a RingBuffer PacketCapture shown in PacketCapture's status.
+	filePaths []string
 	// captureErr is the error observed during the capturing phase.
 	captureErr error
 	// uploadErr is the error observed during the uploading phase.
@@ -117,6 +123,9 @@ type packetCaptureState struct {
 }
 
 func (pcs *packetCaptureState) isCaptureSuccessful() bool {
+	if pcs.targetCapturedBytes > 0 && pcs.capturedBytes >= pcs.targetCapturedBytes {
+		return true
+	}
 	return pcs.capturedPacketsNum == pcs.targetCapturedPacketsNum && pcs.targetCapturedPacketsNum > 0
 }
 
@@ -128,7 +137,7 @@ type Controller struct {
 	packetCaptureSynced   cache.InformerSynced
 	interfaceStore        interfacestore.InterfaceStore
 	queue                 workqueue.TypedRateLimitingInterface[string]
-	sftpUploader          sftp.Uploader
+	uploaders             map[upload.Scheme]upload.Uploader
 	captureInterface      PacketCapturer
 	mutex                 sync.Mutex
 	// A name-state mapping for all PacketCapture CRs.
@@ -153,8 +162,8 @@ func NewPacketCaptureController(
 			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "packetcapture"},
 		),
-		sftpUploader: sftp.NewUploader(),
-		captures:     make(map[string]*packetCaptureState),
+		uploaders: upload.NewUploaders(),
+		captures:  make(map[string]*packetCaptureState),
 	}
 
 	packetCaptureInformer.Informer().AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
@@ -278,8 +287,13 @@ func (c *Controller) syncPacketCapture(pcName string) error {
 		state := c.captures[pcName]
 		if state == nil {
 			state = &packetCaptureState{
-				phase:                    packetCapturePhasePending,
-				targetCapturedPacketsNum: pc.Spec.CaptureConfig.FirstN.Number,
+				phase: packetCapturePhasePending,
+			}
+			if firstN := pc.Spec.CaptureConfig.FirstN; firstN != nil {
+				state.targetCapturedPacketsNum = firstN.Number
+			}
+			if bounded := pc.Spec.CaptureConfig.Bounded; bounded != nil && bounded.MaxBytes != nil {
+				state.targetCapturedBytes = *bounded.MaxBytes
 			}
 			c.captures[pcName] = state
 		}
@@ -317,6 +331,9 @@ func (c *Controller) syncPacketCapture(pcName string) error {
 }
 
 func (c *Controller) validatePacketCapture(spec *crdv1alpha1.PacketCaptureSpec) error {
+	if err := validateCaptureConfig(&spec.CaptureConfig); err != nil {
+		return err
+	}
 	if spec.Packet != nil {
 		protocol := spec.Packet.Protocol
 		if protocol != nil {
@@ -345,12 +362,50 @@ func (c *Controller) validatePacketCapture(spec *crdv1alpha1.PacketCaptureSpec)
 	return nil
 }
 
+func validateCaptureConfig(config *crdv1alpha1.CaptureConfig) error {
+	modes := 0
+	if config.FirstN != nil {
+		modes++
+	}
+	if config.Bounded != nil {
+		modes++
+		if config.Bounded.Duration == nil && config.Bounded.MaxBytes == nil {
+			return fmt.Errorf("at least one of duration and maxBytes must be set for bounded capture")
+		}
+	}
+	if rb := config.RingBuffer; rb != nil {
+		modes++
+		if rb.FileCount < 1 {
+			return fmt.Errorf("invalid fileCount %d for ring buffer capture; must be at least 1", rb.FileCount)
+		}
+		if rb.PacketsPerFile == nil && rb.BytesPerFile == nil {
+			return fmt.Errorf("at least one of packetsPerFile and bytesPerFile must be set for ring buffer capture")
+		}
+		if len(rb.Trigger.TCPFlags) == 0 && len(rb.Trigger.ICMPMessages) == 0 {
+			return fmt.Errorf("at least one of tcpFlags and icmpMessages must be set for ring buffer trigger")
+		}
+		for _, m := range rb.Trigger.ICMPMessages {
+			if m.Type.Type == intstr.String {
+				if _, ok := capture.ICMPMsgTypeMap[crdv1alpha1.ICMPMsgType(strings.ToLower(m.Type.StrVal))]; !ok {
+					return fmt.Errorf("invalid ICMP type string in ring buffer trigger: %q; supported values are: %v (case insensitive)",
+						m.Type.StrVal, slices.Collect(maps.Keys(capture.ICMPMsgTypeMap)))
+				}
+			}
+		}
+	}
+	if modes != 1 {
+		return fmt.Errorf("exactly one of firstN, bounded and ringBuffer must be set in captureConfig")
+	}
+	return nil
+}
+
 func (c *Controller) cleanupPacketCapture(pcName string) {
-	path := nameToPath(pcName)
-	if err := defaultFS.RemoveAll(path); err == nil {
-		klog.V(2).InfoS("Deleted the captured pcap file successfully", "name", pcName, "path", path)
-	} else {
-		klog.ErrorS(err, "Failed to delete the captured pcap file", "name", pcName, "path", path)
+	for _, path := range []string{nameToPath(pcName), nameToRingBufferDir(pcName)} {
+		if err := defaultFS.RemoveAll(path); err == nil {
+			klog.V(2).InfoS("Deleted the captured pcap file successfully", "name", pcName, "path", path)
+		} else {
+			klog.ErrorS(err, "Failed to delete the captured pcap file", "name", pcName, "path", path)
+		}
 	}
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -405,34 +460,72 @@ func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCap
 	// Resync the PacketCapture on exit of the capture goroutine.
 	defer c.enqueuePacketCapture(pc)
 
-	var filePath string
+	// filePaths holds the paths of all captured files from the oldest to the newest. There is more than one file only
+	// for RingBuffer captures.
+	var filePaths []string
 	var captureErr, uploadErr error
 	func() {
-		localFilePath := nameToPath(pc.Name)
-		file, err := getPacketFile(localFilePath)
-		if err != nil {
-			captureErr = err
-			return
+		var writer packetWriter
+		var files []afero.File
+		var localFilePaths []string
+		var rb *ringBuffer
+		if rbConfig := pc.Spec.CaptureConfig.RingBuffer; rbConfig != nil {
+			var err error
+			rb, err = newRingBuffer(pc.Name, rbConfig)
+			if err != nil {
+				captureErr = err
+				return
+			}
+			defer rb.close()
+			writer = rb
+		} else {
+			localFilePath := nameToPath(pc.Name)
+			file, err := getPacketFile(localFilePath)
+			if err != nil {
+				captureErr = err
+				return
+			}
+			defer file.Close()
+			if writer, err = newPcapngWriter(file); err != nil {
+				captureErr = err
+				return
+			}
+			files = []afero.File{file}
+			localFilePaths = []string{localFilePath}
 		}
-		defer file.Close()
 
 		var capturedAny bool
-		capturedAny, captureErr = c.performCapture(ctx, pc, state, file, device)
+		capturedAny, captureErr = c.performCapture(ctx, pc, state, writer, device)
 		// If nothing is captured, no need to proceed.
 		if !capturedAny {
 			return
 		}
+		// The ring buffer is frozen now, collect its files from the oldest to the newest.
+		if rb != nil {
+			files = rb.orderedFiles()
+			localFilePaths = rb.orderedPaths()
+		}
 		// If any is captured, upload it if required and update filePath in the status of the PacketCapture.
-		filePath = env.GetPodName() + ":" + localFilePath
+		for _, localFilePath := range localFilePaths {
+			filePaths = append(filePaths, env.GetPodName()+":"+localFilePath)
+		}
 
 		if pc.Spec.FileServer == nil {
 			return
 		}
-		// It can't use the same context as performCapture because it might have timed out.
-		if uploadErr = c.uploadPackets(context.TODO(), pc, file); uploadErr != nil {
-			return
+		var uploadedFilePaths []string
+		for i, file := range files {
+			fileName := c.generatePacketsPathForServer(pc.Name)
+			if rb != nil {
+				fileName = generateRingBufferPathForServer(pc.Name, i)
+			}
+			// It can't use the same context as performCapture because it might have timed out.
+			if uploadErr = c.uploadPackets(context.TODO(), pc, file, fileName); uploadErr != nil {
+				return
+			}
+			uploadedFilePaths = append(uploadedFilePaths, getUploadedFilePath(pc.Spec.FileServer.URL, fileName))
 		}
-		filePath = fmt.Sprintf("%s/%s.pcapng", pc.Spec.FileServer.URL, pc.Name)
+		filePaths = uploadedFilePaths
 	}()
 
 	if captureErr != nil {
@@ -445,21 +538,31 @@ func (c *Controller) startCapture(ctx context.Context, pc *crdv1alpha1.PacketCap
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	state.phase = packetCapturePhaseComplete
-	state.filePath = filePath
+	if len(filePaths) > 0 {
+		// FilePath always points to the newest file, which contains the packet firing the trigger for RingBuffer
+		// captures.
+		state.filePath = filePaths[len(filePaths)-1]
+	}
+	if pc.Spec.CaptureConfig.RingBuffer != nil {
+		state.filePaths = filePaths
+	}
 	state.captureErr = captureErr
 	state.uploadErr = uploadErr
 	c.numRunningCaptures -= 1
 }
 
-// performCapture blocks until either the target number of packets have been captured, the context is canceled, or the
-// context reaches its deadline.
-// It returns a boolean indicating whether any packet is captured, and an error if the target number of packets are not
-// captured.
+// performCapture blocks until either the capture is done according to its capture config, the context is canceled, or
+// the context reaches its deadline. A capture is done when:
+//   - FirstN: the target number of packets have been captured.
+//   - Bounded: the duration elapses or the byte limit is reached.
+//   - RingBuffer: the trigger fires and the post-trigger packets have been captured.
+//
+// It returns a boolean indicating whether any packet is captured, and an error if the capture is not done.
 func (c *Controller) performCapture(
 	ctx context.Context,
 	pc *crdv1alpha1.PacketCapture,
 	captureState *packetCaptureState,
-	file afero.File,
+	writer packetWriter,
 	device string,
 ) (bool, error) {
 	srcIP, dstIP, err := c.parseIPs(ctx, pc)
@@ -467,22 +570,27 @@ func (c *Controller) performCapture(
 		return false, err
 	}
 
-	// set SnapLength here to make tcpdump on Mac OSX works. By default, its value is
-	// 0 and means unlimited, but tcpdump on Mac OSX will complain:
-	// 'tcpdump: pcap_loop: invalid packet capture length <len>, bigger than snaplen of 524288'
-	ngInterface := pcapgo.DefaultNgInterface
-	ngInterface.SnapLength = snapLen
-	ngInterface.LinkType = layers.LinkTypeEthernet
-	pcapngWriter, err := pcapgo.NewNgWriterInterface(file, ngInterface, pcapgo.DefaultNgWriterOptions)
-	if err != nil {
-		return false, fmt.Errorf("couldn't initialize a pcap writer: %w", err)
-	}
-	defer pcapngWriter.Flush()
+	defer writer.Flush()
 	updateRateLimiter := rate.NewLimiter(rate.Every(captureStatusUpdatePeriod), 1)
 	packets, err := c.captureInterface.Capture(ctx, device, snapLen, srcIP, dstIP, pc.Spec.Packet, pc.Spec.Direction)
 	if err != nil {
 		return false, err
 	}
+	// durationCh is only set for Bounded captures with a duration. Reaching the duration is considered as success.
+	var durationCh <-chan time.Time
+	if bounded := pc.Spec.CaptureConfig.Bounded; bounded != nil && bounded.Duration != nil {
+		timer := time.NewTimer(time.Duration(*bounded.Duration) * time.Second)
+		defer timer.Stop()
+		durationCh = timer.C
+	}
+	rb, _ := writer.(*ringBuffer)
+	var trigger *crdv1alpha1.PacketCaptureTrigger
+	if pc.Spec.CaptureConfig.RingBuffer != nil {
+		trigger = &pc.Spec.CaptureConfig.RingBuffer.Trigger
+	}
+	// postTriggerPackets is the number of packets still to be captured after the trigger fires. It is negative until
+	// the trigger fires.
+	postTriggerPackets := int32(-1)
 	// Track whether any packet is captured.
 	capturedAny := false
 	for {
@@ -494,24 +602,50 @@ func (c *Controller) performCapture(
 				Length:        len(packet.Data()),
 			}
 			klog.V(5).InfoS("Captured packet", "name", pc.Name, "len", ci.Length)
-			if err = pcapngWriter.WritePacket(ci, packet.Data()); err != nil {
+			if err = writer.WritePacket(ci, packet.Data()); err != nil {
 				return capturedAny, fmt.Errorf("couldn't write packets: %w", err)
 			}
 			capturedAny = true
 
+			triggered := false
+			if trigger != nil {
+				if postTriggerPackets < 0 {
+					if triggerMatches(trigger, packet) {
+						klog.InfoS("Trigger fired for ring buffer capture", "name", pc.Name)
+						triggered = true
+						postTriggerPackets = trigger.PostTriggerPackets
+					}
+				} else {
+					postTriggerPackets--
+				}
+			}
+
 			if success := func() bool {
 				c.mutex.Lock()
 				defer c.mutex.Unlock()
 				captureState.capturedPacketsNum++
+				captureState.capturedBytes += int64(ci.Length)
+				if rb != nil {
+					captureState.ringBufferRotations = rb.rotations
+					captureState.ringBufferFileIndex = int32(rb.current)
+				}
+				if triggered {
+					captureState.triggerTime = &metav1.Time{Time: ci.Timestamp}
+				}
 				klog.V(5).InfoS("Captured packets count", "name", pc.Name, "count", captureState.capturedPacketsNum)
 				return captureState.isCaptureSuccessful()
 			}(); success {
 				return true, nil
 			}
+			if postTriggerPackets == 0 {
+				return true, nil
+			}
 			// use rate limiter to reduce the times we need to update status.
 			if updateRateLimiter.Allow() {
 				c.enqueuePacketCapture(pc)
 			}
+		case <-durationCh:
+			return capturedAny, nil
 		case <-ctx.Done():
 			return capturedAny, ctx.Err()
 		}
@@ -567,47 +701,69 @@ func (c *Controller) parseIPs(ctx context.Context, pc *crdv1alpha1.PacketCapture
 	return
 }
 
-func (c *Controller) getUploaderByProtocol(protocol storageProtocolType) (sftp.Uploader, error) {
-	if protocol == sftpProtocol {
-		return c.sftpUploader, nil
+func (c *Controller) getUploaderByProtocol(scheme upload.Scheme) (upload.Uploader, error) {
+	if uploader, ok := c.uploaders[scheme]; ok {
+		return uploader, nil
 	}
-	return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	return nil, fmt.Errorf("unsupported protocol %s", scheme)
 }
 
 func (c *Controller) generatePacketsPathForServer(name string) string {
 	return name + ".pcapng"
 }
 
-func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, outputFile afero.File) error {
+// generateRingBufferPathForServer returns the name of the index-th (from the oldest to the newest) file of a
+// RingBuffer capture on the file server.
+func generateRingBufferPathForServer(name string, index int) string {
+	return fmt.Sprintf("%s-%d.pcapng", name, index)
+}
+
+// getUploadedFilePath returns the location of a file uploaded to the file server. The file name is joined to the path
+// of the URL, so that the query of the URL (e.g., the region of a S3 bucket) is preserved and a trailing slash doesn't
+// lead to an empty path segment.
+func getUploadedFilePath(serverURL, fileName string) string {
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		// For backwards-compatibility, SFTP URLs may have no scheme, e.g., "10.0.0.1:22/path".
+		return strings.TrimSuffix(serverURL, "/") + "/" + fileName
+	}
+	return parsedURL.JoinPath(fileName).String()
+}
+
+func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, outputFile afero.File, fileName string) error {
 	klog.V(2).InfoS("Uploading captured packets for PacketCapture", "name", pc.Name)
-	uploader, err := c.getUploaderByProtocol(sftpProtocol)
+	scheme, err := upload.ParseScheme(pc.Spec.FileServer.URL)
+	if err != nil {
+		return fmt.Errorf("failed to upload packets while parsing file server URL: %w", err)
+	}
+	uploader, err := c.getUploaderByProtocol(scheme)
 	if err != nil {
 		return fmt.Errorf("failed to upload packets while getting uploader: %w", err)
 	}
 	if _, err := outputFile.Seek(0, 0); err != nil {
 		return fmt.Errorf("failed to upload to the file server while setting offset: %v", err)
 	}
-	authSecret := v1.SecretReference{
-		Name:      fileServerAuthSecretName,
-		Namespace: env.GetAntreaNamespace(),
-	}
-	serverAuth, err := auth.GetAuthConfigurationFromSecret(ctx, auth.BasicAuthenticationType, &authSecret, c.kubeClient)
-	if err != nil {
-		klog.ErrorS(err, "Failed to get authentication for the file server", "name", pc.Name, "authSecret", authSecret)
-		return err
-	}
-	if serverAuth.BasicAuthentication == nil {
-		return fmt.Errorf("failed to get basic authentication info for the file server")
-	}
-	cfg, err := sftp.GetSSHClientConfig(
-		serverAuth.BasicAuthentication.Username,
-		serverAuth.BasicAuthentication.Password,
-		pc.Spec.FileServer.HostPublicKey,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to generate SSH client config: %w", err)
+	credentials := &upload.Credentials{HostPublicKey: pc.Spec.FileServer.HostPublicKey}
+	// Files are written to a local volume when using the "file" scheme, no authentication is required.
+	if scheme != upload.SchemeFile {
+		authSecret := v1.SecretReference{
+			Name:      fileServerAuthSecretName,
+			Namespace: env.GetAntreaNamespace(),
+		}
+		authType := auth.BasicAuthenticationType
+		if pc.Spec.FileServer.AuthType != "" {
+			authType = auth.AuthType(pc.Spec.FileServer.AuthType)
+		}
+		serverAuth, err := auth.GetAuthConfigurationFromSecret(ctx, authType, &authSecret, c.kubeClient)
+		// The Secret is optional for S3 and HTTP(S) file servers: the default AWS credential chain is used for S3,
+		// and HTTP requests are sent without an Authorization header.
+		if err != nil && !(apierrors.IsNotFound(err) && scheme != upload.SchemeSFTP) {
+			klog.ErrorS(err, "Failed to get authentication for the file server", "name", pc.Name, "authSecret", authSecret)
+			return err
+		}
+		credentials.Auth = serverAuth
 	}
-	return uploader.Upload(pc.Spec.FileServer.URL, c.generatePacketsPathForServer(pc.Name), cfg, outputFile)
+	return uploader.Upload(ctx, pc.Spec.FileServer.URL, fileName, credentials, outputFile)
 }
 
 func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCapture, state packetCaptureState) error {
@@ -617,7 +773,16 @@ func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCap
 	t := metav1.Now()
 	desiredStatus := crdv1alpha1.PacketCaptureStatus{
 		NumberCaptured: state.capturedPacketsNum,
+		CapturedBytes:  state.capturedBytes,
 		FilePath:       state.filePath,
+		FilePaths:      state.filePaths,
+	}
+	if pc.Spec.CaptureConfig.RingBuffer != nil {
+		desiredStatus.RingBuffer = &crdv1alpha1.PacketCaptureRingBufferStatus{
+			Rotations:        state.ringBufferRotations,
+			CurrentFileIndex: state.ringBufferFileIndex,
+			TriggerTime:      state.triggerTime,
+		}
 	}
 
 	var conditionStarted, conditionComplete, conditionUploaded crdv1alpha1.PacketCaptureCondition
@@ -0,0 +1,247 @@
+// Copyright 2026 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/agent/packetcapture/capture"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+// packetWriter is the destination of captured packets.
+type packetWriter interface {
+	WritePacket(ci gopacket.CaptureInfo, data []byte) error
+	Flush() error
+}
+
+func newPcapngWriter(file afero.File) (*pcapgo.NgWriter, error) {
+	// set SnapLength here to make tcpdump on Mac OSX works. By default, its value is
+	// 0 and means unlimited, but tcpdump on Mac OSX will complain:
+	// 'tcpdump: pcap_loop: invalid packet capture length <len>, bigger than snaplen of 524288'
+	ngInterface := pcapgo.DefaultNgInterface
+	ngInterface.SnapLength = snapLen
+	ngInterface.LinkType = layers.LinkTypeEthernet
+	pcapngWriter, err := pcapgo.NewNgWriterInterface(file, ngInterface, pcapgo.DefaultNgWriterOptions)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize a pcap writer: %w", err)
+	}
+	return pcapngWriter, nil
+}
+
+// nameToRingBufferDir returns the directory storing the files of a RingBuffer capture. A dedicated directory is used
+// so that the files can never conflict with the file of another PacketCapture.
+func nameToRingBufferDir(name string) string {
+	return filepath.Join(packetDirectory, name+".ring")
+}
+
+func nameToRingBufferPath(name string, index int) string {
+	return filepath.Join(nameToRingBufferDir(name), fmt.Sprintf("%d.pcapng", index))
+}
+
+// ringBuffer writes captured packets into a fixed number of pcapng files. When the current file reaches the packet
+// or byte limit, it moves to the next file, and the oldest file is truncated and reused once all the files have been
+// used. It is not thread-safe.
+type ringBuffer struct {
+	name           string
+	fileCount      int
+	packetsPerFile int32
+	bytesPerFile   int64
+
+	files   []afero.File
+	writer  *pcapgo.NgWriter
+	current int
+	// currentPackets and currentBytes record how much data has been written into the current file.
+	currentPackets int32
+	currentBytes   int64
+	// rotations records how many times the ring buffer has moved to the next file.
+	rotations int32
+}
+
+func newRingBuffer(name string, config *crdv1alpha1.PacketCaptureRingBufferConfig) (*ringBuffer, error) {
+	rb := &ringBuffer{
+		name:      name,
+		fileCount: int(config.FileCount),
+		files:     make([]afero.File, config.FileCount),
+	}
+	if config.PacketsPerFile != nil {
+		rb.packetsPerFile = *config.PacketsPerFile
+	}
+	if config.BytesPerFile != nil {
+		rb.bytesPerFile = *config.BytesPerFile
+	}
+	dir := nameToRingBufferDir(name)
+	if err := defaultFS.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := defaultFS.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create ring buffer directory: %w", err)
+	}
+	if err := rb.openCurrent(); err != nil {
+		return nil, err
+	}
+	return rb, nil
+}
+
+// openCurrent (re)creates the file at the current index and initializes a pcapng writer for it.
+func (rb *ringBuffer) openCurrent() error {
+	if f := rb.files[rb.current]; f != nil {
+		f.Close()
+	}
+	file, err := defaultFS.Create(nameToRingBufferPath(rb.name, rb.current))
+	if err != nil {
+		return fmt.Errorf("failed to create pcapng file: %w", err)
+	}
+	writer, err := newPcapngWriter(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	rb.files[rb.current] = file
+	rb.writer = writer
+	rb.currentPackets = 0
+	rb.currentBytes = 0
+	return nil
+}
+
+func (rb *ringBuffer) isCurrentFull() bool {
+	if rb.packetsPerFile > 0 && rb.currentPackets >= rb.packetsPerFile {
+		return true
+	}
+	if rb.bytesPerFile > 0 && rb.currentBytes >= rb.bytesPerFile {
+		return true
+	}
+	return false
+}
+
+func (rb *ringBuffer) rotate() error {
+	if err := rb.writer.Flush(); err != nil {
+		return err
+	}
+	rb.current = (rb.current + 1) % rb.fileCount
+	rb.rotations++
+	return rb.openCurrent()
+}
+
+func (rb *ringBuffer) WritePacket(ci gopacket.CaptureInfo, data []byte) error {
+	if rb.isCurrentFull() {
+		if err := rb.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate ring buffer: %w", err)
+		}
+	}
+	if err := rb.writer.WritePacket(ci, data); err != nil {
+		return err
+	}
+	rb.currentPackets++
+	rb.currentBytes += int64(ci.Length)
+	return nil
+}
+
+func (rb *ringBuffer) Flush() error {
+	return rb.writer.Flush()
+}
+
+// orderedIndexes returns the indexes of the files which have been written, from the oldest to the newest.
+func (rb *ringBuffer) orderedIndexes() []int {
+	var indexes []int
+	for i := 1; i <= rb.fileCount; i++ {
+		index := (rb.current + i) % rb.fileCount
+		if rb.files[index] != nil {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// orderedFiles returns the files which have been written, from the oldest to the newest.
+func (rb *ringBuffer) orderedFiles() []afero.File {
+	var files []afero.File
+	for _, i := range rb.orderedIndexes() {
+		files = append(files, rb.files[i])
+	}
+	return files
+}
+
+// orderedPaths returns the local paths of the files which have been written, from the oldest to the newest.
+func (rb *ringBuffer) orderedPaths() []string {
+	var paths []string
+	for _, i := range rb.orderedIndexes() {
+		paths = append(paths, nameToRingBufferPath(rb.name, i))
+	}
+	return paths
+}
+
+func (rb *ringBuffer) close() {
+	for _, f := range rb.files {
+		if f != nil {
+			f.Close()
+		}
+	}
+}
+
+// triggerMatches returns whether the packet matches any of the matchers of the trigger.
+func triggerMatches(trigger *crdv1alpha1.PacketCaptureTrigger, packet gopacket.Packet) bool {
+	if len(trigger.TCPFlags) > 0 {
+		if tcpLayer, ok := packet.Layer(layers.LayerTypeTCP).(*layers.TCP); ok {
+			flags := tcpFlags(tcpLayer)
+			for _, m := range trigger.TCPFlags {
+				mask := m.Value
+				if m.Mask != nil {
+					mask = *m.Mask
+				}
+				if flags&mask == m.Value {
+					return true
+				}
+			}
+		}
+	}
+	if len(trigger.ICMPMessages) > 0 {
+		if icmpLayer, ok := packet.Layer(layers.LayerTypeICMPv4).(*layers.ICMPv4); ok {
+			icmpType := int32(icmpLayer.TypeCode.Type())
+			icmpCode := int32(icmpLayer.TypeCode.Code())
+			for _, m := range trigger.ICMPMessages {
+				var matchType int32
+				if m.Type.Type == intstr.Int {
+					matchType = m.Type.IntVal
+				} else {
+					matchType = int32(capture.ICMPMsgTypeMap[crdv1alpha1.ICMPMsgType(strings.ToLower(m.Type.StrVal))])
+				}
+				if matchType == icmpType && (m.Code == nil || *m.Code == icmpCode) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// tcpFlags returns the value of the flags byte (offset 13) of the TCP header.
+func tcpFlags(tcp *layers.TCP) int32 {
+	var flags int32
+	for i, set := range []bool{tcp.FIN, tcp.SYN, tcp.RST, tcp.PSH, tcp.ACK, tcp.URG, tcp.ECE, tcp.CWR} {
+		if set {
+			flags |= 1 << i
+		}
+	}
+	return flags
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetcapture
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gopacket/gopacket"
+	"github.com/gopacket/gopacket/layers"
+	"github.com/gopacket/gopacket/pcapgo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func countPackets(t *testing.T, path string) int {
+	file, err := defaultFS.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	reader, err := pcapgo.NewNgReader(file, pcapgo.DefaultNgReaderOptions)
+	require.NoError(t, err)
+	count := 0
+	for {
+		if _, _, err := reader.ReadPacketData(); err != nil {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+func TestRingBuffer(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+
+	data := craftTestPacket().Data()
+	ci := gopacket.CaptureInfo{Timestamp: time.Now(), CaptureLength: len(data), Length: len(data)}
+
+	tcs := []struct {
+		name              string
+		config            *crdv1alpha1.PacketCaptureRingBufferConfig
+		numPackets        int
+		expectedRotations int32
+		expectedPaths     []string
+		expectedCounts    []int
+	}{
+		{
+			name: "not full",
+			config: &crdv1alpha1.PacketCaptureRingBufferConfig{
+				FileCount:      3,
+				PacketsPerFile: ptr.To[int32](4),
+			},
+			numPackets:        6,
+			expectedRotations: 1,
+			expectedPaths:     []string{nameToRingBufferPath("pc", 0), nameToRingBufferPath("pc", 1)},
+			expectedCounts:    []int{4, 2},
+		},
+		{
+			name: "wrapped around",
+			config: &crdv1alpha1.PacketCaptureRingBufferConfig{
+				FileCount:      3,
+				PacketsPerFile: ptr.To[int32](4),
+			},
+			numPackets:        14,
+			expectedRotations: 3,
+			expectedPaths:     []string{nameToRingBufferPath("pc", 1), nameToRingBufferPath("pc", 2), nameToRingBufferPath("pc", 0)},
+			expectedCounts:    []int{4, 4, 2},
+		},
+		{
+			name: "rotate by bytes",
+			config: &crdv1alpha1.PacketCaptureRingBufferConfig{
+				FileCount:    2,
+				BytesPerFile: ptr.To(int64(2 * len(data))),
+			},
+			numPackets:        5,
+			expectedRotations: 2,
+			expectedPaths:     []string{nameToRingBufferPath("pc", 1), nameToRingBufferPath("pc", 0)},
+			expectedCounts:    []int{2, 1},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			rb, err := newRingBuffer("pc", tc.config)
+			require.NoError(t, err)
+			defer rb.close()
+			for i := 0; i < tc.numPackets; i++ {
+				require.NoError(t, rb.WritePacket(ci, data))
+			}
+			require.NoError(t, rb.Flush())
+			assert.Equal(t, tc.expectedRotations, rb.rotations)
+			assert.Equal(t, tc.expectedPaths, rb.orderedPaths())
+			assert.Len(t, rb.orderedFiles(), len(tc.expectedPaths))
+			for i, path := range tc.expectedPaths {
+				assert.Equal(t, tc.expectedCounts[i], countPackets(t, path))
+			}
+		})
+	}
+}
+
+func TestTriggerMatches(t *testing.T) {
+	craftPacket := func(l gopacket.SerializableLayer) gopacket.Packet {
+		buffer := gopacket.NewSerializeBuffer()
+		ipv4 := &layers.IPv4{Version: 4, SrcIP: net.IP{10, 0, 0, 1}, DstIP: net.IP{10, 0, 0, 2}, Protocol: layers.IPProtocolTCP}
+		if _, ok := l.(*layers.ICMPv4); ok {
+			ipv4.Protocol = layers.IPProtocolICMPv4
+		}
+		require.NoError(t, gopacket.SerializeLayers(buffer, gopacket.SerializeOptions{FixLengths: true},
+			&layers.Ethernet{SrcMAC: pod1MAC, DstMAC: pod2MAC, EthernetType: layers.EthernetTypeIPv4}, ipv4, l))
+		return gopacket.NewPacket(buffer.Bytes(), layers.LayerTypeEthernet, gopacket.Default)
+	}
+	rstPacket := craftPacket(&layers.TCP{RST: true, ACK: true})
+	synPacket := craftPacket(&layers.TCP{SYN: true})
+	unreachPacket := craftPacket(&layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeDestinationUnreachable, 1)})
+
+	rstTrigger := &crdv1alpha1.PacketCaptureTrigger{
+		TCPFlags: []crdv1alpha1.TCPFlagsMatcher{{Value: 0x4}},
+	}
+	unreachTrigger := &crdv1alpha1.PacketCaptureTrigger{
+		ICMPMessages: []crdv1alpha1.ICMPMsgMatcher{{Type: intstr.FromString("icmp-unreach"), Code: ptr.To[int32](1)}},
+	}
+	unreachCodeMismatchTrigger := &crdv1alpha1.PacketCaptureTrigger{
+		ICMPMessages: []crdv1alpha1.ICMPMsgMatcher{{Type: intstr.FromInt32(3), Code: ptr.To[int32](3)}},
+	}
+
+	assert.True(t, triggerMatches(rstTrigger, rstPacket))
+	assert.False(t, triggerMatches(rstTrigger, synPacket))
+	assert.False(t, triggerMatches(rstTrigger, unreachPacket))
+	assert.True(t, triggerMatches(unreachTrigger, unreachPacket))
+	assert.False(t, triggerMatches(unreachTrigger, rstPacket))
+	assert.False(t, triggerMatches(unreachCodeMismatchTrigger, unreachPacket))
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/proxy/types"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+// connectionLimitsIDAllocator allocates the IDs of the connection limits of Service ports. The IDs are independent of
+// the group IDs, as far fewer CT zones are available for the connection limits than groups.
+type connectionLimitsIDAllocator struct {
+	nextID      uint32
+	releasedIDs []uint32
+}
+
+func newConnectionLimitsIDAllocator() *connectionLimitsIDAllocator {
+	return &connectionLimitsIDAllocator{nextID: 1}
+}
+
+func (a *connectionLimitsIDAllocator) allocate() (uint32, error) {
+	if len(a.releasedIDs) > 0 {
+		id := a.releasedIDs[0]
+		a.releasedIDs = a.releasedIDs[1:]
+		return id, nil
+	}
+	if a.nextID > agenttypes.MaxServiceConnectionLimitsID {
+		return 0, fmt.Errorf("no ID available, at most %d Service ports can have connection limits", agenttypes.MaxServiceConnectionLimitsID)
+	}
+	id := a.nextID
+	a.nextID++
+	return id, nil
+}
+
+func (a *connectionLimitsIDAllocator) release(id uint32) {
+	a.releasedIDs = append(a.releasedIDs, id)
+}
+
+// getServiceConnectionLimits returns the connection limits of a Service, or nil if the Service has no limits or they
+// cannot be enforced. An ID is allocated for the limits the first time, and is kept until the limits are removed. If
+// no ID is available, the limits are ignored but the Service is still installed.
+func (p *proxier) getServiceConnectionLimits(svcPortName k8sproxy.ServicePortName, svcInfo *types.ServiceInfo) *agenttypes.ServiceConnectionLimits {
+	if svcInfo.MaxConnections == 0 && svcInfo.MaxNewConnectionsPerSecond == 0 {
+		return nil
+	}
+	if !p.supportConnectionLimits {
+		klog.V(2).InfoS("Ignoring the Service's connection limits as OVS meters are not supported", "ServiceInfo", svcInfo.String())
+		return nil
+	}
+	id, ok := p.connectionLimitsIDs[svcPortName]
+	if !ok {
+		var err error
+		if id, err = p.connectionLimitsIDAllocator.allocate(); err != nil {
+			klog.ErrorS(err, "Ignoring the Service's connection limits", "ServiceInfo", svcInfo.String())
+			return nil
+		}
+		p.connectionLimitsIDs[svcPortName] = id
+	}
+	return &agenttypes.ServiceConnectionLimits{
+		ID:                         id,
+		MaxConnections:             svcInfo.MaxConnections,
+		MaxNewConnectionsPerSecond: svcInfo.MaxNewConnectionsPerSecond,
+	}
+}
+
+// installServiceConnectionLimits installs the given connection limits of a Service, and removes the previously
+// installed ones if they are no longer used. It must be called after the flows of the Service using the previous limits
+// are removed, and before the flows using the new limits are installed.
+func (p *proxier) installServiceConnectionLimits(svcPortName k8sproxy.ServicePortName, limits *agenttypes.ServiceConnectionLimits) bool {
+	if limits == nil {
+		return p.removeServiceConnectionLimits(svcPortName)
+	}
+	if err := p.ofClient.InstallServiceConnectionLimits(limits, p.isIPv6); err != nil {
+		klog.ErrorS(err, "Error when installing connection limits for Service", "ServicePortName", svcPortName)
+		return false
+	}
+	p.connectionLimitsInstalled[svcPortName] = limits
+	return true
+}
+
+// removeServiceConnectionLimits removes the installed connection limits of a Service, and releases their ID. It must be
+// called after the flows of the Service using the limits are removed.
+func (p *proxier) removeServiceConnectionLimits(svcPortName k8sproxy.ServicePortName) bool {
+	if installedLimits, ok := p.connectionLimitsInstalled[svcPortName]; ok {
+		if err := p.ofClient.UninstallServiceConnectionLimits(installedLimits.ID); err != nil {
+			klog.ErrorS(err, "Error when uninstalling connection limits for Service", "ServicePortName", svcPortName)
+			return false
+		}
+		delete(p.connectionLimitsInstalled, svcPortName)
+	}
+	if id, ok := p.connectionLimitsIDs[svcPortName]; ok {
+		p.connectionLimitsIDAllocator.release(id)
+		delete(p.connectionLimitsIDs, svcPortName)
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/proxy/types"
+	antreatypes "antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+func TestServiceConnectionLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient, mockRouteClient := getMockClients(ctrl)
+	fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+	fp.supportConnectionLimits = true
+
+	svc := makeTestService(svcPortName.Namespace, svcPortName.Name, func(svc *corev1.Service) {
+		svc.Annotations = map[string]string{
+			antreatypes.ServiceMaxConnectionsAnnotationKey:             "1000",
+			antreatypes.ServiceMaxNewConnectionsPerSecondAnnotationKey: "100",
+		}
+		svc.Spec.ClusterIP = svc1IPv4.String()
+		svc.Spec.ClusterIPs = []string{svc1IPv4.String()}
+		svc.Spec.Ports = []corev1.ServicePort{{
+			Name:     svcPortName.Port,
+			Port:     int32(svcPort),
+			Protocol: corev1.ProtocolTCP,
+		}}
+	})
+	ep1, epPort := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep1IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep1}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnServiceUpdate(nil, svc)
+	fp.OnServiceSynced()
+	fp.OnEndpointSliceUpdate(nil, eps)
+	fp.OnEndpointsSynced()
+
+	expectedEp1 := k8sproxy.NewBaseEndpointInfo(ep1IPv4.String(), "", "", svcPort, false, true, true, false, nil)
+	expectedLimits := &antreatypes.ServiceConnectionLimits{ID: 1, MaxConnections: 1000, MaxNewConnectionsPerSecond: 100}
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{expectedEp1}, nil)
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, []k8sproxy.Endpoint{expectedEp1})
+	mockOFClient.EXPECT().InstallServiceConnectionLimits(expectedLimits, false)
+	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
+		ServiceIP:        svc1IPv4,
+		ServicePort:      uint16(svcPort),
+		Protocol:         binding.ProtocolTCP,
+		ClusterGroupID:   1,
+		ConnectionLimits: expectedLimits,
+	})
+	fp.syncProxyRules()
+
+	// The Service flows are reinstalled when the limits are updated.
+	updatedSvc := svc.DeepCopy()
+	delete(updatedSvc.Annotations, antreatypes.ServiceMaxConnectionsAnnotationKey)
+	fp.OnServiceUpdate(svc, updatedSvc)
+	expectedLimits = &antreatypes.ServiceConnectionLimits{ID: 1, MaxNewConnectionsPerSecond: 100}
+	mockOFClient.EXPECT().UninstallServiceFlows(svc1IPv4, uint16(svcPort), binding.ProtocolTCP)
+	mockOFClient.EXPECT().InstallServiceConnectionLimits(expectedLimits, false)
+	mockOFClient.EXPECT().InstallServiceFlows(gomock.Any())
+	fp.syncProxyRules()
+	assert.Equal(t, expectedLimits, fp.connectionLimitsInstalled[svcPortName])
+
+	// The limits are uninstalled when all the annotations are removed.
+	svc, updatedSvc = updatedSvc, updatedSvc.DeepCopy()
+	updatedSvc.Annotations = nil
+	fp.OnServiceUpdate(svc, updatedSvc)
+	mockOFClient.EXPECT().UninstallServiceFlows(svc1IPv4, uint16(svcPort), binding.ProtocolTCP)
+	mockOFClient.EXPECT().UninstallServiceConnectionLimits(uint32(1))
+	mockOFClient.EXPECT().InstallServiceFlows(gomock.Any())
+	fp.syncProxyRules()
+	assert.NotContains(t, fp.connectionLimitsInstalled, svcPortName)
+}
+
+func TestRemoveStaleServiceConnectionLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient, mockRouteClient := getMockClients(ctrl)
+	fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+	fp.supportConnectionLimits = true
+
+	svc := makeTestService(svcPortName.Namespace, svcPortName.Name, func(svc *corev1.Service) {
+		svc.Annotations = map[string]string{
+			antreatypes.ServiceMaxConnectionsAnnotationKey: "1000",
+		}
+		svc.Spec.ClusterIP = svc1IPv4.String()
+		svc.Spec.ClusterIPs = []string{svc1IPv4.String()}
+		svc.Spec.Ports = []corev1.ServicePort{{
+			Name:     svcPortName.Port,
+			Port:     int32(svcPort),
+			Protocol: corev1.ProtocolTCP,
+		}}
+	})
+	ep1, epPort := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep1IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep1}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnServiceUpdate(nil, svc)
+	fp.OnServiceSynced()
+	fp.OnEndpointSliceUpdate(nil, eps)
+	fp.OnEndpointsSynced()
+
+	expectedLimits := &antreatypes.ServiceConnectionLimits{ID: 1, MaxConnections: 1000}
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceConnectionLimits(expectedLimits, false)
+	mockOFClient.EXPECT().InstallServiceFlows(gomock.Any())
+	fp.syncProxyRules()
+
+	// The Service is kept when the limits fail to be uninstalled, and the removal is retried in the next sync.
+	fp.OnServiceUpdate(svc, nil)
+	mockOFClient.EXPECT().UninstallServiceFlows(svc1IPv4, uint16(svcPort), binding.ProtocolTCP)
+	mockOFClient.EXPECT().UninstallServiceConnectionLimits(uint32(1)).Return(fmt.Errorf("error"))
+	fp.syncProxyRules()
+	assert.Contains(t, fp.serviceInstalledMap, svcPortName)
+	assert.Contains(t, fp.connectionLimitsInstalled, svcPortName)
+	assert.Contains(t, fp.connectionLimitsIDs, svcPortName)
+
+	mockOFClient.EXPECT().UninstallServiceFlows(svc1IPv4, uint16(svcPort), binding.ProtocolTCP)
+	mockOFClient.EXPECT().UninstallServiceConnectionLimits(uint32(1))
+	mockOFClient.EXPECT().UninstallServiceGroup(binding.GroupIDType(1))
+	mockOFClient.EXPECT().UninstallEndpointFlows(binding.ProtocolTCP, gomock.Any())
+	fp.syncProxyRules()
+	assert.NotContains(t, fp.serviceInstalledMap, svcPortName)
+	assert.NotContains(t, fp.connectionLimitsInstalled, svcPortName)
+	assert.NotContains(t, fp.connectionLimitsIDs, svcPortName)
+}
+
+func TestGetServiceConnectionLimits(t *testing.T) {
+	svcPortName1 := makeSvcPortName("ns", "svc1", "80", corev1.ProtocolTCP)
+	svcPortName2 := makeSvcPortName("ns", "svc2", "80", corev1.ProtocolTCP)
+	p := &proxier{
+		supportConnectionLimits:     true,
+		connectionLimitsIDs:         map[k8sproxy.ServicePortName]uint32{},
+		connectionLimitsIDAllocator: newConnectionLimitsIDAllocator(),
+	}
+
+	assert.Nil(t, p.getServiceConnectionLimits(svcPortName1, &types.ServiceInfo{}))
+	assert.Empty(t, p.connectionLimitsIDs)
+
+	assert.Equal(t, &antreatypes.ServiceConnectionLimits{ID: 1, MaxConnections: 1000},
+		p.getServiceConnectionLimits(svcPortName1, &types.ServiceInfo{MaxConnections: 1000}))
+	assert.Equal(t, &antreatypes.ServiceConnectionLimits{ID: 2, MaxNewConnectionsPerSecond: 100},
+		p.getServiceConnectionLimits(svcPortName2, &types.ServiceInfo{MaxNewConnectionsPerSecond: 100}))
+	// The ID allocated for a Service port is kept when its limits are updated.
+	assert.Equal(t, &antreatypes.ServiceConnectionLimits{ID: 1, MaxConnections: 1000, MaxNewConnectionsPerSecond: 100},
+		p.getServiceConnectionLimits(svcPortName1, &types.ServiceInfo{MaxConnections: 1000, MaxNewConnectionsPerSecond: 100}))
+
+	// The limits are ignored when no ID is available.
+	p.connectionLimitsIDAllocator.nextID = antreatypes.MaxServiceConnectionLimitsID + 1
+	svcPortName3 := makeSvcPortName("ns", "svc3", "80", corev1.ProtocolTCP)
+	assert.Nil(t, p.getServiceConnectionLimits(svcPortName3, &types.ServiceInfo{MaxConnections: 1000}))
+	assert.NotContains(t, p.connectionLimitsIDs, svcPortName3)
+
+	// A released ID is reused.
+	assert.True(t, p.removeServiceConnectionLimits(svcPortName2))
+	assert.Equal(t, &antreatypes.ServiceConnectionLimits{ID: 2, MaxConnections: 1000},
+		p.getServiceConnectionLimits(svcPortName3, &types.ServiceInfo{MaxConnections: 1000}))
+}
@@ -0,0 +1,324 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package endpointprober implements active health checking of Service Endpoints. The antrea-agent probes the Endpoints
+// of the Services which enable health checking, so that Endpoints which are ready according to kubelet but fail to
+// serve traffic can be removed from the OVS groups of the Services.
+package endpointprober
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	kmetrics "k8s.io/component-base/metrics"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/proxy/metrics"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+const (
+	// probeInterval is the interval between two probes of an Endpoint.
+	probeInterval = 5 * time.Second
+	// probeTimeout is the timeout of a probe.
+	probeTimeout = 2 * time.Second
+	// failureThreshold is the number of consecutive failed probes after which an Endpoint is considered unhealthy. A
+	// single successful probe makes the Endpoint healthy again.
+	failureThreshold = 3
+)
+
+type ProbeType string
+
+const (
+	ProbeTypeTCP  ProbeType = "TCP"
+	ProbeTypeHTTP ProbeType = "HTTP"
+)
+
+// ProbeConfig describes how the Endpoints of a Service are probed.
+type ProbeConfig struct {
+	Type ProbeType
+	// Path is the path of the HTTP request, only used by HTTP probes.
+	Path string
+}
+
+func (c ProbeConfig) String() string {
+	if c.Type == ProbeTypeHTTP {
+		return fmt.Sprintf("%s %s", c.Type, c.Path)
+	}
+	return string(c.Type)
+}
+
+// ParseProbeConfig parses a probe config in the format "tcp", "http" or "http:<path>". The path of an HTTP probe
+// defaults to "/".
+func ParseProbeConfig(configStr string) (*ProbeConfig, error) {
+	probeType, path, hasPath := strings.Cut(strings.TrimSpace(configStr), ":")
+	switch strings.ToLower(probeType) {
+	case "tcp":
+		if hasPath {
+			return nil, fmt.Errorf("path is not supported by TCP probes")
+		}
+		return &ProbeConfig{Type: ProbeTypeTCP}, nil
+	case "http":
+		if !hasPath {
+			path = "/"
+		}
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("path %q of HTTP probe must start with \"/\"", path)
+		}
+		return &ProbeConfig{Type: ProbeTypeHTTP, Path: path}, nil
+	}
+	return nil, fmt.Errorf("unsupported probe type %q, it must be tcp or http", probeType)
+}
+
+// Status is the health status of an Endpoint of a Service.
+type Status struct {
+	ServicePortName k8sproxy.ServicePortName
+	Endpoint        string
+	Config          ProbeConfig
+	Healthy         bool
+	// ConsecutiveFailures is the number of consecutive failed probes.
+	ConsecutiveFailures int
+	// LastProbeTime is the time of the last probe, zero if the Endpoint has not been probed yet.
+	LastProbeTime time.Time
+	// LastError is the error of the last probe, empty if it succeeded.
+	LastError string
+}
+
+type targetKey struct {
+	svcPortName k8sproxy.ServicePortName
+	endpoint    string
+}
+
+type target struct {
+	config ProbeConfig
+	stopCh chan struct{}
+	// The following fields are protected by the mutex of the Prober.
+	healthy             bool
+	consecutiveFailures int
+	lastProbeTime       time.Time
+	lastError           string
+}
+
+type probeFunc func(ctx context.Context, config ProbeConfig, endpoint string) error
+
+// Prober periodically probes the Endpoints of Services, and keeps track of their health status. Endpoints are
+// considered healthy until they fail failureThreshold consecutive probes.
+type Prober struct {
+	mutex   sync.RWMutex
+	targets map[targetKey]*target
+	// onChange is called when the health status of an Endpoint changes.
+	onChange func()
+	probe    probeFunc
+	clock    clock.WithTicker
+	isIPv6   bool
+}
+
+func NewProber(onChange func(), isIPv6 bool) *Prober {
+	return newProber(onChange, isIPv6, probeEndpoint, clock.RealClock{})
+}
+
+func newProber(onChange func(), isIPv6 bool, probe probeFunc, clock clock.WithTicker) *Prober {
+	return &Prober{
+		targets:  map[targetKey]*target{},
+		onChange: onChange,
+		probe:    probe,
+		clock:    clock,
+		isIPv6:   isIPv6,
+	}
+}
+
+// UpdateTargets sets the Endpoints of a Service to probe with the given config. It starts probing the new Endpoints,
+// and stops probing the Endpoints which are no longer given. If config is nil, all Endpoints of the Service stop being
+// probed. The health status of an Endpoint is reset when its config changes.
+func (p *Prober) UpdateTargets(svcPortName k8sproxy.ServicePortName, config *ProbeConfig, endpoints []k8sproxy.Endpoint) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	current := map[string]struct{}{}
+	if config != nil {
+		for _, endpoint := range endpoints {
+			current[endpoint.String()] = struct{}{}
+		}
+	}
+	for key, t := range p.targets {
+		if key.svcPortName != svcPortName {
+			continue
+		}
+		if _, ok := current[key.endpoint]; ok && t.config == *config {
+			delete(current, key.endpoint)
+			continue
+		}
+		p.deleteTarget(key, t)
+	}
+	for endpoint := range current {
+		key := targetKey{svcPortName: svcPortName, endpoint: endpoint}
+		t := &target{config: *config, stopCh: make(chan struct{}), healthy: true}
+		p.targets[key] = t
+		klog.V(2).InfoS("Start probing Endpoint", "ServicePortName", svcPortName, "endpoint", endpoint, "probe", config)
+		go p.run(key, t)
+	}
+}
+
+// deleteTarget must be called with the mutex held.
+func (p *Prober) deleteTarget(key targetKey, t *target) {
+	klog.V(2).InfoS("Stop probing Endpoint", "ServicePortName", key.svcPortName, "endpoint", key.endpoint)
+	close(t.stopCh)
+	if !t.healthy {
+		p.unhealthyEndpointsGauge().Dec()
+	}
+	delete(p.targets, key)
+}
+
+// IsHealthy returns whether the Endpoint of the Service is healthy. Endpoints which are not probed are healthy.
+func (p *Prober) IsHealthy(svcPortName k8sproxy.ServicePortName, endpoint string) bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	t, ok := p.targets[targetKey{svcPortName: svcPortName, endpoint: endpoint}]
+	return !ok || t.healthy
+}
+
+// GetStatuses returns the health status of all the probed Endpoints, sorted by Service and Endpoint.
+func (p *Prober) GetStatuses() []Status {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	statuses := make([]Status, 0, len(p.targets))
+	for key, t := range p.targets {
+		statuses = append(statuses, Status{
+			ServicePortName:     key.svcPortName,
+			Endpoint:            key.endpoint,
+			Config:              t.config,
+			Healthy:             t.healthy,
+			ConsecutiveFailures: t.consecutiveFailures,
+			LastProbeTime:       t.lastProbeTime,
+			LastError:           t.lastError,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].ServicePortName.String() != statuses[j].ServicePortName.String() {
+			return statuses[i].ServicePortName.String() < statuses[j].ServicePortName.String()
+		}
+		return statuses[i].Endpoint < statuses[j].Endpoint
+	})
+	return statuses
+}
+
+func (p *Prober) run(key targetKey, t *target) {
+	ticker := p.clock.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		p.probeTarget(key, t)
+		select {
+		case <-t.stopCh:
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+func (p *Prober) probeTarget(key targetKey, t *target) {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	err := p.probe(ctx, t.config, key.endpoint)
+	p.probeCounter(err == nil).Inc()
+
+	p.mutex.Lock()
+	select {
+	case <-t.stopCh:
+		// The target has been deleted while it was being probed.
+		p.mutex.Unlock()
+		return
+	default:
+	}
+	wasHealthy := t.healthy
+	t.lastProbeTime = p.clock.Now()
+	if err == nil {
+		t.consecutiveFailures = 0
+		t.lastError = ""
+		t.healthy = true
+	} else {
+		t.consecutiveFailures++
+		t.lastError = err.Error()
+		if t.consecutiveFailures >= failureThreshold {
+			t.healthy = false
+		}
+	}
+	changed := wasHealthy != t.healthy
+	if changed {
+		if t.healthy {
+			p.unhealthyEndpointsGauge().Dec()
+			klog.InfoS("Endpoint is healthy again", "ServicePortName", key.svcPortName, "endpoint", key.endpoint)
+		} else {
+			p.unhealthyEndpointsGauge().Inc()
+			klog.InfoS("Endpoint is unhealthy", "ServicePortName", key.svcPortName, "endpoint", key.endpoint, "failures", t.consecutiveFailures, "err", err)
+		}
+	}
+	p.mutex.Unlock()
+	if changed {
+		p.onChange()
+	}
+}
+
+func (p *Prober) unhealthyEndpointsGauge() *kmetrics.Gauge {
+	if p.isIPv6 {
+		return metrics.UnhealthyEndpointsV6
+	}
+	return metrics.UnhealthyEndpoints
+}
+
+func (p *Prober) probeCounter(success bool) kmetrics.CounterMetric {
+	result := "success"
+	if !success {
+		result = "failure"
+	}
+	if p.isIPv6 {
+		return metrics.EndpointProbesTotalV6.WithLabelValues(result)
+	}
+	return metrics.EndpointProbesTotal.WithLabelValues(result)
+}
+
+func probeEndpoint(ctx context.Context, config ProbeConfig, endpoint string) error {
+	switch config.Type {
+	case ProbeTypeTCP:
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", endpoint)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case ProbeTypeHTTP:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+endpoint+config.Path, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("User-Agent", "antrea-agent-endpoint-prober")
+		client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		// Like kubelet HTTP probes, any code greater than or equal to 200 and less than 400 indicates success.
+		if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("HTTP probe failed with status code %d", resp.StatusCode)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported probe type %s", config.Type)
+}
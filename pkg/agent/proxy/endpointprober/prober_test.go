@@ -0,0 +1,183 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpointprober
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+func TestParseProbeConfig(t *testing.T) {
+	tests := []struct {
+		config         string
+		expectedConfig *ProbeConfig
+		expectedErr    string
+	}{
+		{config: "tcp", expectedConfig: &ProbeConfig{Type: ProbeTypeTCP}},
+		{config: "TCP", expectedConfig: &ProbeConfig{Type: ProbeTypeTCP}},
+		{config: "http", expectedConfig: &ProbeConfig{Type: ProbeTypeHTTP, Path: "/"}},
+		{config: "http:/healthz?verbose=1", expectedConfig: &ProbeConfig{Type: ProbeTypeHTTP, Path: "/healthz?verbose=1"}},
+		{config: "tcp:/healthz", expectedErr: "path is not supported by TCP probes"},
+		{config: "http:healthz", expectedErr: "path \"healthz\" of HTTP probe must start with \"/\""},
+		{config: "udp", expectedErr: "unsupported probe type \"udp\", it must be tcp or http"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.config, func(t *testing.T) {
+			config, err := ParseProbeConfig(tt.config)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedConfig, config)
+			}
+		})
+	}
+}
+
+type fakeProbe struct {
+	mutex  sync.Mutex
+	errors map[string]error
+	probes chan string
+}
+
+func (f *fakeProbe) setError(endpoint string, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.errors[endpoint] = err
+}
+
+func (f *fakeProbe) probe(_ context.Context, _ ProbeConfig, endpoint string) error {
+	f.mutex.Lock()
+	err := f.errors[endpoint]
+	f.mutex.Unlock()
+	f.probes <- endpoint
+	return err
+}
+
+func TestProber(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	fp := &fakeProbe{errors: map[string]error{}, probes: make(chan string, 10)}
+	changes := make(chan struct{}, 10)
+	p := newProber(func() { changes <- struct{}{} }, false, fp.probe, fakeClock)
+
+	svcPortName := k8sproxy.ServicePortName{NamespacedName: apimachinerytypes.NamespacedName{Namespace: "ns1", Name: "svc1"}, Port: "http"}
+	ep1 := k8sproxy.NewBaseEndpointInfo("10.180.0.1", "", "", 80, false, true, true, false, nil)
+	ep2 := k8sproxy.NewBaseEndpointInfo("10.180.0.2", "", "", 80, false, true, true, false, nil)
+	config := &ProbeConfig{Type: ProbeTypeTCP}
+
+	// waitForProbes waits until each of the Endpoints has been probed once.
+	waitForProbes := func(n int) {
+		for i := 0; i < n; i++ {
+			select {
+			case <-fp.probes:
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "Timeout waiting for probes")
+			}
+		}
+	}
+	// step triggers the next probe of the Endpoints. The ticker of an Endpoint is created before its first probe, and
+	// buffers a tick if the Endpoint is still being probed.
+	step := func(n int) {
+		fakeClock.Step(probeInterval)
+		waitForProbes(n)
+	}
+
+	fp.setError(ep2.String(), fmt.Errorf("connection refused"))
+	p.UpdateTargets(svcPortName, config, []k8sproxy.Endpoint{ep1, ep2})
+	waitForProbes(2)
+	// The Endpoint is still healthy until it fails failureThreshold consecutive probes.
+	assert.True(t, p.IsHealthy(svcPortName, ep2.String()))
+	step(2)
+	assert.True(t, p.IsHealthy(svcPortName, ep2.String()))
+	step(2)
+	require.Eventually(t, func() bool { return len(changes) == 1 }, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, p.IsHealthy(svcPortName, ep2.String()))
+	assert.True(t, p.IsHealthy(svcPortName, ep1.String()))
+
+	statuses := p.GetStatuses()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, ep1.String(), statuses[0].Endpoint)
+	assert.True(t, statuses[0].Healthy)
+	assert.Equal(t, Status{
+		ServicePortName:     svcPortName,
+		Endpoint:            ep2.String(),
+		Config:              *config,
+		Healthy:             false,
+		ConsecutiveFailures: 3,
+		LastProbeTime:       fakeClock.Now(),
+		LastError:           "connection refused",
+	}, statuses[1])
+
+	// A single successful probe makes the Endpoint healthy again.
+	fp.setError(ep2.String(), nil)
+	step(2)
+	require.Eventually(t, func() bool { return len(changes) == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, p.IsHealthy(svcPortName, ep2.String()))
+
+	// Removed Endpoints are no longer probed.
+	p.UpdateTargets(svcPortName, config, []k8sproxy.Endpoint{ep1})
+	statuses = p.GetStatuses()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, ep1.String(), statuses[0].Endpoint)
+
+	// Changing the config restarts probing.
+	p.UpdateTargets(svcPortName, &ProbeConfig{Type: ProbeTypeHTTP, Path: "/"}, []k8sproxy.Endpoint{ep1})
+	waitForProbes(1)
+	assert.Equal(t, ProbeTypeHTTP, p.GetStatuses()[0].Config.Type)
+
+	p.UpdateTargets(svcPortName, nil, []k8sproxy.Endpoint{ep1})
+	assert.Empty(t, p.GetStatuses())
+	fakeClock.Step(probeInterval)
+	assert.Never(t, func() bool { return len(fp.probes) > 0 }, 100*time.Millisecond, 10*time.Millisecond, "Endpoints should no longer be probed")
+}
+
+func TestProbeEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+
+	// Get a port on which nothing listens.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedEndpoint := listener.Addr().String()
+	listener.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, probeEndpoint(ctx, ProbeConfig{Type: ProbeTypeTCP}, endpoint))
+	assert.Error(t, probeEndpoint(ctx, ProbeConfig{Type: ProbeTypeTCP}, closedEndpoint))
+	assert.NoError(t, probeEndpoint(ctx, ProbeConfig{Type: ProbeTypeHTTP, Path: "/healthz"}, endpoint))
+	assert.EqualError(t, probeEndpoint(ctx, ProbeConfig{Type: ProbeTypeHTTP, Path: "/"}, endpoint), "HTTP probe failed with status code 503")
+	assert.Error(t, probeEndpoint(ctx, ProbeConfig{Type: ProbeTypeHTTP, Path: "/healthz"}, closedEndpoint))
+}
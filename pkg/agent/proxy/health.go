@@ -0,0 +1,56 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/proxy/endpointprober"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+// endpointHealthChecker checks the health of the Endpoints of Services. It is implemented by endpointprober.Prober.
+type endpointHealthChecker interface {
+	UpdateTargets(svcPortName k8sproxy.ServicePortName, config *endpointprober.ProbeConfig, endpoints []k8sproxy.Endpoint)
+	IsHealthy(svcPortName k8sproxy.ServicePortName, endpoint string) bool
+	GetStatuses() []endpointprober.Status
+}
+
+// filterUnhealthyEndpoints removes the Endpoints which failed health checks from the given Endpoints of a Service, and
+// adds them to unhealthyEndpoints. If all the Endpoints are unhealthy, they are all kept, as it's better to keep
+// sending traffic to them than dropping it. nil is returned if endpoints is nil.
+func (p *proxier) filterUnhealthyEndpoints(svcPortName k8sproxy.ServicePortName, endpoints []k8sproxy.Endpoint, unhealthyEndpoints sets.Set[string]) []k8sproxy.Endpoint {
+	if len(endpoints) == 0 {
+		return endpoints
+	}
+	healthyEndpoints := make([]k8sproxy.Endpoint, 0, len(endpoints))
+	var unhealthy []string
+	for _, endpoint := range endpoints {
+		if p.endpointProber.IsHealthy(svcPortName, endpoint.String()) {
+			healthyEndpoints = append(healthyEndpoints, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint.String())
+		}
+	}
+	if len(healthyEndpoints) == 0 {
+		return endpoints
+	}
+	unhealthyEndpoints.Insert(unhealthy...)
+	return healthyEndpoints
+}
+
+func (p *proxier) GetEndpointHealthStatuses() []endpointprober.Status {
+	return p.endpointProber.GetStatuses()
+}
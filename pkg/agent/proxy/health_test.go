@@ -0,0 +1,112 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/proxy/endpointprober"
+	antreatypes "antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+type fakeHealthChecker struct {
+	configs   map[k8sproxy.ServicePortName]*endpointprober.ProbeConfig
+	unhealthy sets.Set[string]
+}
+
+func (f *fakeHealthChecker) UpdateTargets(svcPortName k8sproxy.ServicePortName, config *endpointprober.ProbeConfig, _ []k8sproxy.Endpoint) {
+	if config == nil {
+		delete(f.configs, svcPortName)
+		return
+	}
+	f.configs[svcPortName] = config
+}
+
+func (f *fakeHealthChecker) IsHealthy(svcPortName k8sproxy.ServicePortName, endpoint string) bool {
+	if _, ok := f.configs[svcPortName]; !ok {
+		return true
+	}
+	return !f.unhealthy.Has(endpoint)
+}
+
+func (f *fakeHealthChecker) GetStatuses() []endpointprober.Status {
+	return nil
+}
+
+func TestServiceEndpointHealthCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient, mockRouteClient := getMockClients(ctrl)
+	fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+	healthChecker := &fakeHealthChecker{configs: map[k8sproxy.ServicePortName]*endpointprober.ProbeConfig{}, unhealthy: sets.New[string]()}
+	fp.endpointProber = healthChecker
+
+	svc := makeTestService(svcPortName.Namespace, svcPortName.Name, func(svc *corev1.Service) {
+		svc.Annotations = map[string]string{antreatypes.ServiceEndpointHealthCheckAnnotationKey: "http:/healthz"}
+		svc.Spec.ClusterIP = svc1IPv4.String()
+		svc.Spec.ClusterIPs = []string{svc1IPv4.String()}
+		svc.Spec.Ports = []corev1.ServicePort{{
+			Name:     svcPortName.Port,
+			Port:     int32(svcPort),
+			Protocol: corev1.ProtocolTCP,
+		}}
+	})
+	ep1, epPort := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep1IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	ep2, _ := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep2IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep1, *ep2}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnServiceUpdate(nil, svc)
+	fp.OnServiceSynced()
+	fp.OnEndpointSliceUpdate(nil, eps)
+	fp.OnEndpointsSynced()
+
+	expectedEp1 := k8sproxy.NewBaseEndpointInfo(ep1IPv4.String(), "", "", svcPort, false, true, true, false, nil)
+	expectedEp2 := k8sproxy.NewBaseEndpointInfo(ep2IPv4.String(), "", "", svcPort, false, true, true, false, nil)
+	expectedEps := []k8sproxy.Endpoint{expectedEp1, expectedEp2}
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedEps), nil)
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, gomock.InAnyOrder(expectedEps))
+	mockOFClient.EXPECT().InstallServiceFlows(gomock.Any())
+	fp.syncProxyRules()
+	assert.Equal(t, &endpointprober.ProbeConfig{Type: endpointprober.ProbeTypeHTTP, Path: "/healthz"}, healthChecker.configs[svcPortName])
+
+	// An unhealthy Endpoint is removed from the group, but its flows are kept.
+	healthChecker.unhealthy.Insert(expectedEp2.String())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{expectedEp1}, nil)
+	fp.syncProxyRules()
+
+	// If all Endpoints are unhealthy, they are all kept in the group.
+	healthChecker.unhealthy.Insert(expectedEp1.String())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedEps), nil)
+	fp.syncProxyRules()
+
+	// The group doesn't change when the Endpoints become healthy again.
+	healthChecker.unhealthy.Clear()
+	fp.syncProxyRules()
+
+	// The Endpoints are no longer probed when the annotation is removed.
+	updatedSvc := svc.DeepCopy()
+	updatedSvc.Annotations = nil
+	fp.OnServiceUpdate(svc, updatedSvc)
+	healthChecker.unhealthy.Insert(expectedEp2.String())
+	fp.syncProxyRules()
+	assert.NotContains(t, healthChecker.configs, svcPortName)
+}
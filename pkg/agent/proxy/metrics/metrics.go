@@ -80,6 +80,57 @@ var (
 			Help:           "The cumulative number of Endpoint updates received by Antrea Proxy",
 		},
 	)
+	EndpointProbesTotal = kmetrics.NewCounterVec(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v4"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "total_endpoint_probes",
+			Help:           "The cumulative number of Endpoint health check probes run by Antrea Proxy, by result",
+		},
+		[]string{"result"},
+	)
+	UnhealthyEndpoints = kmetrics.NewGauge(
+		&kmetrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v4"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "unhealthy_endpoints",
+			Help:           "The number of Endpoints which are considered unhealthy by the health checks of Antrea Proxy",
+		},
+	)
+	RateLimitedConnectionsTotal = kmetrics.NewCounter(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v4"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "total_rate_limited_connections",
+			Help:           "The cumulative number of new connections to Services dropped by the connection rate limits of Antrea Proxy",
+		},
+	)
+	ConnectionLimitDroppedConnectionsTotal = kmetrics.NewCounter(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v4"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "total_connection_limit_dropped_connections",
+			Help:           "The cumulative number of new connections to Services dropped by the limits of concurrent connections of Antrea Proxy",
+		},
+	)
+	ConnectionLimitedServices = kmetrics.NewGauge(
+		&kmetrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v4"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "connection_limited_services",
+			Help:           "The number of Services which have reached their limits of concurrent connections on the Node",
+		},
+	)
 
 	SyncProxyDurationV6 = kmetrics.NewHistogram(
 		&kmetrics.HistogramOpts{
@@ -131,6 +182,57 @@ var (
 			Help:           "The cumulative number of Endpoint updates received by Antrea Proxy",
 		},
 	)
+	EndpointProbesTotalV6 = kmetrics.NewCounterVec(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v6"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "total_endpoint_probes",
+			Help:           "The cumulative number of Endpoint health check probes run by Antrea Proxy, by result",
+		},
+		[]string{"result"},
+	)
+	UnhealthyEndpointsV6 = kmetrics.NewGauge(
+		&kmetrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v6"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "unhealthy_endpoints",
+			Help:           "The number of Endpoints which are considered unhealthy by the health checks of Antrea Proxy",
+		},
+	)
+	RateLimitedConnectionsTotalV6 = kmetrics.NewCounter(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v6"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "total_rate_limited_connections",
+			Help:           "The cumulative number of new connections to Services dropped by the connection rate limits of Antrea Proxy",
+		},
+	)
+	ConnectionLimitDroppedConnectionsTotalV6 = kmetrics.NewCounter(
+		&kmetrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v6"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "total_connection_limit_dropped_connections",
+			Help:           "The cumulative number of new connections to Services dropped by the limits of concurrent connections of Antrea Proxy",
+		},
+	)
+	ConnectionLimitedServicesV6 = kmetrics.NewGauge(
+		&kmetrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemProxy,
+			ConstLabels:    map[string]string{"ip_family": "v6"},
+			StabilityLevel: kmetrics.ALPHA,
+			Name:           "connection_limited_services",
+			Help:           "The number of Services which have reached their limits of concurrent connections on the Node",
+		},
+	)
 )
 
 func Register() {
@@ -142,11 +244,21 @@ func Register() {
 			EndpointsInstalledTotal,
 			ServicesUpdatesTotal,
 			EndpointsUpdatesTotal,
+			EndpointProbesTotal,
+			UnhealthyEndpoints,
+			RateLimitedConnectionsTotal,
+			ConnectionLimitDroppedConnectionsTotal,
+			ConnectionLimitedServices,
 			SyncProxyDurationV6,
 			ServicesInstalledTotalV6,
 			EndpointsInstalledTotalV6,
 			ServicesUpdatesTotalV6,
 			EndpointsUpdatesTotalV6,
+			EndpointProbesTotalV6,
+			UnhealthyEndpointsV6,
+			RateLimitedConnectionsTotalV6,
+			ConnectionLimitDroppedConnectionsTotalV6,
+			ConnectionLimitedServicesV6,
 		)
 	})
 }
@@ -16,6 +16,7 @@ package proxy
 
 import (
 	"fmt"
+	"maps"
 	"math"
 	"net"
 	"reflect"
@@ -32,17 +33,20 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	utilnet "k8s.io/utils/net"
 	"k8s.io/utils/strings/slices"
 
 	agentconfig "antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/nodeip"
 	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/proxy/endpointprober"
 	"antrea.io/antrea/pkg/agent/proxy/metrics"
 	"antrea.io/antrea/pkg/agent/proxy/types"
 	"antrea.io/antrea/pkg/agent/route"
@@ -80,6 +84,8 @@ type Proxier interface {
 	// GetServiceByIP returns the ServicePortName struct for the given serviceString(ClusterIP:Port/Proto).
 	// False is returned if the serviceString is not found in serviceStringMap.
 	GetServiceByIP(serviceStr string) (k8sproxy.ServicePortName, bool)
+	// GetEndpointHealthStatuses returns the health status of the Endpoints probed by the health checks of Services.
+	GetEndpointHealthStatuses() []endpointprober.Status
 }
 
 type proxier struct {
@@ -108,6 +114,26 @@ type proxier struct {
 	// endpointsMap, nodeLabels, and endpointsInstalledMap, which can be read by
 	// GetServiceFlowKeys() called by the "/ovsflows" API handler.
 	serviceEndpointsMapsMutex sync.Mutex
+	// endpointStartTimes stores the time at which each reachable Endpoint of a Service was first seen, which is used
+	// to ramp up the weights of new Endpoints during slow-start.
+	endpointStartTimes map[k8sproxy.ServicePortName]map[string]time.Time
+	// endpointWeightsInstalled stores the Endpoint weights of the Service groups we actually installed.
+	endpointWeightsInstalled map[k8sproxy.ServicePortName]map[string]uint16
+	// weightsUpdateTimer triggers a sync when the weights of Endpoints in slow-start must be updated.
+	weightsUpdateTimer clock.Timer
+	clock              clock.WithDelayedExecution
+	// endpointProber probes the Endpoints of the Services which enable health checking.
+	endpointProber endpointHealthChecker
+	// unhealthyEndpointsInstalled stores the Endpoints excluded from the Service groups we actually installed because
+	// they failed health checks.
+	unhealthyEndpointsInstalled map[k8sproxy.ServicePortName]sets.Set[string]
+	// supportConnectionLimits indicates whether the connection limits of Services can be enforced by OVS.
+	supportConnectionLimits bool
+	// connectionLimitsInstalled stores the connection limits of the Services we actually installed.
+	connectionLimitsInstalled map[k8sproxy.ServicePortName]*agenttypes.ServiceConnectionLimits
+	// connectionLimitsIDs stores the IDs allocated for the connection limits of the Services.
+	connectionLimitsIDs         map[k8sproxy.ServicePortName]uint32
+	connectionLimitsIDAllocator *connectionLimitsIDAllocator
 	// endpointReferenceCounter stores the number of times an Endpoint is referenced by Services.
 	endpointReferenceCounter map[string]int
 	// groupCounter is used to allocate groupID.
@@ -191,6 +217,10 @@ func (p *proxier) removeStaleServices() {
 		if !p.removeServiceFlows(svcInfo) {
 			continue
 		}
+		// Remove the connection limits, which are no longer referenced by the Service flows.
+		if !p.removeServiceConnectionLimits(svcPortName) {
+			continue
+		}
 		// Remove Service group which has only local Endpoints.
 		if !p.removeServiceGroup(svcPortName, true) {
 			continue
@@ -213,6 +243,10 @@ func (p *proxier) removeStaleServices() {
 		}
 
 		delete(p.serviceInstalledMap, svcPortName)
+		delete(p.endpointStartTimes, svcPortName)
+		delete(p.endpointWeightsInstalled, svcPortName)
+		delete(p.unhealthyEndpointsInstalled, svcPortName)
+		p.endpointProber.UpdateTargets(svcPortName, nil, nil)
 		p.deleteServiceByIP(svcInfoStr)
 	}
 }
@@ -249,7 +283,7 @@ func (p *proxier) removeServiceFlows(svcInfo *types.ServiceInfo) bool {
 	return true
 }
 
-func (p *proxier) installServiceGroup(svcPortName k8sproxy.ServicePortName, needUpdate, local, withSessionAffinity bool, endpoints []k8sproxy.Endpoint) (binding.GroupIDType, bool) {
+func (p *proxier) installServiceGroup(svcPortName k8sproxy.ServicePortName, needUpdate, local, withSessionAffinity, consistentHashing bool, endpoints []k8sproxy.Endpoint, weights map[string]uint16) (binding.GroupIDType, bool) {
 	groupID, exists := p.groupCounter.Get(svcPortName, local)
 	if exists && !needUpdate {
 		return groupID, true
@@ -264,7 +298,7 @@ func (p *proxier) installServiceGroup(svcPortName k8sproxy.ServicePortName, need
 			}
 		}()
 	}
-	if err := p.ofClient.InstallServiceGroup(groupID, withSessionAffinity, endpoints); err != nil {
+	if err := p.ofClient.InstallServiceGroup(groupID, withSessionAffinity, consistentHashing, endpoints, weights); err != nil {
 		klog.ErrorS(err, "Error when installing group of Endpoints for Service", "ServicePortName", svcPortName, "local", local)
 		return 0, false
 	}
@@ -541,7 +575,7 @@ func smallSliceSame(s1, s2 []string) []string {
 	return same
 }
 
-func (p *proxier) installNodePortService(localGroupID, clusterGroupID binding.GroupIDType, svcPort uint16, protocol binding.Protocol, trafficPolicyLocal bool, affinityTimeout uint16) error {
+func (p *proxier) installNodePortService(localGroupID, clusterGroupID binding.GroupIDType, svcPort uint16, protocol binding.Protocol, trafficPolicyLocal bool, affinityTimeout uint16, connectionLimits *agenttypes.ServiceConnectionLimits) error {
 	if svcPort == 0 {
 		return nil
 	}
@@ -561,6 +595,7 @@ func (p *proxier) installNodePortService(localGroupID, clusterGroupID binding.Gr
 		IsNodePort:         true,
 		IsNested:           false, // Unsupported for NodePort
 		IsDSR:              false, // Unsupported because external traffic has been DNAT'd in host network before it's forwarded to OVS.
+		ConnectionLimits:   connectionLimits,
 	}); err != nil {
 		return fmt.Errorf("failed to install NodePort load balancing OVS flows: %w", err)
 	}
@@ -595,7 +630,8 @@ func (p *proxier) installExternalIPService(svcInfoStr string,
 	protocol binding.Protocol,
 	trafficPolicyLocal bool,
 	affinityTimeout uint16,
-	loadBalancerMode agentconfig.LoadBalancerMode) error {
+	loadBalancerMode agentconfig.LoadBalancerMode,
+	connectionLimits *agenttypes.ServiceConnectionLimits) error {
 	for _, externalIP := range externalIPStrings {
 		ip := net.ParseIP(externalIP)
 		if err := p.ofClient.InstallServiceFlows(&agenttypes.ServiceConfig{
@@ -610,6 +646,7 @@ func (p *proxier) installExternalIPService(svcInfoStr string,
 			IsNodePort:         false,
 			IsNested:           false, // Unsupported for ExternalIP
 			IsDSR:              features.DefaultFeatureGate.Enabled(features.LoadBalancerModeDSR) && loadBalancerMode == agentconfig.LoadBalancerModeDSR,
+			ConnectionLimits:   connectionLimits,
 		}); err != nil {
 			return fmt.Errorf("failed to install ExternalIP load balancing OVS flows: %w", err)
 		}
@@ -641,7 +678,8 @@ func (p *proxier) installLoadBalancerService(svcInfoStr string,
 	protocol binding.Protocol,
 	trafficPolicyLocal bool,
 	affinityTimeout uint16,
-	loadBalancerMode agentconfig.LoadBalancerMode) error {
+	loadBalancerMode agentconfig.LoadBalancerMode,
+	connectionLimits *agenttypes.ServiceConnectionLimits) error {
 	for _, ingress := range loadBalancerIPStrings {
 		if ingress != "" {
 			ip := net.ParseIP(ingress)
@@ -657,6 +695,7 @@ func (p *proxier) installLoadBalancerService(svcInfoStr string,
 				IsNodePort:         false,
 				IsNested:           false, // Unsupported for LoadBalancerIP
 				IsDSR:              features.DefaultFeatureGate.Enabled(features.LoadBalancerModeDSR) && loadBalancerMode == agentconfig.LoadBalancerModeDSR,
+				ConnectionLimits:   connectionLimits,
 			}); err != nil {
 				return fmt.Errorf("failed to install LoadBalancerIP load balancing OVS flows: %w", err)
 			}
@@ -688,6 +727,11 @@ func (p *proxier) uninstallLoadBalancerService(svcInfoStr string, loadBalancerIP
 }
 
 func (p *proxier) installServices() {
+	// nextWeightsUpdate is the duration after which the weights of Endpoints in slow-start must be updated.
+	var nextWeightsUpdate time.Duration
+	defer func() {
+		p.scheduleWeightsUpdate(nextWeightsUpdate)
+	}()
 	for svcPortName, svcPort := range p.serviceMap {
 		svcInfo := svcPort.(*types.ServiceInfo)
 		svcInfoStr := svcInfo.String()
@@ -716,7 +760,8 @@ func (p *proxier) installServices() {
 			needUpdateServiceExternalAddresses = serviceExternalAddressesChanged(svcInfo, pSvcInfo)
 			needUpdateEndpoints = pSvcInfo.SessionAffinityType() != svcInfo.SessionAffinityType() ||
 				pSvcInfo.ExternalPolicyLocal() != svcInfo.ExternalPolicyLocal() ||
-				pSvcInfo.InternalPolicyLocal() != svcInfo.InternalPolicyLocal()
+				pSvcInfo.InternalPolicyLocal() != svcInfo.InternalPolicyLocal() ||
+				pSvcInfo.ConsistentHashing != svcInfo.ConsistentHashing
 			if p.cleanupStaleUDPSvcConntrack && needClearConntrackEntries(pSvcInfo.OFProtocol) {
 				// We clean the UDP conntrack entries for the following Service update cases:
 				// - Service port changed, clean the conntrack entries matched by each of the current clusterIP / externalIPs
@@ -745,11 +790,28 @@ func (p *proxier) installServices() {
 		}
 
 		clusterEndpoints, localEndpoints, allReachableEndpoints := p.categorizeEndpoints(endpointsToInstall, svcInfo)
+		// Endpoints failing health checks are removed from the groups, but their flows are kept for the existing
+		// connections.
+		p.endpointProber.UpdateTargets(svcPortName, svcInfo.HealthCheck, allReachableEndpoints)
+		unhealthyEndpoints := sets.New[string]()
+		clusterEndpoints = p.filterUnhealthyEndpoints(svcPortName, clusterEndpoints, unhealthyEndpoints)
+		localEndpoints = p.filterUnhealthyEndpoints(svcPortName, localEndpoints, unhealthyEndpoints)
+		if !unhealthyEndpoints.Equal(p.unhealthyEndpointsInstalled[svcPortName]) {
+			needUpdateEndpoints = true
+		}
 		// Get the stale Endpoints and new Endpoints based on the diff of endpointsInstalled and allReachableEndpoints.
 		staleEndpoints, newEndpoints := compareEndpoints(endpointsInstalled, allReachableEndpoints)
 		if len(staleEndpoints) > 0 || len(newEndpoints) > 0 {
 			needUpdateEndpoints = true
 		}
+		p.updateEndpointStartTimes(svcPortName, allReachableEndpoints)
+		endpointWeights, weightsUpdateAfter := p.getEndpointWeights(svcPortName, svcInfo, allReachableEndpoints)
+		if weightsUpdateAfter > 0 && (nextWeightsUpdate == 0 || weightsUpdateAfter < nextWeightsUpdate) {
+			nextWeightsUpdate = weightsUpdateAfter
+		}
+		if !maps.Equal(endpointWeights, p.endpointWeightsInstalled[svcPortName]) {
+			needUpdateEndpoints = true
+		}
 		// We also clean the conntrack entries related to the stale Endpoints for a UDP Service. Conntrack entries
 		// matched by each of stale Endpoint IPs and each of the remaining Service IPs and ports will be deleted.
 		if len(staleEndpoints) > 0 && needClearConntrackEntries(svcInfo.OFProtocol) {
@@ -772,7 +834,7 @@ func (p *proxier) installServices() {
 		// Note that nil represents the group should not exist and empty represents the group should exist but there is
 		// no available Endpoints.
 		if localEndpoints != nil {
-			if localGroupID, ok = p.installServiceGroup(svcPortName, needUpdateEndpoints, true, withSessionAffinity, localEndpoints); !ok {
+			if localGroupID, ok = p.installServiceGroup(svcPortName, needUpdateEndpoints, true, withSessionAffinity, svcInfo.ConsistentHashing, localEndpoints, endpointWeights); !ok {
 				continue
 			}
 		} else {
@@ -781,7 +843,7 @@ func (p *proxier) installServices() {
 			}
 		}
 		if clusterEndpoints != nil {
-			if clusterGroupID, ok = p.installServiceGroup(svcPortName, needUpdateEndpoints, false, withSessionAffinity, clusterEndpoints); !ok {
+			if clusterGroupID, ok = p.installServiceGroup(svcPortName, needUpdateEndpoints, false, withSessionAffinity, svcInfo.ConsistentHashing, clusterEndpoints, endpointWeights); !ok {
 				continue
 			}
 		} else {
@@ -789,6 +851,14 @@ func (p *proxier) installServices() {
 				continue
 			}
 		}
+		p.endpointWeightsInstalled[svcPortName] = endpointWeights
+		p.unhealthyEndpointsInstalled[svcPortName] = unhealthyEndpoints
+
+		// The connection limits are referenced by all the Service flows.
+		connectionLimits := p.getServiceConnectionLimits(svcPortName, svcInfo)
+		if !reflect.DeepEqual(connectionLimits, p.connectionLimitsInstalled[svcPortName]) {
+			needUpdateService = true
+		}
 
 		if needUpdateService {
 			// Delete previous flows.
@@ -797,11 +867,14 @@ func (p *proxier) installServices() {
 					continue
 				}
 			}
-			if !p.installServiceFlows(svcInfo, localGroupID, clusterGroupID) {
+			if !p.installServiceConnectionLimits(svcPortName, connectionLimits) {
+				continue
+			}
+			if !p.installServiceFlows(svcInfo, localGroupID, clusterGroupID, connectionLimits) {
 				continue
 			}
 		} else if needUpdateServiceExternalAddresses {
-			if !p.updateServiceExternalAddresses(pSvcInfo, svcInfo, localGroupID, clusterGroupID) {
+			if !p.updateServiceExternalAddresses(pSvcInfo, svcInfo, localGroupID, clusterGroupID, connectionLimits) {
 				continue
 			}
 		}
@@ -850,7 +923,7 @@ func getAffinityTimeout(svcInfo *types.ServiceInfo) uint16 {
 	return uint16(affinityTimeout)
 }
 
-func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType) bool {
+func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType, connectionLimits *agenttypes.ServiceConnectionLimits) bool {
 	svcInfoStr := svcInfo.String()
 	svcPort := uint16(svcInfo.Port())
 	svcProto := svcInfo.OFProtocol
@@ -877,25 +950,26 @@ func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID,
 		IsNodePort:         false,
 		IsNested:           isNestedService,
 		IsDSR:              false, // not applicable for ClusterIP
+		ConnectionLimits:   connectionLimits,
 	}); err != nil {
 		klog.ErrorS(err, "Error when installing ClusterIP flows for Service", "ServiceInfo", svcInfoStr)
 		return false
 	}
 	if p.proxyAll {
 		// Install NodePort flows and configurations.
-		if err := p.installNodePortService(localGroupID, clusterGroupID, uint16(svcInfo.NodePort()), svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout); err != nil {
+		if err := p.installNodePortService(localGroupID, clusterGroupID, uint16(svcInfo.NodePort()), svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, connectionLimits); err != nil {
 			klog.ErrorS(err, "Error when installing NodePort flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
 		// Install ExternalIP flows and configurations.
-		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.ExternalIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.ExternalIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, connectionLimits); err != nil {
 			klog.ErrorS(err, "Error when installing ExternalIP flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
 	}
 	// Install LoadBalancer flows and configurations.
 	if p.proxyLoadBalancerIPs {
-		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.LoadBalancerIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.LoadBalancerIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, connectionLimits); err != nil {
 			klog.ErrorS(err, "Error when installing LoadBalancer flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
@@ -903,7 +977,7 @@ func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID,
 	return true
 }
 
-func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType) bool {
+func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType, connectionLimits *agenttypes.ServiceConnectionLimits) bool {
 	pSvcInfoStr := pSvcInfo.String()
 	svcInfoStr := svcInfo.String()
 	pSvcPort := uint16(pSvcInfo.Port())
@@ -920,7 +994,7 @@ func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.Servic
 				klog.ErrorS(err, "Error when uninstalling NodePort flows and configurations for Service", "ServiceInfo", pSvcInfoStr)
 				return false
 			}
-			if err := p.installNodePortService(localGroupID, clusterGroupID, svcNodePort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout); err != nil {
+			if err := p.installNodePortService(localGroupID, clusterGroupID, svcNodePort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, connectionLimits); err != nil {
 				klog.ErrorS(err, "Error when installing NodePort flows and configurations for Service", "ServiceInfo", svcInfoStr)
 				return false
 			}
@@ -931,7 +1005,7 @@ func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.Servic
 			klog.ErrorS(err, "Error when uninstalling ExternalIP flows and configurations for Service", "ServiceInfo", pSvcInfoStr)
 			return false
 		}
-		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, addedExternalIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, addedExternalIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, connectionLimits); err != nil {
 			klog.ErrorS(err, "Error when installing ExternalIP flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
@@ -943,7 +1017,7 @@ func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.Servic
 			klog.ErrorS(err, "Error when uninstalling LoadBalancer flows and configurations for Service", "ServiceInfo", pSvcInfoStr)
 			return false
 		}
-		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, addedLoadBalancerIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, addedLoadBalancerIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, connectionLimits); err != nil {
 			klog.ErrorS(err, "Error when installing LoadBalancer flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
@@ -1418,6 +1492,14 @@ func newProxier(
 		endpointsInstalledMap:             types.EndpointsMap{},
 		endpointsMap:                      types.EndpointsMap{},
 		endpointReferenceCounter:          map[string]int{},
+		endpointStartTimes:                map[k8sproxy.ServicePortName]map[string]time.Time{},
+		endpointWeightsInstalled:          map[k8sproxy.ServicePortName]map[string]uint16{},
+		unhealthyEndpointsInstalled:       map[k8sproxy.ServicePortName]sets.Set[string]{},
+		supportConnectionLimits:           openflow.OVSMetersAreSupported(),
+		connectionLimitsInstalled:         map[k8sproxy.ServicePortName]*agenttypes.ServiceConnectionLimits{},
+		connectionLimitsIDs:               map[k8sproxy.ServicePortName]uint32{},
+		connectionLimitsIDAllocator:       newConnectionLimitsIDAllocator(),
+		clock:                             clock.RealClock{},
 		nodeLabels:                        map[string]string{},
 		serviceStringMap:                  map[string]k8sproxy.ServicePortName{},
 		groupCounter:                      groupCounter,
@@ -1440,6 +1522,8 @@ func newProxier(
 
 	p.serviceConfig.RegisterEventHandler(p)
 	p.runner = k8sproxy.NewBoundedFrequencyRunner(componentName, p.syncProxyRules, time.Second, 30*time.Second, 2)
+	// The groups of a Service must be updated when the health status of its Endpoints changes.
+	p.endpointProber = endpointprober.NewProber(func() { p.runner.Run() }, isIPv6)
 	if endpointSliceEnabled {
 		p.endpointSliceConfig = config.NewEndpointSliceConfig(endpointSliceInformer, resyncPeriod)
 		p.endpointSliceConfig.RegisterEventHandler(p)
@@ -1474,6 +1558,10 @@ func (p *metaProxierWrapper) GetServiceFlowKeys(serviceName, namespace string) (
 	return append(v4Flows, v6Flows...), append(v4Groups, v6Groups...), v4Found || v6Found
 }
 
+func (p *metaProxierWrapper) GetEndpointHealthStatuses() []endpointprober.Status {
+	return append(p.ipv4Proxier.GetEndpointHealthStatuses(), p.ipv6Proxier.GetEndpointHealthStatuses()...)
+}
+
 func (p *metaProxierWrapper) GetServiceByIP(serviceStr string) (k8sproxy.ServicePortName, bool) {
 	// Format of serviceStr is <clusterIP>:<svcPort>/<protocol>.
 	lastColonIndex := strings.LastIndex(serviceStr, ":")
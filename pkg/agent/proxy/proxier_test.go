@@ -574,7 +574,7 @@ func testClusterIPAdd(t *testing.T,
 
 	if nodeLocalInternal == false {
 		mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedAllEps))
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedAllEps))
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:      svcIP,
 			ServicePort:    uint16(svcPort),
@@ -593,7 +593,7 @@ func testClusterIPAdd(t *testing.T,
 		}
 	} else {
 		mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedAllEps))
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedLocalEps))
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedLocalEps), nil)
 		var clusterGroup binding.GroupIDType
 		if externalIP != nil {
 			// Cluster Group is created when externalIPs is not empty.
@@ -609,7 +609,7 @@ func testClusterIPAdd(t *testing.T,
 			IsNested:           true,
 		})
 		if externalIP != nil {
-			mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.InAnyOrder(expectedAllEps))
+			mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.InAnyOrder(expectedAllEps), nil)
 			mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 				ServiceIP:      externalIP,
 				ServicePort:    uint16(svcPort),
@@ -710,8 +710,8 @@ func testLoadBalancerAdd(t *testing.T,
 	isDSR := !nodeLocalExternal && dsrEnabled
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedAllEps))
 	if nodeLocalInternal != nodeLocalExternal {
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedLocalEps))
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.InAnyOrder(expectedAllEps))
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedLocalEps), nil)
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:          svcIP,
 			ServicePort:        uint16(svcPort),
@@ -760,16 +760,16 @@ func testLoadBalancerAdd(t *testing.T,
 		if nodeLocalVal {
 			localGroupID = 1
 			clusterGroupID = 2
-			mockOFClient.EXPECT().InstallServiceGroup(localGroupID, false, gomock.InAnyOrder(expectedLocalEps))
-			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, gomock.InAnyOrder(expectedAllEps))
+			mockOFClient.EXPECT().InstallServiceGroup(localGroupID, false, false, gomock.InAnyOrder(expectedLocalEps), nil)
+			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		} else if isDSR {
 			localGroupID = 1
 			clusterGroupID = 2
-			mockOFClient.EXPECT().InstallServiceGroup(localGroupID, false, gomock.InAnyOrder(expectedLocalEps))
-			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, gomock.InAnyOrder(expectedAllEps))
+			mockOFClient.EXPECT().InstallServiceGroup(localGroupID, false, false, gomock.InAnyOrder(expectedLocalEps), nil)
+			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		} else {
 			clusterGroupID = 1
-			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, gomock.InAnyOrder(expectedAllEps))
+			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		}
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:          svcIP,
@@ -895,8 +895,8 @@ func testNodePortAdd(t *testing.T,
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedAllEps))
 	if nodeLocalInternal != nodeLocalExternal {
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedLocalEps))
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.InAnyOrder(expectedAllEps))
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedLocalEps), nil)
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:          svcIP,
 			ServicePort:        uint16(svcPort),
@@ -932,11 +932,11 @@ func testNodePortAdd(t *testing.T,
 		if nodeLocalVal {
 			localGroupID = 1
 			clusterGroupID = 2
-			mockOFClient.EXPECT().InstallServiceGroup(localGroupID, false, gomock.InAnyOrder(expectedLocalEps))
-			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, gomock.InAnyOrder(expectedAllEps))
+			mockOFClient.EXPECT().InstallServiceGroup(localGroupID, false, false, gomock.InAnyOrder(expectedLocalEps), nil)
+			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		} else {
 			clusterGroupID = 1
-			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, gomock.InAnyOrder(expectedAllEps))
+			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, false, gomock.InAnyOrder(expectedAllEps), nil)
 		}
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:          svcIP,
@@ -1211,8 +1211,8 @@ func TestLoadBalancerServiceWithMultiplePorts(t *testing.T) {
 	localGroupID1 := fp.groupCounter.AllocateIfNotExist(svcPortName1, true)
 	clusterGroupID1 := fp.groupCounter.AllocateIfNotExist(svcPortName1, false)
 	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, gomock.InAnyOrder([]k8sproxy.Endpoint{localEndpointForPort80, remoteEndpointForPort80}))
-	mockOFClient.EXPECT().InstallServiceGroup(localGroupID1, false, []k8sproxy.Endpoint{localEndpointForPort80})
-	mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID1, false, gomock.InAnyOrder([]k8sproxy.Endpoint{localEndpointForPort80, remoteEndpointForPort80}))
+	mockOFClient.EXPECT().InstallServiceGroup(localGroupID1, false, false, []k8sproxy.Endpoint{localEndpointForPort80}, nil)
+	mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID1, false, false, gomock.InAnyOrder([]k8sproxy.Endpoint{localEndpointForPort80, remoteEndpointForPort80}), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:          svc1IPv4,
 		ServicePort:        uint16(port80Int32),
@@ -1246,8 +1246,8 @@ func TestLoadBalancerServiceWithMultiplePorts(t *testing.T) {
 	localGroupID2 := fp.groupCounter.AllocateIfNotExist(svcPortName2, true)
 	clusterGroupID2 := fp.groupCounter.AllocateIfNotExist(svcPortName2, false)
 	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, gomock.InAnyOrder([]k8sproxy.Endpoint{localEndpointForPort443, remoteEndpointForPort443}))
-	mockOFClient.EXPECT().InstallServiceGroup(localGroupID2, false, []k8sproxy.Endpoint{localEndpointForPort443})
-	mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID2, false, gomock.InAnyOrder([]k8sproxy.Endpoint{localEndpointForPort443, remoteEndpointForPort443}))
+	mockOFClient.EXPECT().InstallServiceGroup(localGroupID2, false, false, []k8sproxy.Endpoint{localEndpointForPort443}, nil)
+	mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID2, false, false, gomock.InAnyOrder([]k8sproxy.Endpoint{localEndpointForPort443, remoteEndpointForPort443}), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svc1IPv4,
 		ServicePort:    uint16(port443Int32),
@@ -1430,7 +1430,7 @@ func TestDualStackService(t *testing.T) {
 	fpv6.OnEndpointsSynced()
 
 	expectedIPv4Eps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(ep1IPv4.String(), "", "", svcPort, false, true, true, false, nil)}
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, expectedIPv4Eps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, expectedIPv4Eps, nil)
 	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, expectedIPv4Eps)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:          svc1IPv4,
@@ -1442,7 +1442,7 @@ func TestDualStackService(t *testing.T) {
 	})
 
 	expectedIPv6Eps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(ep1IPv6.String(), "", "", svcPort, false, true, true, false, nil)}
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, expectedIPv6Eps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, expectedIPv6Eps, nil)
 	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCPv6, expectedIPv6Eps)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:          svc1IPv6,
@@ -1485,6 +1485,57 @@ func TestDualStackService(t *testing.T) {
 	assert.NotContains(t, fpv6.serviceInstalledMap, svcPortName)
 }
 
+func TestServiceConsistentHashing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient, mockRouteClient := getMockClients(ctrl)
+	fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+
+	makeService := func(algorithm string) *corev1.Service {
+		return makeTestService(svcPortName.Namespace, svcPortName.Name, func(svc *corev1.Service) {
+			if algorithm != "" {
+				svc.Annotations = map[string]string{antreatypes.ServiceLoadBalancerAlgorithmAnnotationKey: algorithm}
+			}
+			svc.Spec.ClusterIP = svc1IPv4.String()
+			svc.Spec.ClusterIPs = []string{svc1IPv4.String()}
+			svc.Spec.Ports = []corev1.ServicePort{{
+				Name:     svcPortName.Port,
+				Port:     int32(svcPort),
+				Protocol: corev1.ProtocolTCP,
+			}}
+		})
+	}
+	svc := makeService("Consistent-Hash")
+	ep, epPort := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep1IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnServiceUpdate(nil, svc)
+	fp.OnServiceSynced()
+	fp.OnEndpointSliceUpdate(nil, eps)
+	fp.OnEndpointsSynced()
+
+	expectedEps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(ep1IPv4.String(), "", "", svcPort, false, true, true, false, nil)}
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, true, expectedEps, nil)
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, expectedEps)
+	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
+		ServiceIP:      svc1IPv4,
+		ServicePort:    uint16(svcPort),
+		Protocol:       binding.ProtocolTCP,
+		ClusterGroupID: 1,
+	})
+	fp.syncProxyRules()
+	assert.Contains(t, fp.serviceInstalledMap, svcPortName)
+
+	// Only the group is updated when the annotation is removed.
+	updatedSvc := makeService("")
+	fp.OnServiceUpdate(svc, updatedSvc)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, expectedEps, nil)
+	fp.syncProxyRules()
+
+	// An invalid algorithm falls back to the default one.
+	invalidSvc := makeService("invalid")
+	fp.OnServiceUpdate(updatedSvc, invalidSvc)
+	fp.syncProxyRules()
+}
+
 func getAPIProtocol(protocol binding.Protocol) corev1.Protocol {
 	switch protocol {
 	case binding.ProtocolUDP, binding.ProtocolUDPv6:
@@ -1536,7 +1587,7 @@ func testClusterIPRemove(t *testing.T, protocol binding.Protocol, isIPv6 bool, n
 
 	if nodeLocalInternal == false {
 		mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:      svcIP,
 			ServicePort:    uint16(svcPort),
@@ -1559,10 +1610,10 @@ func testClusterIPRemove(t *testing.T, protocol binding.Protocol, isIPv6 bool, n
 		}
 	} else {
 		var clusterGroupID binding.GroupIDType
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
 		if externalIP != nil {
 			clusterGroupID = 2
-			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, gomock.Any())
+			mockOFClient.EXPECT().InstallServiceGroup(clusterGroupID, false, false, gomock.Any(), nil)
 			mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
 			mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 				ServiceIP:      externalIP,
@@ -1663,8 +1714,8 @@ func testNodePortRemove(t *testing.T, protocol binding.Protocol, isIPv6 bool, en
 	}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -1781,8 +1832,8 @@ func testLoadBalancerRemove(t *testing.T, protocol binding.Protocol, isIPv6 bool
 	}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -2021,7 +2072,7 @@ func testClusterIPNoEndpoint(t *testing.T, protocol binding.Protocol, isIPv6 boo
 	makeServiceMap(fp, svc)
 	makeEndpointSliceMap(fp)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, []k8sproxy.Endpoint{})
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{}, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:          svcIP,
 		ServicePort:        uint16(svcPort),
@@ -2095,8 +2146,8 @@ func testNodePortNoEndpoint(t *testing.T, protocol binding.Protocol, isIPv6 bool
 	makeServiceMap(fp, svc)
 	makeEndpointSliceMap(fp)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -2199,8 +2250,8 @@ func testLoadBalancerNoEndpoint(t *testing.T, protocol binding.Protocol, isIPv6
 	svcInfoStr := fmt.Sprintf("%s:%d/%s", svcIP, svcPort, apiProtocol)
 	updatedSvcInfoStr := fmt.Sprintf("%s:%d/%s", svcIP, svcPort+1, apiProtocol)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -2314,8 +2365,8 @@ func testClusterIPRemoveSamePortEndpoint(t *testing.T, isIPv6 bool) {
 
 	groupID := fp.groupCounter.AllocateIfNotExist(svcPortNameTCP, false)
 	groupIDUDP := fp.groupCounter.AllocateIfNotExist(svcPortNameUDP, false)
-	mockOFClient.EXPECT().InstallServiceGroup(groupID, false, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(groupIDUDP, false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(groupID, false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(groupIDUDP, false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallEndpointFlows(protocolTCP, gomock.Any())
 	mockOFClient.EXPECT().InstallEndpointFlows(protocolUDP, gomock.Any())
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
@@ -2332,13 +2383,13 @@ func testClusterIPRemoveSamePortEndpoint(t *testing.T, isIPv6 bool) {
 	})
 	fp.syncProxyRules()
 
-	mockOFClient.EXPECT().InstallServiceGroup(groupIDUDP, false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(groupIDUDP, false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().UninstallEndpointFlows(protocolUDP, gomock.Any())
 	mockRouteClient.EXPECT().ClearConntrackEntryForService(svcIP, uint16(svcPort), epIP, protocolUDP)
 	fp.endpointsChanges.OnEndpointSliceUpdate(epsUDP, true)
 	fp.syncProxyRules()
 
-	mockOFClient.EXPECT().InstallServiceGroup(groupID, false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(groupID, false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().UninstallEndpointFlows(protocolTCP, gomock.Any())
 	fp.endpointsChanges.OnEndpointSliceUpdate(epsTCP, true)
 	fp.syncProxyRules()
@@ -2391,7 +2442,7 @@ func testLoadBalancerRemoveEndpoints(t *testing.T, protocol binding.Protocol, is
 	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep}, []discovery.EndpointPort{*epPort}, isIPv6)
 	makeEndpointSliceMap(fp, eps)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
@@ -2430,7 +2481,7 @@ func testLoadBalancerRemoveEndpoints(t *testing.T, protocol binding.Protocol, is
 	assert.Contains(t, fp.serviceInstalledMap, svcPortName)
 	assert.Contains(t, fp.endpointsInstalledMap, svcPortName)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().UninstallEndpointFlows(protocol, gomock.Any())
 	if needClearConntrackEntries(protocol) {
 		mockRouteClient.EXPECT().ClearConntrackEntryForService(svcIP, uint16(svcPort), epIP, protocol)
@@ -2494,7 +2545,7 @@ func testSessionAffinity(t *testing.T, affinitySeconds int32, isIPv6 bool) {
 	makeEndpointSliceMap(fp, eps)
 
 	protocol := protocolTCP(isIPv6)
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, false, gomock.Any(), nil)
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
 	var expectedAffinity uint16
 	if affinitySeconds > math.MaxUint16 {
@@ -2559,7 +2610,7 @@ func testSessionAffinityNoEndpoint(t *testing.T, isIPv6 bool) {
 	makeServiceMap(fp, svc)
 	makeEndpointsMap(fp)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, []k8sproxy.Endpoint{})
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, false, []k8sproxy.Endpoint{}, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:       svcIP,
 		ServicePort:     uint16(svcPort),
@@ -2616,7 +2667,7 @@ func testServicePortUpdate(t *testing.T, protocol binding.Protocol, isIPv6 bool,
 	expectedEps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(epIP.String(), "", "", svcPort, false, true, true, false, nil)}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, expectedEps)
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, expectedEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, expectedEps, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -2772,7 +2823,7 @@ func testServiceNodePortUpdate(t *testing.T, protocol binding.Protocol, isIPv6 b
 	expectedEps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(epIP.String(), "", "", svcPort, false, true, true, false, nil)}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, expectedEps)
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, expectedEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, expectedEps, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -2906,7 +2957,7 @@ func testServiceExternalTrafficPolicyUpdate(t *testing.T, protocol binding.Proto
 	expectedAllEps := append(expectedLocalEps, k8sproxy.NewBaseEndpointInfo(ep1IP.String(), "", "", svcPort, false, true, true, false, nil))
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedAllEps))
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedAllEps))
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedAllEps), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -2949,8 +3000,8 @@ func testServiceExternalTrafficPolicyUpdate(t *testing.T, protocol binding.Proto
 
 	fp.serviceChanges.OnServiceUpdate(svc, updatedSvc)
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedAllEps))
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, expectedLocalEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedAllEps), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, expectedLocalEps, nil)
 	mockOFClient.EXPECT().UninstallServiceFlows(svcIP, uint16(svcPort), protocol)
 	mockOFClient.EXPECT().UninstallServiceFlows(externalIP, uint16(svcPort), protocol)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
@@ -3087,7 +3138,7 @@ func testServiceInternalTrafficPolicyUpdate(t *testing.T, protocol binding.Proto
 	expectedAllEps := append(expectedLocalEps, expectedRemoteEps...)
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedAllEps))
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedAllEps))
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedAllEps), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -3118,7 +3169,7 @@ func testServiceInternalTrafficPolicyUpdate(t *testing.T, protocol binding.Proto
 	}
 	mockOFClient.EXPECT().UninstallServiceGroup(binding.GroupIDType(1))
 	mockOFClient.EXPECT().UninstallServiceFlows(svcIP, uint16(svcPort), protocol)
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, expectedLocalEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), false, false, expectedLocalEps, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:          svcIP,
 		ServicePort:        uint16(svcPort),
@@ -3203,7 +3254,7 @@ func testServiceExternalIPsUpdate(t *testing.T, protocol binding.Protocol, isIPv
 	expectedEps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(epIP.String(), "", "", svcPort, false, true, true, false, nil)}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.InAnyOrder(expectedEps))
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, gomock.InAnyOrder(expectedEps))
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder(expectedEps), nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -3350,7 +3401,7 @@ func testServiceStickyMaxAgeSecondsUpdate(t *testing.T, protocol binding.Protoco
 	expectedEps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(epIP.String(), "", "", svcPort, false, true, true, false, nil)}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, expectedEps)
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, expectedEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, false, expectedEps, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:       svcIP,
 		ServicePort:     uint16(svcPort),
@@ -3504,7 +3555,7 @@ func testServiceSessionAffinityTypeUpdate(t *testing.T, protocol binding.Protoco
 	expectedEps := []k8sproxy.Endpoint{k8sproxy.NewBaseEndpointInfo(epIP.String(), "", "", svcPort, false, true, true, false, nil)}
 
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, expectedEps)
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, expectedEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, expectedEps, nil)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:      svcIP,
 		ServicePort:    uint16(svcPort),
@@ -3512,7 +3563,7 @@ func testServiceSessionAffinityTypeUpdate(t *testing.T, protocol binding.Protoco
 		ClusterGroupID: 1,
 	})
 
-	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, expectedEps)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), true, false, expectedEps, nil)
 	mockOFClient.EXPECT().UninstallServiceFlows(svcIP, uint16(svcPort), protocol)
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 		ServiceIP:       svcIP,
@@ -3641,8 +3692,8 @@ func TestServicesWithSameEndpoints(t *testing.T) {
 
 	groupID1 := fp.groupCounter.AllocateIfNotExist(svcPortName1, false)
 	groupID2 := fp.groupCounter.AllocateIfNotExist(svcPortName2, false)
-	mockOFClient.EXPECT().InstallServiceGroup(groupID1, false, gomock.Any())
-	mockOFClient.EXPECT().InstallServiceGroup(groupID2, false, gomock.Any())
+	mockOFClient.EXPECT().InstallServiceGroup(groupID1, false, false, gomock.Any(), nil)
+	mockOFClient.EXPECT().InstallServiceGroup(groupID2, false, false, gomock.Any(), nil)
 	protocol := binding.ProtocolTCP
 	mockOFClient.EXPECT().InstallEndpointFlows(protocol, gomock.Any())
 	mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
@@ -3806,8 +3857,8 @@ func TestGetServiceFlowKeys(t *testing.T) {
 			}
 			if tc.svc != nil && tc.eps != nil && tc.serviceInstalled {
 				mockRouteClient.EXPECT().AddNodePortConfigs(nodePortAddressesIPv4, uint16(svcNodePort), binding.ProtocolTCP)
-				mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), gomock.Any(), gomock.Any())
-				mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), gomock.Any(), gomock.Any())
+				mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), gomock.Any(), false, gomock.Any(), nil)
+				mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(2), gomock.Any(), false, gomock.Any(), nil)
 				mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, gomock.Any())
 				mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 					ServiceIP:          svc1IPv4,
@@ -3870,7 +3921,7 @@ func TestServiceLabelSelector(t *testing.T) {
 		makeServiceMap(fp, svc1, svc2, svc3, svc4)
 		makeEndpointSliceMap(fp)
 
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, []k8sproxy.Endpoint{})
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{}, nil)
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:      svc2IP,
 			ServicePort:    uint16(svcPort),
@@ -3890,7 +3941,7 @@ func TestServiceLabelSelector(t *testing.T) {
 		makeServiceMap(fp, svc1, svc2, svc3, svc4)
 		makeEndpointSliceMap(fp)
 
-		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, []k8sproxy.Endpoint{})
+		mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{}, nil)
 		mockOFClient.EXPECT().InstallServiceFlows(&antreatypes.ServiceConfig{
 			ServiceIP:      svc1IP,
 			ServicePort:    uint16(svcPort),
@@ -27,6 +27,7 @@ package testing
 import (
 	reflect "reflect"
 
+	endpointprober "antrea.io/antrea/pkg/agent/proxy/endpointprober"
 	openflow "antrea.io/antrea/pkg/ovs/openflow"
 	proxy "antrea.io/antrea/third_party/proxy"
 	gomock "go.uber.org/mock/gomock"
@@ -56,6 +57,20 @@ func (m *MockProxier) EXPECT() *MockProxierMockRecorder {
 	return m.recorder
 }
 
+// GetEndpointHealthStatuses mocks base method.
+func (m *MockProxier) GetEndpointHealthStatuses() []endpointprober.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpointHealthStatuses")
+	ret0, _ := ret[0].([]endpointprober.Status)
+	return ret0
+}
+
+// GetEndpointHealthStatuses indicates an expected call of GetEndpointHealthStatuses.
+func (mr *MockProxierMockRecorder) GetEndpointHealthStatuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpointHealthStatuses", reflect.TypeOf((*MockProxier)(nil).GetEndpointHealthStatuses))
+}
+
 // GetProxyProvider mocks base method.
 func (m *MockProxier) GetProxyProvider() proxy.Provider {
 	m.ctrl.T.Helper()
@@ -15,12 +15,18 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 
 	mccommon "antrea.io/antrea/multicluster/controllers/multicluster/common"
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/proxy/endpointprober"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/ovs/openflow"
 	k8sproxy "antrea.io/antrea/third_party/proxy"
@@ -37,6 +43,24 @@ type ServiceInfo struct {
 	IsNested bool
 	// The load balancer mode specified in annotations.
 	LoadBalancerMode *config.LoadBalancerMode
+	// ConsistentHashing means the Service's Endpoints are selected with consistent hashing, as specified in
+	// annotations.
+	ConsistentHashing bool
+	// SlowStartWindow is the duration over which the weight of a new Endpoint is ramped up linearly, as specified in
+	// annotations. 0 means slow-start is disabled.
+	SlowStartWindow time.Duration
+	// ZoneWeights are the weights of the Endpoints in each zone, as specified in annotations. Endpoints in other zones
+	// have the default weight.
+	ZoneWeights map[string]uint16
+	// HealthCheck is the config of the probes used to check the health of the Endpoints, as specified in annotations.
+	// nil means the Endpoints are not probed.
+	HealthCheck *endpointprober.ProbeConfig
+	// MaxConnections is the maximum number of concurrent connections to the Service port on the Node, as specified in
+	// annotations. 0 means unlimited.
+	MaxConnections uint32
+	// MaxNewConnectionsPerSecond is the maximum rate of new connections to the Service port on the Node, as specified
+	// in annotations. 0 means unlimited.
+	MaxNewConnectionsPerSecond uint32
 }
 
 func getLoadBalancerMode(service *corev1.Service) *config.LoadBalancerMode {
@@ -51,11 +75,112 @@ func getLoadBalancerMode(service *corev1.Service) *config.LoadBalancerMode {
 	return nil
 }
 
+// loadBalancerAlgorithmConsistentHash is the value of the load balancer algorithm annotation which enables consistent
+// hashing.
+const loadBalancerAlgorithmConsistentHash = "consistent-hash"
+
+func getConsistentHashing(service *corev1.Service) bool {
+	if algorithm, exists := service.Annotations[types.ServiceLoadBalancerAlgorithmAnnotationKey]; exists {
+		if !strings.EqualFold(algorithm, loadBalancerAlgorithmConsistentHash) {
+			klog.ErrorS(nil, "The Service's load balancer algorithm annotation is invalid", "Service", klog.KObj(service), "algorithm", algorithm)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// getSlowStartWindow returns the slow-start window of the Service. Slow-start is not supported with consistent hashing:
+// each antrea-agent ramps up the weights of the Endpoints independently, so different Nodes would select different
+// Endpoints for the same connection.
+func getSlowStartWindow(service *corev1.Service, consistentHashing bool) time.Duration {
+	if windowStr, exists := service.Annotations[types.ServiceSlowStartWindowAnnotationKey]; exists {
+		window, err := time.ParseDuration(windowStr)
+		if err != nil || window < 0 {
+			klog.ErrorS(err, "The Service's slow-start window annotation is invalid", "Service", klog.KObj(service), "window", windowStr)
+			return 0
+		}
+		if window > 0 && consistentHashing {
+			klog.ErrorS(nil, "Ignoring the Service's slow-start window annotation as slow-start is not supported with consistent hashing", "Service", klog.KObj(service), "window", windowStr)
+			return 0
+		}
+		return window
+	}
+	return 0
+}
+
+// parseZoneWeights parses zone weights in the format "<zone>=<weight>[,<zone>=<weight>]...". A weight must be between 1
+// and types.DefaultEndpointWeight.
+func parseZoneWeights(weightsStr string) (map[string]uint16, error) {
+	weights := map[string]uint16{}
+	for _, item := range strings.Split(weightsStr, ",") {
+		zone, weightStr, found := strings.Cut(strings.TrimSpace(item), "=")
+		if !found || zone == "" {
+			return nil, fmt.Errorf("invalid zone weight %q", item)
+		}
+		weight, err := strconv.ParseUint(weightStr, 10, 16)
+		if err != nil || weight == 0 || weight > uint64(types.DefaultEndpointWeight) {
+			return nil, fmt.Errorf("invalid weight %q for zone %s, it must be an integer between 1 and %d", weightStr, zone, types.DefaultEndpointWeight)
+		}
+		weights[zone] = uint16(weight)
+	}
+	return weights, nil
+}
+
+func getZoneWeights(service *corev1.Service) map[string]uint16 {
+	if weightsStr, exists := service.Annotations[types.ServiceEndpointZoneWeightsAnnotationKey]; exists {
+		weights, err := parseZoneWeights(weightsStr)
+		if err != nil {
+			klog.ErrorS(err, "The Service's Endpoint zone weights annotation is invalid", "Service", klog.KObj(service), "weights", weightsStr)
+			return nil
+		}
+		return weights
+	}
+	return nil
+}
+
+func getHealthCheck(port *corev1.ServicePort, service *corev1.Service) *endpointprober.ProbeConfig {
+	if configStr, exists := service.Annotations[types.ServiceEndpointHealthCheckAnnotationKey]; exists {
+		// Probes are only supported for TCP ports.
+		if port.Protocol != corev1.ProtocolTCP {
+			klog.V(2).InfoS("Ignoring the Service's Endpoint health check annotation for non-TCP port", "Service", klog.KObj(service), "port", port.Name)
+			return nil
+		}
+		config, err := endpointprober.ParseProbeConfig(configStr)
+		if err != nil {
+			klog.ErrorS(err, "The Service's Endpoint health check annotation is invalid", "Service", klog.KObj(service), "healthCheck", configStr)
+			return nil
+		}
+		return config
+	}
+	return nil
+}
+
+// getConnectionLimit returns the connection limit specified by the annotation with the given key. The limit must be a
+// positive integer, 0 is returned if the annotation is absent or invalid.
+func getConnectionLimit(service *corev1.Service, annotationKey string) uint32 {
+	if limitStr, exists := service.Annotations[annotationKey]; exists {
+		limit, err := strconv.ParseUint(limitStr, 10, 32)
+		if err != nil || limit == 0 {
+			klog.ErrorS(err, "The Service's connection limit annotation is invalid", "Service", klog.KObj(service), "annotation", annotationKey, "limit", limitStr)
+			return 0
+		}
+		return uint32(limit)
+	}
+	return 0
+}
+
 // NewServiceInfo returns a new k8sproxy.ServicePort which abstracts a serviceInfo.
 func NewServiceInfo(port *corev1.ServicePort, service *corev1.Service, baseInfo *k8sproxy.BaseServiceInfo) k8sproxy.ServicePort {
 	info := &ServiceInfo{BaseServiceInfo: baseInfo}
 	info.IsNested = mccommon.IsMulticlusterService(service)
 	info.LoadBalancerMode = getLoadBalancerMode(service)
+	info.ConsistentHashing = getConsistentHashing(service)
+	info.SlowStartWindow = getSlowStartWindow(service, info.ConsistentHashing)
+	info.ZoneWeights = getZoneWeights(service)
+	info.HealthCheck = getHealthCheck(port, service)
+	info.MaxConnections = getConnectionLimit(service, types.ServiceMaxConnectionsAnnotationKey)
+	info.MaxNewConnectionsPerSecond = getConnectionLimit(service, types.ServiceMaxNewConnectionsPerSecondAnnotationKey)
 	if utilnet.IsIPv6(baseInfo.ClusterIP()) {
 		info.OFProtocol = openflow.ProtocolTCPv6
 		switch port.Protocol {
@@ -0,0 +1,103 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"time"
+
+	"antrea.io/antrea/pkg/agent/proxy/types"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+// slowStartSteps is the number of steps in which the weight of a new Endpoint is ramped up during the slow-start
+// window. The group of the Service is updated at each step.
+const slowStartSteps = 10
+
+// updateEndpointStartTimes records the time at which each of the given Endpoints of a Service became reachable, and
+// forgets the Endpoints which are no longer reachable.
+func (p *proxier) updateEndpointStartTimes(svcPortName k8sproxy.ServicePortName, endpoints []k8sproxy.Endpoint) {
+	startTimes, ok := p.endpointStartTimes[svcPortName]
+	if !ok {
+		startTimes = map[string]time.Time{}
+		p.endpointStartTimes[svcPortName] = startTimes
+	}
+	currentEndpoints := make(map[string]struct{}, len(endpoints))
+	now := p.clock.Now()
+	for _, endpoint := range endpoints {
+		key := endpoint.String()
+		currentEndpoints[key] = struct{}{}
+		if _, ok := startTimes[key]; !ok {
+			startTimes[key] = now
+		}
+	}
+	for key := range startTimes {
+		if _, ok := currentEndpoints[key]; !ok {
+			delete(startTimes, key)
+		}
+	}
+}
+
+// getEndpointWeights returns the weights of the given Endpoints of a Service, keyed by the Endpoint string. Endpoints
+// with the default weight are omitted, so nil is returned if all Endpoints have the default weight. The second return
+// value is the duration after which the weights must be computed again because some Endpoints are still in slow-start,
+// or 0 if no Endpoint is in slow-start.
+func (p *proxier) getEndpointWeights(svcPortName k8sproxy.ServicePortName, svcInfo *types.ServiceInfo, endpoints []k8sproxy.Endpoint) (map[string]uint16, time.Duration) {
+	if svcInfo.SlowStartWindow == 0 && len(svcInfo.ZoneWeights) == 0 {
+		return nil, 0
+	}
+	var weights map[string]uint16
+	var nextUpdate time.Duration
+	now := p.clock.Now()
+	startTimes := p.endpointStartTimes[svcPortName]
+	for _, endpoint := range endpoints {
+		key := endpoint.String()
+		weight := agenttypes.DefaultEndpointWeight
+		if zoneWeight, ok := svcInfo.ZoneWeights[endpoint.GetZone()]; ok {
+			weight = zoneWeight
+		}
+		if startTime, ok := startTimes[key]; ok && svcInfo.SlowStartWindow > 0 {
+			if elapsed := now.Sub(startTime); elapsed < svcInfo.SlowStartWindow {
+				// The weight is ramped up in steps: it is 1/slowStartSteps of the full weight during the first step,
+				// and reaches the full weight during the last step.
+				step := int64(elapsed)*slowStartSteps/int64(svcInfo.SlowStartWindow) + 1
+				weight = max(uint16(int64(weight)*step/slowStartSteps), 1)
+				untilNextStep := max(startTime.Add(time.Duration(int64(svcInfo.SlowStartWindow)*step/slowStartSteps)).Sub(now), time.Millisecond)
+				if nextUpdate == 0 || untilNextStep < nextUpdate {
+					nextUpdate = untilNextStep
+				}
+			}
+		}
+		if weight != agenttypes.DefaultEndpointWeight {
+			if weights == nil {
+				weights = map[string]uint16{}
+			}
+			weights[key] = weight
+		}
+	}
+	return weights, nextUpdate
+}
+
+// scheduleWeightsUpdate triggers a sync of the proxy rules after the given duration, so that the weights of the
+// Endpoints in slow-start are updated. It replaces any sync previously scheduled by it.
+func (p *proxier) scheduleWeightsUpdate(after time.Duration) {
+	if p.weightsUpdateTimer != nil {
+		p.weightsUpdateTimer.Stop()
+		p.weightsUpdateTimer = nil
+	}
+	if after > 0 {
+		p.weightsUpdateTimer = p.clock.AfterFunc(after, p.runner.Run)
+	}
+}
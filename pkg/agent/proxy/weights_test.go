@@ -0,0 +1,213 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/proxy/types"
+	antreatypes "antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+func TestGetEndpointWeights(t *testing.T) {
+	startTime := time.Now()
+	ep1 := k8sproxy.NewBaseEndpointInfo("10.180.0.1", "node1", "zone-a", 80, false, true, true, false, nil)
+	ep2 := k8sproxy.NewBaseEndpointInfo("10.180.0.2", "node2", "zone-b", 80, false, true, true, false, nil)
+	ep3 := k8sproxy.NewBaseEndpointInfo("10.180.0.3", "node3", "", 80, false, true, true, false, nil)
+	endpoints := []k8sproxy.Endpoint{ep1, ep2, ep3}
+	startTimes := map[string]time.Time{
+		ep1.String(): startTime.Add(-time.Hour),
+		ep2.String(): startTime.Add(-15 * time.Second),
+		ep3.String(): startTime,
+	}
+	tests := []struct {
+		name               string
+		svcInfo            *types.ServiceInfo
+		expectedWeights    map[string]uint16
+		expectedNextUpdate time.Duration
+	}{
+		{
+			name:    "no weighting",
+			svcInfo: &types.ServiceInfo{},
+		},
+		{
+			name:    "zone weights",
+			svcInfo: &types.ServiceInfo{ZoneWeights: map[string]uint16{"zone-a": 100, "zone-b": 50}},
+			expectedWeights: map[string]uint16{
+				ep2.String(): 50,
+			},
+		},
+		{
+			name:    "slow-start",
+			svcInfo: &types.ServiceInfo{SlowStartWindow: 100 * time.Second},
+			expectedWeights: map[string]uint16{
+				ep2.String(): 20,
+				ep3.String(): 10,
+			},
+			expectedNextUpdate: 5 * time.Second,
+		},
+		{
+			name:    "slow-start with zone weights",
+			svcInfo: &types.ServiceInfo{SlowStartWindow: 100 * time.Second, ZoneWeights: map[string]uint16{"zone-a": 30, "zone-b": 3}},
+			expectedWeights: map[string]uint16{
+				ep1.String(): 30,
+				ep2.String(): 1,
+				ep3.String(): 10,
+			},
+			expectedNextUpdate: 5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &proxier{
+				clock:              clocktesting.NewFakeClock(startTime),
+				endpointStartTimes: map[k8sproxy.ServicePortName]map[string]time.Time{svcPortName: startTimes},
+			}
+			weights, nextUpdate := p.getEndpointWeights(svcPortName, tt.svcInfo, endpoints)
+			assert.Equal(t, tt.expectedWeights, weights)
+			assert.Equal(t, tt.expectedNextUpdate, nextUpdate)
+		})
+	}
+}
+
+func TestUpdateEndpointStartTimes(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	p := &proxier{
+		clock:              fakeClock,
+		endpointStartTimes: map[k8sproxy.ServicePortName]map[string]time.Time{},
+	}
+	ep1 := k8sproxy.NewBaseEndpointInfo("10.180.0.1", "", "", 80, false, true, true, false, nil)
+	ep2 := k8sproxy.NewBaseEndpointInfo("10.180.0.2", "", "", 80, false, true, true, false, nil)
+	startTime1 := fakeClock.Now()
+	p.updateEndpointStartTimes(svcPortName, []k8sproxy.Endpoint{ep1})
+
+	fakeClock.Step(time.Minute)
+	startTime2 := fakeClock.Now()
+	p.updateEndpointStartTimes(svcPortName, []k8sproxy.Endpoint{ep1, ep2})
+	assert.Equal(t, map[string]time.Time{ep1.String(): startTime1, ep2.String(): startTime2}, p.endpointStartTimes[svcPortName])
+
+	p.updateEndpointStartTimes(svcPortName, []k8sproxy.Endpoint{ep2})
+	assert.Equal(t, map[string]time.Time{ep2.String(): startTime2}, p.endpointStartTimes[svcPortName])
+}
+
+func TestServiceSlowStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient, mockRouteClient := getMockClients(ctrl)
+	fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	fp.clock = fakeClock
+
+	svc := makeTestService(svcPortName.Namespace, svcPortName.Name, func(svc *corev1.Service) {
+		svc.Annotations = map[string]string{
+			antreatypes.ServiceSlowStartWindowAnnotationKey:     "100s",
+			antreatypes.ServiceEndpointZoneWeightsAnnotationKey: "zone-b=50",
+		}
+		svc.Spec.ClusterIP = svc1IPv4.String()
+		svc.Spec.ClusterIPs = []string{svc1IPv4.String()}
+		svc.Spec.Ports = []corev1.ServicePort{{
+			Name:     svcPortName.Port,
+			Port:     int32(svcPort),
+			Protocol: corev1.ProtocolTCP,
+		}}
+	})
+	ep1, epPort := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep1IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	ep1.Zone = ptr.To("zone-a")
+	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep1}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnServiceUpdate(nil, svc)
+	fp.OnServiceSynced()
+	fp.OnEndpointSliceUpdate(nil, eps)
+	fp.OnEndpointsSynced()
+
+	expectedEp1 := k8sproxy.NewBaseEndpointInfo(ep1IPv4.String(), "", "zone-a", svcPort, false, true, true, false, nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{expectedEp1}, map[string]uint16{expectedEp1.String(): 10})
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, []k8sproxy.Endpoint{expectedEp1})
+	mockOFClient.EXPECT().InstallServiceFlows(gomock.Any())
+	fp.syncProxyRules()
+	assert.True(t, fakeClock.HasWaiters(), "A sync should be scheduled to update the weights")
+
+	// The weight is increased at each step of the slow-start window.
+	fakeClock.Step(10 * time.Second)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, []k8sproxy.Endpoint{expectedEp1}, map[string]uint16{expectedEp1.String(): 20})
+	fp.syncProxyRules()
+
+	// The group is not updated if the weights have not changed.
+	fakeClock.Step(5 * time.Second)
+	fp.syncProxyRules()
+
+	// A new Endpoint in zone-b starts its own slow-start with the weight of its zone.
+	ep2, _ := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep2IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	ep2.Zone = ptr.To("zone-b")
+	updatedEps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep1, *ep2}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnEndpointSliceUpdate(eps, updatedEps)
+	expectedEp2 := k8sproxy.NewBaseEndpointInfo(ep2IPv4.String(), "", "zone-b", svcPort, false, true, true, false, nil)
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, []k8sproxy.Endpoint{expectedEp2})
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder([]k8sproxy.Endpoint{expectedEp1, expectedEp2}), map[string]uint16{expectedEp1.String(): 20, expectedEp2.String(): 5})
+	fp.syncProxyRules()
+
+	// Once the slow-start window has elapsed, only the zone weights remain.
+	fakeClock.Step(100 * time.Second)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, false, gomock.InAnyOrder([]k8sproxy.Endpoint{expectedEp1, expectedEp2}), map[string]uint16{expectedEp2.String(): 50})
+	fp.syncProxyRules()
+	assert.False(t, fakeClock.HasWaiters(), "No sync should be scheduled once slow-start is over")
+}
+
+func TestServiceSlowStartWithConsistentHashing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient, mockRouteClient := getMockClients(ctrl)
+	fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	fp.clock = fakeClock
+
+	svc := makeTestService(svcPortName.Namespace, svcPortName.Name, func(svc *corev1.Service) {
+		svc.Annotations = map[string]string{
+			antreatypes.ServiceLoadBalancerAlgorithmAnnotationKey: "consistent-hash",
+			antreatypes.ServiceSlowStartWindowAnnotationKey:       "100s",
+			antreatypes.ServiceEndpointZoneWeightsAnnotationKey:   "zone-a=50",
+		}
+		svc.Spec.ClusterIP = svc1IPv4.String()
+		svc.Spec.ClusterIPs = []string{svc1IPv4.String()}
+		svc.Spec.Ports = []corev1.ServicePort{{
+			Name:     svcPortName.Port,
+			Port:     int32(svcPort),
+			Protocol: corev1.ProtocolTCP,
+		}}
+	})
+	ep1, epPort := makeTestEndpointSliceEndpointAndPort(&svcPortName, ep1IPv4, int32(svcPort), corev1.ProtocolTCP, false)
+	ep1.Zone = ptr.To("zone-a")
+	eps := makeTestEndpointSlice(svcPortName.Namespace, svcPortName.Name, []discovery.Endpoint{*ep1}, []discovery.EndpointPort{*epPort}, false)
+	fp.OnServiceUpdate(nil, svc)
+	fp.OnServiceSynced()
+	fp.OnEndpointSliceUpdate(nil, eps)
+	fp.OnEndpointsSynced()
+
+	// Slow-start is ignored as the weights would differ across Nodes, while the zone weights are still applied.
+	expectedEp1 := k8sproxy.NewBaseEndpointInfo(ep1IPv4.String(), "", "zone-a", svcPort, false, true, true, false, nil)
+	mockOFClient.EXPECT().InstallServiceGroup(binding.GroupIDType(1), false, true, []k8sproxy.Endpoint{expectedEp1}, map[string]uint16{expectedEp1.String(): 50})
+	mockOFClient.EXPECT().InstallEndpointFlows(binding.ProtocolTCP, []k8sproxy.Endpoint{expectedEp1})
+	mockOFClient.EXPECT().InstallServiceFlows(gomock.Any())
+	fp.syncProxyRules()
+	assert.False(t, fakeClock.HasWaiters(), "No sync should be scheduled without slow-start")
+}
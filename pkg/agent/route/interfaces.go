@@ -70,6 +70,14 @@ type Interface interface {
 	// DeleteEgressRoutes deletes the routes installed by AddEgressRoute.
 	DeleteEgressRoutes(tableID uint32) error
 
+	// AddBGPRoute adds a route to the provided destination via the provided next hop, for a route received from a
+	// BGP peer. It should override the route if it already exists, without error.
+	AddBGPRoute(dst *net.IPNet, nextHop net.IP) error
+
+	// DeleteBGPRoute deletes the route installed by AddBGPRoute.
+	// It should do nothing if the route doesn't exist, without error.
+	DeleteBGPRoute(dst *net.IPNet) error
+
 	// AddEgressRule creates an IP rule which makes Egress traffic with the provided mark look up the specified table.
 	AddEgressRule(tableID uint32, mark uint32) error
 
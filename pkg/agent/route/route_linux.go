@@ -92,6 +92,12 @@ const (
 
 	preNodeNetworkPolicyIngressRulesChain = "ANTREA-POL-PRE-INGRESS-RULES"
 	preNodeNetworkPolicyEgressRulesChain  = "ANTREA-POL-PRE-EGRESS-RULES"
+
+	// The routes received from BGP peers are installed with the "bgp" protocol and a dedicated metric, so that they
+	// can be identified, and so that they never replace the routes installed by Antrea or by other routing daemons
+	// for the same destinations.
+	bgpRouteProtocol = netlink.RouteProtocol(unix.RTPROT_BGP)
+	bgpRouteMetric   = 200
 )
 
 // Client implements Interface.
@@ -152,6 +158,8 @@ type Client struct {
 	clusterNodeIP6s sync.Map
 	// egressRoutes caches ip routes about Egresses.
 	egressRoutes sync.Map
+	// bgpRoutes caches ip routes received from BGP peers. It's a map of destination CIDR to route.
+	bgpRoutes sync.Map
 	// The latest calculated Service CIDRs can be got from serviceCIDRProvider.
 	serviceCIDRProvider servicecidr.Interface
 	// nodeNetworkPolicyIPSetsIPv4 caches all existing IPv4 ipsets for NodeNetworkPolicy.
@@ -385,6 +393,9 @@ func (c *Client) syncRoute() error {
 		}
 		return true
 	})
+	c.bgpRoutes.Range(func(_, v any) bool {
+		return restoreRoute(v.(*netlink.Route))
+	})
 	// These routes are installed automatically by the kernel when the address is configured on
 	// the interface (with "proto kernel"). If these routes are deleted manually by mistake, we
 	// restore them as part of this sync (without "proto kernel"). An alternative would be to
@@ -1430,6 +1441,12 @@ func (c *Client) Reconcile(podCIDRs []string) error {
 		}
 	}
 
+	// Remove the routes received from BGP peers which were installed before the agent restarted and have not been
+	// installed again.
+	if err := c.deleteOrphanedBGPRoutes(); err != nil {
+		return err
+	}
+
 	// Return immediately if there is no IPv6 gateway address configured on the Nodes.
 	if desiredIPv6GWs.Len() == 0 {
 		return nil
@@ -1873,6 +1890,53 @@ func (c *Client) DeleteEgressRoutes(tableID uint32) error {
 	return nil
 }
 
+func (c *Client) AddBGPRoute(dst *net.IPNet, nextHop net.IP) error {
+	route := &netlink.Route{
+		Dst:      dst,
+		Gw:       nextHop,
+		Protocol: bgpRouteProtocol,
+		Priority: bgpRouteMetric,
+	}
+	if err := c.netlink.RouteReplace(route); err != nil {
+		return fmt.Errorf("failed to install route to %s via %s: %w", dst, nextHop, err)
+	}
+	c.bgpRoutes.Store(dst.String(), route)
+	return nil
+}
+
+func (c *Client) DeleteBGPRoute(dst *net.IPNet) error {
+	value, exists := c.bgpRoutes.Load(dst.String())
+	if !exists {
+		return nil
+	}
+	if err := c.netlink.RouteDel(value.(*netlink.Route)); err != nil && err != unix.ESRCH {
+		return fmt.Errorf("failed to delete route to %s: %w", dst, err)
+	}
+	c.bgpRoutes.Delete(dst.String())
+	return nil
+}
+
+func (c *Client) deleteOrphanedBGPRoutes() error {
+	routes, err := c.netlink.RouteListFiltered(netlink.FAMILY_ALL, &netlink.Route{Protocol: bgpRouteProtocol}, netlink.RT_FILTER_PROTOCOL)
+	if err != nil {
+		return fmt.Errorf("error listing BGP routes: %w", err)
+	}
+	for i := range routes {
+		route := routes[i]
+		if route.Priority != bgpRouteMetric || route.Dst == nil {
+			continue
+		}
+		if _, exists := c.bgpRoutes.Load(route.Dst.String()); exists {
+			continue
+		}
+		klog.InfoS("Deleting orphaned BGP route", "route", route)
+		if err := c.netlink.RouteDel(&route); err != nil && err != unix.ESRCH {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *Client) AddEgressRule(tableID uint32, mark uint32) error {
 	rule := netlink.NewRule()
 	rule.Table = int(tableID)
@@ -1003,6 +1003,15 @@ func TestReconcile(t *testing.T) {
 	mockNetlink.EXPECT().RouteDel(&netlink.Route{Dst: ip.MustParseCIDR("192.168.11.0/24")})
 	mockNetlink.EXPECT().RouteDel(&netlink.Route{Dst: ip.MustParseCIDR("2001:ab03:cd04:55ee:100b::/80")})
 
+	bgpRoute := &netlink.Route{Dst: ip.MustParseCIDR("10.10.0.0/16"), Gw: net.ParseIP("172.16.0.1"), Protocol: bgpRouteProtocol, Priority: bgpRouteMetric}
+	c.bgpRoutes.Store("10.10.0.0/16", bgpRoute)
+	mockNetlink.EXPECT().RouteListFiltered(netlink.FAMILY_ALL, &netlink.Route{Protocol: bgpRouteProtocol}, netlink.RT_FILTER_PROTOCOL).Return([]netlink.Route{
+		*bgpRoute, // installed BGP route, should not be deleted.
+		{Dst: ip.MustParseCIDR("10.20.0.0/16"), Gw: net.ParseIP("172.16.0.1"), Protocol: bgpRouteProtocol, Priority: 20},             // route installed by another BGP daemon, should not be deleted.
+		{Dst: ip.MustParseCIDR("10.30.0.0/16"), Gw: net.ParseIP("172.16.0.1"), Protocol: bgpRouteProtocol, Priority: bgpRouteMetric}, // orphaned BGP route, should be deleted.
+	}, nil)
+	mockNetlink.EXPECT().RouteDel(&netlink.Route{Dst: ip.MustParseCIDR("10.30.0.0/16"), Gw: net.ParseIP("172.16.0.1"), Protocol: bgpRouteProtocol, Priority: bgpRouteMetric})
+
 	mockNetlink.EXPECT().NeighList(10, netlink.FAMILY_V6).Return([]netlink.Neigh{
 		{IP: net.ParseIP("2001:ab03:cd04:55ee:1001::1")}, // existing podCIDR, should not be deleted.
 		{IP: net.ParseIP("fc01::aabb:ccdd:eeff")},        // virtual service IP, should not be deleted.
@@ -2181,6 +2190,47 @@ func TestEgressRoutes(t *testing.T) {
 	}
 }
 
+func TestBGPRoutes(t *testing.T) {
+	tests := []struct {
+		name    string
+		dst     *net.IPNet
+		nextHop net.IP
+	}{
+		{
+			name:    "IPv4",
+			dst:     ip.MustParseCIDR("10.10.0.0/16"),
+			nextHop: net.ParseIP("172.16.0.1"),
+		},
+		{
+			name:    "IPv6",
+			dst:     ip.MustParseCIDR("fd00:10::/64"),
+			nextHop: net.ParseIP("fd00:172:16::1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockNetlink := netlinktest.NewMockInterface(ctrl)
+			c := &Client{
+				netlink:    mockNetlink,
+				nodeConfig: nodeConfig,
+			}
+			expectedRoute := &netlink.Route{Dst: tt.dst, Gw: tt.nextHop, Protocol: bgpRouteProtocol, Priority: bgpRouteMetric}
+			mockNetlink.EXPECT().RouteReplace(expectedRoute)
+			mockNetlink.EXPECT().RouteDel(expectedRoute)
+
+			assert.NoError(t, c.AddBGPRoute(tt.dst, tt.nextHop))
+			assert.NoError(t, c.DeleteBGPRoute(tt.dst))
+			// Deleting a route which doesn't exist should do nothing.
+			assert.NoError(t, c.DeleteBGPRoute(tt.dst))
+			c.bgpRoutes.Range(func(key, value any) bool {
+				t.Errorf("The bgpRoutes should be empty but contains %v:%v", key, value)
+				return true
+			})
+		})
+	}
+}
+
 func TestEgressRule(t *testing.T) {
 	tests := []struct {
 		name          string
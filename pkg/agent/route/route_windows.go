@@ -602,6 +602,14 @@ func generateNeigh(ip net.IP, linkIndex int) *winnet.Neighbor {
 	}
 }
 
+func (c *Client) AddBGPRoute(dst *net.IPNet, nextHop net.IP) error {
+	return errors.New("AddBGPRoute is not implemented on Windows")
+}
+
+func (c *Client) DeleteBGPRoute(dst *net.IPNet) error {
+	return errors.New("DeleteBGPRoute is not implemented on Windows")
+}
+
 func (c *Client) AddRouteForLink(dstCIDR *net.IPNet, linkIndex int) error {
 	return errors.New("AddRouteForLink is not implemented on Windows")
 }
@@ -58,6 +58,20 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AddBGPRoute mocks base method.
+func (m *MockInterface) AddBGPRoute(dst *net.IPNet, nextHop net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBGPRoute", dst, nextHop)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBGPRoute indicates an expected call of AddBGPRoute.
+func (mr *MockInterfaceMockRecorder) AddBGPRoute(dst, nextHop any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBGPRoute", reflect.TypeOf((*MockInterface)(nil).AddBGPRoute), dst, nextHop)
+}
+
 // AddEgressRoutes mocks base method.
 func (m *MockInterface) AddEgressRoutes(tableID uint32, dev int, gateway net.IP, prefixLength int) error {
 	m.ctrl.T.Helper()
@@ -212,6 +226,20 @@ func (mr *MockInterfaceMockRecorder) ClearConntrackEntryForService(svcIP, svcPor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearConntrackEntryForService", reflect.TypeOf((*MockInterface)(nil).ClearConntrackEntryForService), svcIP, svcPort, endpointIP, protocol)
 }
 
+// DeleteBGPRoute mocks base method.
+func (m *MockInterface) DeleteBGPRoute(dst *net.IPNet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBGPRoute", dst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBGPRoute indicates an expected call of DeleteBGPRoute.
+func (mr *MockInterfaceMockRecorder) DeleteBGPRoute(dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBGPRoute", reflect.TypeOf((*MockInterface)(nil).DeleteBGPRoute), dst)
+}
+
 // DeleteEgressRoutes mocks base method.
 func (m *MockInterface) DeleteEgressRoutes(tableID uint32) error {
 	m.ctrl.T.Helper()
@@ -49,6 +49,16 @@ type statsCollection struct {
 	antreaNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
 	// multicastGroups is a map that encodes the list of Pods that has joined the multicast group.
 	multicastGroups map[string][]cpv1beta.PodReference
+	// egressStats is a mapping from Egress UIDs to the traffic stats of their local Pods.
+	egressStats map[types.UID]*egressStats
+}
+
+// egressStats contains the traffic stats of an Egress, broken down by the ofPorts of the local Pods to which the
+// Egress is applied. The stats are kept per ofPort because the counters of a Pod's flow are reset when the flow is
+// reinstalled, e.g. when the Pod switches to another Egress.
+type egressStats struct {
+	name      string
+	podsStats map[int32]*statsv1alpha1.TrafficStats
 }
 
 // Collector is responsible for collecting stats from the Openflow client, calculating the delta compared with the last
@@ -62,13 +72,14 @@ type Collector struct {
 	ofClient             openflow.Client
 	networkPolicyQuerier querier.AgentNetworkPolicyInfoQuerier
 	multicastQuerier     querier.AgentMulticastInfoQuerier
+	egressQuerier        querier.EgressQuerier
 	// lastStatsCollection is the last statistics that has been reported to antrea-controller successfully.
 	// It is used to calculate the delta of the statistics that will be reported.
 	lastStatsCollection *statsCollection
 	multicastEnabled    bool
 }
 
-func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller) *Collector {
+func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller, egressQuerier querier.EgressQuerier) *Collector {
 	nodeName, _ := env.GetNodeName()
 	manager := &Collector{
 		nodeName:             nodeName,
@@ -77,6 +88,7 @@ func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient ope
 		networkPolicyQuerier: npQuerier,
 		multicastQuerier:     mcQuerier,
 		multicastEnabled:     mcQuerier != nil,
+		egressQuerier:        egressQuerier,
 	}
 	return manager
 }
@@ -109,13 +121,18 @@ func (m *Collector) Run(stopCh <-chan struct{}) {
 	}
 }
 
-// collect collects the stats of Openflow rules, maps them to the stats of NetworkPolicies.
+// collect collects the stats of Openflow rules, maps them to the stats of NetworkPolicies and Egresses.
 // It returns a map from NetworkPolicyReferences to their stats.
 func (m *Collector) collect() *statsCollection {
-	ruleStatsMap := m.ofClient.NetworkPolicyMetrics()
 	npStatsMap := map[types.UID]*statsv1alpha1.TrafficStats{}
 	acnpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
 	annpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
+	// The NetworkPolicy querier is nil when NetworkPolicyStats is disabled, in which case only Egress stats are
+	// collected.
+	var ruleStatsMap map[uint32]*agenttypes.RuleMetric
+	if m.networkPolicyQuerier != nil {
+		ruleStatsMap = m.ofClient.NetworkPolicyMetrics()
+	}
 
 	for ofID, ruleStats := range ruleStatsMap {
 		rule := m.networkPolicyQuerier.GetRuleByFlowID(ofID)
@@ -145,9 +162,41 @@ func (m *Collector) collect() *statsCollection {
 		antreaClusterNetworkPolicyStats: acnpStatsMap,
 		antreaNetworkPolicyStats:        annpStatsMap,
 		multicastGroups:                 multicastGroupMap,
+		egressStats:                     m.collectEgressStats(),
 	}
 }
 
+// collectEgressStats collects the stats of the SNAT flows of local Pods and maps them to the Egresses applied to the
+// Pods.
+func (m *Collector) collectEgressStats() map[types.UID]*egressStats {
+	if m.egressQuerier == nil {
+		return nil
+	}
+	egressOFPorts := m.egressQuerier.GetEgressOFPorts()
+	if len(egressOFPorts) == 0 {
+		return nil
+	}
+	podStatsMap := m.ofClient.EgressMetrics()
+	egressStatsMap := make(map[types.UID]*egressStats, len(egressOFPorts))
+	for _, egress := range egressOFPorts {
+		stats := &egressStats{
+			name:      egress.Name,
+			podsStats: make(map[int32]*statsv1alpha1.TrafficStats, len(egress.OFPorts)),
+		}
+		for _, ofPort := range egress.OFPorts {
+			podStats, exists := podStatsMap[uint32(ofPort)]
+			if !exists {
+				continue
+			}
+			trafficStats := new(statsv1alpha1.TrafficStats)
+			addUp(trafficStats, podStats)
+			stats.podsStats[ofPort] = trafficStats
+		}
+		egressStatsMap[egress.UID] = stats
+	}
+	return egressStatsMap
+}
+
 func addPolicyStatsUp(statsMap map[types.UID]*statsv1alpha1.TrafficStats, ruleStats *agenttypes.RuleMetric, rule *agenttypes.PolicyRule) {
 	policyStats, exists := statsMap[rule.PolicyRef.UID]
 	if !exists {
@@ -215,13 +264,14 @@ func (m *Collector) calculateNodeStatsSummary(curStatsCollection *statsCollectio
 	var multicastGroups []cpv1beta.MulticastGroupInfo
 	multicastGroupsUpdated := false
 	npStats, acnpStats, annpStats := m.calculateNPStats(curStatsCollection)
+	egressStats := calculateEgressDiff(curStatsCollection.egressStats, m.lastStatsCollection.egressStats)
 	if m.multicastEnabled {
 		multicastGroupsUpdated = !isIdenticalMulticastGroupMap(curStatsCollection.multicastGroups, m.lastStatsCollection.multicastGroups)
 		acnpStats, annpStats = m.mergeStatsWithIGMPReports(acnpStats, annpStats)
 		multicastGroups = m.convertMulticastGroups(curStatsCollection.multicastGroups)
 	}
 	// Semantically, reporting networkpolicy statistics with zero length is equal to reporting the same multicastGroupInfo.
-	if len(npStats) == 0 && len(acnpStats) == 0 && len(annpStats) == 0 && !multicastGroupsUpdated {
+	if len(npStats) == 0 && len(acnpStats) == 0 && len(annpStats) == 0 && len(egressStats) == 0 && !multicastGroupsUpdated {
 		return nil
 	}
 	return &cpv1beta.NodeStatsSummary{
@@ -232,6 +282,7 @@ func (m *Collector) calculateNodeStatsSummary(curStatsCollection *statsCollectio
 		AntreaClusterNetworkPolicies: acnpStats,
 		AntreaNetworkPolicies:        annpStats,
 		Multicast:                    multicastGroups,
+		Egresses:                     egressStats,
 	}
 }
 
@@ -381,3 +432,44 @@ func calculateDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.Traffi
 	}
 	return statsList
 }
+
+func calculateEgressDiff(curStatsMap, lastStatsMap map[types.UID]*egressStats) []cpv1beta.EgressStats {
+	if len(curStatsMap) == 0 {
+		return nil
+	}
+	statsList := make([]cpv1beta.EgressStats, 0, len(curStatsMap))
+	for uid, curStats := range curStatsMap {
+		var lastPodsStats map[int32]*statsv1alpha1.TrafficStats
+		if lastStats, exists := lastStatsMap[uid]; exists {
+			lastPodsStats = lastStats.podsStats
+		}
+		stats := statsv1alpha1.TrafficStats{}
+		for ofPort, curPodStats := range curStats.podsStats {
+			lastPodStats, exists := lastPodsStats[ofPort]
+			// curPodStats.Bytes < lastPodStats.Bytes could happen if the SNAT flow of the Pod is reinstalled in-between
+			// two collections. In this case, curPodStats is the delta it should report.
+			if !exists || curPodStats.Bytes < lastPodStats.Bytes {
+				addUpTrafficStats(&stats, curPodStats)
+			} else {
+				stats.Packets += curPodStats.Packets - lastPodStats.Packets
+				stats.Bytes += curPodStats.Bytes - lastPodStats.Bytes
+			}
+		}
+		// If the statistics of the Egress remain unchanged, no need to report it.
+		if stats.Bytes == 0 {
+			continue
+		}
+		statsList = append(statsList, cpv1beta.EgressStats{
+			Name:         curStats.name,
+			UID:          uid,
+			TrafficStats: stats,
+		})
+	}
+	return statsList
+}
+
+func addUpTrafficStats(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
+	stats.Sessions += inc.Sessions
+	stats.Packets += inc.Packets
+	stats.Bytes += inc.Bytes
+}
@@ -837,3 +837,125 @@ func TestCalculateRuleDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectEgressStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ofClient := oftest.NewMockClient(ctrl)
+	egressQuerier := queriertest.NewMockEgressQuerier(ctrl)
+	egressQuerier.EXPECT().GetEgressOFPorts().Return([]agenttypes.EgressOFPorts{
+		{Name: "egress1", UID: "uid1", OFPorts: []int32{10, 11}},
+		{Name: "egress2", UID: "uid2", OFPorts: []int32{12}},
+	})
+	ofClient.EXPECT().EgressMetrics().Return(map[uint32]*agenttypes.RuleMetric{
+		10: {Packets: 10, Bytes: 1000},
+		11: {Packets: 5, Bytes: 600},
+		13: {Packets: 1, Bytes: 100},
+	})
+
+	m := &Collector{ofClient: ofClient, egressQuerier: egressQuerier}
+	expected := map[types.UID]*egressStats{
+		"uid1": {
+			name: "egress1",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{
+				10: {Packets: 10, Bytes: 1000},
+				11: {Packets: 5, Bytes: 600},
+			},
+		},
+		"uid2": {
+			name:      "egress2",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{},
+		},
+	}
+	assert.Equal(t, expected, m.collectEgressStats())
+
+	// The OVS flows are not dumped if there is no Egress applied to local Pods.
+	egressQuerier.EXPECT().GetEgressOFPorts().Return(nil)
+	assert.Nil(t, m.collectEgressStats())
+}
+
+func TestCollectEgressStatsOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ofClient := oftest.NewMockClient(ctrl)
+	egressQuerier := queriertest.NewMockEgressQuerier(ctrl)
+	egressQuerier.EXPECT().GetEgressOFPorts().Return([]agenttypes.EgressOFPorts{
+		{Name: "egress1", UID: "uid1", OFPorts: []int32{10}},
+	})
+	ofClient.EXPECT().EgressMetrics().Return(map[uint32]*agenttypes.RuleMetric{
+		10: {Bytes: 100, Packets: 2},
+	})
+	// The NetworkPolicy flows are not dumped if NetworkPolicyStats is disabled.
+	m := &Collector{ofClient: ofClient, egressQuerier: egressQuerier}
+	expected := &statsCollection{
+		networkPolicyStats:              map[types.UID]*statsv1alpha1.TrafficStats{},
+		antreaClusterNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+		antreaNetworkPolicyStats:        map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+		egressStats: map[types.UID]*egressStats{
+			"uid1": {
+				name: "egress1",
+				podsStats: map[int32]*statsv1alpha1.TrafficStats{
+					10: {Bytes: 100, Packets: 2},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, m.collect())
+}
+
+func TestCalculateEgressDiff(t *testing.T) {
+	lastStats := map[types.UID]*egressStats{
+		"uid1": {
+			name: "egress1",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{
+				10: {Packets: 10, Bytes: 1000},
+				11: {Packets: 5, Bytes: 600},
+			},
+		},
+		"uid2": {
+			name: "egress2",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{
+				12: {Packets: 3, Bytes: 300},
+			},
+		},
+	}
+	curStats := map[types.UID]*egressStats{
+		"uid1": {
+			name: "egress1",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{
+				// Existing Pod.
+				10: {Packets: 15, Bytes: 1500},
+				// The flow of the Pod has been reinstalled.
+				11: {Packets: 2, Bytes: 200},
+				// New Pod.
+				13: {Packets: 1, Bytes: 100},
+			},
+		},
+		// Unchanged Egress.
+		"uid2": {
+			name: "egress2",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{
+				12: {Packets: 3, Bytes: 300},
+			},
+		},
+		// New Egress.
+		"uid3": {
+			name: "egress3",
+			podsStats: map[int32]*statsv1alpha1.TrafficStats{
+				14: {Packets: 4, Bytes: 400},
+			},
+		},
+	}
+	expected := []cpv1beta.EgressStats{
+		{
+			Name:         "egress1",
+			UID:          "uid1",
+			TrafficStats: statsv1alpha1.TrafficStats{Packets: 8, Bytes: 800},
+		},
+		{
+			Name:         "egress3",
+			UID:          "uid3",
+			TrafficStats: statsv1alpha1.TrafficStats{Packets: 4, Bytes: 400},
+		},
+	}
+	assert.ElementsMatch(t, expected, calculateEgressDiff(curStats, lastStats))
+	assert.Nil(t, calculateEgressDiff(nil, lastStats))
+}
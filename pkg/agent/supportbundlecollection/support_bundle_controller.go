@@ -37,16 +37,13 @@ import (
 	"antrea.io/antrea/pkg/ovs/ovsctl"
 	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/support"
+	"antrea.io/antrea/pkg/util/auth"
 	"antrea.io/antrea/pkg/util/compress"
 	"antrea.io/antrea/pkg/util/k8s"
-	"antrea.io/antrea/pkg/util/sftp"
+	"antrea.io/antrea/pkg/util/upload"
 )
 
-type ProtocolType string
-
 const (
-	sftpProtocol ProtocolType = "sftp"
-
 	controllerName = "SupportBundleCollectionController"
 )
 
@@ -71,7 +68,7 @@ type SupportBundleController struct {
 	npq                          querier.AgentNetworkPolicyInfoQuerier
 	v4Enabled                    bool
 	v6Enabled                    bool
-	sftpUploader                 sftp.Uploader
+	uploaders                    map[upload.Scheme]upload.Uploader
 }
 
 func NewSupportBundleController(nodeName string,
@@ -96,7 +93,7 @@ func NewSupportBundleController(nodeName string,
 		npq:          npq,
 		v4Enabled:    v4Enabled,
 		v6Enabled:    v6Enabled,
-		sftpUploader: sftp.NewUploader(),
+		uploaders:    upload.NewUploaders(),
 	}
 	return c
 }
@@ -288,7 +285,11 @@ func (c *SupportBundleController) generateSupportBundle(supportBundle *cpv1b2.Su
 
 func (c *SupportBundleController) uploadSupportBundle(supportBundle *cpv1b2.SupportBundleCollection, outputFile afero.File) error {
 	klog.V(2).InfoS("Uploading support bundle collection", "name", supportBundle.Name)
-	uploader, err := c.getUploaderByProtocol(sftpProtocol)
+	scheme, err := upload.ParseScheme(supportBundle.FileServer.URL)
+	if err != nil {
+		return fmt.Errorf("failed to upload support bundle while parsing file server URL: %v", err)
+	}
+	uploader, err := c.getUploaderByProtocol(scheme)
 	if err != nil {
 		return fmt.Errorf("failed to upload support bundle while getting uploader: %v", err)
 	}
@@ -297,22 +298,40 @@ func (c *SupportBundleController) uploadSupportBundle(supportBundle *cpv1b2.Supp
 		return fmt.Errorf("failed to upload to the file server while setting offset: %v", err)
 	}
 	fileName := c.nodeName + "_" + supportBundle.Name + ".tar.gz"
-	cfg, err := sftp.GetSSHClientConfig(
-		supportBundle.Authentication.BasicAuthentication.Username,
-		supportBundle.Authentication.BasicAuthentication.Password,
-		supportBundle.FileServer.HostPublicKey,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to generate SSH client config: %w", err)
+	credentials := &upload.Credentials{
+		Auth:          toAuthConfiguration(&supportBundle.Authentication),
+		HostPublicKey: supportBundle.FileServer.HostPublicKey,
 	}
-	return uploader.Upload(supportBundle.FileServer.URL, fileName, cfg, outputFile)
+	return uploader.Upload(context.TODO(), supportBundle.FileServer.URL, fileName, credentials, outputFile)
 }
 
-func (c *SupportBundleController) getUploaderByProtocol(protocol ProtocolType) (sftp.Uploader, error) {
-	if protocol == sftpProtocol {
-		return c.sftpUploader, nil
+func (c *SupportBundleController) getUploaderByProtocol(scheme upload.Scheme) (upload.Uploader, error) {
+	if uploader, ok := c.uploaders[scheme]; ok {
+		return uploader, nil
+	}
+	return nil, fmt.Errorf("unsupported protocol %s", scheme)
+}
+
+// toAuthConfiguration converts the authentication information received from the Antrea Controller, so that it can be
+// consumed by an upload.Uploader.
+func toAuthConfiguration(authConfig *cpv1b2.BundleServerAuthConfiguration) *auth.AuthConfiguration {
+	authConfiguration := &auth.AuthConfiguration{
+		BearerToken: authConfig.BearerToken,
+		APIKey:      authConfig.APIKey,
+	}
+	switch {
+	case authConfig.BasicAuthentication != nil:
+		authConfiguration.AuthType = auth.BasicAuthenticationType
+		authConfiguration.BasicAuthentication = &auth.BasicAuthentication{
+			Username: authConfig.BasicAuthentication.Username,
+			Password: authConfig.BasicAuthentication.Password,
+		}
+	case authConfig.BearerToken != "":
+		authConfiguration.AuthType = auth.BearerTokenType
+	case authConfig.APIKey != "":
+		authConfiguration.AuthType = auth.APIKeyType
 	}
-	return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	return authConfiguration
 }
 
 func (c *SupportBundleController) updateSupportBundleCollectionStatus(key string, complete bool, genErr error) error {
@@ -41,6 +41,7 @@ import (
 	"antrea.io/antrea/pkg/support"
 	"antrea.io/antrea/pkg/util/sftp"
 	sftptesting "antrea.io/antrea/pkg/util/sftp/testing"
+	"antrea.io/antrea/pkg/util/upload"
 )
 
 type fakeController struct {
@@ -69,6 +70,7 @@ func newFakeController(t *testing.T) (*fakeController, *fakeversioned.Clientset)
 
 func TestSupportBundleCollectionAdd(t *testing.T) {
 	uploadErr := fmt.Errorf("upload failed")
+	localDir := t.TempDir()
 	generateHostKey := func(t *testing.T) ssh.PublicKey {
 		publicKey, _, err := sftptesting.GenerateEd25519Key()
 		require.NoError(t, err)
@@ -98,12 +100,18 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 		},
 		{
 			name:                    "Add SupportBundleCollection with unsupported url prefix",
-			supportBundleCollection: generateSupportbundleCollection("supportBundle3", "https://10.220.175.92:22/root/supportbundle", nil),
+			supportBundleCollection: generateSupportbundleCollection("supportBundle3", "ftp://10.220.175.92:22/root/supportbundle", nil),
 			agentDumper:             &mockAgentDumper{},
 			uploader: &testUploader{
 				err: uploadErr,
 			},
-			expectedSyncErr: uploadErr.Error(),
+			expectedSyncErr: "unsupported protocol ftp",
+		},
+		{
+			name:                    "Add SupportBundleCollection with local volume",
+			supportBundleCollection: generateSupportbundleCollection("supportBundleLocal", "file://"+localDir, nil),
+			agentDumper:             &mockAgentDumper{},
+			uploader:                &testUploader{err: uploadErr},
 		},
 		{
 			name:                    "Add SupportBundleCollection with retry logics",
@@ -214,7 +222,10 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 				newAgentDumper = support.NewAgentDumper
 			}()
 			controller, clientset := newFakeController(t)
-			controller.sftpUploader = tt.uploader
+			controller.uploaders = map[upload.Scheme]upload.Uploader{
+				upload.SchemeSFTP: upload.NewSFTPUploader(tt.uploader),
+				upload.SchemeFile: upload.NewFileUploader(),
+			}
 			var bundleStatus *cpv1b2.SupportBundleCollectionStatus
 			clientset.AddReactor("update", "supportbundlecollections/status", k8stesting.ReactionFunc(func(action k8stesting.Action) (bool, runtime.Object, error) {
 				bundleStatus = action.(k8stesting.UpdateAction).GetObject().(*cpv1b2.SupportBundleCollectionStatus)
@@ -39,6 +39,24 @@ const (
 	// ServiceLoadBalancerModeAnnotationKey is the key of the Service annotation that specifies the Service's load balancer mode.
 	ServiceLoadBalancerModeAnnotationKey string = "service.antrea.io/load-balancer-mode"
 
+	// ServiceLoadBalancerAlgorithmAnnotationKey is the key of the Service annotation that specifies the algorithm used to select the Service's Endpoints.
+	ServiceLoadBalancerAlgorithmAnnotationKey string = "service.antrea.io/load-balancer-algorithm"
+
+	// ServiceSlowStartWindowAnnotationKey is the key of the Service annotation that specifies the duration over which the weight of a new Endpoint is ramped up.
+	ServiceSlowStartWindowAnnotationKey string = "service.antrea.io/slow-start-window"
+
+	// ServiceEndpointZoneWeightsAnnotationKey is the key of the Service annotation that specifies the weights of the Service's Endpoints by zone.
+	ServiceEndpointZoneWeightsAnnotationKey string = "service.antrea.io/endpoint-zone-weights"
+
+	// ServiceEndpointHealthCheckAnnotationKey is the key of the Service annotation that specifies how the antrea-agent probes the health of the Service's Endpoints.
+	ServiceEndpointHealthCheckAnnotationKey string = "service.antrea.io/endpoint-health-check"
+
+	// ServiceMaxConnectionsAnnotationKey is the key of the Service annotation that specifies the maximum number of concurrent connections to the Service on each Node.
+	ServiceMaxConnectionsAnnotationKey string = "service.antrea.io/max-connections"
+
+	// ServiceMaxNewConnectionsPerSecondAnnotationKey is the key of the Service annotation that specifies the maximum rate of new connections to the Service on each Node.
+	ServiceMaxNewConnectionsPerSecondAnnotationKey string = "service.antrea.io/max-new-connections-per-second"
+
 	// L7FlowExporterAnnotationKey is the key of the L7 network flow export annotation that enables L7 network flow export for annotated Pod or Namespace based on the value of annotation which is direction of traffic.
 	L7FlowExporterAnnotationKey string = "visibility.antrea.io/l7-export"
 )
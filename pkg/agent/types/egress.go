@@ -24,3 +24,10 @@ type EgressConfig struct {
 	EgressIP   string
 	EgressNode string
 }
+
+// EgressOFPorts contains the ofPorts of the local Pods to which an Egress is applied.
+type EgressOFPorts struct {
+	Name    string
+	UID     types.UID
+	OFPorts []int32
+}
@@ -113,6 +113,7 @@ type PolicyRule struct {
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
 	LogLabel      string
+	LogSampling   *v1beta2.LogSampling
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
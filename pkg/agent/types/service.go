@@ -20,6 +20,14 @@ import (
 	"antrea.io/antrea/pkg/ovs/openflow"
 )
 
+const (
+	// DefaultEndpointWeight is the weight of an Endpoint in the group of a Service when no weight is specified for it.
+	DefaultEndpointWeight uint16 = 100
+	// MaxServiceConnectionLimitsID is the maximum ID of the connection limits of a Service port. It's limited by the
+	// number of CT zones reserved for the connection limits.
+	MaxServiceConnectionLimitsID uint32 = 0x7fff
+)
+
 // ServiceConfig contains the configuration needed to install flows for a given Service entrypoint.
 type ServiceConfig struct {
 	ServiceIP          net.IP
@@ -37,6 +45,21 @@ type ServiceConfig struct {
 	IsNested bool
 	// IsDSR indicates that whether the Service works in Direct Server Return mode.
 	IsDSR bool
+	// ConnectionLimits is the connection limits of the Service, nil if the Service has no limits.
+	ConnectionLimits *ServiceConnectionLimits
+}
+
+// ServiceConnectionLimits contains the limits enforced on the connections to a Service port on the Node. The limits are
+// shared by all the entrypoints (ClusterIP, NodePort, LoadBalancerIP and ExternalIP) of the Service port.
+type ServiceConnectionLimits struct {
+	// ID identifies the limits of the Service port in OVS, in the range [1, MaxServiceConnectionLimitsID]. It's used
+	// to derive the ID of the meter limiting the rate of new connections and the CT zone limiting the number of
+	// concurrent connections.
+	ID uint32
+	// MaxConnections is the maximum number of concurrent connections, 0 means unlimited.
+	MaxConnections uint32
+	// MaxNewConnectionsPerSecond is the maximum rate of new connections, 0 means unlimited.
+	MaxNewConnectionsPerSecond uint32
 }
 
 func (c *ServiceConfig) TrafficPolicyGroupID() openflow.GroupIDType {
@@ -32,11 +32,13 @@ import (
 	"antrea.io/antrea/pkg/antctl/transform/addressgroup"
 	"antrea.io/antrea/pkg/antctl/transform/appliedtogroup"
 	"antrea.io/antrea/pkg/antctl/transform/controllerinfo"
+	"antrea.io/antrea/pkg/antctl/transform/egressstats"
 	"antrea.io/antrea/pkg/antctl/transform/networkpolicy"
 	"antrea.io/antrea/pkg/antctl/transform/ovstracing"
 	"antrea.io/antrea/pkg/antctl/transform/version"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
 	controllerapis "antrea.io/antrea/pkg/apiserver/apis"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
@@ -311,6 +313,24 @@ $ antctl get podmulticaststats pod -n namespace`,
 			commandGroup:        get,
 			transformedResponse: reflect.TypeOf(controllerinfo.Response{}),
 		},
+		{
+			use:     "egressstats",
+			aliases: []string{"egressstat", "es"},
+			short:   "Print Egress traffic statistics",
+			long:    "Print the packets and bytes sent through each Egress, aggregated from all Nodes by ${component}",
+			example: `  Get the traffic statistics of all Egresses
+  $ antctl get egressstats
+  Get the traffic statistics of a specific Egress
+  $ antctl get egressstats egress-prod`,
+			commandGroup: get,
+			controllerEndpoint: &endpoint{
+				resourceEndpoint: &resourceEndpoint{
+					groupVersionResource: &statsv1alpha1.EgressStatsVersionResource,
+				},
+				addonTransform: egressstats.Transform,
+			},
+			transformedResponse: reflect.TypeOf(egressstats.Response{}),
+		},
 		{
 			use:     "agentinfo",
 			aliases: []string{"agentinfos", "ai"},
@@ -689,16 +709,18 @@ $ antctl get podmulticaststats pod -n namespace`,
 		{
 			use:     "bgproutes",
 			aliases: []string{"bgproute"},
-			short:   "Print the advertised bgp routes.",
-			long:    "Print the advertised bgp routes.",
-			example: `  Get the list of all advertised bgp routes
+			short:   "Print the advertised and imported bgp routes.",
+			long:    "Print the advertised and imported bgp routes.",
+			example: `  Get the list of all advertised and imported bgp routes
   $ antctl get bgproutes
-  Get the list of advertised IPv4 bgp routes
+  Get the list of IPv4 bgp routes
   $ antctl get bgproutes --ipv4-only
-  Get the list of advertised IPv6 bgp routes
+  Get the list of IPv6 bgp routes
   $ antctl get bgproutes --ipv6-only
   Get the list of all advertised routes of a specific type
   $ antctl get bgproutes -T EgressIP
+  Get the list of all bgp routes imported from the BGP peers
+  $ antctl get bgproutes -T Imported
 `,
 			agentEndpoint: &endpoint{
 				nonResourceEndpoint: &nonResourceEndpoint{
@@ -706,19 +728,19 @@ $ antctl get podmulticaststats pod -n namespace`,
 					params: []flagInfo{
 						{
 							name:   "ipv4-only",
-							usage:  "Get advertised IPv4 bgp routes only",
+							usage:  "Get IPv4 bgp routes only",
 							isBool: true,
 						},
 						{
 							name:   "ipv6-only",
-							usage:  "Get advertised IPv6 bgp routes only",
+							usage:  "Get IPv6 bgp routes only",
 							isBool: true,
 						},
 						{
 							name:            "type",
 							shorthand:       "T",
-							usage:           "Get advertised bgp routes of a specific type. Valid types are EgressIP, ServiceLoadBalancerIP, ServiceExternalIP, ServiceClusterIP or NodeIPAMPodCIDR.",
-							supportedValues: []string{"EgressIP", "ServiceLoadBalancerIP", "ServiceExternalIP", "ServiceClusterIP", "NodeIPAMPodCIDR"},
+							usage:           "Get bgp routes of a specific type. Valid types are EgressIP, ServiceLoadBalancerIP, ServiceExternalIP, ServiceClusterIP, NodeIPAMPodCIDR, PodIP or Imported.",
+							supportedValues: []string{"EgressIP", "ServiceLoadBalancerIP", "ServiceExternalIP", "ServiceClusterIP", "NodeIPAMPodCIDR", "PodIP", "Imported"},
 						},
 					},
 					outputType: multiple,
@@ -753,6 +775,37 @@ $ antctl get podmulticaststats pod -n namespace`,
 			commandGroup:        get,
 			transformedResponse: reflect.TypeOf(agentapis.FQDNCacheResponse{}),
 		},
+		{
+			use:   "endpointhealth",
+			short: "Print Endpoint health check statuses",
+			long:  "Print the health check statuses of the Endpoints of the Services which enable active health checking in AntreaProxy",
+			example: `  Get the health statuses of all the probed Endpoints
+  $ antctl get endpointhealth
+  Get the health statuses of the probed Endpoints in a Namespace
+  $ antctl get endpointhealth -n ns1
+  Get the health statuses of the probed Endpoints of a Service
+  $ antctl get endpointhealth -n ns1 -S svc1`,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/endpointhealth",
+					params: []flagInfo{
+						{
+							name:      "namespace",
+							usage:     "Get the health statuses of the Endpoints of Services in a specific Namespace",
+							shorthand: "n",
+						},
+						{
+							name:      "service",
+							usage:     "Get the health statuses of the Endpoints of a specific Service",
+							shorthand: "S",
+						},
+					},
+					outputType: multiple,
+				},
+			},
+			commandGroup:        get,
+			transformedResponse: reflect.TypeOf(agentapis.EndpointHealthResponse{}),
+		},
 	},
 	rawCommands: []rawCommand{
 		{
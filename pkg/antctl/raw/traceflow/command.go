@@ -51,6 +51,7 @@ var (
 		flow        string
 		liveTraffic bool
 		droppedOnly bool
+		roundTrip   bool
 		timeout     time.Duration
 		nowait      bool
 	}{}
@@ -80,6 +81,8 @@ type Response struct {
 	Source         string                 `json:"source,omitempty" yaml:"source,omitempty"`                 // Traceflow source, e.g. "default/pod0"
 	Destination    string                 `json:"destination,omitempty" yaml:"destination,omitempty"`       // Traceflow destination, e.g. "default/pod1"
 	NodeResults    []v1beta1.NodeResult   `json:"results,omitempty" yaml:"results,omitempty"`               // Traceflow node results
+	ReplyResults   []v1beta1.NodeResult   `json:"replyResults,omitempty" yaml:"replyResults,omitempty"`     // Traceflow node results of the reply packet
+	RoundTripTime  string                 `json:"roundTripTime,omitempty" yaml:"roundTripTime,omitempty"`   // Round-trip time measured in round-trip Traceflow
 	CapturedPacket *CapturedPacket        `json:"capturedPacket,omitempty" yaml:"capturedPacket,omitempty"` // Captured packet in live-traffic Traceflow
 }
 
@@ -99,6 +102,8 @@ func init() {
   $antctl traceflow -S pod1 -D pod2 -f udp,udp_dst=1234
   Start a Traceflow for live TCP traffic from pod1 to svc1, with 1 minute timeout
   $antctl traceflow -S pod1 -D svc1 -f tcp --live-traffic -t 1m
+  Start a round-trip Traceflow from pod1 to pod2, and report the round-trip time
+  $antctl traceflow -S pod1 -D pod2 --round-trip
   Start a Traceflow to capture the first dropped TCP packet to pod1 on port 80, within 10 minutes
   $antctl traceflow -D pod1 -f tcp,tcp_dst=80 --live-traffic --dropped-only -t 10m
 `,
@@ -112,6 +117,7 @@ func init() {
 	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the Traceflow packet, including tcp_src, tcp_dst, tcp_flags, udp_src, udp_dst, ipv6")
 	Command.Flags().BoolVarP(&option.liveTraffic, "live-traffic", "L", false, "if set, the Traceflow will trace the first packet of the matched live traffic flow")
 	Command.Flags().BoolVarP(&option.droppedOnly, "dropped-only", "", false, "if set, capture only the dropped packet in a live-traffic Traceflow")
+	Command.Flags().BoolVarP(&option.roundTrip, "round-trip", "", false, "if set, also trace the reply packet and report the round-trip time")
 	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving results")
 }
 
@@ -157,6 +163,11 @@ func runE(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
+	if option.liveTraffic && option.roundTrip {
+		fmt.Fprintf(cmd.OutOrStdout(), "--round-trip is not supported with live-traffic Traceflow")
+		return nil
+	}
+
 	k8sclient, client, err := getClients(cmd)
 	if err != nil {
 		return err
@@ -295,6 +306,7 @@ func newTraceflow(client kubernetes.Interface) (*v1beta1.Traceflow, error) {
 			Packet:      *pkt,
 			LiveTraffic: option.liveTraffic,
 			DroppedOnly: option.droppedOnly,
+			RoundTrip:   option.roundTrip,
 			Timeout:     int32(option.timeout.Seconds()),
 		},
 	}
@@ -395,11 +407,15 @@ func getPortFields(cleanFlow string) (map[string]int, error) {
 
 func output(tf *v1beta1.Traceflow, writer io.Writer) error {
 	r := Response{
-		Name:        tf.Name,
-		Phase:       tf.Status.Phase,
-		Reason:      tf.Status.Reason,
-		Source:      fmt.Sprintf("%s/%s", tf.Spec.Source.Namespace, tf.Spec.Source.Pod),
-		NodeResults: tf.Status.Results,
+		Name:         tf.Name,
+		Phase:        tf.Status.Phase,
+		Reason:       tf.Status.Reason,
+		Source:       fmt.Sprintf("%s/%s", tf.Spec.Source.Namespace, tf.Spec.Source.Pod),
+		NodeResults:  tf.Status.Results,
+		ReplyResults: tf.Status.ReplyResults,
+	}
+	if tf.Status.RoundTripTimeNanos > 0 {
+		r.RoundTripTime = time.Duration(tf.Status.RoundTripTimeNanos).String()
 	}
 	if len(tf.Spec.Destination.IP) > 0 {
 		r.Destination = tf.Spec.Destination.IP
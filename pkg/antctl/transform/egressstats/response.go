@@ -0,0 +1,81 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egressstats
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	"antrea.io/antrea/pkg/antctl/transform"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+type Response struct {
+	Name    string `json:"name" yaml:"name"`
+	Packets int64  `json:"packets" yaml:"packets"`
+	Bytes   int64  `json:"bytes" yaml:"bytes"`
+	// StartTime is the time since which the stats have been collected.
+	StartTime string `json:"startTime" yaml:"startTime"`
+}
+
+func listTransform(l interface{}, opts map[string]string) (interface{}, error) {
+	statsList := l.(*statsv1alpha1.EgressStatsList)
+	if len(statsList.Items) == 0 {
+		return "", nil
+	}
+	result := make([]Response, 0, len(statsList.Items))
+	for i := range statsList.Items {
+		o, _ := objectTransform(&statsList.Items[i], opts)
+		result = append(result, o.(Response))
+	}
+	return result, nil
+}
+
+func objectTransform(o interface{}, _ map[string]string) (interface{}, error) {
+	stats := o.(*statsv1alpha1.EgressStats)
+	return Response{
+		Name:      stats.Name,
+		Packets:   stats.TrafficStats.Packets,
+		Bytes:     stats.TrafficStats.Bytes,
+		StartTime: stats.CreationTimestamp.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func Transform(reader io.Reader, single bool, opts map[string]string) (interface{}, error) {
+	return transform.GenericFactory(
+		reflect.TypeOf(statsv1alpha1.EgressStats{}),
+		reflect.TypeOf(statsv1alpha1.EgressStatsList{}),
+		objectTransform,
+		listTransform,
+		opts,
+	)(reader, single)
+}
+
+var _ common.TableOutput = new(Response)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"NAME", "PACKETS", "BYTES", "START-TIME"}
+}
+
+func (r Response) GetTableRow(_ int) []string {
+	return []string{r.Name, strconv.FormatInt(r.Packets, 10), strconv.FormatInt(r.Bytes, 10), r.StartTime}
+}
+
+func (r Response) SortRows() bool {
+	return true
+}
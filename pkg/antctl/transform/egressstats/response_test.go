@@ -0,0 +1,73 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egressstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+func TestTransform(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	stats := statsv1alpha1.EgressStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "egress-a",
+			CreationTimestamp: startTime,
+		},
+		TrafficStats: statsv1alpha1.TrafficStats{
+			Packets: 10,
+			Bytes:   1500,
+		},
+	}
+	expectedResponse := Response{
+		Name:      "egress-a",
+		Packets:   10,
+		Bytes:     1500,
+		StartTime: "2026-01-02T03:04:05Z",
+	}
+
+	t.Run("single", func(t *testing.T) {
+		data, err := json.Marshal(stats)
+		require.NoError(t, err)
+		result, err := Transform(bytes.NewReader(data), true, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResponse, result)
+		assert.Equal(t, []string{"egress-a", "10", "1500", "2026-01-02T03:04:05Z"}, result.(Response).GetTableRow(0))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		data, err := json.Marshal(statsv1alpha1.EgressStatsList{Items: []statsv1alpha1.EgressStats{stats}})
+		require.NoError(t, err)
+		result, err := Transform(bytes.NewReader(data), false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Response{expectedResponse}, result)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		data, err := json.Marshal(statsv1alpha1.EgressStatsList{})
+		require.NoError(t, err)
+		result, err := Transform(bytes.NewReader(data), false, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "", result)
+	})
+}
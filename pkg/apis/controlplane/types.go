@@ -277,6 +277,17 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// LogSampling limits the logs generated for this rule when EnableLogging is true.
+	LogSampling *LogSampling
+}
+
+// LogSampling describes the sampling and rate limiting of the logs generated for a rule.
+type LogSampling struct {
+	// SamplingRate is the sampling rate of the logged packets: one packet out of every SamplingRate packets is
+	// logged. 0 and 1 mean that all packets are logged.
+	SamplingRate int32
+	// MaxEventsPerSecond is the maximum number of packets logged per second. 0 means no limit.
+	MaxEventsPerSecond int32
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -330,6 +341,8 @@ type Service struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol
 	TLS  *TLSProtocol
+	GRPC *GRPCProtocol
+	DNS  *DNSProtocol
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All
@@ -344,6 +357,31 @@ type HTTPProtocol struct {
 	Method string
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string
+	// PathRegex represents a regular expression which the URI path must fully match (Ex. "/api/v[0-9]+/pods").
+	// It cannot be used together with Path.
+	PathRegex string
+	// Headers represents the HTTP request headers to match. All headers must be matched.
+	Headers []HTTPHeaderMatch
+	// QueryParams represents the query parameters of the URI to match. All query parameters must be matched.
+	QueryParams []HTTPQueryParamMatch
+}
+
+// HTTPHeaderMatch matches an HTTP request header with specific name and value.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string
+	// Value represents the value of the header to match (Ex. "tenant-a", "tenant-*").
+	// If not set, it matches any request which has the header.
+	Value string
+}
+
+// HTTPQueryParamMatch matches a query parameter of the URI with specific name and value.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match.
+	Name string
+	// Value represents the value of the query parameter to match (Ex. "v2", "v*").
+	// If not set, it matches any request which has the query parameter.
+	Value string
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -353,6 +391,27 @@ type TLSProtocol struct {
 	SNI string
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. Method
+// can only be used together with Service. If all fields are not provided, this
+// matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string
+}
+
+// DNSProtocol matches DNS queries with specific query name and type. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match (Ex. "www.example.com", "*.example.com").
+	QueryName string
+	// QueryType represents the type of the DNS query to match.
+	// It could be A, AAAA, CNAME, MX, NS, PTR, SOA, SRV, TXT and ANY.
+	QueryType string
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could contain one of the subfields or a combination of them.
 type NetworkPolicyPeer struct {
@@ -406,6 +465,8 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats
 	// Multicast group information from the Node.
 	Multicast []MulticastGroupInfo
+	// The TrafficStats of Egresses collected from the Node.
+	Egresses []EgressStats
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -426,6 +487,16 @@ type NetworkPolicyStats struct {
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats
 }
 
+// EgressStats contains the information and traffic stats of an Egress.
+type EgressStats struct {
+	// The name of the Egress.
+	Name string
+	// The UID of the Egress.
+	UID types.UID
+	// The stats of the traffic sent by local Pods through the Egress.
+	TrafficStats statsv1alpha1.TrafficStats
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatus is the status of a NetworkPolicy.
@@ -328,10 +328,38 @@ func (m *ClusterGroupMembers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterGroupMembers proto.InternalMessageInfo
 
+func (m *DNSProtocol) Reset()      { *m = DNSProtocol{} }
+func (*DNSProtocol) ProtoMessage() {}
+func (*DNSProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+}
+func (m *DNSProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DNSProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DNSProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSProtocol.Merge(m, src)
+}
+func (m *DNSProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *DNSProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSProtocol proto.InternalMessageInfo
+
 func (m *EgressGroup) Reset()      { *m = EgressGroup{} }
 func (*EgressGroup) ProtoMessage() {}
 func (*EgressGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+	return fileDescriptor_fbaa7d016762fa1d, []int{11}
 }
 func (m *EgressGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +387,7 @@ var xxx_messageInfo_EgressGroup proto.InternalMessageInfo
 func (m *EgressGroupList) Reset()      { *m = EgressGroupList{} }
 func (*EgressGroupList) ProtoMessage() {}
 func (*EgressGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+	return fileDescriptor_fbaa7d016762fa1d, []int{12}
 }
 func (m *EgressGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +415,7 @@ var xxx_messageInfo_EgressGroupList proto.InternalMessageInfo
 func (m *EgressGroupPatch) Reset()      { *m = EgressGroupPatch{} }
 func (*EgressGroupPatch) ProtoMessage() {}
 func (*EgressGroupPatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+	return fileDescriptor_fbaa7d016762fa1d, []int{13}
 }
 func (m *EgressGroupPatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,10 +440,38 @@ func (m *EgressGroupPatch) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EgressGroupPatch proto.InternalMessageInfo
 
+func (m *EgressStats) Reset()      { *m = EgressStats{} }
+func (*EgressStats) ProtoMessage() {}
+func (*EgressStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+}
+func (m *EgressStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressStats.Merge(m, src)
+}
+func (m *EgressStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressStats proto.InternalMessageInfo
+
 func (m *Entity) Reset()      { *m = Entity{} }
 func (*Entity) ProtoMessage() {}
 func (*Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+	return fileDescriptor_fbaa7d016762fa1d, []int{15}
 }
 func (m *Entity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +499,7 @@ var xxx_messageInfo_Entity proto.InternalMessageInfo
 func (m *ExternalEntityReference) Reset()      { *m = ExternalEntityReference{} }
 func (*ExternalEntityReference) ProtoMessage() {}
 func (*ExternalEntityReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+	return fileDescriptor_fbaa7d016762fa1d, []int{16}
 }
 func (m *ExternalEntityReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,10 +524,38 @@ func (m *ExternalEntityReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExternalEntityReference proto.InternalMessageInfo
 
+func (m *GRPCProtocol) Reset()      { *m = GRPCProtocol{} }
+func (*GRPCProtocol) ProtoMessage() {}
+func (*GRPCProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+}
+func (m *GRPCProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCProtocol.Merge(m, src)
+}
+func (m *GRPCProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCProtocol proto.InternalMessageInfo
+
 func (m *GroupAssociation) Reset()      { *m = GroupAssociation{} }
 func (*GroupAssociation) ProtoMessage() {}
 func (*GroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+	return fileDescriptor_fbaa7d016762fa1d, []int{18}
 }
 func (m *GroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +583,7 @@ var xxx_messageInfo_GroupAssociation proto.InternalMessageInfo
 func (m *GroupMember) Reset()      { *m = GroupMember{} }
 func (*GroupMember) ProtoMessage() {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+	return fileDescriptor_fbaa7d016762fa1d, []int{19}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +611,7 @@ var xxx_messageInfo_GroupMember proto.InternalMessageInfo
 func (m *GroupMembers) Reset()      { *m = GroupMembers{} }
 func (*GroupMembers) ProtoMessage() {}
 func (*GroupMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+	return fileDescriptor_fbaa7d016762fa1d, []int{20}
 }
 func (m *GroupMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +639,7 @@ var xxx_messageInfo_GroupMembers proto.InternalMessageInfo
 func (m *GroupReference) Reset()      { *m = GroupReference{} }
 func (*GroupReference) ProtoMessage() {}
 func (*GroupReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+	return fileDescriptor_fbaa7d016762fa1d, []int{21}
 }
 func (m *GroupReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,10 +664,38 @@ func (m *GroupReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupReference proto.InternalMessageInfo
 
+func (m *HTTPHeaderMatch) Reset()      { *m = HTTPHeaderMatch{} }
+func (*HTTPHeaderMatch) ProtoMessage() {}
+func (*HTTPHeaderMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+}
+func (m *HTTPHeaderMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderMatch.Merge(m, src)
+}
+func (m *HTTPHeaderMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderMatch proto.InternalMessageInfo
+
 func (m *HTTPProtocol) Reset()      { *m = HTTPProtocol{} }
 func (*HTTPProtocol) ProtoMessage() {}
 func (*HTTPProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{19}
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
 }
 func (m *HTTPProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,10 +720,38 @@ func (m *HTTPProtocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPProtocol proto.InternalMessageInfo
 
+func (m *HTTPQueryParamMatch) Reset()      { *m = HTTPQueryParamMatch{} }
+func (*HTTPQueryParamMatch) ProtoMessage() {}
+func (*HTTPQueryParamMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+}
+func (m *HTTPQueryParamMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPQueryParamMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPQueryParamMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPQueryParamMatch.Merge(m, src)
+}
+func (m *HTTPQueryParamMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPQueryParamMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPQueryParamMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPQueryParamMatch proto.InternalMessageInfo
+
 func (m *IPBlock) Reset()      { *m = IPBlock{} }
 func (*IPBlock) ProtoMessage() {}
 func (*IPBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *IPBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +779,7 @@ var xxx_messageInfo_IPBlock proto.InternalMessageInfo
 func (m *IPGroupAssociation) Reset()      { *m = IPGroupAssociation{} }
 func (*IPGroupAssociation) ProtoMessage() {}
 func (*IPGroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *IPGroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +807,7 @@ var xxx_messageInfo_IPGroupAssociation proto.InternalMessageInfo
 func (m *IPNet) Reset()      { *m = IPNet{} }
 func (*IPNet) ProtoMessage() {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +835,7 @@ var xxx_messageInfo_IPNet proto.InternalMessageInfo
 func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
 func (*L7Protocol) ProtoMessage() {}
 func (*L7Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
 }
 func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,10 +860,38 @@ func (m *L7Protocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
 
+func (m *LogSampling) Reset()      { *m = LogSampling{} }
+func (*LogSampling) ProtoMessage() {}
+func (*LogSampling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+}
+func (m *LogSampling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogSampling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogSampling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogSampling.Merge(m, src)
+}
+func (m *LogSampling) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogSampling) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogSampling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogSampling proto.InternalMessageInfo
+
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +919,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +947,7 @@ var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +975,7 @@ var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluation) Reset()      { *m = NetworkPolicyEvaluation{} }
 func (*NetworkPolicyEvaluation) ProtoMessage() {}
 func (*NetworkPolicyEvaluation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *NetworkPolicyEvaluation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1003,7 @@ var xxx_messageInfo_NetworkPolicyEvaluation proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationRequest) Reset()      { *m = NetworkPolicyEvaluationRequest{} }
 func (*NetworkPolicyEvaluationRequest) ProtoMessage() {}
 func (*NetworkPolicyEvaluationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *NetworkPolicyEvaluationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1031,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationRequest proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationResponse) Reset()      { *m = NetworkPolicyEvaluationResponse{} }
 func (*NetworkPolicyEvaluationResponse) ProtoMessage() {}
 func (*NetworkPolicyEvaluationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
 }
 func (m *NetworkPolicyEvaluationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1059,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationResponse proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1087,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{37}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1115,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+	return fileDescriptor_fbaa7d016762fa1d, []int{38}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1143,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{33}
+	return fileDescriptor_fbaa7d016762fa1d, []int{39}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1171,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{34}
+	return fileDescriptor_fbaa7d016762fa1d, []int{40}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1199,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+	return fileDescriptor_fbaa7d016762fa1d, []int{41}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1227,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+	return fileDescriptor_fbaa7d016762fa1d, []int{42}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1255,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{37}
+	return fileDescriptor_fbaa7d016762fa1d, []int{43}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1283,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{38}
+	return fileDescriptor_fbaa7d016762fa1d, []int{44}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1311,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{39}
+	return fileDescriptor_fbaa7d016762fa1d, []int{45}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1339,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{40}
+	return fileDescriptor_fbaa7d016762fa1d, []int{46}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1367,7 @@ var xxx_messageInfo_PodReference proto.InternalMessageInfo
 func (m *RuleRef) Reset()      { *m = RuleRef{} }
 func (*RuleRef) ProtoMessage() {}
 func (*RuleRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{41}
+	return fileDescriptor_fbaa7d016762fa1d, []int{47}
 }
 func (m *RuleRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1395,7 @@ var xxx_messageInfo_RuleRef proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{42}
+	return fileDescriptor_fbaa7d016762fa1d, []int{48}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1423,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{43}
+	return fileDescriptor_fbaa7d016762fa1d, []int{49}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1451,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *SupportBundleCollection) Reset()      { *m = SupportBundleCollection{} }
 func (*SupportBundleCollection) ProtoMessage() {}
 func (*SupportBundleCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{44}
+	return fileDescriptor_fbaa7d016762fa1d, []int{50}
 }
 func (m *SupportBundleCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1479,7 @@ var xxx_messageInfo_SupportBundleCollection proto.InternalMessageInfo
 func (m *SupportBundleCollectionList) Reset()      { *m = SupportBundleCollectionList{} }
 func (*SupportBundleCollectionList) ProtoMessage() {}
 func (*SupportBundleCollectionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{45}
+	return fileDescriptor_fbaa7d016762fa1d, []int{51}
 }
 func (m *SupportBundleCollectionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1507,7 @@ var xxx_messageInfo_SupportBundleCollectionList proto.InternalMessageInfo
 func (m *SupportBundleCollectionNodeStatus) Reset()      { *m = SupportBundleCollectionNodeStatus{} }
 func (*SupportBundleCollectionNodeStatus) ProtoMessage() {}
 func (*SupportBundleCollectionNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{46}
+	return fileDescriptor_fbaa7d016762fa1d, []int{52}
 }
 func (m *SupportBundleCollectionNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1535,7 @@ var xxx_messageInfo_SupportBundleCollectionNodeStatus proto.InternalMessageInfo
 func (m *SupportBundleCollectionStatus) Reset()      { *m = SupportBundleCollectionStatus{} }
 func (*SupportBundleCollectionStatus) ProtoMessage() {}
 func (*SupportBundleCollectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{47}
+	return fileDescriptor_fbaa7d016762fa1d, []int{53}
 }
 func (m *SupportBundleCollectionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1563,7 @@ var xxx_messageInfo_SupportBundleCollectionStatus proto.InternalMessageInfo
 func (m *TLSProtocol) Reset()      { *m = TLSProtocol{} }
 func (*TLSProtocol) ProtoMessage() {}
 func (*TLSProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+	return fileDescriptor_fbaa7d016762fa1d, []int{54}
 }
 func (m *TLSProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,20 +1599,26 @@ func init() {
 	proto.RegisterType((*BundleFileServer)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleFileServer")
 	proto.RegisterType((*BundleServerAuthConfiguration)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleServerAuthConfiguration")
 	proto.RegisterType((*ClusterGroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ClusterGroupMembers")
+	proto.RegisterType((*DNSProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.DNSProtocol")
 	proto.RegisterType((*EgressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroup")
 	proto.RegisterType((*EgressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupList")
 	proto.RegisterType((*EgressGroupPatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupPatch")
+	proto.RegisterType((*EgressStats)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressStats")
 	proto.RegisterType((*Entity)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Entity")
 	proto.RegisterType((*ExternalEntityReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ExternalEntityReference")
+	proto.RegisterType((*GRPCProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GRPCProtocol")
 	proto.RegisterType((*GroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupAssociation")
 	proto.RegisterType((*GroupMember)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMember")
 	proto.RegisterType((*GroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMembers")
 	proto.RegisterType((*GroupReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupReference")
+	proto.RegisterType((*HTTPHeaderMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPHeaderMatch")
 	proto.RegisterType((*HTTPProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPProtocol")
+	proto.RegisterType((*HTTPQueryParamMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPQueryParamMatch")
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPGroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPGroupAssociation")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
 	proto.RegisterType((*L7Protocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7Protocol")
+	proto.RegisterType((*LogSampling)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.LogSampling")
 	proto.RegisterType((*MulticastGroupInfo)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.MulticastGroupInfo")
 	proto.RegisterType((*NamedPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamedPort")
 	proto.RegisterType((*NetworkPolicy)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicy")
@@ -1477,200 +1651,220 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 3077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0xc7,
-	0xb1, 0x9a, 0xfd, 0x90, 0xdc, 0xda, 0x25, 0x45, 0x35, 0x6d, 0x8b, 0xcf, 0xb6, 0x48, 0x79, 0xfc,
-	0x9e, 0xa1, 0x17, 0x38, 0x4b, 0x8b, 0xb1, 0x2d, 0xc5, 0x3f, 0x84, 0x4b, 0x51, 0xf4, 0x26, 0x14,
-	0xb5, 0x6e, 0xd2, 0x36, 0x62, 0xc7, 0x8e, 0x87, 0x33, 0xbd, 0xcb, 0xb1, 0x66, 0x67, 0x46, 0xdd,
-	0x3d, 0xb4, 0xe4, 0x43, 0xe0, 0x20, 0xc9, 0xc1, 0xf9, 0x39, 0xc8, 0x25, 0xf0, 0x2d, 0xb7, 0x5c,
-	0x72, 0xcb, 0xcd, 0xa7, 0xf8, 0x10, 0xc0, 0x47, 0x07, 0x41, 0x10, 0x9f, 0x88, 0x98, 0x41, 0x1c,
-	0xf8, 0x60, 0x04, 0xc8, 0x2d, 0x0a, 0x02, 0x04, 0xfd, 0x99, 0xdf, 0x2e, 0x57, 0xd4, 0x92, 0x14,
-	0x13, 0xc4, 0x3a, 0x71, 0xa7, 0xaa, 0xba, 0xaa, 0xba, 0xab, 0xba, 0xeb, 0xd3, 0x4d, 0x78, 0xc6,
-	0xf2, 0x39, 0x25, 0x56, 0xdd, 0x0d, 0xe6, 0xd4, 0xaf, 0xb9, 0xf0, 0x4a, 0x67, 0xce, 0x0a, 0x5d,
-	0x36, 0x67, 0x07, 0x3e, 0xa7, 0x81, 0x17, 0x7a, 0x96, 0x4f, 0xe6, 0xb6, 0xce, 0x6e, 0x10, 0x6e,
-	0xcd, 0xcf, 0x75, 0x88, 0x4f, 0xa8, 0xc5, 0x89, 0x53, 0x0f, 0x69, 0xc0, 0x03, 0x54, 0x57, 0xa3,
-	0xbe, 0xe9, 0x06, 0xfa, 0x57, 0x3d, 0xbc, 0xd2, 0xa9, 0x8b, 0xf1, 0xf5, 0xec, 0xf8, 0xba, 0x1e,
-	0x7f, 0xef, 0xf9, 0xc1, 0xf2, 0x18, 0xb7, 0x38, 0x9b, 0xdb, 0x3a, 0x6b, 0x79, 0xe1, 0xa6, 0x75,
-	0xb6, 0x57, 0xd2, 0xbd, 0x5f, 0xec, 0xb8, 0x7c, 0x33, 0xda, 0xa8, 0xdb, 0x41, 0x77, 0xae, 0x13,
-	0x74, 0x82, 0x39, 0x09, 0xde, 0x88, 0xda, 0xf2, 0x4b, 0x7e, 0xc8, 0x5f, 0x9a, 0xfc, 0xd1, 0x2b,
-	0xe7, 0x99, 0x94, 0x12, 0xba, 0x5d, 0xcb, 0xde, 0x74, 0x7d, 0x42, 0xaf, 0xa7, 0xb2, 0xba, 0x84,
-	0x5b, 0x73, 0x5b, 0xfd, 0x42, 0xe6, 0x06, 0x8d, 0xa2, 0x91, 0xcf, 0xdd, 0x2e, 0xe9, 0x1b, 0xf0,
-	0xf8, 0x5e, 0x03, 0x98, 0xbd, 0x49, 0xba, 0x56, 0xdf, 0xb8, 0x2f, 0x0d, 0x1a, 0x17, 0x71, 0xd7,
-	0x9b, 0x73, 0x7d, 0xce, 0x38, 0xed, 0x1d, 0x64, 0xfe, 0xc5, 0x80, 0xda, 0x82, 0xe3, 0x50, 0xc2,
-	0xd8, 0x32, 0x0d, 0xa2, 0x10, 0xbd, 0x06, 0x63, 0x62, 0x26, 0x8e, 0xc5, 0xad, 0x69, 0xe3, 0xb4,
-	0x71, 0xa6, 0x3a, 0xff, 0x48, 0x5d, 0x31, 0xae, 0x67, 0x19, 0xa7, 0x36, 0x11, 0xd4, 0xf5, 0xad,
-	0xb3, 0xf5, 0xcb, 0x1b, 0xaf, 0x13, 0x9b, 0x5f, 0x22, 0xdc, 0x6a, 0xa0, 0x0f, 0xb6, 0x67, 0x8f,
-	0xed, 0x6c, 0xcf, 0x42, 0x0a, 0xc3, 0x09, 0x57, 0x14, 0x41, 0xad, 0x23, 0x44, 0x5d, 0x22, 0xdd,
-	0x0d, 0x42, 0xd9, 0x74, 0xe1, 0x74, 0xf1, 0x4c, 0x75, 0xfe, 0xc9, 0x21, 0xcd, 0x5e, 0x5f, 0x4e,
-	0x79, 0x34, 0xee, 0xd2, 0x02, 0x6b, 0x19, 0x20, 0xc3, 0x39, 0x31, 0xe6, 0xef, 0x0c, 0x98, 0xcc,
-	0xce, 0x74, 0xc5, 0x65, 0x1c, 0x7d, 0xa3, 0x6f, 0xb6, 0xf5, 0x5b, 0x9b, 0xad, 0x18, 0x2d, 0xe7,
-	0x3a, 0xa9, 0x45, 0x8f, 0xc5, 0x90, 0xcc, 0x4c, 0x2d, 0x28, 0xbb, 0x9c, 0x74, 0xe3, 0x29, 0x3e,
-	0x35, 0xec, 0x14, 0xb3, 0xea, 0x36, 0xc6, 0xb5, 0xa0, 0x72, 0x53, 0xb0, 0xc4, 0x8a, 0xb3, 0xf9,
-	0x76, 0x11, 0x4e, 0x64, 0xc9, 0x5a, 0x16, 0xb7, 0x37, 0x8f, 0xc0, 0x88, 0xdf, 0x35, 0xe0, 0x84,
-	0xe5, 0x38, 0xc4, 0x59, 0x3e, 0x64, 0x53, 0xfe, 0x8f, 0x16, 0x2b, 0x66, 0x95, 0xe7, 0x8e, 0xfb,
-	0x05, 0xa2, 0xef, 0x1b, 0x30, 0x45, 0x49, 0x37, 0xd8, 0xea, 0x51, 0xa4, 0x78, 0x70, 0x45, 0xee,
-	0xd3, 0x8a, 0x4c, 0xe1, 0x7e, 0xfe, 0x78, 0x37, 0xa1, 0xe6, 0xa7, 0x06, 0x4c, 0x2c, 0x84, 0xa1,
-	0xe7, 0x12, 0x67, 0x3d, 0xf8, 0x2f, 0xdf, 0x4d, 0x7f, 0x30, 0x00, 0xe5, 0xe7, 0x7a, 0x04, 0xfb,
-	0xc9, 0xce, 0xef, 0xa7, 0x67, 0x86, 0xde, 0x4f, 0x39, 0x85, 0x07, 0xec, 0xa8, 0x1f, 0x14, 0x61,
-	0x2a, 0x4f, 0x78, 0x67, 0x4f, 0xfd, 0xfb, 0xf6, 0xd4, 0x55, 0x98, 0x6a, 0x58, 0xcc, 0xb5, 0x17,
-	0x22, 0xbe, 0x49, 0x7c, 0xee, 0xda, 0x16, 0x77, 0x03, 0x1f, 0x3d, 0x0c, 0x63, 0x11, 0x23, 0xd4,
-	0xb7, 0xba, 0x44, 0x1a, 0xa3, 0x92, 0xfa, 0xcd, 0xf3, 0x1a, 0x8e, 0x13, 0x0a, 0x41, 0x1d, 0x5a,
-	0x8c, 0xbd, 0x11, 0x50, 0x67, 0xba, 0x90, 0xa7, 0x6e, 0x69, 0x38, 0x4e, 0x28, 0xcc, 0xd7, 0x61,
-	0xb2, 0x11, 0xf9, 0x8e, 0x47, 0x2e, 0xba, 0x1e, 0x59, 0x23, 0x74, 0x8b, 0x50, 0x74, 0x0a, 0x8a,
-	0x11, 0xf5, 0xb4, 0xa8, 0xaa, 0x1e, 0x5c, 0x7c, 0x1e, 0xaf, 0x60, 0x01, 0x47, 0xe7, 0x60, 0x7c,
-	0x33, 0x60, 0xbc, 0x15, 0x6d, 0x78, 0xae, 0xfd, 0x35, 0x72, 0x5d, 0x4a, 0xa9, 0x35, 0x4e, 0xec,
-	0x6c, 0xcf, 0x8e, 0x3f, 0x9b, 0x45, 0xe0, 0x3c, 0x9d, 0xf9, 0x4e, 0x01, 0x4e, 0x29, 0x61, 0x4a,
-	0x90, 0x98, 0xe6, 0x62, 0xe0, 0xb7, 0xdd, 0x4e, 0x44, 0xd5, 0x4c, 0x1f, 0x83, 0xea, 0x06, 0xb1,
-	0x28, 0xa1, 0xeb, 0xc1, 0x15, 0xe2, 0x6b, 0x0d, 0xa6, 0xb4, 0x06, 0xd5, 0x46, 0x8a, 0xc2, 0x59,
-	0x3a, 0xf4, 0x10, 0x8c, 0x58, 0xa1, 0x1b, 0xab, 0x52, 0x69, 0x4c, 0xe8, 0x11, 0x23, 0x0b, 0xad,
-	0xa6, 0xd0, 0x43, 0x63, 0xd1, 0x8f, 0x0d, 0x98, 0xda, 0xe8, 0x5f, 0xe0, 0xe9, 0xa2, 0xf4, 0xf0,
-	0xc5, 0x61, 0x8d, 0xbd, 0x8b, 0xad, 0x1a, 0x27, 0x85, 0xc1, 0x77, 0x41, 0xe0, 0xdd, 0x04, 0x9b,
-	0x3f, 0x2f, 0xc1, 0xd4, 0xa2, 0x17, 0x31, 0x4e, 0x68, 0xce, 0x2b, 0x6f, 0xff, 0xf6, 0xfb, 0xb6,
-	0x01, 0x93, 0xa4, 0xdd, 0x26, 0x36, 0x77, 0xb7, 0xc8, 0x21, 0xee, 0xbe, 0x69, 0x2d, 0x75, 0x72,
-	0xa9, 0x87, 0x39, 0xee, 0x13, 0x87, 0xbe, 0x05, 0x27, 0x12, 0x58, 0xb3, 0xd5, 0xf0, 0x02, 0xfb,
-	0x4a, 0xbc, 0xf1, 0x1e, 0x1b, 0x56, 0x87, 0x66, 0x6b, 0x95, 0xf0, 0x74, 0xef, 0x2f, 0xf5, 0xf2,
-	0xc5, 0xfd, 0xa2, 0xd0, 0x79, 0xa8, 0xf1, 0x80, 0x5b, 0x5e, 0x3c, 0xfd, 0xd2, 0x69, 0xe3, 0x4c,
-	0x31, 0x0d, 0x08, 0xeb, 0x19, 0x1c, 0xce, 0x51, 0xa2, 0x79, 0x00, 0xf9, 0xdd, 0xb2, 0x3a, 0x84,
-	0x4d, 0x97, 0xe5, 0xb8, 0x64, 0xbd, 0xd7, 0x13, 0x0c, 0xce, 0x50, 0x09, 0xdf, 0xb6, 0x23, 0x4a,
-	0x89, 0xcf, 0xc5, 0xf7, 0xf4, 0x88, 0x1c, 0x94, 0xf8, 0xf6, 0x62, 0x8a, 0xc2, 0x59, 0x3a, 0xf3,
-	0x13, 0x03, 0xaa, 0x4b, 0x9d, 0xcf, 0x41, 0xca, 0xfa, 0x5b, 0x03, 0x8e, 0x67, 0x26, 0x7a, 0x04,
-	0x11, 0xf6, 0xb5, 0x7c, 0x84, 0x1d, 0x7a, 0x86, 0x19, 0x6d, 0x07, 0x84, 0xd7, 0x1f, 0x16, 0x61,
-	0x32, 0x43, 0xa5, 0x62, 0xab, 0x03, 0x10, 0x24, 0xeb, 0x7e, 0xa8, 0x36, 0xcc, 0xf0, 0xbd, 0x13,
-	0x5f, 0x77, 0x89, 0xaf, 0x16, 0x8c, 0x2c, 0xf9, 0xdc, 0xe5, 0xd7, 0xd1, 0x8b, 0x50, 0x0c, 0x03,
-	0x47, 0x2f, 0xfe, 0xd0, 0xa5, 0x4a, 0x2b, 0x70, 0x30, 0x69, 0x13, 0x4a, 0x7c, 0x9b, 0x34, 0x46,
-	0x45, 0x70, 0x14, 0x10, 0xc1, 0xd1, 0xf4, 0xe0, 0xe4, 0xd2, 0x35, 0x2e, 0x42, 0xb1, 0xa7, 0x44,
-	0x25, 0x84, 0xe8, 0x34, 0x94, 0x32, 0x21, 0xbc, 0xa6, 0xb5, 0x2f, 0xad, 0x8a, 0xf0, 0x2d, 0x31,
-	0x68, 0x0e, 0x2a, 0xe2, 0x2f, 0x0b, 0x2d, 0x9b, 0xe8, 0x50, 0x76, 0x42, 0x93, 0x55, 0x56, 0x63,
-	0x04, 0x4e, 0x69, 0xcc, 0x7f, 0x18, 0x30, 0x29, 0x67, 0xb8, 0xc0, 0x58, 0x60, 0xbb, 0x2a, 0x88,
-	0x1e, 0x49, 0xee, 0x36, 0x69, 0x69, 0x89, 0x7a, 0x89, 0xf7, 0x9d, 0xa6, 0xca, 0xd1, 0xe9, 0x6a,
-	0x26, 0xf1, 0x63, 0xa1, 0x87, 0x3f, 0xee, 0x93, 0x68, 0xbe, 0x57, 0x82, 0x6a, 0xc6, 0xbe, 0xb7,
-	0xcd, 0xa8, 0xe8, 0x3b, 0x06, 0x4c, 0x90, 0x9c, 0x55, 0xa5, 0x75, 0xaa, 0xf3, 0xcb, 0x43, 0x1f,
-	0x19, 0xbb, 0xfb, 0x46, 0x03, 0xed, 0x6c, 0xcf, 0x4e, 0xf4, 0x20, 0x7b, 0x44, 0xa2, 0x87, 0xa0,
-	0xe8, 0x86, 0x6a, 0xe7, 0xd4, 0x1a, 0x77, 0x09, 0x05, 0x9b, 0x2d, 0x76, 0x63, 0x7b, 0xb6, 0xd2,
-	0x6c, 0xe9, 0xa2, 0x18, 0x0b, 0x02, 0xf4, 0x2a, 0x94, 0xc3, 0x80, 0x72, 0x11, 0xcf, 0x84, 0x45,
-	0xbe, 0x3c, 0xac, 0x8e, 0xc2, 0xd3, 0x9c, 0x56, 0x40, 0x79, 0x7a, 0xa8, 0x89, 0x2f, 0x86, 0x15,
-	0x5b, 0xf4, 0x32, 0x94, 0xfc, 0xc0, 0x21, 0x32, 0xec, 0x55, 0xe7, 0x9f, 0x1e, 0x9a, 0x7d, 0xe0,
-	0x90, 0x74, 0xe2, 0x63, 0x72, 0x0b, 0x08, 0x90, 0x64, 0x8a, 0x3a, 0x30, 0xca, 0x08, 0xdd, 0x72,
-	0x6d, 0x15, 0x21, 0xab, 0xf3, 0x5f, 0x19, 0x96, 0xff, 0x9a, 0x1a, 0x9e, 0x8a, 0xa8, 0xee, 0x6c,
-	0xcf, 0x8e, 0xc6, 0xd0, 0x98, 0xbb, 0xf9, 0x6e, 0x09, 0x6a, 0x77, 0x72, 0xae, 0x3b, 0x39, 0xd7,
-	0x6e, 0x39, 0xd7, 0x2f, 0x0c, 0x98, 0xc8, 0x9f, 0x4b, 0xf9, 0xa3, 0xd9, 0xd8, 0xfb, 0x68, 0x4e,
-	0x4e, 0xfb, 0xc2, 0xc0, 0xd3, 0xbe, 0x01, 0xc5, 0xc8, 0x75, 0x64, 0xf1, 0x51, 0x69, 0x3c, 0x92,
-	0x94, 0x59, 0xcd, 0x0b, 0x37, 0xb6, 0x67, 0x1f, 0x18, 0xd4, 0xde, 0xe4, 0xd7, 0x43, 0xc2, 0xea,
-	0xcf, 0x37, 0x2f, 0x60, 0x31, 0xd8, 0x7c, 0x13, 0x6a, 0xcf, 0xae, 0xaf, 0xb7, 0x5a, 0x34, 0xe0,
-	0x81, 0x1d, 0x78, 0x42, 0xaa, 0xa8, 0xb9, 0x7a, 0x63, 0x8c, 0x28, 0xcb, 0xb0, 0xc4, 0x88, 0x5a,
-	0xa9, 0x4b, 0xf8, 0x66, 0xe0, 0xf4, 0xd6, 0x4a, 0x97, 0x24, 0x14, 0x6b, 0xac, 0xe0, 0x14, 0x5a,
-	0x7c, 0x53, 0xab, 0x97, 0x70, 0x6a, 0x59, 0x7c, 0x13, 0x4b, 0x8c, 0xf9, 0xbe, 0x01, 0xa3, 0xda,
-	0xae, 0xe8, 0x45, 0x28, 0xd9, 0xae, 0x43, 0xf5, 0xc6, 0xd9, 0xa7, 0x27, 0x25, 0x42, 0x16, 0x9b,
-	0x17, 0x30, 0x96, 0x0c, 0xd1, 0x2b, 0x30, 0x42, 0xae, 0xd9, 0x24, 0xe4, 0x7a, 0xa3, 0xec, 0x93,
-	0x75, 0x32, 0xcb, 0x25, 0xc9, 0x0c, 0x6b, 0xa6, 0xe6, 0x3f, 0x0d, 0x40, 0xcd, 0xd6, 0xe7, 0x37,
-	0x84, 0xb6, 0xa1, 0x2c, 0x17, 0x08, 0x3d, 0x08, 0x05, 0x37, 0x94, 0x73, 0xad, 0x35, 0xa6, 0x76,
-	0xb6, 0x67, 0x0b, 0xcd, 0x56, 0x3e, 0xb4, 0x14, 0xdc, 0x50, 0x6c, 0xde, 0x90, 0x92, 0xb6, 0x7b,
-	0x6d, 0x85, 0xf8, 0x1d, 0xbe, 0x29, 0x3d, 0xa8, 0x9c, 0x6e, 0xde, 0x56, 0x06, 0x87, 0x73, 0x94,
-	0xe6, 0xaf, 0x0d, 0x80, 0x95, 0x73, 0x89, 0x9b, 0xbe, 0x04, 0xa5, 0x4d, 0xce, 0xc3, 0xfd, 0x86,
-	0xea, 0xac, 0xcb, 0xab, 0x08, 0x22, 0x20, 0x58, 0xf2, 0x44, 0x2f, 0x40, 0x91, 0x7b, 0x4c, 0x07,
-	0xe8, 0xa1, 0xcf, 0xd5, 0xf5, 0x95, 0xb5, 0x84, 0xb3, 0x4c, 0x02, 0xd6, 0x57, 0xd6, 0xb0, 0x60,
-	0x68, 0xbe, 0x6b, 0x00, 0xba, 0x14, 0x79, 0xa2, 0x76, 0x67, 0x5c, 0x2e, 0x5f, 0xd3, 0x6f, 0x07,
-	0xe8, 0x41, 0x28, 0xcb, 0x32, 0x46, 0x6f, 0xb9, 0x24, 0x64, 0x2a, 0xa3, 0x28, 0x1c, 0x7a, 0x15,
-	0x4a, 0x61, 0xe0, 0xec, 0xbb, 0x35, 0x9e, 0x4b, 0x4d, 0xd2, 0xad, 0x18, 0x38, 0x0c, 0x4b, 0xbe,
-	0xe6, 0xdb, 0x06, 0x54, 0x92, 0xb0, 0x2d, 0xb7, 0x6e, 0x40, 0xd5, 0x21, 0x50, 0xce, 0xd2, 0x53,
-	0x8e, 0x25, 0xe6, 0x16, 0x0e, 0xa7, 0xf3, 0x30, 0x16, 0xea, 0x75, 0xd0, 0x47, 0xc0, 0xfd, 0x49,
-	0x17, 0x49, 0xc3, 0x6f, 0x64, 0x7e, 0xe3, 0x84, 0xda, 0xfc, 0xac, 0x08, 0xe3, 0xab, 0x84, 0xbf,
-	0x11, 0xd0, 0x2b, 0xad, 0xc0, 0x73, 0xed, 0xeb, 0x47, 0xb0, 0x9b, 0xda, 0x50, 0xa6, 0x91, 0x47,
-	0xe2, 0x05, 0x5e, 0x18, 0x3a, 0x27, 0xc9, 0xea, 0x8b, 0x23, 0x8f, 0xa4, 0x76, 0x14, 0x5f, 0x0c,
-	0x2b, 0xf6, 0xe8, 0x69, 0x38, 0x6e, 0xe5, 0xba, 0xa5, 0x2a, 0x76, 0x56, 0xe4, 0x96, 0x39, 0x9e,
-	0x6f, 0xa4, 0x32, 0xdc, 0x4b, 0x8b, 0xce, 0x88, 0x45, 0x75, 0x03, 0x2a, 0x12, 0x48, 0x11, 0xf8,
-	0x8c, 0x46, 0x4d, 0x2d, 0xa8, 0x82, 0xe1, 0x04, 0x8b, 0x1e, 0x85, 0x1a, 0x77, 0x09, 0x8d, 0x31,
-	0x32, 0xdc, 0x95, 0x1b, 0x93, 0x32, 0x44, 0x66, 0xe0, 0x38, 0x47, 0x85, 0x18, 0x54, 0x58, 0x10,
-	0x51, 0x99, 0xfc, 0xe8, 0xf4, 0xe9, 0xe2, 0xc1, 0x96, 0x22, 0xf1, 0xba, 0x71, 0x11, 0xe8, 0xd6,
-	0x62, 0xe6, 0x38, 0x95, 0x63, 0x7e, 0x56, 0x80, 0x93, 0xb9, 0x41, 0x4b, 0x5b, 0x96, 0x17, 0xf5,
-	0x9f, 0xa3, 0xc5, 0xdb, 0xd4, 0xac, 0x18, 0xa5, 0xe4, 0x6a, 0x44, 0x74, 0xcc, 0xab, 0xce, 0xaf,
-	0x1e, 0x68, 0xc2, 0xa9, 0xee, 0x58, 0x71, 0x55, 0xd9, 0xa3, 0xfe, 0xc0, 0xb1, 0x2c, 0x74, 0x1d,
-	0xc6, 0x28, 0x61, 0x61, 0xe0, 0x33, 0xa2, 0x4f, 0x9a, 0xcb, 0x87, 0x26, 0x57, 0xb1, 0x55, 0xae,
-	0x11, 0x7f, 0xe1, 0x44, 0x9c, 0xf9, 0x57, 0x03, 0x66, 0x6e, 0xae, 0x33, 0x7a, 0x15, 0x46, 0x94,
-	0x7d, 0xf4, 0x9a, 0x3c, 0x3e, 0x74, 0x99, 0x22, 0x2b, 0x8e, 0x34, 0x6a, 0x6a, 0xc3, 0x6b, 0xae,
-	0xa8, 0x0b, 0x55, 0x87, 0x30, 0xee, 0xfa, 0xaa, 0x7d, 0x5a, 0x38, 0x90, 0x90, 0x24, 0x1d, 0xbb,
-	0x90, 0xb2, 0xc4, 0x59, 0xfe, 0xe6, 0xaf, 0x0a, 0x30, 0xbb, 0xc7, 0x6a, 0x89, 0x12, 0x6d, 0xdc,
-	0xcf, 0xd2, 0xe8, 0xa9, 0x1f, 0x96, 0xff, 0xdf, 0xad, 0xb5, 0xcc, 0x1f, 0x6d, 0x38, 0x2f, 0x53,
-	0x64, 0x89, 0xe2, 0xa0, 0x68, 0xfa, 0x0e, 0xb9, 0xa6, 0xa3, 0x63, 0x92, 0x25, 0xe2, 0x18, 0x81,
-	0x53, 0x1a, 0xf4, 0x75, 0x28, 0x89, 0x0f, 0xbd, 0x39, 0xce, 0x0d, 0xab, 0xac, 0xe0, 0x89, 0x49,
-	0x3b, 0x3d, 0xc1, 0x25, 0x40, 0xb2, 0x34, 0x7f, 0x6f, 0xc0, 0x89, 0x9c, 0xb2, 0x47, 0xd0, 0x51,
-	0xdb, 0xc8, 0x77, 0xd4, 0x9e, 0x3e, 0xd0, 0xe2, 0x0f, 0xe8, 0xa9, 0xfd, 0xcd, 0xe8, 0x39, 0x6f,
-	0x44, 0xf5, 0xb8, 0xc6, 0x2d, 0x1e, 0x31, 0xf4, 0x30, 0x8c, 0x89, 0x2a, 0x72, 0x75, 0x97, 0x9b,
-	0x92, 0x55, 0x0d, 0xc7, 0x09, 0x85, 0xa8, 0x28, 0xf4, 0x0b, 0x81, 0xd8, 0x8b, 0x33, 0x15, 0xc5,
-	0x72, 0x82, 0xc1, 0x19, 0x2a, 0xf4, 0x55, 0x40, 0x94, 0x58, 0x9e, 0xfb, 0xa6, 0xfc, 0xbc, 0x68,
-	0xb9, 0x5e, 0x44, 0x95, 0xf9, 0xc6, 0x1a, 0xf7, 0xea, 0xb1, 0x08, 0xf7, 0x51, 0xe0, 0x5d, 0x46,
-	0xa1, 0xff, 0x87, 0xd1, 0x2e, 0x61, 0x4c, 0x54, 0x26, 0x25, 0xa9, 0xec, 0x71, 0xcd, 0x60, 0xf4,
-	0x92, 0x02, 0xe3, 0x18, 0x2f, 0x6f, 0xbe, 0x73, 0x93, 0x6e, 0x11, 0x42, 0xd1, 0x39, 0x18, 0xb7,
-	0x32, 0xd7, 0xe1, 0x6c, 0xda, 0x90, 0xc1, 0x48, 0xde, 0xc4, 0x64, 0xef, 0xc9, 0x19, 0xce, 0xd3,
-	0x21, 0x02, 0x63, 0x6e, 0xa8, 0x8b, 0x3f, 0x65, 0xaa, 0x73, 0xc3, 0xe7, 0xd5, 0x72, 0x7c, 0xba,
-	0xc0, 0x49, 0xd5, 0x97, 0xb0, 0x46, 0xb3, 0x50, 0x6e, 0x5f, 0x75, 0xfc, 0x38, 0x48, 0x56, 0x84,
-	0x2d, 0x2f, 0x3e, 0x77, 0x61, 0x95, 0x61, 0x05, 0x47, 0x5c, 0xd4, 0x74, 0xba, 0x34, 0x8f, 0xfb,
-	0x15, 0x07, 0x2f, 0xf8, 0x33, 0x55, 0x61, 0xcc, 0x1b, 0x67, 0xe4, 0x88, 0x28, 0xee, 0x59, 0x1b,
-	0xc4, 0x6b, 0x3a, 0x44, 0x1c, 0x41, 0xae, 0x2c, 0x27, 0x8b, 0x67, 0xc6, 0x55, 0x14, 0x5f, 0xc9,
-	0xa3, 0x70, 0x2f, 0xad, 0xf9, 0x89, 0x01, 0xf7, 0xec, 0x7e, 0x4a, 0xa0, 0xc7, 0xa0, 0x24, 0x0a,
-	0x34, 0xed, 0x7b, 0x0f, 0xc4, 0xbb, 0x72, 0xfd, 0x7a, 0x48, 0x6e, 0x6c, 0xcf, 0xe6, 0x2d, 0x28,
-	0x80, 0x58, 0x92, 0x0f, 0xdd, 0xf7, 0x4b, 0xf2, 0xb7, 0xe2, 0x5e, 0xc5, 0x65, 0xe9, 0x20, 0xc5,
-	0xe5, 0xfb, 0x23, 0x3d, 0x4e, 0x27, 0x4e, 0x17, 0xf4, 0x14, 0x54, 0x1c, 0x97, 0x8a, 0xb2, 0x3e,
-	0x88, 0x6f, 0xe8, 0x66, 0x62, 0x65, 0x2f, 0xc4, 0x88, 0x1b, 0xd9, 0x0f, 0x9c, 0x0e, 0x40, 0x36,
-	0x94, 0xda, 0x34, 0xe8, 0xea, 0x98, 0x71, 0xb0, 0x44, 0x4d, 0xec, 0x81, 0x74, 0xf2, 0x17, 0x69,
-	0xd0, 0xc5, 0x92, 0x39, 0x7a, 0x05, 0x0a, 0x3c, 0xd0, 0x67, 0xea, 0x21, 0x88, 0x00, 0x2d, 0xa2,
-	0xb0, 0x1e, 0xe0, 0x02, 0x0f, 0xc4, 0xee, 0x61, 0x79, 0x9f, 0x3d, 0xb7, 0x4f, 0x9f, 0x4d, 0x77,
-	0x4f, 0xe2, 0xa8, 0x09, 0x6b, 0x79, 0x91, 0xdb, 0x93, 0xff, 0xa5, 0x29, 0x78, 0x5f, 0xc6, 0xf8,
-	0x02, 0x8c, 0x58, 0xca, 0x26, 0x23, 0xd2, 0x26, 0xcf, 0xc8, 0xfb, 0xcf, 0xd8, 0x18, 0x8f, 0xdc,
-	0xe4, 0x99, 0x1a, 0x75, 0xf4, 0xeb, 0xb4, 0xb3, 0x32, 0x9e, 0xa8, 0x31, 0x58, 0x73, 0x43, 0x4f,
-	0xc2, 0x38, 0xf1, 0xad, 0x0d, 0x8f, 0xac, 0x04, 0x9d, 0x8e, 0xeb, 0x77, 0xa6, 0x47, 0xe5, 0x59,
-	0x97, 0xc4, 0xc3, 0xa5, 0x2c, 0x12, 0xe7, 0x69, 0x77, 0xcb, 0x97, 0xc7, 0x86, 0xc8, 0x97, 0x63,
-	0x37, 0xaf, 0x0c, 0x74, 0xf3, 0xab, 0x50, 0xf5, 0x92, 0xb2, 0x92, 0x4d, 0x83, 0xb4, 0xc6, 0x13,
-	0xc3, 0x5a, 0x23, 0xad, 0x4c, 0xd3, 0x6c, 0x24, 0x85, 0x31, 0x9c, 0x95, 0x21, 0xcc, 0xe2, 0x05,
-	0x1d, 0x79, 0x4a, 0x4c, 0x57, 0xf3, 0x31, 0x66, 0x45, 0xc3, 0x71, 0x42, 0x61, 0xbe, 0x53, 0x04,
-	0x94, 0xf3, 0x28, 0x11, 0xa9, 0xd8, 0x7f, 0x48, 0xba, 0x12, 0x42, 0x8d, 0x53, 0xab, 0xdd, 0x76,
-	0x6d, 0xa9, 0xd5, 0x2d, 0x24, 0x72, 0xf2, 0x8d, 0x61, 0x3d, 0x7e, 0x63, 0x58, 0x5f, 0xcf, 0x8c,
-	0xce, 0x34, 0xf1, 0x32, 0x50, 0x9c, 0x93, 0x80, 0xde, 0x32, 0x60, 0x52, 0x64, 0x27, 0x59, 0x12,
-	0xdd, 0x7e, 0x7c, 0xe2, 0xd6, 0xc5, 0xe2, 0x1e, 0x0e, 0x69, 0xcb, 0xa3, 0x17, 0x83, 0xfb, 0xa4,
-	0x99, 0x7f, 0x36, 0x60, 0xaa, 0xcf, 0x22, 0xd1, 0x51, 0xf4, 0x7f, 0x3d, 0x28, 0x8b, 0xdc, 0x23,
-	0x0e, 0xb9, 0xcb, 0x07, 0xb2, 0x75, 0x9a, 0xf5, 0xa4, 0x79, 0x92, 0x80, 0x31, 0xac, 0x84, 0x98,
-	0x67, 0x61, 0x3c, 0xd7, 0x6a, 0xdf, 0xfb, 0xfe, 0xc9, 0x7c, 0xaf, 0x0c, 0x93, 0x31, 0x5f, 0xb6,
-	0x16, 0x75, 0xbb, 0x16, 0x3d, 0x8a, 0xea, 0xfd, 0x7b, 0x06, 0x1c, 0xcf, 0x3a, 0xa6, 0x9b, 0x2c,
-	0x51, 0xe3, 0x40, 0x4b, 0xa4, 0x7c, 0xe3, 0xa4, 0x96, 0x7d, 0x7c, 0x35, 0x2f, 0x02, 0xf7, 0xca,
-	0x44, 0xbf, 0x34, 0xe0, 0x7e, 0x25, 0x45, 0xbf, 0xc9, 0xe8, 0x19, 0xa1, 0x1d, 0xf5, 0x30, 0x94,
-	0xfa, 0x5f, 0xad, 0xd4, 0xfd, 0x0b, 0x37, 0x91, 0x87, 0x6f, 0xaa, 0x0d, 0xfa, 0x99, 0x01, 0x77,
-	0x2b, 0x82, 0x5e, 0x3d, 0x4b, 0x87, 0xa6, 0xe7, 0x29, 0xad, 0xe7, 0xdd, 0x0b, 0xbb, 0x09, 0xc2,
-	0xbb, 0xcb, 0x47, 0x0c, 0x2a, 0xdd, 0xb8, 0x53, 0x26, 0x53, 0xab, 0x7d, 0x28, 0xd3, 0xdf, 0x6a,
-	0x4b, 0x73, 0xa2, 0x04, 0x87, 0x53, 0x39, 0xe6, 0x2b, 0x70, 0x57, 0xcb, 0xea, 0xe8, 0x9a, 0x71,
-	0x99, 0xf0, 0xcb, 0xa1, 0xf8, 0xc1, 0x54, 0x23, 0xbb, 0xa3, 0xdc, 0xbe, 0x98, 0x6d, 0x64, 0x77,
-	0x08, 0x96, 0x18, 0xf4, 0x20, 0x94, 0x3d, 0xb7, 0xeb, 0x72, 0x5d, 0x02, 0x24, 0xdb, 0x69, 0x45,
-	0x00, 0xb1, 0xc2, 0x99, 0x16, 0xd4, 0xb2, 0x6d, 0xb8, 0xdb, 0x71, 0x9b, 0xfb, 0xbe, 0x01, 0xa3,
-	0xba, 0xa2, 0x3b, 0x60, 0x96, 0xb5, 0x77, 0x7f, 0x2f, 0x4d, 0x17, 0x8a, 0x87, 0x99, 0x2e, 0x98,
-	0xbf, 0x29, 0x42, 0x7c, 0xd7, 0x86, 0x1e, 0xcd, 0xf4, 0x10, 0xd5, 0x14, 0xa6, 0xf7, 0xee, 0x1f,
-	0xa2, 0x55, 0xdd, 0xbd, 0x2c, 0xec, 0x71, 0xd6, 0x44, 0xdc, 0xf5, 0xea, 0xea, 0xa1, 0x77, 0xbd,
-	0xe9, 0xf3, 0xcb, 0x74, 0x8d, 0x53, 0xd7, 0xef, 0xa8, 0x7e, 0x70, 0xa6, 0xd7, 0xf9, 0x7f, 0x30,
-	0x4a, 0x7c, 0xd9, 0x18, 0x95, 0x53, 0x2d, 0xab, 0x8e, 0xce, 0x92, 0x02, 0xe1, 0x18, 0x87, 0xce,
-	0xc0, 0x98, 0x6b, 0x77, 0x43, 0x91, 0x95, 0xcb, 0xac, 0xb9, 0xac, 0x1a, 0x30, 0xcd, 0xc5, 0x4b,
-	0x2d, 0x99, 0xa9, 0x27, 0xd8, 0x98, 0x72, 0x31, 0xbe, 0x03, 0xcd, 0x50, 0x0a, 0x18, 0x4e, 0xb0,
-	0x92, 0xb2, 0xa3, 0x79, 0x8e, 0x64, 0x28, 0x97, 0x13, 0x9e, 0x1a, 0x8b, 0xce, 0xeb, 0x37, 0x37,
-	0xba, 0x6a, 0x93, 0x49, 0x56, 0xa5, 0xe7, 0xd9, 0x4c, 0xdc, 0x89, 0xcf, 0x51, 0x8a, 0xe9, 0x31,
-	0x6a, 0xcb, 0xe9, 0x8d, 0xa5, 0xd3, 0x5b, 0x53, 0x20, 0x1c, 0xe3, 0x50, 0x1d, 0x80, 0x51, 0x5b,
-	0xcf, 0x5a, 0x26, 0x54, 0xe5, 0xc6, 0x84, 0x38, 0x91, 0xd7, 0x12, 0x28, 0xce, 0x50, 0x98, 0x04,
-	0x26, 0x7b, 0xeb, 0xaa, 0xdb, 0xe1, 0xf2, 0xef, 0x94, 0xe0, 0xe4, 0x5a, 0x14, 0x0a, 0x43, 0xa9,
-	0x97, 0x81, 0x8b, 0x81, 0xe7, 0x69, 0x27, 0xbe, 0xfd, 0x81, 0xe7, 0x65, 0xa8, 0x90, 0x6b, 0xa1,
-	0x4b, 0x89, 0xb3, 0x10, 0xfb, 0xdb, 0x17, 0x6e, 0x4d, 0xc4, 0xba, 0xdb, 0x25, 0xe9, 0xd4, 0x96,
-	0x62, 0x26, 0x38, 0xe5, 0x27, 0xd6, 0x82, 0xb9, 0xbe, 0x4d, 0x04, 0xa9, 0xde, 0x64, 0xc9, 0x80,
-	0xb5, 0x18, 0x81, 0x53, 0x1a, 0x51, 0x0c, 0xb7, 0x93, 0x47, 0x98, 0xd2, 0x07, 0xf7, 0x51, 0x0c,
-	0xf7, 0x3e, 0xe6, 0x4c, 0x57, 0x20, 0x85, 0xe1, 0x8c, 0x1c, 0xf4, 0x23, 0x03, 0x26, 0xac, 0xfc,
-	0x73, 0x48, 0x75, 0xb1, 0x7f, 0x69, 0x7f, 0xa2, 0x07, 0x3c, 0xed, 0x6c, 0xdc, 0xa3, 0xf5, 0x98,
-	0xe8, 0x79, 0x17, 0xd9, 0x23, 0xdc, 0xfc, 0xd4, 0x80, 0xfb, 0x06, 0x78, 0xc4, 0x11, 0x34, 0xb0,
-	0xbc, 0x7c, 0x03, 0x6b, 0xe8, 0x14, 0x6d, 0x80, 0xe6, 0x03, 0x5a, 0x59, 0x3f, 0x2d, 0xc0, 0x03,
-	0x03, 0x46, 0xec, 0xbb, 0xa9, 0xf5, 0x24, 0x8c, 0xc7, 0xbf, 0xb3, 0xdb, 0x30, 0x2d, 0x08, 0xb2,
-	0x48, 0x9c, 0xa7, 0x8d, 0x45, 0xc9, 0x03, 0xab, 0xd8, 0x2f, 0x4a, 0x1d, 0x5a, 0x31, 0x85, 0xf0,
-	0x70, 0x3b, 0xe8, 0x86, 0x1e, 0xe1, 0x44, 0x75, 0x1a, 0xc6, 0x52, 0x0f, 0x5f, 0x8c, 0x11, 0x38,
-	0xa5, 0x11, 0x81, 0x96, 0x50, 0x1a, 0x50, 0xe9, 0x61, 0x99, 0xbb, 0xb2, 0x25, 0x01, 0xc4, 0x0a,
-	0x67, 0xfe, 0xdd, 0x80, 0x53, 0x03, 0x16, 0xe5, 0xc8, 0x32, 0xf5, 0xad, 0x7c, 0xa6, 0xfe, 0xdc,
-	0x21, 0xb9, 0xc1, 0x9e, 0x39, 0xfb, 0xc3, 0x50, 0xcd, 0x5c, 0x40, 0xa2, 0x53, 0x50, 0x64, 0xbe,
-	0xdb, 0xfb, 0x10, 0x7b, 0x6d, 0xb5, 0x89, 0x05, 0xbc, 0xb1, 0xfe, 0xc1, 0xc7, 0x33, 0xc7, 0x3e,
-	0xfc, 0x78, 0xe6, 0xd8, 0x47, 0x1f, 0xcf, 0x1c, 0x7b, 0x6b, 0x67, 0xc6, 0xf8, 0x60, 0x67, 0xc6,
-	0xf8, 0x70, 0x67, 0xc6, 0xf8, 0x68, 0x67, 0xc6, 0xf8, 0xe3, 0xce, 0x8c, 0xf1, 0x93, 0x3f, 0xcd,
-	0x1c, 0x7b, 0xa9, 0x3e, 0xdc, 0x7f, 0xa8, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7e, 0xc9,
-	0xb1, 0xd2, 0x36, 0x00, 0x00,
+	// 3394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x24, 0x47,
+	0xd9, 0xdb, 0xf3, 0xf0, 0xe3, 0x9b, 0xf1, 0x63, 0xcb, 0x49, 0x76, 0xfe, 0x24, 0x6b, 0x6f, 0x3a,
+	0xff, 0x1f, 0xed, 0x8f, 0xc2, 0x38, 0xbb, 0x24, 0xd9, 0x85, 0x3c, 0xc0, 0x63, 0x7b, 0x9d, 0x01,
+	0xdb, 0x3b, 0x5b, 0xe3, 0x24, 0x90, 0x17, 0x69, 0x77, 0xd7, 0x8c, 0x3b, 0xdb, 0xd3, 0xdd, 0x5b,
+	0x5d, 0xe3, 0xac, 0x73, 0x40, 0x41, 0xc0, 0x21, 0xbc, 0x82, 0xc8, 0x01, 0xe5, 0xc6, 0x05, 0x71,
+	0xe1, 0x82, 0xb8, 0x71, 0xe3, 0x80, 0x94, 0x63, 0x10, 0x42, 0xe4, 0x64, 0x11, 0x23, 0x40, 0x1c,
+	0x22, 0x24, 0xc4, 0x85, 0x45, 0x48, 0xa8, 0x1e, 0xfd, 0x9c, 0xf1, 0x7a, 0x67, 0xec, 0x35, 0x88,
+	0xec, 0xc9, 0xd3, 0xdf, 0xf7, 0xd5, 0xf7, 0x7d, 0x55, 0xf5, 0xd5, 0xf7, 0xaa, 0x32, 0x3c, 0x6d,
+	0xb8, 0x8c, 0x12, 0xa3, 0x6a, 0x7b, 0xf3, 0xf2, 0xd7, 0xbc, 0x7f, 0xb5, 0x3d, 0x6f, 0xf8, 0x76,
+	0x30, 0x6f, 0x7a, 0x2e, 0xa3, 0x9e, 0xe3, 0x3b, 0x86, 0x4b, 0xe6, 0xb7, 0xcf, 0x6d, 0x12, 0x66,
+	0x9c, 0x9f, 0x6f, 0x13, 0x97, 0x50, 0x83, 0x11, 0xab, 0xea, 0x53, 0x8f, 0x79, 0xa8, 0x2a, 0x47,
+	0x7d, 0xd9, 0xf6, 0xd4, 0xaf, 0xaa, 0x7f, 0xb5, 0x5d, 0xe5, 0xe3, 0xab, 0xc9, 0xf1, 0x55, 0x35,
+	0xfe, 0xde, 0x8b, 0xfb, 0xcb, 0x0b, 0x98, 0xc1, 0x82, 0xf9, 0xed, 0x73, 0x86, 0xe3, 0x6f, 0x19,
+	0xe7, 0xb2, 0x92, 0xee, 0xfd, 0x64, 0xdb, 0x66, 0x5b, 0xdd, 0xcd, 0xaa, 0xe9, 0x75, 0xe6, 0xdb,
+	0x5e, 0xdb, 0x9b, 0x17, 0xe0, 0xcd, 0x6e, 0x4b, 0x7c, 0x89, 0x0f, 0xf1, 0x4b, 0x91, 0x3f, 0x7a,
+	0xf5, 0x62, 0x20, 0xa4, 0xf8, 0x76, 0xc7, 0x30, 0xb7, 0x6c, 0x97, 0xd0, 0x9d, 0x58, 0x56, 0x87,
+	0x30, 0x63, 0x7e, 0xbb, 0x57, 0xc8, 0xfc, 0x7e, 0xa3, 0x68, 0xd7, 0x65, 0x76, 0x87, 0xf4, 0x0c,
+	0x78, 0xfc, 0xa0, 0x01, 0x81, 0xb9, 0x45, 0x3a, 0x46, 0xcf, 0xb8, 0x4f, 0xed, 0x37, 0xae, 0xcb,
+	0x6c, 0x67, 0xde, 0x76, 0x59, 0xc0, 0x68, 0x76, 0x90, 0xfe, 0x27, 0x0d, 0xca, 0x0b, 0x96, 0x45,
+	0x49, 0x10, 0xac, 0x50, 0xaf, 0xeb, 0xa3, 0x57, 0x61, 0x8c, 0xcf, 0xc4, 0x32, 0x98, 0x51, 0xd1,
+	0xce, 0x68, 0x67, 0x4b, 0xe7, 0x1f, 0xa9, 0x4a, 0xc6, 0xd5, 0x24, 0xe3, 0x78, 0x4f, 0x38, 0x75,
+	0x75, 0xfb, 0x5c, 0xf5, 0xf2, 0xe6, 0x6b, 0xc4, 0x64, 0x6b, 0x84, 0x19, 0x35, 0xf4, 0xde, 0xee,
+	0xdc, 0x89, 0xbd, 0xdd, 0x39, 0x88, 0x61, 0x38, 0xe2, 0x8a, 0xba, 0x50, 0x6e, 0x73, 0x51, 0x6b,
+	0xa4, 0xb3, 0x49, 0x68, 0x50, 0xc9, 0x9d, 0xc9, 0x9f, 0x2d, 0x9d, 0x7f, 0x62, 0xc0, 0x6d, 0xaf,
+	0xae, 0xc4, 0x3c, 0x6a, 0x77, 0x29, 0x81, 0xe5, 0x04, 0x30, 0xc0, 0x29, 0x31, 0xfa, 0xaf, 0x35,
+	0x98, 0x4e, 0xce, 0x74, 0xd5, 0x0e, 0x18, 0x7a, 0xa9, 0x67, 0xb6, 0xd5, 0x5b, 0x9b, 0x2d, 0x1f,
+	0x2d, 0xe6, 0x3a, 0xad, 0x44, 0x8f, 0x85, 0x90, 0xc4, 0x4c, 0x0d, 0x28, 0xda, 0x8c, 0x74, 0xc2,
+	0x29, 0x3e, 0x39, 0xe8, 0x14, 0x93, 0xea, 0xd6, 0x26, 0x94, 0xa0, 0x62, 0x9d, 0xb3, 0xc4, 0x92,
+	0xb3, 0xfe, 0x56, 0x1e, 0x4e, 0x26, 0xc9, 0x1a, 0x06, 0x33, 0xb7, 0x8e, 0x61, 0x13, 0xbf, 0xae,
+	0xc1, 0x49, 0xc3, 0xb2, 0x88, 0xb5, 0x72, 0xc4, 0x5b, 0xf9, 0x3f, 0x4a, 0xec, 0xc9, 0x85, 0x2c,
+	0x77, 0xdc, 0x2b, 0x10, 0x7d, 0x53, 0x83, 0x19, 0x4a, 0x3a, 0xde, 0x76, 0x46, 0x91, 0xfc, 0xe1,
+	0x15, 0xb9, 0x4f, 0x29, 0x32, 0x83, 0x7b, 0xf9, 0xe3, 0x7e, 0x42, 0xf5, 0x3f, 0x6b, 0x30, 0xb9,
+	0xe0, 0xfb, 0x8e, 0x4d, 0xac, 0x0d, 0xef, 0xbf, 0xfc, 0x34, 0xfd, 0x56, 0x03, 0x94, 0x9e, 0xeb,
+	0x31, 0x9c, 0x27, 0x33, 0x7d, 0x9e, 0x9e, 0x1e, 0xf8, 0x3c, 0xa5, 0x14, 0xde, 0xe7, 0x44, 0x7d,
+	0x2b, 0x0f, 0x33, 0x69, 0xc2, 0x3b, 0x67, 0xea, 0xdf, 0x77, 0xa6, 0xae, 0xc1, 0x4c, 0xcd, 0x08,
+	0x6c, 0x73, 0xa1, 0xcb, 0xb6, 0x88, 0xcb, 0x6c, 0xd3, 0x60, 0xb6, 0xe7, 0xa2, 0x87, 0x61, 0xac,
+	0x1b, 0x10, 0xea, 0x1a, 0x1d, 0x22, 0x36, 0x63, 0x3c, 0xb6, 0x9b, 0x67, 0x15, 0x1c, 0x47, 0x14,
+	0x9c, 0xda, 0x37, 0x82, 0xe0, 0x75, 0x8f, 0x5a, 0x95, 0x5c, 0x9a, 0xba, 0xa1, 0xe0, 0x38, 0xa2,
+	0xd0, 0x5f, 0x83, 0xe9, 0x5a, 0xd7, 0xb5, 0x1c, 0x72, 0xc9, 0x76, 0x48, 0x93, 0xd0, 0x6d, 0x42,
+	0xd1, 0x69, 0xc8, 0x77, 0xa9, 0xa3, 0x44, 0x95, 0xd4, 0xe0, 0xfc, 0xb3, 0x78, 0x15, 0x73, 0x38,
+	0xba, 0x00, 0x13, 0x5b, 0x5e, 0xc0, 0x1a, 0xdd, 0x4d, 0xc7, 0x36, 0xbf, 0x40, 0x76, 0x84, 0x94,
+	0x72, 0xed, 0xe4, 0xde, 0xee, 0xdc, 0xc4, 0x33, 0x49, 0x04, 0x4e, 0xd3, 0xe9, 0x6f, 0xe7, 0xe0,
+	0xb4, 0x14, 0x26, 0x05, 0xf1, 0x69, 0x2e, 0x7a, 0x6e, 0xcb, 0x6e, 0x77, 0xa9, 0x9c, 0xe9, 0x63,
+	0x50, 0xda, 0x24, 0x06, 0x25, 0x74, 0xc3, 0xbb, 0x4a, 0x5c, 0xa5, 0xc1, 0x8c, 0xd2, 0xa0, 0x54,
+	0x8b, 0x51, 0x38, 0x49, 0x87, 0x1e, 0x82, 0x11, 0xc3, 0xb7, 0x43, 0x55, 0xc6, 0x6b, 0x93, 0x6a,
+	0xc4, 0xc8, 0x42, 0xa3, 0xce, 0xf5, 0x50, 0x58, 0xf4, 0x5d, 0x0d, 0x66, 0x36, 0x7b, 0x17, 0xb8,
+	0x92, 0x17, 0x16, 0xbe, 0x38, 0xe8, 0x66, 0xf7, 0xd9, 0xab, 0xda, 0x29, 0xbe, 0xe1, 0x7d, 0x10,
+	0xb8, 0x9f, 0x60, 0xfd, 0x87, 0x05, 0x98, 0x59, 0x74, 0xba, 0x01, 0x23, 0x34, 0x65, 0x95, 0xb7,
+	0xff, 0xf8, 0x7d, 0x55, 0x83, 0x69, 0xd2, 0x6a, 0x11, 0x93, 0xd9, 0xdb, 0xe4, 0x08, 0x4f, 0x5f,
+	0x45, 0x49, 0x9d, 0x5e, 0xce, 0x30, 0xc7, 0x3d, 0xe2, 0xd0, 0x57, 0xe0, 0x64, 0x04, 0xab, 0x37,
+	0x6a, 0x8e, 0x67, 0x5e, 0x0d, 0x0f, 0xde, 0x63, 0x83, 0xea, 0x50, 0x6f, 0xac, 0x13, 0x16, 0x9f,
+	0xfd, 0xe5, 0x2c, 0x5f, 0xdc, 0x2b, 0x0a, 0x5d, 0x84, 0x32, 0xf3, 0x98, 0xe1, 0x84, 0xd3, 0x2f,
+	0x9c, 0xd1, 0xce, 0xe6, 0xe3, 0x80, 0xb0, 0x91, 0xc0, 0xe1, 0x14, 0x25, 0x3a, 0x0f, 0x20, 0xbe,
+	0x1b, 0x46, 0x9b, 0x04, 0x95, 0xa2, 0x18, 0x17, 0xad, 0xf7, 0x46, 0x84, 0xc1, 0x09, 0x2a, 0x6e,
+	0xdb, 0x66, 0x97, 0x52, 0xe2, 0x32, 0xfe, 0x5d, 0x19, 0x11, 0x83, 0x22, 0xdb, 0x5e, 0x8c, 0x51,
+	0x38, 0x49, 0xa7, 0x7b, 0x50, 0x5a, 0x5a, 0x6f, 0x36, 0xa8, 0xc7, 0x3c, 0xd3, 0x73, 0xd0, 0x3c,
+	0x8c, 0x5f, 0xeb, 0x12, 0xba, 0xb3, 0x1e, 0x3b, 0x83, 0x93, 0x8a, 0xc7, 0xf8, 0x95, 0x10, 0x81,
+	0x63, 0x9a, 0x68, 0xc0, 0xc6, 0x8e, 0x4f, 0x2a, 0xb9, 0x3e, 0x03, 0x38, 0x02, 0xc7, 0x34, 0xfa,
+	0x1f, 0x35, 0x28, 0x2d, 0xb7, 0x3f, 0x06, 0x39, 0xf2, 0xaf, 0x34, 0x98, 0x4a, 0x4c, 0xf4, 0x18,
+	0x42, 0xfa, 0xab, 0xe9, 0x90, 0x3e, 0xf0, 0x0c, 0x13, 0xda, 0xee, 0x13, 0xcf, 0xbf, 0x9d, 0x87,
+	0xe9, 0x04, 0x95, 0x0c, 0xe6, 0x16, 0x80, 0x17, 0xad, 0xfb, 0x91, 0xee, 0x61, 0x82, 0xef, 0x9d,
+	0x80, 0xde, 0x27, 0xa0, 0xff, 0x21, 0x3a, 0x4b, 0x4d, 0x66, 0xb0, 0x00, 0x9d, 0x81, 0x42, 0x22,
+	0x8a, 0x97, 0x15, 0xbf, 0x82, 0x38, 0xb3, 0x02, 0x83, 0x6a, 0x90, 0xef, 0xda, 0x61, 0xe0, 0x7e,
+	0x24, 0x8a, 0xbd, 0xf5, 0xa5, 0x1b, 0xbb, 0x73, 0x0f, 0xec, 0x57, 0xf3, 0xb2, 0x1d, 0x9f, 0x04,
+	0xd5, 0x67, 0xeb, 0x4b, 0x98, 0x0f, 0x46, 0x3e, 0x94, 0x19, 0x35, 0x5a, 0x2d, 0xdb, 0x14, 0x52,
+	0x55, 0x78, 0x7b, 0xfc, 0x26, 0x53, 0x17, 0xad, 0x83, 0x6a, 0xd8, 0x3a, 0xa8, 0x6e, 0x24, 0x46,
+	0x27, 0xfc, 0x61, 0x02, 0x8a, 0x53, 0x12, 0x74, 0x03, 0x46, 0x96, 0x5d, 0x66, 0xb3, 0x1d, 0xf4,
+	0x3c, 0xe4, 0x7d, 0xcf, 0x52, 0x46, 0x36, 0x70, 0x0d, 0xd8, 0xf0, 0x2c, 0x4c, 0x5a, 0x84, 0x12,
+	0xd7, 0x24, 0xb5, 0x51, 0x3e, 0x73, 0x0e, 0xe1, 0x1c, 0x75, 0x07, 0x4e, 0x2d, 0x5f, 0x67, 0x84,
+	0xba, 0x86, 0x23, 0x45, 0x45, 0x84, 0xb7, 0xb0, 0xaa, 0xf3, 0x30, 0xce, 0xff, 0x06, 0xbe, 0x61,
+	0xf6, 0x38, 0xc1, 0xf5, 0x10, 0x81, 0x63, 0x1a, 0xdd, 0x80, 0xf2, 0x0a, 0x6e, 0x2c, 0x46, 0x6e,
+	0xf7, 0xff, 0x61, 0x34, 0x20, 0x74, 0xdb, 0x36, 0x43, 0x29, 0x53, 0x6a, 0xf8, 0x68, 0x53, 0x82,
+	0x71, 0x88, 0xe7, 0xc9, 0x48, 0x87, 0xb0, 0x2d, 0xcf, 0xca, 0x26, 0x23, 0x6b, 0x02, 0x8a, 0x15,
+	0x56, 0xff, 0x87, 0x06, 0xd3, 0xc2, 0x58, 0x16, 0x82, 0xc0, 0x33, 0x6d, 0x99, 0x00, 0x1d, 0x4b,
+	0xde, 0x3d, 0x6d, 0x28, 0x89, 0xca, 0x5a, 0x87, 0x2e, 0x31, 0xc4, 0xe8, 0x78, 0xc3, 0xa2, 0xd8,
+	0xbf, 0x90, 0xe1, 0x8f, 0x7b, 0x24, 0xea, 0x3f, 0x2f, 0x40, 0x29, 0x71, 0x54, 0x6e, 0x9b, 0xdd,
+	0xa0, 0xaf, 0x69, 0x30, 0x49, 0x52, 0x86, 0x23, 0xf6, 0xa5, 0x74, 0x7e, 0x65, 0x60, 0xef, 0xdb,
+	0xdf, 0xfc, 0x6a, 0x68, 0x6f, 0x77, 0x6e, 0x32, 0x83, 0xcc, 0x88, 0x44, 0x0f, 0x41, 0xde, 0xf6,
+	0xa5, 0x13, 0x2a, 0xd7, 0xee, 0xe2, 0x0a, 0xd6, 0x1b, 0xc1, 0x8d, 0xdd, 0xb9, 0xf1, 0x7a, 0x43,
+	0x35, 0x34, 0x30, 0x27, 0x40, 0xaf, 0x40, 0xd1, 0xf7, 0x28, 0xe3, 0xb9, 0x08, 0xdf, 0x91, 0x4f,
+	0x0f, 0xaa, 0x23, 0x37, 0x66, 0xab, 0xe1, 0x51, 0x16, 0xc7, 0x07, 0xfe, 0x15, 0x60, 0xc9, 0x16,
+	0xbd, 0x08, 0x05, 0xd7, 0xb3, 0x88, 0x48, 0x59, 0x4a, 0xe7, 0x9f, 0x1a, 0x98, 0xbd, 0x67, 0x91,
+	0x78, 0xe2, 0x63, 0xe2, 0x94, 0x71, 0x90, 0x60, 0x8a, 0xda, 0xf1, 0x21, 0x19, 0x11, 0xfc, 0x3f,
+	0x37, 0x28, 0xff, 0xf0, 0x30, 0x45, 0x22, 0x4a, 0xfd, 0x8e, 0x98, 0xfe, 0x6e, 0x01, 0xca, 0x77,
+	0xf2, 0xe5, 0x3b, 0xf9, 0x72, 0xbf, 0x7c, 0xf9, 0xc7, 0x1a, 0x4c, 0xa6, 0xfd, 0x52, 0xda, 0xfb,
+	0x6b, 0x07, 0x7b, 0xff, 0x28, 0xa0, 0xe4, 0x0e, 0x0a, 0xd3, 0xf9, 0x43, 0x84, 0x69, 0xfd, 0x8b,
+	0x30, 0xf5, 0xcc, 0xc6, 0x46, 0xe3, 0x19, 0x62, 0x58, 0x84, 0xae, 0x89, 0x4c, 0xed, 0xe0, 0x48,
+	0xf6, 0x20, 0x14, 0xb7, 0x0d, 0xa7, 0x1b, 0xea, 0x16, 0x9d, 0xf2, 0xe7, 0x38, 0x10, 0x4b, 0x9c,
+	0xfe, 0x4e, 0x1e, 0xca, 0x9c, 0x75, 0x14, 0xbe, 0xce, 0x40, 0x81, 0x97, 0xe2, 0x59, 0xbe, 0xbc,
+	0x5a, 0xc7, 0x02, 0x73, 0xab, 0x51, 0x8b, 0x73, 0xf2, 0x0d, 0xb6, 0x55, 0xc9, 0xa7, 0x39, 0x35,
+	0x0c, 0xb6, 0x85, 0x05, 0x86, 0xaf, 0x36, 0xff, 0x8b, 0x49, 0x9b, 0x5c, 0xaf, 0x14, 0xd2, 0xab,
+	0xdd, 0x08, 0x11, 0x38, 0xa6, 0x41, 0xaf, 0xc1, 0xe8, 0x96, 0x58, 0x03, 0x6e, 0x19, 0xdc, 0x98,
+	0x3f, 0x3b, 0xa8, 0x31, 0x67, 0x96, 0x31, 0x0e, 0xce, 0x12, 0x18, 0xe0, 0x50, 0x00, 0x7a, 0x03,
+	0x4a, 0xa2, 0xd2, 0x69, 0x18, 0xd4, 0xe8, 0x04, 0x95, 0x91, 0x33, 0xf9, 0x61, 0x0a, 0x7f, 0x2e,
+	0xef, 0x4a, 0xc4, 0x46, 0xca, 0x8c, 0x2c, 0x33, 0x46, 0x04, 0x38, 0x29, 0x4c, 0x7f, 0x09, 0x66,
+	0xfa, 0x0c, 0x3c, 0xaa, 0x3d, 0xff, 0x85, 0x06, 0xa3, 0xea, 0xa4, 0xa2, 0xe7, 0xa1, 0x60, 0xda,
+	0x16, 0x55, 0xae, 0x70, 0x48, 0xdf, 0x10, 0x69, 0xb2, 0x58, 0x5f, 0xc2, 0x58, 0x30, 0x44, 0x2f,
+	0xc3, 0x08, 0xb9, 0x6e, 0x12, 0x9f, 0x29, 0xd7, 0x37, 0x24, 0xeb, 0xc8, 0xb8, 0x96, 0x05, 0x33,
+	0xac, 0x98, 0xea, 0xff, 0xd4, 0x00, 0xd5, 0x1b, 0x1f, 0xdf, 0xa4, 0xa8, 0x05, 0x45, 0xb1, 0x40,
+	0xe8, 0x41, 0xc8, 0xd9, 0xbe, 0x98, 0x6b, 0xb9, 0x36, 0xb3, 0xb7, 0x3b, 0x97, 0xab, 0x37, 0xd2,
+	0xc9, 0x42, 0xce, 0xf6, 0xb9, 0x3b, 0xf6, 0x29, 0x69, 0xd9, 0xd7, 0x57, 0x89, 0xdb, 0x66, 0x5b,
+	0xc2, 0x3a, 0x8a, 0xb1, 0x3b, 0x6e, 0x24, 0x70, 0x38, 0x45, 0xa9, 0xff, 0x2d, 0x07, 0xb0, 0x7a,
+	0x21, 0xf2, 0x0e, 0x2f, 0x40, 0x61, 0x8b, 0x31, 0x7f, 0xd8, 0xe4, 0x2b, 0xe9, 0x69, 0x64, 0x4e,
+	0xc0, 0x21, 0x58, 0xf0, 0x44, 0xcf, 0x41, 0x9e, 0x39, 0x81, 0x4a, 0xb9, 0x06, 0x8e, 0x94, 0x1b,
+	0xab, 0x51, 0xe7, 0x43, 0xa6, 0x75, 0x1b, 0xab, 0x4d, 0xcc, 0x19, 0x72, 0x9d, 0xdb, 0xd4, 0x37,
+	0x2b, 0xf9, 0xe1, 0x74, 0x4e, 0x26, 0xf7, 0x52, 0x67, 0x0e, 0xc1, 0x82, 0x27, 0xd7, 0xd9, 0x72,
+	0x65, 0x78, 0x1b, 0x42, 0xe7, 0xa5, 0xf5, 0x8c, 0xce, 0x4b, 0xeb, 0x4d, 0xcc, 0x19, 0xea, 0xef,
+	0x68, 0x50, 0x5a, 0xf5, 0xda, 0x4d, 0xa3, 0xe3, 0x3b, 0xb6, 0xdb, 0xe6, 0x1b, 0x18, 0xa8, 0xdf,
+	0xd8, 0x60, 0xd2, 0x03, 0x24, 0x36, 0xb0, 0x99, 0xc0, 0xe1, 0x14, 0x25, 0xfa, 0x3c, 0xa0, 0x8e,
+	0x71, 0x7d, 0x79, 0x9b, 0xb8, 0x2c, 0x68, 0x10, 0xda, 0x24, 0xa6, 0xe7, 0x5a, 0xca, 0x00, 0xee,
+	0x55, 0xe3, 0xd1, 0x5a, 0x0f, 0x05, 0xee, 0x33, 0x4a, 0x7f, 0x57, 0x03, 0xb4, 0xd6, 0x75, 0x98,
+	0x6d, 0x1a, 0x01, 0x13, 0x86, 0x58, 0x77, 0x5b, 0x1e, 0x77, 0x3a, 0xa2, 0x5b, 0x52, 0xd1, 0xd2,
+	0x4e, 0x47, 0x9a, 0xb7, 0xc4, 0xa1, 0x57, 0xa0, 0xe0, 0x7b, 0xd6, 0xd0, 0x57, 0x7e, 0xa9, 0xb4,
+	0x3d, 0x8e, 0x25, 0x9e, 0x15, 0x60, 0xc1, 0x57, 0x7f, 0x4b, 0x83, 0xf1, 0x28, 0xa5, 0x15, 0xb1,
+	0xc7, 0xa3, 0x4c, 0xad, 0x53, 0x82, 0x9e, 0x32, 0x5c, 0xf0, 0x15, 0xc5, 0x01, 0x81, 0xfb, 0x22,
+	0x8c, 0xf9, 0x6a, 0x77, 0x54, 0x0c, 0xbb, 0x3f, 0xea, 0x8e, 0x2b, 0xf8, 0x8d, 0xc4, 0x6f, 0x1c,
+	0x51, 0xeb, 0x1f, 0xe5, 0x61, 0x62, 0x9d, 0xb0, 0xd7, 0x3d, 0x7a, 0xb5, 0xe1, 0x39, 0xb6, 0xb9,
+	0x73, 0x0c, 0x7e, 0xa9, 0x05, 0x45, 0xda, 0x75, 0x48, 0xb8, 0xc0, 0x0b, 0x03, 0xe7, 0xeb, 0x49,
+	0x7d, 0x71, 0xd7, 0x21, 0xf1, 0x3e, 0xf2, 0xaf, 0x00, 0x4b, 0xf6, 0xe8, 0x29, 0x98, 0x32, 0x52,
+	0xb7, 0x40, 0x32, 0xaf, 0x1c, 0x17, 0xce, 0x67, 0x2a, 0x7d, 0x41, 0x14, 0xe0, 0x2c, 0x2d, 0x3a,
+	0xcb, 0x17, 0xd5, 0xf6, 0x28, 0x2f, 0xae, 0xf8, 0xa9, 0xd1, 0x6a, 0x65, 0xb9, 0xa0, 0x12, 0x86,
+	0x23, 0x2c, 0x7a, 0x14, 0xca, 0xcc, 0x26, 0x34, 0xc4, 0x88, 0x54, 0xb0, 0x58, 0x9b, 0x16, 0xe9,
+	0x63, 0x02, 0x8e, 0x53, 0x54, 0x28, 0x80, 0xf1, 0xc0, 0xeb, 0x52, 0x51, 0x18, 0xa8, 0xd2, 0xe2,
+	0xd2, 0xe1, 0x96, 0x22, 0xb2, 0xba, 0x09, 0x9e, 0x96, 0x34, 0x43, 0xe6, 0x38, 0x96, 0xa3, 0x7f,
+	0x94, 0x83, 0x53, 0xa9, 0x41, 0xcb, 0x3c, 0xd0, 0xf6, 0x46, 0xa4, 0xfc, 0x6d, 0xea, 0x89, 0x8e,
+	0x52, 0x72, 0xad, 0x4b, 0x54, 0xd2, 0x56, 0x3a, 0xbf, 0x7e, 0xa8, 0x09, 0xc7, 0xba, 0x63, 0xc9,
+	0x55, 0x56, 0x56, 0xea, 0x03, 0x87, 0xb2, 0xd0, 0x0e, 0x8c, 0x51, 0x12, 0xf8, 0x9e, 0x1b, 0x10,
+	0xe5, 0xb3, 0x2f, 0x1f, 0x99, 0x5c, 0xc9, 0x56, 0x9a, 0x46, 0xf8, 0x85, 0x23, 0x71, 0xfa, 0x5f,
+	0x34, 0x98, 0xbd, 0xb9, 0xce, 0xe8, 0x15, 0x18, 0x91, 0xfb, 0x53, 0xd1, 0x0e, 0x6c, 0x69, 0xf5,
+	0x2f, 0xe1, 0x45, 0x35, 0x1e, 0xe7, 0x1f, 0x6a, 0xe3, 0x15, 0x57, 0xd4, 0x81, 0x92, 0x45, 0x02,
+	0x66, 0xbb, 0x42, 0x6a, 0x25, 0x77, 0x28, 0x21, 0x51, 0x42, 0xb8, 0x14, 0xb3, 0xc4, 0x49, 0xfe,
+	0xfa, 0xcf, 0x72, 0x30, 0x77, 0xc0, 0x6a, 0xf1, 0xf6, 0xc5, 0x84, 0x9b, 0xa4, 0xa9, 0x68, 0x47,
+	0x6a, 0xff, 0x77, 0x2b, 0x2d, 0xd3, 0xae, 0x0d, 0xa7, 0x65, 0xf2, 0x9c, 0x9e, 0x3b, 0x8a, 0xba,
+	0x6b, 0x91, 0xeb, 0x2a, 0xcc, 0x44, 0x39, 0x3d, 0x0e, 0x11, 0x38, 0xa6, 0x41, 0x5f, 0x82, 0x02,
+	0xff, 0x50, 0x87, 0xe3, 0xc2, 0xa0, 0xca, 0x72, 0x9e, 0x98, 0xb4, 0x62, 0x0f, 0x2e, 0x00, 0x82,
+	0xa5, 0xfe, 0x1b, 0x0d, 0x4e, 0xa6, 0x94, 0x3d, 0x86, 0xc6, 0xfd, 0x66, 0xba, 0x71, 0xff, 0xd4,
+	0xa1, 0x16, 0x7f, 0x9f, 0xd6, 0xfd, 0x5f, 0xb5, 0x8c, 0xbf, 0xe1, 0x9d, 0x15, 0xde, 0x5e, 0xed,
+	0x06, 0xfc, 0x4e, 0x97, 0x77, 0x58, 0xd6, 0xfb, 0xdc, 0x00, 0xaf, 0x2b, 0x38, 0x8e, 0x28, 0x78,
+	0xb5, 0xad, 0x5e, 0x3e, 0x85, 0x56, 0x9c, 0xa8, 0xb6, 0x57, 0x22, 0x0c, 0x4e, 0x50, 0xf1, 0x8c,
+	0x82, 0x12, 0xc3, 0xb1, 0xdf, 0x10, 0x9f, 0x97, 0x0c, 0xdb, 0xe9, 0x52, 0xb9, 0x7d, 0x63, 0x71,
+	0x46, 0x81, 0x7b, 0x28, 0x70, 0x9f, 0x51, 0xbc, 0x59, 0xda, 0x21, 0x41, 0xc0, 0xab, 0xf6, 0x42,
+	0xba, 0x59, 0xba, 0x26, 0xc1, 0x38, 0xc4, 0x8b, 0x17, 0x3d, 0xa9, 0x49, 0x37, 0x08, 0xa1, 0xfc,
+	0x86, 0xd9, 0x48, 0x3c, 0xf3, 0x09, 0x2a, 0x9a, 0x08, 0x46, 0xe2, 0x86, 0x39, 0xf9, 0xfe, 0x27,
+	0xc0, 0x69, 0x3a, 0x44, 0x60, 0xcc, 0xf6, 0x55, 0x63, 0x44, 0x6e, 0xd5, 0x85, 0xc1, 0x2b, 0x14,
+	0x31, 0x3e, 0x5e, 0xe0, 0xa8, 0x23, 0x12, 0xb1, 0x46, 0x73, 0x50, 0x6c, 0x5d, 0xb3, 0xdc, 0x30,
+	0x48, 0x8e, 0xf3, 0xbd, 0xbc, 0x74, 0x65, 0x69, 0x3d, 0xc0, 0x12, 0x8e, 0x18, 0xef, 0x77, 0xa8,
+	0xb6, 0x55, 0xd8, 0xcb, 0x3b, 0x7c, 0x33, 0x2c, 0xd1, 0x31, 0x09, 0x79, 0xe3, 0x84, 0x1c, 0x1e,
+	0xc5, 0x1d, 0x63, 0x93, 0x38, 0x75, 0x8b, 0x70, 0x17, 0x64, 0x13, 0x59, 0x50, 0x4f, 0xc8, 0x28,
+	0xbe, 0x9a, 0x46, 0xe1, 0x2c, 0x2d, 0xbf, 0xf8, 0xbb, 0xa7, 0xbf, 0x97, 0x40, 0x8f, 0x41, 0x81,
+	0x37, 0x2f, 0x94, 0xed, 0x3d, 0x10, 0x9e, 0x4a, 0x7e, 0x5f, 0x78, 0x63, 0x77, 0x2e, 0xbd, 0x83,
+	0x1c, 0x88, 0x05, 0xf9, 0xc0, 0x6d, 0xf7, 0x28, 0x7f, 0xcb, 0x1f, 0xd4, 0x78, 0x29, 0x1c, 0xa6,
+	0xf1, 0xf2, 0xd3, 0xd1, 0x8c, 0xd1, 0x71, 0xef, 0x82, 0x9e, 0x84, 0x71, 0xcb, 0xa6, 0xc4, 0x14,
+	0x87, 0x46, 0x4e, 0x74, 0x36, 0x54, 0x76, 0x29, 0x44, 0xdc, 0x48, 0x7e, 0xe0, 0x78, 0x00, 0x32,
+	0xa1, 0xd0, 0xa2, 0x5e, 0x47, 0xc5, 0x8c, 0xc3, 0x25, 0x6a, 0xfc, 0x0c, 0xc4, 0x93, 0xbf, 0x44,
+	0xbd, 0x0e, 0x16, 0xcc, 0xd1, 0xcb, 0x90, 0x63, 0x5e, 0x25, 0x7f, 0x54, 0x22, 0x40, 0x89, 0xc8,
+	0x6d, 0x78, 0x38, 0xc7, 0x3c, 0x7e, 0x7a, 0x82, 0xb4, 0xcd, 0x5e, 0x18, 0xd2, 0x66, 0xe3, 0xd3,
+	0x13, 0x19, 0x6a, 0xc4, 0x5a, 0x3c, 0x50, 0xc9, 0xe4, 0x7f, 0x71, 0x0a, 0xde, 0x93, 0x31, 0x3e,
+	0x07, 0x23, 0x86, 0xdc, 0x93, 0x11, 0xb1, 0x27, 0x4f, 0x8b, 0x77, 0x1d, 0xe1, 0x66, 0x3c, 0x72,
+	0x93, 0xe7, 0xb7, 0xd4, 0x52, 0xaf, 0x6e, 0xcf, 0x89, 0x78, 0x22, 0xc7, 0x60, 0xc5, 0x0d, 0x3d,
+	0x01, 0x13, 0xc4, 0x35, 0x36, 0x1d, 0xb2, 0xea, 0xb5, 0xdb, 0xb6, 0xdb, 0xae, 0x8c, 0x0a, 0x5f,
+	0x17, 0xc5, 0xc3, 0xe5, 0x24, 0x12, 0xa7, 0x69, 0xfb, 0xe5, 0xcb, 0x63, 0x03, 0xe4, 0xcb, 0xa1,
+	0x99, 0x8f, 0xef, 0x6b, 0xe6, 0xd7, 0xa0, 0xe4, 0x44, 0x05, 0x7a, 0x50, 0x01, 0xb1, 0x1b, 0x9f,
+	0x19, 0x74, 0x37, 0xe2, 0x1a, 0x3f, 0xce, 0x46, 0x62, 0x58, 0x80, 0x93, 0x32, 0xf8, 0xb6, 0x38,
+	0x5e, 0x5b, 0x78, 0x89, 0x4a, 0x29, 0x1d, 0x63, 0x56, 0x15, 0x1c, 0x47, 0x14, 0xc8, 0x85, 0x92,
+	0x13, 0x97, 0xb2, 0x95, 0xf2, 0x70, 0xb5, 0x72, 0xa2, 0x1a, 0xae, 0x4d, 0x09, 0xed, 0x62, 0x00,
+	0x4e, 0x0a, 0xd0, 0xdf, 0xce, 0x03, 0x4a, 0x59, 0xb0, 0xbc, 0x50, 0xfd, 0xcf, 0x48, 0x8f, 0xb2,
+	0x17, 0xae, 0xb9, 0xdb, 0x7d, 0xe1, 0x8a, 0xde, 0xd4, 0x60, 0x9a, 0x67, 0x43, 0x1b, 0xe9, 0x7b,
+	0xde, 0x83, 0xac, 0x24, 0x23, 0x16, 0x67, 0x38, 0xc4, 0xcd, 0xaa, 0x2c, 0x06, 0xf7, 0x48, 0xe3,
+	0x77, 0xdb, 0x33, 0x3d, 0x3b, 0xd2, 0x3d, 0x8e, 0xbb, 0x18, 0x07, 0x8a, 0x3c, 0xd7, 0x09, 0x43,
+	0xfc, 0xca, 0xa1, 0xf6, 0x3a, 0xce, 0xb2, 0xe2, 0xbc, 0x8c, 0xc3, 0x02, 0x2c, 0x85, 0xe8, 0xe7,
+	0x60, 0x22, 0x75, 0xed, 0x75, 0x70, 0xc3, 0x56, 0xff, 0xd1, 0x08, 0x4c, 0x87, 0x7c, 0x83, 0x66,
+	0xb7, 0xd3, 0x31, 0xe8, 0x71, 0x74, 0x0b, 0xbe, 0xa1, 0xc1, 0x54, 0xd2, 0x30, 0xed, 0x68, 0x89,
+	0x6a, 0x87, 0x5a, 0x22, 0x69, 0x1b, 0xa7, 0x94, 0xec, 0xa9, 0xf5, 0xb4, 0x08, 0x9c, 0x95, 0x89,
+	0x7e, 0xa2, 0xc1, 0xfd, 0x52, 0x8a, 0x7a, 0xdb, 0x96, 0x19, 0x51, 0xc9, 0x1f, 0x99, 0x52, 0xff,
+	0xab, 0x94, 0xba, 0x7f, 0xe1, 0x26, 0xf2, 0xf0, 0x4d, 0xb5, 0x41, 0x3f, 0xd0, 0xe0, 0x6e, 0x49,
+	0x90, 0xd5, 0xb3, 0x70, 0x64, 0x7a, 0x9e, 0x56, 0x7a, 0xde, 0xbd, 0xd0, 0x4f, 0x10, 0xee, 0x2f,
+	0x9f, 0xf7, 0x3d, 0x3a, 0x61, 0x67, 0xae, 0x52, 0x1c, 0x4e, 0x99, 0xde, 0xd6, 0x5e, 0x9c, 0x83,
+	0x45, 0x38, 0x1c, 0xcb, 0x41, 0x36, 0x8c, 0x11, 0xf1, 0x64, 0x85, 0x84, 0xf7, 0x23, 0x43, 0xbe,
+	0x53, 0x92, 0x33, 0x8f, 0x82, 0xc8, 0xb2, 0x62, 0x8a, 0x23, 0xf6, 0xfa, 0xcb, 0x70, 0x57, 0xc3,
+	0x68, 0xab, 0x72, 0x78, 0x85, 0xb0, 0xcb, 0x3e, 0xff, 0x11, 0xc8, 0x4b, 0xa6, 0xb6, 0x3c, 0x61,
+	0xf9, 0xe4, 0x25, 0x53, 0x9b, 0x60, 0x81, 0xe1, 0xdd, 0x49, 0xc7, 0xee, 0xd8, 0x4c, 0x55, 0x37,
+	0xd1, 0xc9, 0x5d, 0xe5, 0x40, 0x2c, 0x71, 0xfc, 0x11, 0x47, 0xb2, 0xc3, 0x78, 0x3b, 0xde, 0x89,
+	0xf0, 0x5b, 0x17, 0x55, 0xac, 0x1e, 0x32, 0x81, 0x3c, 0xb8, 0x75, 0x19, 0x67, 0x42, 0xf9, 0xa3,
+	0xcc, 0x84, 0xf4, 0x5f, 0xe6, 0x21, 0xbc, 0x62, 0x47, 0x8f, 0x26, 0xda, 0xa3, 0x72, 0x0a, 0x95,
+	0x83, 0x5b, 0xa3, 0x68, 0x5d, 0x35, 0x66, 0x73, 0x07, 0xb8, 0x35, 0xfe, 0xbf, 0x39, 0x55, 0xf9,
+	0xbf, 0x39, 0xd5, 0xba, 0xcb, 0x2e, 0xd3, 0x26, 0xa3, 0x3c, 0xf4, 0x8f, 0x65, 0xda, 0xb8, 0xff,
+	0x07, 0xa3, 0xc4, 0x15, 0x3d, 0x5f, 0x31, 0xd5, 0xa2, 0x6c, 0x56, 0x2d, 0x4b, 0x10, 0x0e, 0x71,
+	0xbc, 0xed, 0x68, 0x9b, 0x1d, 0x5f, 0xbc, 0x6c, 0x2c, 0xc8, 0x9e, 0xb0, 0x28, 0xd8, 0x16, 0xd7,
+	0x1a, 0x1c, 0x86, 0x23, 0x6c, 0x48, 0xb9, 0x18, 0x3e, 0x7d, 0x48, 0x50, 0x72, 0x18, 0x8e, 0xb0,
+	0x82, 0xb2, 0xad, 0x78, 0x8e, 0x24, 0x28, 0x57, 0x22, 0x9e, 0x0a, 0xcb, 0xbb, 0xf7, 0xa2, 0x09,
+	0xae, 0x0a, 0x52, 0x91, 0x3f, 0x8e, 0x67, 0x1e, 0x1e, 0x2a, 0x1c, 0x4e, 0x51, 0xf2, 0xe9, 0x05,
+	0xd4, 0x14, 0xd3, 0x1b, 0x8b, 0xa7, 0xd7, 0x94, 0x20, 0x1c, 0xe2, 0x50, 0x15, 0x20, 0xa0, 0xa6,
+	0x9a, 0xb5, 0xc8, 0x15, 0x8b, 0xb5, 0x49, 0xee, 0xfc, 0x9b, 0x11, 0x14, 0x27, 0x28, 0x74, 0x02,
+	0xd3, 0xd9, 0x92, 0xf1, 0x76, 0x98, 0xfc, 0xdb, 0x05, 0x38, 0xd5, 0xec, 0xfa, 0x7c, 0xa3, 0xe4,
+	0x63, 0xee, 0x45, 0xcf, 0x71, 0x94, 0x11, 0xdf, 0xfe, 0x18, 0xf7, 0x22, 0x8c, 0x93, 0xeb, 0xbe,
+	0x4d, 0x89, 0xb5, 0x10, 0xda, 0xdb, 0x27, 0x6e, 0x4d, 0xc4, 0x86, 0xdd, 0x21, 0xf1, 0xd4, 0x96,
+	0x43, 0x26, 0x38, 0xe6, 0xc7, 0xd7, 0x22, 0xb0, 0x5d, 0x93, 0x70, 0x52, 0x75, 0xc8, 0xa2, 0x01,
+	0xcd, 0x10, 0x81, 0x63, 0x1a, 0x5e, 0xe7, 0xb7, 0xa2, 0x77, 0xf3, 0xea, 0xc2, 0x68, 0xe0, 0x3a,
+	0x3f, 0xfb, 0xfe, 0x3e, 0x5e, 0x81, 0x18, 0x86, 0x13, 0x72, 0xd0, 0x77, 0x34, 0x98, 0x34, 0xd2,
+	0x2f, 0xd8, 0xe5, 0x7b, 0x9e, 0xb5, 0xe1, 0x44, 0xef, 0xf3, 0x1a, 0xbf, 0x76, 0x8f, 0xd2, 0x63,
+	0x32, 0xf3, 0x94, 0x3d, 0x23, 0x9c, 0xff, 0x2b, 0xd0, 0x7d, 0xfb, 0x58, 0xc4, 0x31, 0xf4, 0xe6,
+	0x9c, 0x74, 0x6f, 0x6e, 0xe0, 0x6c, 0x70, 0x1f, 0xcd, 0xf7, 0xe9, 0xd2, 0x7d, 0x3f, 0x07, 0x0f,
+	0xec, 0x33, 0x62, 0xe8, 0x7e, 0xdd, 0x13, 0x30, 0x11, 0xfe, 0x4e, 0x1e, 0xc3, 0xb8, 0xf6, 0x48,
+	0x22, 0x71, 0x9a, 0x36, 0x14, 0x25, 0x1c, 0x56, 0xbe, 0x57, 0x94, 0x74, 0x5a, 0x21, 0x05, 0xb7,
+	0x70, 0xd3, 0xeb, 0xf8, 0x0e, 0x61, 0x44, 0x36, 0x51, 0xc6, 0x62, 0x0b, 0x5f, 0x0c, 0x11, 0x38,
+	0xa6, 0xe1, 0x81, 0x96, 0x50, 0xea, 0xd1, 0x4a, 0x31, 0x7d, 0x0d, 0xb8, 0xcc, 0x81, 0x58, 0xe2,
+	0xf4, 0xbf, 0x6b, 0x70, 0x7a, 0x9f, 0x45, 0x39, 0xb6, 0xa2, 0x60, 0x3b, 0x5d, 0x14, 0x5c, 0x39,
+	0x22, 0x33, 0x38, 0xb0, 0x3c, 0x78, 0x18, 0x4a, 0x89, 0x5b, 0x6a, 0xfe, 0xbf, 0x33, 0x81, 0x6b,
+	0x67, 0xff, 0x77, 0xa6, 0xb9, 0x5e, 0xc7, 0x1c, 0x5e, 0xdb, 0x78, 0xef, 0xc3, 0xd9, 0x13, 0xef,
+	0x7f, 0x38, 0x7b, 0xe2, 0x83, 0x0f, 0x67, 0x4f, 0xbc, 0xb9, 0x37, 0xab, 0xbd, 0xb7, 0x37, 0xab,
+	0xbd, 0xbf, 0x37, 0xab, 0x7d, 0xb0, 0x37, 0xab, 0xfd, 0x6e, 0x6f, 0x56, 0xfb, 0xde, 0xef, 0x67,
+	0x4f, 0xbc, 0x50, 0x1d, 0xec, 0x9f, 0x8a, 0xff, 0x35, 0x00, 0x2f, 0xf7, 0x33, 0x53, 0x85, 0x3c,
+	0x00, 0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -2166,6 +2360,39 @@ func (m *ClusterGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DNSProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DNSProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.QueryType)
+	copy(dAtA[i:], m.QueryType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueryType)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.QueryName)
+	copy(dAtA[i:], m.QueryName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueryName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2321,6 +2548,49 @@ func (m *EgressGroupPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EgressStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.UID)
+	copy(dAtA[i:], m.UID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Entity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2389,7 +2659,7 @@ func (m *ExternalEntityReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+func (m *GRPCProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2399,31 +2669,64 @@ func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupAssociation) MarshalTo(dAtA []byte) (int, error) {
+func (m *GRPCProtocol) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GRPCProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AssociatedGroups) > 0 {
-		for iNdEx := len(m.AssociatedGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssociatedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAssociation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssociatedGroups) > 0 {
+		for iNdEx := len(m.AssociatedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssociatedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -2638,6 +2941,39 @@ func (m *GroupReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPHeaderMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2658,6 +2994,39 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueryParams) > 0 {
+		for iNdEx := len(m.QueryParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.PathRegex)
+	copy(dAtA[i:], m.PathRegex)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PathRegex)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -2676,6 +3045,39 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPQueryParamMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPQueryParamMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPQueryParamMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IPBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2823,6 +3225,30 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DNS != nil {
+		{
+			size, err := m.DNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -2850,6 +3276,35 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LogSampling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogSampling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogSampling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxEventsPerSecond))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SamplingRate))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *MulticastGroupInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3383,6 +3838,18 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LogSampling != nil {
+		{
+			size, err := m.LogSampling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	i -= len(m.LogLabel)
 	copy(dAtA[i:], m.LogLabel)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogLabel)))
@@ -3628,6 +4095,20 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Egresses) > 0 {
+		for iNdEx := len(m.Egresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Egresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Multicast) > 0 {
 		for iNdEx := len(m.Multicast) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4346,6 +4827,19 @@ func (m *ClusterGroupMembers) Size() (n int) {
 	return n
 }
 
+func (m *DNSProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.QueryType)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *EgressGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4403,6 +4897,21 @@ func (m *EgressGroupPatch) Size() (n int) {
 	return n
 }
 
+func (m *EgressStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Entity) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4429,6 +4938,19 @@ func (m *ExternalEntityReference) Size() (n int) {
 	return n
 }
 
+func (m *GRPCProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GroupAssociation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4524,6 +5046,19 @@ func (m *GroupReference) Size() (n int) {
 	return n
 }
 
+func (m *HTTPHeaderMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HTTPProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4536,6 +5071,33 @@ func (m *HTTPProtocol) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PathRegex)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.QueryParams) > 0 {
+		for _, e := range m.QueryParams {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HTTPQueryParamMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4601,16 +5163,35 @@ func (m *L7Protocol) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *MulticastGroupInfo) Size() (n int) {
+func (m *LogSampling) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Group)
+	n += 1 + sovGenerated(uint64(m.SamplingRate))
+	n += 1 + sovGenerated(uint64(m.MaxEventsPerSecond))
+	return n
+}
+
+func (m *MulticastGroupInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
 	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Pods) > 0 {
 		for _, e := range m.Pods {
@@ -4841,6 +5422,10 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.LogLabel)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LogSampling != nil {
+		l = m.LogSampling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4923,6 +5508,12 @@ func (m *NodeStatsSummary) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Egresses) > 0 {
+		for _, e := range m.Egresses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5272,6 +5863,17 @@ func (this *ClusterGroupMembers) String() string {
 	}, "")
 	return s
 }
+func (this *DNSProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSProtocol{`,
+		`QueryName:` + fmt.Sprintf("%v", this.QueryName) + `,`,
+		`QueryType:` + fmt.Sprintf("%v", this.QueryType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EgressGroup) String() string {
 	if this == nil {
 		return "nil"
@@ -5326,6 +5928,18 @@ func (this *EgressGroupPatch) String() string {
 	}, "")
 	return s
 }
+func (this *EgressStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EgressStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TrafficStats), "TrafficStats", "v1alpha1.TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Entity) String() string {
 	if this == nil {
 		return "nil"
@@ -5347,6 +5961,17 @@ func (this *ExternalEntityReference) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCProtocol{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupAssociation) String() string {
 	if this == nil {
 		return "nil"
@@ -5420,14 +6045,49 @@ func (this *GroupReference) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPHeaderMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPProtocol) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHeaders := "[]HTTPHeaderMatch{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeaderMatch", "HTTPHeaderMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	repeatedStringForQueryParams := "[]HTTPQueryParamMatch{"
+	for _, f := range this.QueryParams {
+		repeatedStringForQueryParams += strings.Replace(strings.Replace(f.String(), "HTTPQueryParamMatch", "HTTPQueryParamMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForQueryParams += "}"
 	s := strings.Join([]string{`&HTTPProtocol{`,
 		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`PathRegex:` + fmt.Sprintf("%v", this.PathRegex) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`QueryParams:` + repeatedStringForQueryParams + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPQueryParamMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPQueryParamMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5482,6 +6142,19 @@ func (this *L7Protocol) String() string {
 	s := strings.Join([]string{`&L7Protocol{`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCProtocol", "GRPCProtocol", 1) + `,`,
+		`DNS:` + strings.Replace(this.DNS.String(), "DNSProtocol", "DNSProtocol", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogSampling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogSampling{`,
+		`SamplingRate:` + fmt.Sprintf("%v", this.SamplingRate) + `,`,
+		`MaxEventsPerSecond:` + fmt.Sprintf("%v", this.MaxEventsPerSecond) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5661,6 +6334,7 @@ func (this *NetworkPolicyRule) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`LogLabel:` + fmt.Sprintf("%v", this.LogLabel) + `,`,
+		`LogSampling:` + strings.Replace(this.LogSampling.String(), "LogSampling", "LogSampling", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5732,12 +6406,18 @@ func (this *NodeStatsSummary) String() string {
 		repeatedStringForMulticast += strings.Replace(strings.Replace(f.String(), "MulticastGroupInfo", "MulticastGroupInfo", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForMulticast += "}"
+	repeatedStringForEgresses := "[]EgressStats{"
+	for _, f := range this.Egresses {
+		repeatedStringForEgresses += strings.Replace(strings.Replace(f.String(), "EgressStats", "EgressStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEgresses += "}"
 	s := strings.Join([]string{`&NodeStatsSummary{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NetworkPolicies:` + repeatedStringForNetworkPolicies + `,`,
 		`AntreaClusterNetworkPolicies:` + repeatedStringForAntreaClusterNetworkPolicies + `,`,
 		`AntreaNetworkPolicies:` + repeatedStringForAntreaNetworkPolicies + `,`,
 		`Multicast:` + repeatedStringForMulticast + `,`,
+		`Egresses:` + repeatedStringForEgresses + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7241,6 +7921,120 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DNSProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7626,7 +8420,7 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Entity) Unmarshal(dAtA []byte) error {
+func (m *EgressStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7649,15 +8443,79 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
+			return fmt.Errorf("proto: EgressStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EgressStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7684,10 +8542,7 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pod == nil {
-				m.Pod = &PodReference{}
-			}
-			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7712,7 +8567,7 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
+func (m *Entity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7735,15 +8590,101 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExternalEntityReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: Entity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExternalEntityReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Entity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pod == nil {
+				m.Pod = &PodReference{}
+			}
+			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalEntityReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalEntityReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7826,6 +8767,120 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GRPCProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8518,23 +9573,383 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeaderMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeaderMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeaderMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathRegex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathRegex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeaderMatch{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			m.QueryParams = append(m.QueryParams, HTTPQueryParamMatch{})
+			if err := m.QueryParams[len(m.QueryParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8557,7 +9972,7 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+func (m *HTTPQueryParamMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8580,15 +9995,15 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPQueryParamMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPQueryParamMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8616,43 +10031,11 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8680,7 +10063,7 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9141,6 +10524,166 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GRPC == nil {
+				m.GRPC = &GRPCProtocol{}
+			}
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DNS == nil {
+				m.DNS = &DNSProtocol{}
+			}
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogSampling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogSampling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogSampling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SamplingRate", wireType)
+			}
+			m.SamplingRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SamplingRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventsPerSecond", wireType)
+			}
+			m.MaxEventsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11103,6 +12646,42 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			}
 			m.LogLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogSampling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogSampling == nil {
+				m.LogSampling = &LogSampling{}
+			}
+			if err := m.LogSampling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11671,6 +13250,40 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Egresses = append(m.Egresses, EgressStats{})
+			if err := m.Egresses[len(m.Egresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -277,6 +277,17 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// LogSampling limits the logs generated for this rule when EnableLogging is true.
+	LogSampling *LogSampling `json:"logSampling,omitempty" protobuf:"bytes,12,opt,name=logSampling"`
+}
+
+// LogSampling describes the sampling and rate limiting of the logs generated for a rule.
+type LogSampling struct {
+	// SamplingRate is the sampling rate of the logged packets: one packet out of every SamplingRate packets is
+	// logged. 0 and 1 mean that all packets are logged.
+	SamplingRate int32 `json:"samplingRate,omitempty" protobuf:"varint,1,opt,name=samplingRate"`
+	// MaxEventsPerSecond is the maximum number of packets logged per second. 0 means no limit.
+	MaxEventsPerSecond int32 `json:"maxEventsPerSecond,omitempty" protobuf:"varint,2,opt,name=maxEventsPerSecond"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -330,6 +341,8 @@ type Service struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
 	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	GRPC *GRPCProtocol `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	DNS  *DNSProtocol  `json:"dns,omitempty" protobuf:"bytes,4,opt,name=dns"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -343,6 +356,31 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// PathRegex represents a regular expression which the URI path must fully match (Ex. "/api/v[0-9]+/pods").
+	// It cannot be used together with Path.
+	PathRegex string `json:"pathRegex,omitempty" protobuf:"bytes,4,opt,name=pathRegex"`
+	// Headers represents the HTTP request headers to match. All headers must be matched.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" protobuf:"bytes,5,rep,name=headers"`
+	// QueryParams represents the query parameters of the URI to match. All query parameters must be matched.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty" protobuf:"bytes,6,rep,name=queryParams"`
+}
+
+// HTTPHeaderMatch matches an HTTP request header with specific name and value.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the value of the header to match (Ex. "tenant-a", "tenant-*").
+	// If not set, it matches any request which has the header.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of the URI with specific name and value.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value represents the value of the query parameter to match (Ex. "v2", "v*").
+	// If not set, it matches any request which has the query parameter.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -352,6 +390,25 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty" protobuf:"bytes,1,opt,name=sni"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. Method can only be used together with Service.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+}
+
+// DNSProtocol matches DNS queries with specific query name and type. All fields could be used alone or together.
+// If all fields are not provided, it matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match (Ex. "www.example.com", "*.example.com").
+	QueryName string `json:"queryName,omitempty" protobuf:"bytes,1,opt,name=queryName"`
+	// QueryType represents the type of the DNS query to match.
+	// It could be A, AAAA, CNAME, MX, NS, PTR, SOA, SRV, TXT and ANY.
+	QueryType string `json:"queryType,omitempty" protobuf:"bytes,2,opt,name=queryType"`
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
@@ -408,6 +465,8 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats `json:"antreaNetworkPolicies,omitempty" protobuf:"bytes,4,rep,name=antreaNetworkPolicies"`
 	// Multicast group information collected from the Node.
 	Multicast []MulticastGroupInfo `json:"multicast,omitempty" protobuf:"bytes,5,rep,name=multicast"`
+	// The TrafficStats of Egresses collected from the Node.
+	Egresses []EgressStats `json:"egresses,omitempty" protobuf:"bytes,6,rep,name=egresses"`
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -428,6 +487,16 @@ type NetworkPolicyStats struct {
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats `json:"ruleTrafficStats,omitempty" protobuf:"bytes,3,rep,name=ruleTrafficStats"`
 }
 
+// EgressStats contains the information and traffic stats of an Egress.
+type EgressStats struct {
+	// The name of the Egress.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// The UID of the Egress.
+	UID types.UID `json:"uid,omitempty" protobuf:"bytes,2,opt,name=uid,casttype=k8s.io/apimachinery/pkg/types.UID"`
+	// The stats of the traffic sent by local Pods through the Egress.
+	TrafficStats statsv1alpha1.TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,3,opt,name=trafficStats"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatus is the status of a NetworkPolicy.
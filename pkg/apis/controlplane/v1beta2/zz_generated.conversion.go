@@ -139,6 +139,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSProtocol)(nil), (*controlplane.DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(a.(*DNSProtocol), b.(*controlplane.DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DNSProtocol)(nil), (*DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(a.(*controlplane.DNSProtocol), b.(*DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EgressGroup)(nil), (*controlplane.EgressGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EgressGroup_To_controlplane_EgressGroup(a.(*EgressGroup), b.(*controlplane.EgressGroup), scope)
 	}); err != nil {
@@ -169,6 +179,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressStats)(nil), (*controlplane.EgressStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EgressStats_To_controlplane_EgressStats(a.(*EgressStats), b.(*controlplane.EgressStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.EgressStats)(nil), (*EgressStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_EgressStats_To_v1beta2_EgressStats(a.(*controlplane.EgressStats), b.(*EgressStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Entity)(nil), (*controlplane.Entity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Entity_To_controlplane_Entity(a.(*Entity), b.(*controlplane.Entity), scope)
 	}); err != nil {
@@ -189,6 +209,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GRPCProtocol)(nil), (*controlplane.GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(a.(*GRPCProtocol), b.(*controlplane.GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCProtocol)(nil), (*GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(a.(*controlplane.GRPCProtocol), b.(*GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GroupAssociation)(nil), (*controlplane.GroupAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(a.(*GroupAssociation), b.(*controlplane.GroupAssociation), scope)
 	}); err != nil {
@@ -229,6 +259,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPHeaderMatch)(nil), (*controlplane.HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(a.(*HTTPHeaderMatch), b.(*controlplane.HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPHeaderMatch)(nil), (*HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(a.(*controlplane.HTTPHeaderMatch), b.(*HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
 	}); err != nil {
@@ -239,6 +279,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPQueryParamMatch)(nil), (*controlplane.HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(a.(*HTTPQueryParamMatch), b.(*controlplane.HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPQueryParamMatch)(nil), (*HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(a.(*controlplane.HTTPQueryParamMatch), b.(*HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPBlock)(nil), (*controlplane.IPBlock)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPBlock_To_controlplane_IPBlock(a.(*IPBlock), b.(*controlplane.IPBlock), scope)
 	}); err != nil {
@@ -279,6 +329,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LogSampling)(nil), (*controlplane.LogSampling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LogSampling_To_controlplane_LogSampling(a.(*LogSampling), b.(*controlplane.LogSampling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.LogSampling)(nil), (*LogSampling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_LogSampling_To_v1beta2_LogSampling(a.(*controlplane.LogSampling), b.(*LogSampling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MulticastGroupInfo)(nil), (*controlplane.MulticastGroupInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_MulticastGroupInfo_To_controlplane_MulticastGroupInfo(a.(*MulticastGroupInfo), b.(*controlplane.MulticastGroupInfo), scope)
 	}); err != nil {
@@ -771,6 +831,28 @@ func Convert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in
 	return autoConvert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in, out, s)
 }
 
+func autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	out.QueryType = in.QueryType
+	return nil
+}
+
+// Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol is an autogenerated conversion function.
+func Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	out.QueryType = in.QueryType
+	return nil
+}
+
+// Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol is an autogenerated conversion function.
+func Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_EgressGroup_To_controlplane_EgressGroup(in *EgressGroup, out *controlplane.EgressGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.GroupMembers = *(*[]controlplane.GroupMember)(unsafe.Pointer(&in.GroupMembers))
@@ -839,6 +921,30 @@ func Convert_controlplane_EgressGroupPatch_To_v1beta2_EgressGroupPatch(in *contr
 	return autoConvert_controlplane_EgressGroupPatch_To_v1beta2_EgressGroupPatch(in, out, s)
 }
 
+func autoConvert_v1beta2_EgressStats_To_controlplane_EgressStats(in *EgressStats, out *controlplane.EgressStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UID = types.UID(in.UID)
+	out.TrafficStats = in.TrafficStats
+	return nil
+}
+
+// Convert_v1beta2_EgressStats_To_controlplane_EgressStats is an autogenerated conversion function.
+func Convert_v1beta2_EgressStats_To_controlplane_EgressStats(in *EgressStats, out *controlplane.EgressStats, s conversion.Scope) error {
+	return autoConvert_v1beta2_EgressStats_To_controlplane_EgressStats(in, out, s)
+}
+
+func autoConvert_controlplane_EgressStats_To_v1beta2_EgressStats(in *controlplane.EgressStats, out *EgressStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UID = types.UID(in.UID)
+	out.TrafficStats = in.TrafficStats
+	return nil
+}
+
+// Convert_controlplane_EgressStats_To_v1beta2_EgressStats is an autogenerated conversion function.
+func Convert_controlplane_EgressStats_To_v1beta2_EgressStats(in *controlplane.EgressStats, out *EgressStats, s conversion.Scope) error {
+	return autoConvert_controlplane_EgressStats_To_v1beta2_EgressStats(in, out, s)
+}
+
 func autoConvert_v1beta2_Entity_To_controlplane_Entity(in *Entity, out *controlplane.Entity, s conversion.Scope) error {
 	out.Pod = (*controlplane.PodReference)(unsafe.Pointer(in.Pod))
 	return nil
@@ -881,6 +987,28 @@ func Convert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityRefer
 	return autoConvert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityReference(in, out, s)
 }
 
+func autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol is an autogenerated conversion function.
+func Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol is an autogenerated conversion function.
+func Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(in *GroupAssociation, out *controlplane.GroupAssociation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.AssociatedGroups = *(*[]controlplane.GroupReference)(unsafe.Pointer(&in.AssociatedGroups))
@@ -987,10 +1115,35 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.PathRegex = in.PathRegex
+	out.Headers = *(*[]controlplane.HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]controlplane.HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -1003,6 +1156,9 @@ func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlpl
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.PathRegex = in.PathRegex
+	out.Headers = *(*[]HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -1011,6 +1167,28 @@ func Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.
 	return autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_IPBlock_To_controlplane_IPBlock(in *IPBlock, out *controlplane.IPBlock, s conversion.Scope) error {
 	if err := Convert_v1beta2_IPNet_To_controlplane_IPNet(&in.CIDR, &out.CIDR, s); err != nil {
 		return err
@@ -1084,6 +1262,8 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*controlplane.GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*controlplane.DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1095,6 +1275,8 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*DNSProtocol)(unsafe.Pointer(in.DNS))
 	return nil
 }
 
@@ -1103,6 +1285,28 @@ func Convert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Pr
 	return autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in, out, s)
 }
 
+func autoConvert_v1beta2_LogSampling_To_controlplane_LogSampling(in *LogSampling, out *controlplane.LogSampling, s conversion.Scope) error {
+	out.SamplingRate = in.SamplingRate
+	out.MaxEventsPerSecond = in.MaxEventsPerSecond
+	return nil
+}
+
+// Convert_v1beta2_LogSampling_To_controlplane_LogSampling is an autogenerated conversion function.
+func Convert_v1beta2_LogSampling_To_controlplane_LogSampling(in *LogSampling, out *controlplane.LogSampling, s conversion.Scope) error {
+	return autoConvert_v1beta2_LogSampling_To_controlplane_LogSampling(in, out, s)
+}
+
+func autoConvert_controlplane_LogSampling_To_v1beta2_LogSampling(in *controlplane.LogSampling, out *LogSampling, s conversion.Scope) error {
+	out.SamplingRate = in.SamplingRate
+	out.MaxEventsPerSecond = in.MaxEventsPerSecond
+	return nil
+}
+
+// Convert_controlplane_LogSampling_To_v1beta2_LogSampling is an autogenerated conversion function.
+func Convert_controlplane_LogSampling_To_v1beta2_LogSampling(in *controlplane.LogSampling, out *LogSampling, s conversion.Scope) error {
+	return autoConvert_controlplane_LogSampling_To_v1beta2_LogSampling(in, out, s)
+}
+
 func autoConvert_v1beta2_MulticastGroupInfo_To_controlplane_MulticastGroupInfo(in *MulticastGroupInfo, out *controlplane.MulticastGroupInfo, s conversion.Scope) error {
 	out.Group = in.Group
 	out.Pods = *(*[]controlplane.PodReference)(unsafe.Pointer(&in.Pods))
@@ -1433,6 +1637,7 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.LogSampling = (*controlplane.LogSampling)(unsafe.Pointer(in.LogSampling))
 	return nil
 }
 
@@ -1467,6 +1672,7 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.LogSampling = (*LogSampling)(unsafe.Pointer(in.LogSampling))
 	return nil
 }
 
@@ -1551,6 +1757,7 @@ func autoConvert_v1beta2_NodeStatsSummary_To_controlplane_NodeStatsSummary(in *N
 	out.AntreaClusterNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]controlplane.MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.Egresses = *(*[]controlplane.EgressStats)(unsafe.Pointer(&in.Egresses))
 	return nil
 }
 
@@ -1565,6 +1772,7 @@ func autoConvert_controlplane_NodeStatsSummary_To_v1beta2_NodeStatsSummary(in *c
 	out.AntreaClusterNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.Egresses = *(*[]EgressStats)(unsafe.Pointer(&in.Egresses))
 	return nil
 }
 
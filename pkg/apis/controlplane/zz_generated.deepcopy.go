@@ -336,6 +336,22 @@ func (in *ClusterGroupMembers) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProtocol) DeepCopyInto(out *DNSProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProtocol.
+func (in *DNSProtocol) DeepCopy() *DNSProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressGroup) DeepCopyInto(out *EgressGroup) {
 	*out = *in
@@ -442,6 +458,23 @@ func (in *EgressGroupPatch) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressStats) DeepCopyInto(out *EgressStats) {
+	*out = *in
+	out.TrafficStats = in.TrafficStats
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressStats.
+func (in *EgressStats) DeepCopy() *EgressStats {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Entity) DeepCopyInto(out *Entity) {
 	*out = *in
@@ -479,6 +512,22 @@ func (in *ExternalEntityReference) DeepCopy() *ExternalEntityReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCProtocol) DeepCopyInto(out *GRPCProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCProtocol.
+func (in *GRPCProtocol) DeepCopy() *GRPCProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupAssociation) DeepCopyInto(out *GroupAssociation) {
 	*out = *in
@@ -618,9 +667,35 @@ func (in *GroupReference) DeepCopy() *GroupReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPProtocol) DeepCopyInto(out *HTTPProtocol) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]HTTPQueryParamMatch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -634,6 +709,22 @@ func (in *HTTPProtocol) DeepCopy() *HTTPProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPQueryParamMatch) DeepCopyInto(out *HTTPQueryParamMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPQueryParamMatch.
+func (in *HTTPQueryParamMatch) DeepCopy() *HTTPQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPQueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IPAddress) DeepCopyInto(out *IPAddress) {
 	{
@@ -736,13 +827,23 @@ func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPProtocol)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSProtocol)
 		**out = **in
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCProtocol)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSProtocol)
+		**out = **in
+	}
 	return
 }
 
@@ -756,6 +857,22 @@ func (in *L7Protocol) DeepCopy() *L7Protocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSampling) DeepCopyInto(out *LogSampling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSampling.
+func (in *LogSampling) DeepCopy() *LogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MulticastGroupInfo) DeepCopyInto(out *MulticastGroupInfo) {
 	*out = *in
@@ -1055,6 +1172,11 @@ func (in *NetworkPolicyRule) DeepCopyInto(out *NetworkPolicyRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LogSampling != nil {
+		in, out := &in.LogSampling, &out.LogSampling
+		*out = new(LogSampling)
+		**out = **in
+	}
 	return
 }
 
@@ -1171,6 +1293,11 @@ func (in *NodeStatsSummary) DeepCopyInto(out *NodeStatsSummary) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Egresses != nil {
+		in, out := &in.Egresses, &out.Egresses
+		*out = make([]EgressStats, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -174,7 +174,10 @@ type BundleExternalNodes struct {
 // BundleFileServer specifies the bundle file server information.
 type BundleFileServer struct {
 	// The URL of the bundle file server. It is set with format: scheme://host[:port][/path],
-	// e.g, https://api.example.com:8443/v1/supportbundles/. If scheme is not set, https is used by default.
+	// e.g, https://api.example.com:8443/v1/supportbundles/. The supported schemes are `sftp`, `s3`
+	// (s3://bucket[/prefix][?region=<region>&endpoint=<endpoint>]), `http`, `https` (files are uploaded
+	// with PUT requests) and `file` (a local path in the antrea-agent container, e.g., a mounted
+	// PersistentVolumeClaim). If scheme is not set, sftp is used by default.
 	URL string `json:"url"`
 	// HostPublicKey specifies the only host public key that will be accepted when connecting to
 	// the file server. If omitted, any host key will be accepted, which is not recommended.
@@ -217,6 +220,60 @@ type NodeLatencyMonitorSpec struct {
 	// PingInterval specifies the interval in seconds between ping requests.
 	// Ping interval should be greater than or equal to 1s.
 	PingIntervalSeconds int32 `json:"pingIntervalSeconds"`
+	// Targets is an optional list of additional targets to probe from each Node, on top
+	// of the ICMP probes sent to all the other Nodes. It can be used to detect datapath
+	// issues which are not visible with ICMP probes between Nodes.
+	// +optional
+	Targets []LatencyProbeTarget `json:"targets,omitempty"`
+}
+
+type LatencyProbeType string
+
+const (
+	// LatencyProbeTypeICMP sends ICMP echo requests to the target.
+	LatencyProbeTypeICMP LatencyProbeType = "ICMP"
+	// LatencyProbeTypeTCP measures the time taken to establish a TCP connection to the target.
+	LatencyProbeTypeTCP LatencyProbeType = "TCP"
+	// LatencyProbeTypeHTTP measures the time taken to receive the response to an HTTP GET
+	// request sent to the target. The probe fails if the response status code is not
+	// between 200 and 399.
+	LatencyProbeTypeHTTP LatencyProbeType = "HTTP"
+)
+
+// LatencyProbeTarget is an additional target probed by NodeLatencyMonitor. Exactly one of
+// Host, Service and ServiceEndpoints must be set.
+type LatencyProbeTarget struct {
+	// Name identifies the target in NodeLatencyStats. It must be unique in the list of targets.
+	Name string `json:"name"`
+	// Host is an IP address or a hostname, typically used for destinations outside of the
+	// cluster. A hostname is resolved by the Agent before each probe, and all the resolved
+	// addresses are probed.
+	// +optional
+	Host string `json:"host,omitempty"`
+	// Service refers to a Service whose ClusterIPs are probed.
+	// +optional
+	Service *NamespacedName `json:"service,omitempty"`
+	// ServiceEndpoints refers to a Service whose Endpoints are probed. For each Node, one
+	// ready Endpoint running on that Node is sampled, so that the Pod-to-Pod datapath is
+	// probed towards every Node running a backend of the Service.
+	// +optional
+	ServiceEndpoints *NamespacedName `json:"serviceEndpoints,omitempty"`
+	// Type is the type of probe. Defaults to ICMP.
+	// +optional
+	Type LatencyProbeType `json:"type,omitempty"`
+	// Port is the destination port of TCP and HTTP probes. It is required for these probe types.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// Path is the path used by HTTP probes. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+	// IntervalSeconds specifies the interval in seconds between probes to this target.
+	// Defaults to PingIntervalSeconds.
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+	// TimeoutSeconds specifies the timeout in seconds of TCP and HTTP probes. Defaults to 5.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -277,18 +334,41 @@ type BGPPolicySpec struct {
 
 	// BGPPeers is the list of BGP peers.
 	BGPPeers []BGPPeer `json:"bgpPeers,omitempty"`
+
+	// AddressFamilies lists the address families enabled on the BGP sessions with all BGP peers. With Multiprotocol
+	// BGP, a single BGP session carries the routes of all the listed address families, regardless of the IP family of
+	// the peer address. The address families of IP families which are not enabled in the cluster are ignored. If not
+	// set, only the address family matching the IP family of the peer address is enabled.
+	AddressFamilies []BGPAddressFamily `json:"addressFamilies,omitempty"`
+
+	// RouteImport configures the installation of the routes received from BGP peers into the routing table of the
+	// Node. If not set, the received routes are not installed.
+	RouteImport *RouteImport `json:"routeImport,omitempty"`
+}
+
+type BGPAddressFamily string
+
+const (
+	BGPAddressFamilyIPv4Unicast BGPAddressFamily = "IPv4Unicast"
+	BGPAddressFamilyIPv6Unicast BGPAddressFamily = "IPv6Unicast"
+)
+
+// RouteImport configures which routes received from BGP peers are installed into the routing table of the Node. Only
+// the best route to each prefix, after the import policies of the BGP peers are applied, is installed.
+type RouteImport struct {
+	// Prefixes is an allow-list of prefixes. Only the received routes matching at least one of the prefixes are
+	// installed.
+	Prefixes []BGPPrefixMatch `json:"prefixes"`
 }
 
 type Advertisements struct {
 	// Service specifies how to advertise Service IPs.
 	Service *ServiceAdvertisement `json:"service,omitempty"`
 
-	// Pod specifies how to advertise Pod IPs. Currently, if this is set, NodeIPAM Pod CIDR instead of specific Pods IPs
-	// will be advertised since pod selector is not added yet.
+	// Pod specifies how to advertise Pod IPs.
 	Pod *PodAdvertisement `json:"pod,omitempty"`
 
-	// Egress specifies how to advertise Egress IPs. Currently, if this is set, all Egress IPs will be advertised since
-	// Egress selector is not added yet.
+	// Egress specifies how to advertise Egress IPs.
 	Egress *EgressAdvertisement `json:"egress,omitempty"`
 }
 
@@ -309,22 +389,25 @@ const (
 )
 
 type ServiceAdvertisement struct {
-	// IPTypes specifies the types of Service IPs from the selected Services to be advertised. Currently, all Services
-	// will be selected since Service selector is not added yet.
+	// IPTypes specifies the types of Service IPs from the selected Services to be advertised.
 	IPTypes []ServiceIPType `json:"ipTypes,omitempty"`
 
-	// Empty now, selectors to be added later, which are used to select specific Services.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// ServiceSelector selects the Services whose IPs are advertised by their labels. If not set, all Services are
+	// selected.
+	ServiceSelector *metav1.LabelSelector `json:"serviceSelector,omitempty"`
 }
 
 type PodAdvertisement struct {
-	// Empty now, selectors to be added later, which are used to select specific Pods.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// PodSelector selects the Pods running on the Node whose IPs are advertised individually, by their labels. If
+	// not set, the NodeIPAM Pod CIDR of the Node is advertised instead of individual Pod IPs. Pods using the host
+	// network are never selected.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
 }
 
 type EgressAdvertisement struct {
-	// Empty now, selectors to be added later, which are used to select specific Egresses.
-	// Selectors []Selector `json:"selectors,omitempty"`
+	// EgressSelector selects the Egresses whose IPs are advertised by their labels. If not set, all the Egress IPs
+	// assigned to the Node are advertised.
+	EgressSelector *metav1.LabelSelector `json:"egressSelector,omitempty"`
 }
 
 type BGPPeer struct {
@@ -344,6 +427,83 @@ type BGPPeer struct {
 	// GracefulRestartTimeSeconds specifies how long the BGP peer would wait for the BGP session to re-establish after
 	// a restart before deleting stale routes. The range of the value is from 1 to 3600, and the default value is 120.
 	GracefulRestartTimeSeconds *int32 `json:"gracefulRestartTimeSeconds,omitempty"`
+
+	// HoldTimeSeconds is the BGP hold time proposed to the BGP peer. The session is torn down if no message is
+	// received from the peer within the negotiated hold time. The range of the value is from 3 to 65535. If not set,
+	// the default value 90 is used.
+	HoldTimeSeconds *int32 `json:"holdTimeSeconds,omitempty"`
+
+	// KeepaliveTimeSeconds is the interval between BGP KEEPALIVE messages sent to the BGP peer. It must be lower than
+	// the hold time. If not set, one third of the hold time is used.
+	KeepaliveTimeSeconds *int32 `json:"keepaliveTimeSeconds,omitempty"`
+
+	// ConnectRetryTimeSeconds is the interval between attempts to establish the BGP session with the BGP peer. If not
+	// set, the default value 120 is used.
+	ConnectRetryTimeSeconds *int32 `json:"connectRetryTimeSeconds,omitempty"`
+
+	// BFD enables a Bidirectional Forwarding Detection session with the BGP peer, so that a failure of the path to
+	// the peer is detected much faster than with the BGP hold timer. The BGP session is reset when the BFD session
+	// goes down. The BGP peer must be configured with BFD as well.
+	BFD *BGPPeerBFD `json:"bfd,omitempty"`
+
+	// ExportPolicy filters and modifies the routes advertised to the BGP peer. If not set, all routes are advertised
+	// without modification.
+	ExportPolicy *BGPRoutePolicy `json:"exportPolicy,omitempty"`
+
+	// ImportPolicy filters and modifies the routes received from the BGP peer. If not set, all routes are accepted
+	// without modification.
+	ImportPolicy *BGPRoutePolicy `json:"importPolicy,omitempty"`
+}
+
+// BGPPeerBFD configures the BFD session with a BGP peer. BFD runs in asynchronous mode, as defined in RFC 5880. The
+// single-hop mode (RFC 5881) is used when MultihopTTL is 1, and the multi-hop mode (RFC 5883) otherwise.
+type BGPPeerBFD struct {
+	// MinTransmitIntervalMilliseconds is the minimum interval between BFD Control packets sent to the BGP peer. The
+	// range of the value is from 50 to 60000, and the default value is 300.
+	MinTransmitIntervalMilliseconds *int32 `json:"minTransmitIntervalMilliseconds,omitempty"`
+
+	// MinReceiveIntervalMilliseconds is the minimum interval between BFD Control packets which the local system is
+	// capable of receiving from the BGP peer. The range of the value is from 50 to 60000, and the default value is
+	// 300.
+	MinReceiveIntervalMilliseconds *int32 `json:"minReceiveIntervalMilliseconds,omitempty"`
+
+	// DetectMultiplier is the number of BFD Control packets which can be missed before the BGP peer is considered
+	// down. The range of the value is from 1 to 255, and the default value is 3.
+	DetectMultiplier *int32 `json:"detectMultiplier,omitempty"`
+}
+
+// BGPRoutePolicy defines which routes are exchanged with a BGP peer, and the attributes set on these routes.
+type BGPRoutePolicy struct {
+	// Prefixes is an allow-list of prefixes. If it is not empty, routes which do not match any of the prefixes are
+	// rejected.
+	Prefixes []BGPPrefixMatch `json:"prefixes,omitempty"`
+
+	// Communities is a list of BGP communities added to the accepted routes. Each community is either in the
+	// "<ASN>:<value>" format (e.g., "65000:100"), or one of the well-known communities "no-export", "no-advertise",
+	// "no-export-subconfed" and "no-peer".
+	Communities []string `json:"communities,omitempty"`
+
+	// MED sets the Multi-Exit Discriminator of the accepted routes. The range of the value is from 0 to 4294967295.
+	MED *int64 `json:"med,omitempty"`
+
+	// LocalPreference sets the local preference of the accepted routes. It is only meaningful for iBGP peers. The
+	// range of the value is from 0 to 4294967295.
+	LocalPreference *int64 `json:"localPreference,omitempty"`
+
+	// ASPathPrependCount is the number of times the local AS number is prepended to the AS path of the accepted
+	// routes. The range of the value is from 1 to 10.
+	ASPathPrependCount *int32 `json:"asPathPrependCount,omitempty"`
+}
+
+// BGPPrefixMatch matches routes by prefix.
+type BGPPrefixMatch struct {
+	// CIDR is the prefix to match, e.g., "10.10.0.0/16".
+	CIDR string `json:"cidr"`
+
+	// MinLength and MaxLength specify the range of prefix lengths to match within CIDR. Both default to the prefix
+	// length of CIDR, which means that only CIDR itself is matched.
+	MinLength *int32 `json:"minLength,omitempty"`
+	MaxLength *int32 `json:"maxLength,omitempty"`
 }
 
 type PodReference struct {
@@ -442,6 +602,43 @@ type PacketCaptureFirstNConfig struct {
 	Number int32 `json:"number"`
 }
 
+// PacketCaptureBoundedConfig contains the config for the Bounded type capture, which captures all the packets matching
+// the filter until the capture duration elapses or the captured bytes reach the limit, whichever comes first. At least
+// one of `Duration` and `MaxBytes` must be set.
+type PacketCaptureBoundedConfig struct {
+	// Duration is the duration of the capture in seconds. It must not be greater than the timeout of the PacketCapture.
+	Duration *int32 `json:"duration,omitempty"`
+	// MaxBytes is the maximum number of bytes to capture, counted using the length of the captured packets.
+	MaxBytes *int64 `json:"maxBytes,omitempty"`
+}
+
+// PacketCaptureRingBufferConfig contains the config for the RingBuffer type capture. Packets matching the filter are
+// written continuously into a fixed number of files, and the oldest file is overwritten once all the files are full,
+// so that only the most recent packets are kept. The ring buffer is frozen and its files are uploaded when the trigger
+// condition fires. At least one of `PacketsPerFile` and `BytesPerFile` must be set.
+type PacketCaptureRingBufferConfig struct {
+	// FileCount is the number of files in the ring buffer.
+	FileCount int32 `json:"fileCount"`
+	// PacketsPerFile is the number of packets after which the current file is rotated.
+	PacketsPerFile *int32 `json:"packetsPerFile,omitempty"`
+	// BytesPerFile is the number of bytes after which the current file is rotated.
+	BytesPerFile *int64 `json:"bytesPerFile,omitempty"`
+	// Trigger is the condition upon which the ring buffer is frozen.
+	Trigger PacketCaptureTrigger `json:"trigger"`
+}
+
+// PacketCaptureTrigger describes the condition upon which a RingBuffer capture is frozen. The trigger fires when a
+// captured packet matches any of the TCP flag or ICMP message matchers. At least one matcher must be provided.
+type PacketCaptureTrigger struct {
+	// TCPFlags is a list of TCP flag match conditions that are logically ORed.
+	TCPFlags []TCPFlagsMatcher `json:"tcpFlags,omitempty"`
+	// ICMPMessages is a list of ICMP message match conditions that are logically ORed.
+	ICMPMessages []ICMPMsgMatcher `json:"icmpMessages,omitempty"`
+	// PostTriggerPackets is the number of packets which are still captured after the trigger fires, before the ring
+	// buffer is frozen. Defaults to 0.
+	PostTriggerPackets int32 `json:"postTriggerPackets,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type PacketCaptureList struct {
@@ -463,21 +660,33 @@ type PacketCapture struct {
 	Status            PacketCaptureStatus `json:"status"`
 }
 
+// CaptureConfig specifies the capture mode. Exactly one of the modes must be set.
 type CaptureConfig struct {
 	// FirstN means we only capture first N packets from the target traffic.
-	// At the moment this is the only supported configuration and it is required for every capture.
 	FirstN *PacketCaptureFirstNConfig `json:"firstN,omitempty"`
+	// Bounded means we capture all the target traffic until a duration or byte limit is reached.
+	Bounded *PacketCaptureBoundedConfig `json:"bounded,omitempty"`
+	// RingBuffer means we keep capturing the target traffic into a rotating set of files until a trigger condition
+	// fires.
+	RingBuffer *PacketCaptureRingBufferConfig `json:"ringBuffer,omitempty"`
 }
 
 // PacketCaptureFileServer specifies the PacketCapture file server information.
 type PacketCaptureFileServer struct {
 	// The URL of the file server. It is set with format: scheme://host[:port][/path],
-	// e.g., https://api.example.com:8443/v1/packets/. Currently only `sftp` protocol is supported.
+	// e.g., https://api.example.com:8443/v1/packets/. The supported schemes are `sftp`, `s3`
+	// (s3://bucket[/prefix][?region=<region>&endpoint=<endpoint>]), `http`, `https` (files are uploaded
+	// with PUT requests) and `file` (a local path in the antrea-agent container, e.g., a mounted
+	// PersistentVolumeClaim).
 	URL string `json:"url"`
 	// HostPublicKey specifies the only host public key that will be accepted when connecting to
 	// the file server. If omitted, any host key will be accepted, which is not recommended.
 	// For SFTP, the key must be formatted for use in the SSH wire protocol according to RFC 4253, section 6.6.
 	HostPublicKey []byte `json:"hostPublicKey,omitempty"`
+	// AuthType is the type of the authentication information stored in the Secret
+	// antrea-packetcapture-fileserver-auth. BearerToken and APIKey are only supported by
+	// HTTP(S) file servers. Defaults to BasicAuthentication.
+	AuthType BundleServerAuthType `json:"authType,omitempty"`
 }
 
 type CaptureDirection string
@@ -489,7 +698,8 @@ const (
 )
 
 type PacketCaptureSpec struct {
-	// Timeout is the timeout for this capture session. If not specified, defaults to 60s.
+	// Timeout is the timeout for this capture session. If not specified, defaults to 60s. It can be at most 300s,
+	// unless the RingBuffer capture mode is used, in which case it can be up to 86400s.
 	Timeout       *int32        `json:"timeout,omitempty"`
 	CaptureConfig CaptureConfig `json:"captureConfig"`
 	// Source is the traffic source we want to perform capture on. Both `Source` and `Destination` is required
@@ -516,10 +726,27 @@ type PacketCaptureStatus struct {
 	// or a local file path on the antrea-agent Pod where the packet was captured, formatted as : <antrea-agent-pod-name>:<path>.
 	// When using a local file path, the file will be automatically removed after the PacketCapture resource is deleted.
 	FilePath string `json:"filePath"`
+	// FilePaths lists the locations of all the files of a RingBuffer capture, from the oldest to the newest, using the
+	// same format as FilePath. FilePath is set to the newest file in this case.
+	FilePaths []string `json:"filePaths,omitempty"`
+	// CapturedBytes records how many bytes have been captured.
+	CapturedBytes int64 `json:"capturedBytes,omitempty"`
+	// RingBuffer reports the rotation state of a RingBuffer capture.
+	RingBuffer *PacketCaptureRingBufferStatus `json:"ringBuffer,omitempty"`
 	// Condition represents the latest available observations of the PacketCapture's current state.
 	Conditions []PacketCaptureCondition `json:"conditions"`
 }
 
+// PacketCaptureRingBufferStatus describes the rotation state of a RingBuffer capture.
+type PacketCaptureRingBufferStatus struct {
+	// Rotations is the number of times the ring buffer has moved to the next file.
+	Rotations int32 `json:"rotations"`
+	// CurrentFileIndex is the index of the file which is currently being written.
+	CurrentFileIndex int32 `json:"currentFileIndex"`
+	// TriggerTime is the time when the trigger condition fired. It is not set if the trigger has not fired yet.
+	TriggerTime *metav1.Time `json:"triggerTime,omitempty"`
+}
+
 type PacketCaptureConditionType string
 
 const (
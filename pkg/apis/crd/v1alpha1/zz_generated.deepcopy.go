@@ -37,12 +37,12 @@ func (in *Advertisements) DeepCopyInto(out *Advertisements) {
 	if in.Pod != nil {
 		in, out := &in.Pod, &out.Pod
 		*out = new(PodAdvertisement)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Egress != nil {
 		in, out := &in.Egress, &out.Egress
 		*out = new(EgressAdvertisement)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -75,6 +75,36 @@ func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.HoldTimeSeconds != nil {
+		in, out := &in.HoldTimeSeconds, &out.HoldTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KeepaliveTimeSeconds != nil {
+		in, out := &in.KeepaliveTimeSeconds, &out.KeepaliveTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectRetryTimeSeconds != nil {
+		in, out := &in.ConnectRetryTimeSeconds, &out.ConnectRetryTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BFD != nil {
+		in, out := &in.BFD, &out.BFD
+		*out = new(BGPPeerBFD)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportPolicy != nil {
+		in, out := &in.ExportPolicy, &out.ExportPolicy
+		*out = new(BGPRoutePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImportPolicy != nil {
+		in, out := &in.ImportPolicy, &out.ImportPolicy
+		*out = new(BGPRoutePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -88,6 +118,37 @@ func (in *BGPPeer) DeepCopy() *BGPPeer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerBFD) DeepCopyInto(out *BGPPeerBFD) {
+	*out = *in
+	if in.MinTransmitIntervalMilliseconds != nil {
+		in, out := &in.MinTransmitIntervalMilliseconds, &out.MinTransmitIntervalMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReceiveIntervalMilliseconds != nil {
+		in, out := &in.MinReceiveIntervalMilliseconds, &out.MinReceiveIntervalMilliseconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DetectMultiplier != nil {
+		in, out := &in.DetectMultiplier, &out.DetectMultiplier
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerBFD.
+func (in *BGPPeerBFD) DeepCopy() *BGPPeerBFD {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerBFD)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPolicy) DeepCopyInto(out *BGPPolicy) {
 	*out = *in
@@ -170,6 +231,16 @@ func (in *BGPPolicySpec) DeepCopyInto(out *BGPPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AddressFamilies != nil {
+		in, out := &in.AddressFamilies, &out.AddressFamilies
+		*out = make([]BGPAddressFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteImport != nil {
+		in, out := &in.RouteImport, &out.RouteImport
+		*out = new(RouteImport)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -183,6 +254,75 @@ func (in *BGPPolicySpec) DeepCopy() *BGPPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPrefixMatch) DeepCopyInto(out *BGPPrefixMatch) {
+	*out = *in
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPrefixMatch.
+func (in *BGPPrefixMatch) DeepCopy() *BGPPrefixMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPrefixMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPRoutePolicy) DeepCopyInto(out *BGPRoutePolicy) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]BGPPrefixMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MED != nil {
+		in, out := &in.MED, &out.MED
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LocalPreference != nil {
+		in, out := &in.LocalPreference, &out.LocalPreference
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ASPathPrependCount != nil {
+		in, out := &in.ASPathPrependCount, &out.ASPathPrependCount
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPRoutePolicy.
+func (in *BGPRoutePolicy) DeepCopy() *BGPRoutePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPRoutePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BundleExternalNodes) DeepCopyInto(out *BundleExternalNodes) {
 	*out = *in
@@ -285,6 +425,16 @@ func (in *CaptureConfig) DeepCopyInto(out *CaptureConfig) {
 		*out = new(PacketCaptureFirstNConfig)
 		**out = **in
 	}
+	if in.Bounded != nil {
+		in, out := &in.Bounded, &out.Bounded
+		*out = new(PacketCaptureBoundedConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RingBuffer != nil {
+		in, out := &in.RingBuffer, &out.RingBuffer
+		*out = new(PacketCaptureRingBufferConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -348,6 +498,11 @@ func (in *Destination) DeepCopy() *Destination {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressAdvertisement) DeepCopyInto(out *EgressAdvertisement) {
 	*out = *in
+	if in.EgressSelector != nil {
+		in, out := &in.EgressSelector, &out.EgressSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -505,6 +660,32 @@ func (in *IPBlock) DeepCopy() *IPBlock {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyProbeTarget) DeepCopyInto(out *LatencyProbeTarget) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(NamespacedName)
+		**out = **in
+	}
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = new(NamespacedName)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyProbeTarget.
+func (in *LatencyProbeTarget) DeepCopy() *LatencyProbeTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyProbeTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -547,7 +728,7 @@ func (in *NodeLatencyMonitor) DeepCopyInto(out *NodeLatencyMonitor) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
@@ -605,6 +786,13 @@ func (in *NodeLatencyMonitorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeLatencyMonitorSpec) DeepCopyInto(out *NodeLatencyMonitorSpec) {
 	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]LatencyProbeTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -668,6 +856,32 @@ func (in *PacketCapture) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureBoundedConfig) DeepCopyInto(out *PacketCaptureBoundedConfig) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureBoundedConfig.
+func (in *PacketCaptureBoundedConfig) DeepCopy() *PacketCaptureBoundedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureBoundedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureCondition) DeepCopyInto(out *PacketCaptureCondition) {
 	*out = *in
@@ -755,6 +969,53 @@ func (in *PacketCaptureList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureRingBufferConfig) DeepCopyInto(out *PacketCaptureRingBufferConfig) {
+	*out = *in
+	if in.PacketsPerFile != nil {
+		in, out := &in.PacketsPerFile, &out.PacketsPerFile
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BytesPerFile != nil {
+		in, out := &in.BytesPerFile, &out.BytesPerFile
+		*out = new(int64)
+		**out = **in
+	}
+	in.Trigger.DeepCopyInto(&out.Trigger)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureRingBufferConfig.
+func (in *PacketCaptureRingBufferConfig) DeepCopy() *PacketCaptureRingBufferConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureRingBufferConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureRingBufferStatus) DeepCopyInto(out *PacketCaptureRingBufferStatus) {
+	*out = *in
+	if in.TriggerTime != nil {
+		in, out := &in.TriggerTime, &out.TriggerTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureRingBufferStatus.
+func (in *PacketCaptureRingBufferStatus) DeepCopy() *PacketCaptureRingBufferStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureRingBufferStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureSpec) DeepCopyInto(out *PacketCaptureSpec) {
 	*out = *in
@@ -792,6 +1053,16 @@ func (in *PacketCaptureSpec) DeepCopy() *PacketCaptureSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PacketCaptureStatus) DeepCopyInto(out *PacketCaptureStatus) {
 	*out = *in
+	if in.FilePaths != nil {
+		in, out := &in.FilePaths, &out.FilePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RingBuffer != nil {
+		in, out := &in.RingBuffer, &out.RingBuffer
+		*out = new(PacketCaptureRingBufferStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]PacketCaptureCondition, len(*in))
@@ -812,9 +1083,44 @@ func (in *PacketCaptureStatus) DeepCopy() *PacketCaptureStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureTrigger) DeepCopyInto(out *PacketCaptureTrigger) {
+	*out = *in
+	if in.TCPFlags != nil {
+		in, out := &in.TCPFlags, &out.TCPFlags
+		*out = make([]TCPFlagsMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ICMPMessages != nil {
+		in, out := &in.ICMPMessages, &out.ICMPMessages
+		*out = make([]ICMPMsgMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureTrigger.
+func (in *PacketCaptureTrigger) DeepCopy() *PacketCaptureTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodAdvertisement) DeepCopyInto(out *PodAdvertisement) {
 	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -844,6 +1150,29 @@ func (in *PodReference) DeepCopy() *PodReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImport) DeepCopyInto(out *RouteImport) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]BGPPrefixMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImport.
+func (in *RouteImport) DeepCopy() *RouteImport {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
 	*out = *in
@@ -852,6 +1181,11 @@ func (in *ServiceAdvertisement) DeepCopyInto(out *ServiceAdvertisement) {
 		*out = make([]ServiceIPType, len(*in))
 		copy(*out, *in)
 	}
+	if in.ServiceSelector != nil {
+		in, out := &in.ServiceSelector, &out.ServiceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
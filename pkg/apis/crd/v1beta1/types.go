@@ -498,6 +498,11 @@ type NetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+
+	// LogSampling limits the logs generated for the rules of this policy which
+	// have EnableLogging set to true and no LogSampling of their own.
+	// +optional
+	LogSampling *LogSampling `json:"logSampling,omitempty"`
 }
 
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
@@ -607,12 +612,33 @@ type Rule struct {
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	// +optional
 	LogLabel string `json:"logLabel,omitempty"`
+	// LogSampling limits the logs generated for this rule when EnableLogging is
+	// true, to avoid flooding the logs when the rule is matched by a large
+	// amount of traffic. If not set, all the packets sent to the agent for
+	// logging are logged.
+	// +optional
+	LogSampling *LogSampling `json:"logSampling,omitempty"`
 	// Select workloads on which this rule will be applied to. Cannot be set in
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
 }
 
+// LogSampling describes the sampling and rate limiting of the logs generated
+// for a rule.
+type LogSampling struct {
+	// SamplingRate is the sampling rate of the logged packets: one packet out of
+	// every SamplingRate packets is logged. Defaults to 1, which means that all
+	// packets are logged.
+	// +optional
+	SamplingRate *int32 `json:"samplingRate,omitempty"`
+	// MaxEventsPerSecond is the maximum number of packets logged per second.
+	// Packets exceeding the limit are not logged. If not set, the number of
+	// logged packets is not limited.
+	// +optional
+	MaxEventsPerSecond *int32 `json:"maxEventsPerSecond,omitempty"`
+}
+
 // NetworkPolicyPeer describes the grouping selector of workloads.
 type NetworkPolicyPeer struct {
 	// IPBlock describes the IPAddresses/IPBlocks that is matched in to/from.
@@ -855,6 +881,11 @@ type ClusterNetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+
+	// LogSampling limits the logs generated for the rules of this policy which
+	// have EnableLogging set to true and no LogSampling of their own.
+	// +optional
+	LogSampling *LogSampling `json:"logSampling,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -912,6 +943,8 @@ type IGMPProtocol struct {
 type L7Protocol struct {
 	HTTP *HTTPProtocol `json:"http,omitempty"`
 	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	GRPC *GRPCProtocol `json:"grpc,omitempty"`
+	DNS  *DNSProtocol  `json:"dns,omitempty"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -925,6 +958,31 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty"`
+	// PathRegex represents a regular expression which the URI path must fully match (Ex. "/api/v[0-9]+/pods").
+	// It cannot be used together with Path.
+	PathRegex string `json:"pathRegex,omitempty"`
+	// Headers represents the HTTP request headers to match. All headers must be matched.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// QueryParams represents the query parameters of the URI to match. All query parameters must be matched.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+}
+
+// HTTPHeaderMatch matches an HTTP request header with specific name and value.
+type HTTPHeaderMatch struct {
+	// Name represents the name of the header to match. It is case-insensitive.
+	Name string `json:"name"`
+	// Value represents the value of the header to match (Ex. "tenant-a", "tenant-*").
+	// If not set, it matches any request which has the header.
+	Value string `json:"value,omitempty"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of the URI with specific name and value.
+type HTTPQueryParamMatch struct {
+	// Name represents the name of the query parameter to match.
+	Name string `json:"name"`
+	// Value represents the value of the query parameter to match (Ex. "v2", "v*").
+	// If not set, it matches any request which has the query parameter.
+	Value string `json:"value,omitempty"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -934,6 +992,25 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. Method can only be used together with Service.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty"`
+}
+
+// DNSProtocol matches DNS queries with specific query name and type. All fields could be used alone or together.
+// If all fields are not provided, it matches all DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the DNS query to match (Ex. "www.example.com", "*.example.com").
+	QueryName string `json:"queryName,omitempty"`
+	// QueryType represents the type of the DNS query to match.
+	// It could be A, AAAA, CNAME, MX, NS, PTR, SOA, SRV, TXT and ANY.
+	QueryType string `json:"queryType,omitempty"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -959,6 +1036,9 @@ type EgressStatus struct {
 	// EgressIP indicates the effective Egress IP for the selected workloads. It could be empty if the Egress IP in spec
 	// is not assigned to any Node. It's also useful when there are more than one Egress IP specified in spec.
 	EgressIP string `json:"egressIP"`
+	// StandbyNodes is the ordered list of Nodes which will take over the Egress IP if the Node holding it becomes
+	// unavailable. It is only reported for Egresses whose Egress IP is allocated from an ExternalIPPool.
+	StandbyNodes []string `json:"standbyNodes,omitempty"`
 
 	Conditions []EgressCondition `json:"conditions,omitempty"`
 }
@@ -1005,6 +1085,35 @@ type EgressSpec struct {
 	ExternalIPPools []string `json:"externalIPPools,omitempty"`
 	// Bandwidth specifies the rate limit of north-south egress traffic of this Egress.
 	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+	// SchedulingPolicy specifies how the Egress IP is scheduled to the Nodes selected by the ExternalIPPool. If it is
+	// not set, Egress IPs are spread across the eligible Nodes using consistent hashing.
+	SchedulingPolicy *EgressSchedulingPolicy `json:"schedulingPolicy,omitempty"`
+}
+
+// EgressSchedulingPolicy defines the order in which the eligible Nodes are selected to hold an Egress IP. The first
+// Node in that order holds the Egress IP, and the next ones are standby Nodes which take over in turn when the Nodes
+// before them become unavailable.
+type EgressSchedulingPolicy struct {
+	// PreferredNodes is a list of weighted Node selectors. Each eligible Node is given a score equal to the sum of the
+	// weights of the selectors matching it, and Nodes with a higher score are selected first. Nodes with the same
+	// score are ordered using consistent hashing.
+	PreferredNodes []EgressPreferredNodes `json:"preferredNodes,omitempty"`
+	// ZoneAntiAffinity spreads the Node holding the Egress IP and the standby Nodes across zones, so that the Egress
+	// IP fails over to another zone when the zone of the Node holding it becomes unavailable.
+	ZoneAntiAffinity *EgressZoneAntiAffinity `json:"zoneAntiAffinity,omitempty"`
+}
+
+type EgressPreferredNodes struct {
+	// Weight associated with matching NodeSelector, in the range 1-100.
+	Weight int32 `json:"weight"`
+	// NodeSelector selects the preferred Nodes by their labels.
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
+type EgressZoneAntiAffinity struct {
+	// TopologyKey is the key of the Node label whose value identifies the zone of a Node. Defaults to
+	// "topology.kubernetes.io/zone". Nodes without this label are considered to be in the same zone.
+	TopologyKey string `json:"topologyKey,omitempty"`
 }
 
 type Bandwidth struct {
@@ -1125,6 +1234,11 @@ type TraceflowSpec struct {
 	// Timeout specifies the timeout of the Traceflow in seconds. Defaults
 	// to 20 seconds if not set.
 	Timeout int32 `json:"timeout,omitempty"`
+	// RoundTrip indicates that the reply to the injected packet should be
+	// traced as well, when set to true. The observations of the reply are
+	// reported in ReplyResults. RoundTrip is not supported for
+	// live-traffic Traceflow.
+	RoundTrip bool `json:"roundTrip,omitempty"`
 }
 
 // Source describes the source spec of the traceflow.
@@ -1227,8 +1341,23 @@ type TraceflowStatus struct {
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 	// DataplaneTag is a tag to identify a traceflow session across Nodes.
 	DataplaneTag int8 `json:"dataplaneTag,omitempty"`
+	// ReplyDataplaneTag is a tag to identify the reply packet of a
+	// round-trip traceflow session across Nodes.
+	ReplyDataplaneTag int8 `json:"replyDataplaneTag,omitempty"`
 	// Results is the collection of all observations on different nodes.
 	Results []NodeResult `json:"results,omitempty"`
+	// ReplyResults is the collection of all observations of the reply
+	// packet on different nodes, for round-trip Traceflow.
+	ReplyResults []NodeResult `json:"replyResults,omitempty"`
+	// RoundTripTimeNanos is the time in nanoseconds between the request
+	// packet leaving the source Pod and the reply packet being delivered
+	// back to it, as observed by the source Node. It is only set for
+	// successful round-trip Traceflow.
+	RoundTripTimeNanos int64 `json:"roundTripTimeNanos,omitempty"`
+	// SourcePodIPs are the IPs of the source Pod of a round-trip Traceflow,
+	// set by the Antrea Controller when the Traceflow is started. They are
+	// used by all Nodes to match the reply packet.
+	SourcePodIPs []string `json:"sourcePodIPs,omitempty"`
 	// CapturedPacket is the captured packet in live-traffic Traceflow.
 	CapturedPacket *Packet `json:"capturedPacket,omitempty"`
 }
@@ -1240,6 +1369,11 @@ type NodeResult struct {
 	Role string `json:"role,omitempty" yaml:"role,omitempty"`
 	// Timestamp is the timestamp of the observations on the node.
 	Timestamp int64 `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+	// TimestampNanos is the timestamp of the observations on the node in
+	// nanoseconds since the Unix epoch. It can be used to compute the
+	// latency between two observations, keeping in mind that the clocks
+	// of different Nodes may not be perfectly synchronized.
+	TimestampNanos int64 `json:"timestampNanos,omitempty" yaml:"timestampNanos,omitempty"`
 	// Observations includes all observations from sender nodes, receiver ones, etc.
 	Observations []Observation `json:"observations,omitempty" yaml:"observations,omitempty"`
 }
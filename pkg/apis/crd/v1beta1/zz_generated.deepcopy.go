@@ -401,6 +401,11 @@ func (in *ClusterNetworkPolicySpec) DeepCopyInto(out *ClusterNetworkPolicySpec)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LogSampling != nil {
+		in, out := &in.LogSampling, &out.LogSampling
+		*out = new(LogSampling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -431,6 +436,22 @@ func (in *ControllerCondition) DeepCopy() *ControllerCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProtocol) DeepCopyInto(out *DNSProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProtocol.
+func (in *DNSProtocol) DeepCopy() *DNSProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Destination) DeepCopyInto(out *Destination) {
 	*out = *in
@@ -525,6 +546,51 @@ func (in *EgressList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressPreferredNodes) DeepCopyInto(out *EgressPreferredNodes) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPreferredNodes.
+func (in *EgressPreferredNodes) DeepCopy() *EgressPreferredNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPreferredNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressSchedulingPolicy) DeepCopyInto(out *EgressSchedulingPolicy) {
+	*out = *in
+	if in.PreferredNodes != nil {
+		in, out := &in.PreferredNodes, &out.PreferredNodes
+		*out = make([]EgressPreferredNodes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ZoneAntiAffinity != nil {
+		in, out := &in.ZoneAntiAffinity, &out.ZoneAntiAffinity
+		*out = new(EgressZoneAntiAffinity)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressSchedulingPolicy.
+func (in *EgressSchedulingPolicy) DeepCopy() *EgressSchedulingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressSchedulingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
 	*out = *in
@@ -544,6 +610,11 @@ func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
 		*out = new(Bandwidth)
 		**out = **in
 	}
+	if in.SchedulingPolicy != nil {
+		in, out := &in.SchedulingPolicy, &out.SchedulingPolicy
+		*out = new(EgressSchedulingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -560,6 +631,11 @@ func (in *EgressSpec) DeepCopy() *EgressSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressStatus) DeepCopyInto(out *EgressStatus) {
 	*out = *in
+	if in.StandbyNodes != nil {
+		in, out := &in.StandbyNodes, &out.StandbyNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]EgressCondition, len(*in))
@@ -580,6 +656,22 @@ func (in *EgressStatus) DeepCopy() *EgressStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressZoneAntiAffinity) DeepCopyInto(out *EgressZoneAntiAffinity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressZoneAntiAffinity.
+func (in *EgressZoneAntiAffinity) DeepCopy() *EgressZoneAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressZoneAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalIPPool) DeepCopyInto(out *ExternalIPPool) {
 	*out = *in
@@ -685,6 +777,22 @@ func (in *ExternalIPPoolStatus) DeepCopy() *ExternalIPPoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCProtocol) DeepCopyInto(out *GRPCProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCProtocol.
+func (in *GRPCProtocol) DeepCopy() *GRPCProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -834,9 +942,35 @@ func (in *GroupStatus) DeepCopy() *GroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderMatch) DeepCopyInto(out *HTTPHeaderMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderMatch.
+func (in *HTTPHeaderMatch) DeepCopy() *HTTPHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPProtocol) DeepCopyInto(out *HTTPProtocol) {
 	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeaderMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]HTTPQueryParamMatch, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -850,6 +984,22 @@ func (in *HTTPProtocol) DeepCopy() *HTTPProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPQueryParamMatch) DeepCopyInto(out *HTTPQueryParamMatch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPQueryParamMatch.
+func (in *HTTPQueryParamMatch) DeepCopy() *HTTPQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPQueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICMPEchoRequestHeader) DeepCopyInto(out *ICMPEchoRequestHeader) {
 	*out = *in
@@ -1159,13 +1309,23 @@ func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPProtocol)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLSProtocol)
 		**out = **in
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPCProtocol)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSProtocol)
+		**out = **in
+	}
 	return
 }
 
@@ -1179,6 +1339,32 @@ func (in *L7Protocol) DeepCopy() *L7Protocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogSampling) DeepCopyInto(out *LogSampling) {
+	*out = *in
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxEventsPerSecond != nil {
+		in, out := &in.MaxEventsPerSecond, &out.MaxEventsPerSecond
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogSampling.
+func (in *LogSampling) DeepCopy() *LogSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(LogSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -1431,6 +1617,11 @@ func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LogSampling != nil {
+		in, out := &in.LogSampling, &out.LogSampling
+		*out = new(LogSampling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1655,6 +1846,11 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 		*out = make([]PeerService, len(*in))
 		copy(*out, *in)
 	}
+	if in.LogSampling != nil {
+		in, out := &in.LogSampling, &out.LogSampling
+		*out = new(LogSampling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AppliedTo != nil {
 		in, out := &in.AppliedTo, &out.AppliedTo
 		*out = make([]AppliedTo, len(*in))
@@ -1930,6 +2126,18 @@ func (in *TraceflowStatus) DeepCopyInto(out *TraceflowStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReplyResults != nil {
+		in, out := &in.ReplyResults, &out.ReplyResults
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourcePodIPs != nil {
+		in, out := &in.SourcePodIPs, &out.SourcePodIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.CapturedPacket != nil {
 		in, out := &in.CapturedPacket, &out.CapturedPacket
 		*out = new(Packet)
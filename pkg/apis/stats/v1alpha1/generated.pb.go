@@ -153,10 +153,66 @@ func (m *AntreaNetworkPolicyStatsList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AntreaNetworkPolicyStatsList proto.InternalMessageInfo
 
+func (m *EgressStats) Reset()      { *m = EgressStats{} }
+func (*EgressStats) ProtoMessage() {}
+func (*EgressStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{4}
+}
+func (m *EgressStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressStats.Merge(m, src)
+}
+func (m *EgressStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressStats proto.InternalMessageInfo
+
+func (m *EgressStatsList) Reset()      { *m = EgressStatsList{} }
+func (*EgressStatsList) ProtoMessage() {}
+func (*EgressStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{5}
+}
+func (m *EgressStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressStatsList.Merge(m, src)
+}
+func (m *EgressStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressStatsList proto.InternalMessageInfo
+
 func (m *MulticastGroup) Reset()      { *m = MulticastGroup{} }
 func (*MulticastGroup) ProtoMessage() {}
 func (*MulticastGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{4}
+	return fileDescriptor_91b517c6fa558473, []int{6}
 }
 func (m *MulticastGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +240,7 @@ var xxx_messageInfo_MulticastGroup proto.InternalMessageInfo
 func (m *MulticastGroupList) Reset()      { *m = MulticastGroupList{} }
 func (*MulticastGroupList) ProtoMessage() {}
 func (*MulticastGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{5}
+	return fileDescriptor_91b517c6fa558473, []int{7}
 }
 func (m *MulticastGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,7 +268,7 @@ var xxx_messageInfo_MulticastGroupList proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{6}
+	return fileDescriptor_91b517c6fa558473, []int{8}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +296,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatsList) Reset()      { *m = NetworkPolicyStatsList{} }
 func (*NetworkPolicyStatsList) ProtoMessage() {}
 func (*NetworkPolicyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{7}
+	return fileDescriptor_91b517c6fa558473, []int{9}
 }
 func (m *NetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +324,7 @@ var xxx_messageInfo_NetworkPolicyStatsList proto.InternalMessageInfo
 func (m *NodeLatencyStats) Reset()      { *m = NodeLatencyStats{} }
 func (*NodeLatencyStats) ProtoMessage() {}
 func (*NodeLatencyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{8}
+	return fileDescriptor_91b517c6fa558473, []int{10}
 }
 func (m *NodeLatencyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +352,7 @@ var xxx_messageInfo_NodeLatencyStats proto.InternalMessageInfo
 func (m *NodeLatencyStatsList) Reset()      { *m = NodeLatencyStatsList{} }
 func (*NodeLatencyStatsList) ProtoMessage() {}
 func (*NodeLatencyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{9}
+	return fileDescriptor_91b517c6fa558473, []int{11}
 }
 func (m *NodeLatencyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +380,7 @@ var xxx_messageInfo_NodeLatencyStatsList proto.InternalMessageInfo
 func (m *PeerNodeLatencyStats) Reset()      { *m = PeerNodeLatencyStats{} }
 func (*PeerNodeLatencyStats) ProtoMessage() {}
 func (*PeerNodeLatencyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{10}
+	return fileDescriptor_91b517c6fa558473, []int{12}
 }
 func (m *PeerNodeLatencyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +408,7 @@ var xxx_messageInfo_PeerNodeLatencyStats proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{11}
+	return fileDescriptor_91b517c6fa558473, []int{13}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,10 +433,38 @@ func (m *PodReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodReference proto.InternalMessageInfo
 
+func (m *ProbeTargetLatencyStats) Reset()      { *m = ProbeTargetLatencyStats{} }
+func (*ProbeTargetLatencyStats) ProtoMessage() {}
+func (*ProbeTargetLatencyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{14}
+}
+func (m *ProbeTargetLatencyStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProbeTargetLatencyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProbeTargetLatencyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbeTargetLatencyStats.Merge(m, src)
+}
+func (m *ProbeTargetLatencyStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProbeTargetLatencyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbeTargetLatencyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbeTargetLatencyStats proto.InternalMessageInfo
+
 func (m *RuleTrafficStats) Reset()      { *m = RuleTrafficStats{} }
 func (*RuleTrafficStats) ProtoMessage() {}
 func (*RuleTrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{12}
+	return fileDescriptor_91b517c6fa558473, []int{15}
 }
 func (m *RuleTrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +492,7 @@ var xxx_messageInfo_RuleTrafficStats proto.InternalMessageInfo
 func (m *TargetIPLatencyStats) Reset()      { *m = TargetIPLatencyStats{} }
 func (*TargetIPLatencyStats) ProtoMessage() {}
 func (*TargetIPLatencyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{13}
+	return fileDescriptor_91b517c6fa558473, []int{16}
 }
 func (m *TargetIPLatencyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +520,7 @@ var xxx_messageInfo_TargetIPLatencyStats proto.InternalMessageInfo
 func (m *TrafficStats) Reset()      { *m = TrafficStats{} }
 func (*TrafficStats) ProtoMessage() {}
 func (*TrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{14}
+	return fileDescriptor_91b517c6fa558473, []int{17}
 }
 func (m *TrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,6 +550,8 @@ func init() {
 	proto.RegisterType((*AntreaClusterNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaClusterNetworkPolicyStatsList")
 	proto.RegisterType((*AntreaNetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaNetworkPolicyStats")
 	proto.RegisterType((*AntreaNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaNetworkPolicyStatsList")
+	proto.RegisterType((*EgressStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.EgressStats")
+	proto.RegisterType((*EgressStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.EgressStatsList")
 	proto.RegisterType((*MulticastGroup)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroup")
 	proto.RegisterType((*MulticastGroupList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroupList")
 	proto.RegisterType((*NetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NetworkPolicyStats")
@@ -474,6 +560,7 @@ func init() {
 	proto.RegisterType((*NodeLatencyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NodeLatencyStatsList")
 	proto.RegisterType((*PeerNodeLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PeerNodeLatencyStats")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PodReference")
+	proto.RegisterType((*ProbeTargetLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.ProbeTargetLatencyStats")
 	proto.RegisterType((*RuleTrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.RuleTrafficStats")
 	proto.RegisterType((*TargetIPLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.TargetIPLatencyStats")
 	proto.RegisterType((*TrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.TrafficStats")
@@ -484,64 +571,70 @@ func init() {
 }
 
 var fileDescriptor_91b517c6fa558473 = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x24, 0xad, 0xb6, 0x9d, 0x0d, 0x50, 0xac, 0x0a, 0x45, 0xd1, 0xca, 0xad, 0xbc, 0x97,
-	0x80, 0xc0, 0xa6, 0x2b, 0xb4, 0xaa, 0x10, 0x42, 0xc2, 0x1c, 0x50, 0xa5, 0x36, 0x44, 0xd3, 0x1c,
-	0x10, 0x02, 0x2d, 0x13, 0xfb, 0xd5, 0x35, 0x89, 0x3d, 0x96, 0x67, 0x5c, 0xd4, 0xdb, 0xde, 0xb8,
-	0x70, 0xd8, 0xbf, 0x82, 0xbf, 0xa5, 0xc7, 0xe5, 0x80, 0x58, 0x2e, 0x2b, 0x1a, 0x84, 0xc4, 0x15,
-	0xc1, 0x81, 0x23, 0x9a, 0xb1, 0x1d, 0xc7, 0x89, 0x4b, 0x1d, 0xad, 0x14, 0x0e, 0xec, 0xa9, 0xf6,
-	0xfb, 0xf1, 0x7d, 0xef, 0xbd, 0xef, 0xcd, 0xb8, 0xc1, 0x87, 0x34, 0x14, 0x31, 0x50, 0xd3, 0x67,
-	0x56, 0xfa, 0x64, 0x45, 0x63, 0xcf, 0xa2, 0x91, 0xcf, 0x2d, 0x2e, 0xa8, 0xe0, 0xd6, 0xc5, 0x01,
-	0x9d, 0x44, 0xe7, 0xf4, 0xc0, 0xf2, 0x20, 0x84, 0x98, 0x0a, 0x70, 0xcd, 0x28, 0x66, 0x82, 0x69,
-	0xbd, 0x34, 0xfe, 0x91, 0xcf, 0xcc, 0x0c, 0x23, 0x1a, 0x7b, 0xa6, 0xcc, 0x34, 0x55, 0xa6, 0x99,
-	0x67, 0x76, 0xdf, 0xf1, 0x7c, 0x71, 0x9e, 0x8c, 0x4c, 0x87, 0x05, 0x96, 0xc7, 0x3c, 0x66, 0x29,
-	0x80, 0x51, 0x72, 0xa6, 0xde, 0xd4, 0x8b, 0x7a, 0x4a, 0x81, 0xbb, 0xef, 0x8d, 0x0f, 0xb9, 0xaa,
-	0x27, 0xf2, 0x03, 0xea, 0x9c, 0xfb, 0x21, 0xc4, 0x97, 0x45, 0x55, 0x01, 0x08, 0x6a, 0x5d, 0x2c,
-	0x95, 0xd3, 0xb5, 0x6e, 0xca, 0x8a, 0x93, 0x50, 0xf8, 0x01, 0x2c, 0x25, 0x3c, 0xbc, 0x2d, 0x81,
-	0x3b, 0xe7, 0x10, 0xd0, 0xc5, 0x3c, 0xe3, 0xef, 0x26, 0xde, 0xfb, 0x48, 0x35, 0xfc, 0xf1, 0x24,
-	0xe1, 0x02, 0xe2, 0x3e, 0x88, 0x6f, 0x58, 0x3c, 0x1e, 0xb0, 0x89, 0xef, 0x5c, 0x9e, 0xca, 0xd6,
-	0xb5, 0xaf, 0xf0, 0x96, 0xac, 0xd3, 0xa5, 0x82, 0x76, 0xd0, 0x3e, 0xea, 0xdd, 0x7d, 0xf0, 0xae,
-	0x99, 0xd2, 0x99, 0xf3, 0x74, 0xc5, 0xc4, 0x64, 0xb4, 0x79, 0x71, 0x60, 0x7e, 0x3a, 0xfa, 0x1a,
-	0x1c, 0x71, 0x02, 0x82, 0xda, 0xda, 0xd5, 0xf3, 0xbd, 0xc6, 0xf4, 0xf9, 0x1e, 0x2e, 0x6c, 0x64,
-	0x86, 0xaa, 0x45, 0xb8, 0x2d, 0x62, 0x7a, 0x76, 0xe6, 0x3b, 0x8a, 0xb1, 0xd3, 0x54, 0x2c, 0x0f,
-	0xcd, 0xba, 0xa2, 0x98, 0xc3, 0xb9, 0x6c, 0x7b, 0x37, 0xe3, 0x6a, 0xcf, 0x5b, 0x49, 0x89, 0x41,
-	0x7b, 0x8c, 0xf0, 0x4e, 0x9c, 0x4c, 0x60, 0x3e, 0xa4, 0xd3, 0xda, 0x6f, 0xf5, 0xee, 0x3e, 0x78,
-	0xbf, 0x3e, 0x2d, 0x59, 0x40, 0xb0, 0x3b, 0x19, 0xf5, 0xce, 0xa2, 0x87, 0x2c, 0xb1, 0x19, 0x7f,
-	0x22, 0x7c, 0xff, 0x96, 0xd1, 0x1f, 0xfb, 0x5c, 0x68, 0x5f, 0x2c, 0x8d, 0xdf, 0xac, 0x37, 0x7e,
-	0x99, 0xad, 0x86, 0xbf, 0x93, 0x55, 0xb5, 0x95, 0x5b, 0xe6, 0x46, 0x1f, 0xe2, 0x4d, 0x5f, 0x40,
-	0x20, 0x67, 0x2e, 0x9b, 0x3f, 0xaa, 0xdf, 0xfc, 0x2d, 0xb5, 0xdb, 0xaf, 0x64, 0xac, 0x9b, 0x47,
-	0x12, 0x9f, 0xa4, 0x34, 0xc6, 0x1f, 0x4d, 0xdc, 0x49, 0x33, 0x5f, 0x6e, 0xda, 0xba, 0x36, 0xed,
-	0x37, 0x84, 0xef, 0xdd, 0x34, 0xf3, 0x35, 0xac, 0x98, 0x57, 0x5e, 0x31, 0x7b, 0xd5, 0x15, 0xab,
-	0xbf, 0x5b, 0x08, 0xbf, 0x7a, 0x92, 0x4c, 0x84, 0xef, 0x50, 0x2e, 0x3e, 0x89, 0x59, 0x12, 0xad,
-	0x61, 0xa3, 0xee, 0xe3, 0x4d, 0x4f, 0x52, 0xa9, 0x55, 0xda, 0x2e, 0x2a, 0x53, 0xfc, 0x24, 0xf5,
-	0x69, 0x9f, 0xe1, 0x8d, 0x88, 0xb9, 0xb9, 0xee, 0x2b, 0xac, 0xdb, 0x80, 0xb9, 0x04, 0xce, 0x20,
-	0x86, 0xd0, 0x01, 0xbb, 0x9d, 0x61, 0x6f, 0x0c, 0x98, 0xcb, 0x89, 0x42, 0x34, 0x7e, 0x40, 0x58,
-	0x2b, 0xf7, 0xbc, 0x06, 0x45, 0xbf, 0x2c, 0x2b, 0x7a, 0x58, 0xbf, 0x9f, 0x72, 0xa9, 0x37, 0xe8,
-	0xf8, 0x3b, 0xc2, 0xda, 0xff, 0xe3, 0x76, 0x30, 0x7e, 0x46, 0xf8, 0x8d, 0xff, 0xe4, 0x50, 0xd2,
-	0xb2, 0x84, 0x1f, 0xd4, 0xef, 0xb1, 0xf6, 0x71, 0xfc, 0xb6, 0x89, 0x77, 0xfa, 0xcc, 0x85, 0x63,
-	0x2a, 0x20, 0x5c, 0x9f, 0x88, 0x4f, 0x10, 0xde, 0x8d, 0x00, 0xe2, 0x45, 0xea, 0xac, 0xd3, 0x0f,
-	0x57, 0x38, 0x7c, 0x15, 0x28, 0xf6, 0xbd, 0x8c, 0x7c, 0xb7, 0xca, 0x4b, 0x2a, 0x99, 0x8d, 0x1f,
-	0x11, 0xde, 0x5d, 0x34, 0xae, 0x41, 0xe3, 0x47, 0x65, 0x8d, 0x57, 0xf8, 0xdc, 0x2c, 0x75, 0x5d,
-	0xad, 0xf0, 0x4f, 0x08, 0x57, 0x8e, 0x41, 0x7b, 0x1b, 0x6f, 0x85, 0xcc, 0x85, 0x3e, 0x0d, 0x40,
-	0xf5, 0xb5, 0x5d, 0xd4, 0xd9, 0xcf, 0xec, 0x64, 0x16, 0xa1, 0x14, 0x13, 0x34, 0xf6, 0x40, 0x1c,
-	0x0d, 0x5e, 0x4c, 0xb1, 0x61, 0x05, 0x4a, 0xa1, 0x58, 0x95, 0x97, 0x54, 0x32, 0x1b, 0x14, 0xb7,
-	0xe7, 0xaf, 0x5e, 0x6d, 0x1f, 0x6f, 0x84, 0x45, 0x33, 0xb3, 0x8b, 0x58, 0x35, 0xa2, 0x3c, 0x9a,
-	0x85, 0xb7, 0xe5, 0x5f, 0x1e, 0x51, 0x07, 0xb2, 0x6f, 0xc1, 0xeb, 0x59, 0xd8, 0x76, 0x3f, 0x77,
-	0x90, 0x22, 0xc6, 0xf8, 0x1e, 0xe1, 0xa5, 0x8f, 0x77, 0x0d, 0x9e, 0xf5, 0xdf, 0x51, 0x7f, 0x35,
-	0x71, 0xe5, 0xe8, 0xa4, 0xca, 0xf9, 0xf0, 0x16, 0x55, 0xce, 0xe3, 0xc9, 0x2c, 0x42, 0x73, 0x71,
-	0x7b, 0x42, 0xb9, 0x38, 0x85, 0xd0, 0x1d, 0xfa, 0x01, 0x64, 0x85, 0xbf, 0x55, 0x6f, 0xdf, 0x65,
-	0x46, 0x51, 0xec, 0xf1, 0x1c, 0x0e, 0x29, 0xa1, 0xe6, 0x2c, 0x04, 0x9c, 0x0b, 0xc5, 0xd2, 0x7a,
-	0x31, 0x96, 0x1c, 0x87, 0x94, 0x50, 0xb5, 0x11, 0xee, 0xca, 0xf7, 0x13, 0xa0, 0x3c, 0x89, 0xc1,
-	0x25, 0xc3, 0x61, 0x9f, 0x86, 0x8c, 0x83, 0xc3, 0x42, 0x97, 0x77, 0x36, 0xf6, 0x51, 0xaf, 0x65,
-	0x1b, 0x19, 0x4e, 0xf7, 0xf8, 0xc6, 0x48, 0xf2, 0x2f, 0x28, 0xc6, 0x77, 0x08, 0x97, 0x54, 0xd1,
-	0xde, 0xc4, 0x77, 0x22, 0xea, 0x8c, 0x41, 0x70, 0x35, 0xed, 0x96, 0xfd, 0x5a, 0xc6, 0x70, 0x67,
-	0x90, 0x9a, 0x49, 0xee, 0x97, 0xff, 0x94, 0x8c, 0x2e, 0x05, 0xa4, 0xdb, 0xd1, 0x2a, 0x4e, 0xaf,
-	0x2d, 0x8d, 0x24, 0xf5, 0x49, 0xf9, 0x38, 0x70, 0xee, 0xb3, 0x90, 0xab, 0x31, 0xb5, 0x0a, 0xf9,
-	0x4e, 0x33, 0x3b, 0x99, 0x45, 0xd8, 0xfd, 0xab, 0x6b, 0xbd, 0xf1, 0xf4, 0x5a, 0x6f, 0x3c, 0xbb,
-	0xd6, 0x1b, 0x8f, 0xa7, 0x3a, 0xba, 0x9a, 0xea, 0xe8, 0xe9, 0x54, 0x47, 0xcf, 0xa6, 0x3a, 0xfa,
-	0x65, 0xaa, 0xa3, 0x27, 0xbf, 0xea, 0x8d, 0xcf, 0x7b, 0x75, 0x7f, 0x81, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x1b, 0x44, 0xff, 0x68, 0xac, 0x0f, 0x00, 0x00,
+	// 999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0x34, 0xad, 0xb6, 0x9d, 0x06, 0xb6, 0x58, 0x15, 0x1b, 0x45, 0x2b, 0xb7, 0xf2, 0x5e,
+	0x02, 0x02, 0x9b, 0xae, 0x60, 0x55, 0x21, 0x84, 0xb4, 0x46, 0x08, 0x55, 0x6a, 0x43, 0x34, 0xcd,
+	0x01, 0xad, 0x40, 0xcb, 0xc4, 0x7e, 0x75, 0x4d, 0x12, 0x8f, 0xe5, 0x99, 0x14, 0xf5, 0xb6, 0x3f,
+	0x80, 0xc3, 0xfe, 0x00, 0xce, 0xfb, 0x5b, 0x2a, 0x71, 0x59, 0x0e, 0x88, 0xe5, 0xb2, 0xa2, 0x41,
+	0x48, 0x5c, 0x11, 0x1c, 0xb8, 0x81, 0x3c, 0xb6, 0x63, 0x3b, 0xb1, 0xa9, 0xa3, 0x95, 0x52, 0x09,
+	0xf6, 0xd4, 0x78, 0xe6, 0xbd, 0xef, 0xfb, 0xde, 0x7b, 0x9f, 0x67, 0xac, 0xe2, 0x7d, 0xea, 0x89,
+	0x00, 0xa8, 0xee, 0x32, 0x23, 0xfa, 0x65, 0xf8, 0x03, 0xc7, 0xa0, 0xbe, 0xcb, 0x0d, 0x2e, 0xa8,
+	0xe0, 0xc6, 0xd9, 0x1e, 0x1d, 0xfa, 0xa7, 0x74, 0xcf, 0x70, 0xc0, 0x83, 0x80, 0x0a, 0xb0, 0x75,
+	0x3f, 0x60, 0x82, 0x29, 0xed, 0x28, 0xfe, 0xa1, 0xcb, 0xf4, 0x18, 0xc3, 0x1f, 0x38, 0x7a, 0x98,
+	0xa9, 0xcb, 0x4c, 0x3d, 0xc9, 0x6c, 0xbd, 0xed, 0xb8, 0xe2, 0x74, 0xdc, 0xd7, 0x2d, 0x36, 0x32,
+	0x1c, 0xe6, 0x30, 0x43, 0x02, 0xf4, 0xc7, 0x27, 0xf2, 0x49, 0x3e, 0xc8, 0x5f, 0x11, 0x70, 0xeb,
+	0xdd, 0xc1, 0x3e, 0x97, 0x7a, 0x7c, 0x77, 0x44, 0xad, 0x53, 0xd7, 0x83, 0xe0, 0x3c, 0x55, 0x35,
+	0x02, 0x41, 0x8d, 0xb3, 0x39, 0x39, 0x2d, 0xa3, 0x2c, 0x2b, 0x18, 0x7b, 0xc2, 0x1d, 0xc1, 0x5c,
+	0xc2, 0xbd, 0xab, 0x12, 0xb8, 0x75, 0x0a, 0x23, 0x3a, 0x9b, 0xa7, 0xfd, 0xb5, 0x82, 0x77, 0xee,
+	0xcb, 0x82, 0x3f, 0x1a, 0x8e, 0xb9, 0x80, 0xa0, 0x03, 0xe2, 0x6b, 0x16, 0x0c, 0xba, 0x6c, 0xe8,
+	0x5a, 0xe7, 0xc7, 0x82, 0x0a, 0xae, 0x7c, 0x89, 0xd7, 0x43, 0x9d, 0x36, 0x15, 0xb4, 0x89, 0x76,
+	0x51, 0x7b, 0xf3, 0xee, 0x3b, 0x7a, 0x44, 0xa7, 0x67, 0xe9, 0xd2, 0x8e, 0x85, 0xd1, 0xfa, 0xd9,
+	0x9e, 0xfe, 0x69, 0xff, 0x2b, 0xb0, 0xc4, 0x11, 0x08, 0x6a, 0x2a, 0x17, 0xcf, 0x77, 0x6a, 0x93,
+	0xe7, 0x3b, 0x38, 0x5d, 0x23, 0x53, 0x54, 0xc5, 0xc7, 0x0d, 0x11, 0xd0, 0x93, 0x13, 0xd7, 0x92,
+	0x8c, 0xcd, 0x15, 0xc9, 0x72, 0x4f, 0xaf, 0x3a, 0x14, 0xbd, 0x97, 0xc9, 0x36, 0xb7, 0x63, 0xae,
+	0x46, 0x76, 0x95, 0xe4, 0x18, 0x94, 0x47, 0x08, 0x6f, 0x05, 0xe3, 0x21, 0x64, 0x43, 0x9a, 0xf5,
+	0xdd, 0x7a, 0x7b, 0xf3, 0xee, 0xfb, 0xd5, 0x69, 0xc9, 0x0c, 0x82, 0xd9, 0x8c, 0xa9, 0xb7, 0x66,
+	0x77, 0xc8, 0x1c, 0x9b, 0xf6, 0x07, 0xc2, 0x77, 0xae, 0x68, 0xfd, 0xa1, 0xcb, 0x85, 0xf2, 0xf9,
+	0x5c, 0xfb, 0xf5, 0x6a, 0xed, 0x0f, 0xb3, 0x65, 0xf3, 0xb7, 0x62, 0x55, 0xeb, 0xc9, 0x4a, 0xa6,
+	0xf5, 0x1e, 0x5e, 0x73, 0x05, 0x8c, 0xc2, 0x9e, 0x87, 0xc5, 0x1f, 0x54, 0x2f, 0xfe, 0x0a, 0xed,
+	0xe6, 0x2b, 0x31, 0xeb, 0xda, 0x41, 0x88, 0x4f, 0x22, 0x1a, 0xed, 0xf7, 0x15, 0xdc, 0x8c, 0x32,
+	0x5f, 0x3a, 0x6d, 0x59, 0x4e, 0xfb, 0x15, 0xe1, 0xdb, 0x65, 0x3d, 0x5f, 0x82, 0xc5, 0x9c, 0xbc,
+	0xc5, 0xcc, 0x45, 0x2d, 0x56, 0xd9, 0x5b, 0x97, 0x08, 0x6f, 0x7e, 0xec, 0x04, 0xc0, 0xf9, 0x7f,
+	0xd6, 0x4e, 0xda, 0x77, 0x08, 0xdf, 0xcc, 0xd4, 0xb8, 0x84, 0xf1, 0x3d, 0xc8, 0x8f, 0xef, 0xbd,
+	0xea, 0xc5, 0x65, 0x74, 0x96, 0x9d, 0x06, 0x08, 0xbf, 0x7a, 0x34, 0x1e, 0x0a, 0xd7, 0xa2, 0x5c,
+	0x7c, 0x12, 0xb0, 0xb1, 0xbf, 0x84, 0xa1, 0xdd, 0xc1, 0x6b, 0x4e, 0x48, 0x25, 0xa7, 0xb5, 0x91,
+	0x2a, 0x93, 0xfc, 0x24, 0xda, 0x53, 0x3e, 0xc3, 0xab, 0x3e, 0xb3, 0x93, 0x37, 0x75, 0x81, 0x89,
+	0x76, 0x99, 0x4d, 0xe0, 0x04, 0x02, 0xf0, 0x2c, 0x30, 0x1b, 0x31, 0xf6, 0x6a, 0x97, 0xd9, 0x9c,
+	0x48, 0x44, 0xed, 0x7b, 0x84, 0x95, 0x7c, 0xcd, 0x4b, 0x18, 0xe2, 0x17, 0xf9, 0x21, 0xee, 0x57,
+	0xaf, 0x27, 0x2f, 0xb5, 0x64, 0x8e, 0xbf, 0x21, 0xac, 0xfc, 0x3f, 0xce, 0x73, 0xed, 0x27, 0x84,
+	0x5f, 0xbf, 0x96, 0x63, 0x94, 0xe6, 0x47, 0xf8, 0x41, 0xf5, 0x1a, 0x2b, 0x1f, 0xa0, 0x4f, 0xea,
+	0x78, 0xab, 0xc3, 0x6c, 0x38, 0xa4, 0x02, 0xbc, 0xe5, 0x0d, 0xf1, 0x31, 0xc2, 0xdb, 0x3e, 0x40,
+	0x30, 0x4b, 0x1d, 0x57, 0xfa, 0xe1, 0x02, 0x2f, 0x5f, 0x01, 0x8a, 0x79, 0x3b, 0x26, 0xdf, 0x2e,
+	0xda, 0x25, 0x85, 0xcc, 0xca, 0xb7, 0x08, 0xdf, 0xf2, 0x03, 0xd6, 0x87, 0x1e, 0x0d, 0x1c, 0x10,
+	0x39, 0x55, 0xd1, 0x91, 0x70, 0x7f, 0x01, 0x55, 0xc5, 0x40, 0xe6, 0x4e, 0x2c, 0xec, 0x56, 0x49,
+	0x00, 0x29, 0x93, 0xa0, 0xfd, 0x80, 0xf0, 0xf6, 0xac, 0xe6, 0x25, 0x58, 0xf0, 0x61, 0xde, 0x82,
+	0x0b, 0x7c, 0xbf, 0xcc, 0x0d, 0xa5, 0xd8, 0x80, 0x3f, 0x22, 0x5c, 0x38, 0x25, 0xe5, 0x2d, 0xbc,
+	0xee, 0x31, 0x1b, 0x3a, 0x74, 0x04, 0xb2, 0xae, 0x8d, 0x54, 0x67, 0x27, 0x5e, 0x27, 0xd3, 0x08,
+	0x69, 0x28, 0x21, 0xbb, 0x76, 0xd0, 0x7d, 0x31, 0x43, 0xf5, 0x0a, 0x50, 0x52, 0x43, 0x15, 0xed,
+	0x92, 0x42, 0x66, 0x8d, 0xe2, 0x46, 0xf6, 0x66, 0x50, 0x76, 0xf1, 0xaa, 0x97, 0x16, 0x33, 0xbd,
+	0x27, 0x64, 0x21, 0x72, 0x47, 0x31, 0xf0, 0x46, 0xf8, 0x97, 0xfb, 0xd4, 0x82, 0xf8, 0xaa, 0x7a,
+	0x2d, 0x0e, 0xdb, 0xe8, 0x24, 0x1b, 0x24, 0x8d, 0xd1, 0xfe, 0x46, 0xb8, 0xcc, 0x49, 0xd5, 0xe8,
+	0x22, 0xb7, 0x9d, 0xfb, 0x73, 0x74, 0xdd, 0x64, 0x83, 0xa4, 0x31, 0xe5, 0x4d, 0xae, 0x5f, 0x5b,
+	0x93, 0x9f, 0x20, 0x3c, 0xf7, 0x3d, 0x5c, 0xa1, 0xf4, 0xe5, 0x5f, 0x22, 0x7f, 0xae, 0xe0, 0xc2,
+	0xba, 0x42, 0x9f, 0x27, 0x95, 0xcd, 0xfa, 0x3c, 0x89, 0x27, 0xd3, 0x08, 0xc5, 0xc6, 0x8d, 0x21,
+	0xe5, 0xe2, 0x18, 0x3c, 0xbb, 0xe7, 0x8e, 0x20, 0x16, 0xfe, 0x66, 0xb5, 0x37, 0x3e, 0xcc, 0x48,
+	0xc5, 0x1e, 0x66, 0x70, 0x48, 0x0e, 0x35, 0x61, 0x21, 0x60, 0x9d, 0x49, 0x96, 0xfa, 0x8b, 0xb1,
+	0x24, 0x38, 0x24, 0x87, 0xaa, 0xf4, 0x71, 0x2b, 0x7c, 0x3e, 0x02, 0xca, 0xc7, 0x01, 0xd8, 0xa4,
+	0xd7, 0xeb, 0x50, 0x8f, 0x71, 0xb0, 0x98, 0x67, 0xf3, 0xe6, 0xea, 0x2e, 0x6a, 0xd7, 0x4d, 0x2d,
+	0xc6, 0x69, 0x1d, 0x96, 0x46, 0x92, 0x7f, 0x41, 0xd1, 0xbe, 0x41, 0x38, 0x37, 0x15, 0xe5, 0x0d,
+	0x7c, 0xc3, 0xa7, 0xd6, 0x00, 0x04, 0x97, 0xdd, 0xae, 0x9b, 0x37, 0x63, 0x86, 0x1b, 0xdd, 0x68,
+	0x99, 0x24, 0xfb, 0xe1, 0x57, 0x63, 0xff, 0x5c, 0x40, 0xe4, 0x8e, 0x7a, 0x7a, 0x7e, 0x99, 0xe1,
+	0x22, 0x89, 0xf6, 0xc2, 0xf1, 0x71, 0xe0, 0xdc, 0x65, 0x1e, 0x97, 0x6d, 0xaa, 0xa7, 0xe3, 0x3b,
+	0x8e, 0xd7, 0xc9, 0x34, 0xc2, 0xec, 0x5c, 0x5c, 0xaa, 0xb5, 0xa7, 0x97, 0x6a, 0xed, 0xd9, 0xa5,
+	0x5a, 0x7b, 0x34, 0x51, 0xd1, 0xc5, 0x44, 0x45, 0x4f, 0x27, 0x2a, 0x7a, 0x36, 0x51, 0xd1, 0xcf,
+	0x13, 0x15, 0x3d, 0xfe, 0x45, 0xad, 0x3d, 0x68, 0x57, 0xfd, 0xa7, 0xd6, 0x3f, 0x03, 0x00, 0xdf,
+	0xea, 0xf6, 0x91, 0xff, 0x12, 0x00, 0x00,
 }
 
 func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
@@ -752,6 +845,96 @@ func (m *AntreaNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EgressStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EgressStatsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressStatsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MulticastGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -961,6 +1144,20 @@ func (m *NodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProbeTargetLatencyStats) > 0 {
+		for iNdEx := len(m.ProbeTargetLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProbeTargetLatencyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PeerNodeLatencyStats) > 0 {
 		for iNdEx := len(m.PeerNodeLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1110,6 +1307,53 @@ func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProbeTargetLatencyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProbeTargetLatencyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProbeTargetLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetIPLatencyStats) > 0 {
+		for iNdEx := len(m.TargetIPLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetIPLatencyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.ProbeType)
+	copy(dAtA[i:], m.ProbeType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProbeType)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1314,6 +1558,36 @@ func (m *AntreaNetworkPolicyStatsList) Size() (n int) {
 	return n
 }
 
+func (m *EgressStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EgressStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MulticastGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1394,6 +1668,12 @@ func (m *NodeLatencyStats) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ProbeTargetLatencyStats) > 0 {
+		for _, e := range m.ProbeTargetLatencyStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1444,6 +1724,25 @@ func (m *PodReference) Size() (n int) {
 	return n
 }
 
+func (m *ProbeTargetLatencyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ProbeType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TargetIPLatencyStats) > 0 {
+		for _, e := range m.TargetIPLatencyStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RuleTrafficStats) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1557,7 +1856,34 @@ func (this *AntreaNetworkPolicyStatsList) String() string {
 	}, "")
 	return s
 }
-func (this *MulticastGroup) String() string {
+func (this *EgressStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EgressStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EgressStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]EgressStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "EgressStats", "EgressStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&EgressStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastGroup) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -1626,9 +1952,15 @@ func (this *NodeLatencyStats) String() string {
 		repeatedStringForPeerNodeLatencyStats += strings.Replace(strings.Replace(f.String(), "PeerNodeLatencyStats", "PeerNodeLatencyStats", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForPeerNodeLatencyStats += "}"
+	repeatedStringForProbeTargetLatencyStats := "[]ProbeTargetLatencyStats{"
+	for _, f := range this.ProbeTargetLatencyStats {
+		repeatedStringForProbeTargetLatencyStats += strings.Replace(strings.Replace(f.String(), "ProbeTargetLatencyStats", "ProbeTargetLatencyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForProbeTargetLatencyStats += "}"
 	s := strings.Join([]string{`&NodeLatencyStats{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`PeerNodeLatencyStats:` + repeatedStringForPeerNodeLatencyStats + `,`,
+		`ProbeTargetLatencyStats:` + repeatedStringForProbeTargetLatencyStats + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1676,6 +2008,23 @@ func (this *PodReference) String() string {
 	}, "")
 	return s
 }
+func (this *ProbeTargetLatencyStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTargetIPLatencyStats := "[]TargetIPLatencyStats{"
+	for _, f := range this.TargetIPLatencyStats {
+		repeatedStringForTargetIPLatencyStats += strings.Replace(strings.Replace(f.String(), "TargetIPLatencyStats", "TargetIPLatencyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTargetIPLatencyStats += "}"
+	s := strings.Join([]string{`&ProbeTargetLatencyStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ProbeType:` + fmt.Sprintf("%v", this.ProbeType) + `,`,
+		`TargetIPLatencyStats:` + repeatedStringForTargetIPLatencyStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RuleTrafficStats) String() string {
 	if this == nil {
 		return "nil"
@@ -1704,23 +2053,290 @@ func (this *TrafficStats) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&TrafficStats{`,
-		`Packets:` + fmt.Sprintf("%v", this.Packets) + `,`,
-		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
-		`Sessions:` + fmt.Sprintf("%v", this.Sessions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	s := strings.Join([]string{`&TrafficStats{`,
+		`Packets:` + fmt.Sprintf("%v", this.Packets) + `,`,
+		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
+		`Sessions:` + fmt.Sprintf("%v", this.Sessions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
+			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AntreaClusterNetworkPolicyStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1743,10 +2359,10 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: AntreaNetworkPolicyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AntreaNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1870,7 +2486,7 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,10 +2509,10 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1961,7 +2577,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AntreaClusterNetworkPolicyStats{})
+			m.Items = append(m.Items, AntreaNetworkPolicyStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1987,7 +2603,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *EgressStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2010,10 +2626,10 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: EgressStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EgressStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2082,40 +2698,6 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
-			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2137,7 +2719,7 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *EgressStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +2742,10 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: EgressStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EgressStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2228,7 +2810,7 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AntreaNetworkPolicyStats{})
+			m.Items = append(m.Items, EgressStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2849,6 +3431,40 @@ func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeTargetLatencyStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProbeTargetLatencyStats = append(m.ProbeTargetLatencyStats, ProbeTargetLatencyStats{})
+			if err := m.ProbeTargetLatencyStats[len(m.ProbeTargetLatencyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3217,6 +3833,154 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProbeTargetLatencyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProbeTargetLatencyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProbeTargetLatencyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProbeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetIPLatencyStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetIPLatencyStats = append(m.TargetIPLatencyStats, TargetIPLatencyStats{})
+			if err := m.TargetIPLatencyStats[len(m.TargetIPLatencyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RuleTrafficStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,8 +23,16 @@ import (
 // GroupName is the group name use in this package
 const GroupName = "stats.antrea.io"
 
-// SchemeGroupVersion is group version used to register these objects
-var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1alpha1"}
+
+	EgressStatsVersionResource = schema.GroupVersionResource{
+		Group:    SchemeGroupVersion.Group,
+		Version:  SchemeGroupVersion.Version,
+		Resource: "egressstats",
+	}
+)
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
 func Resource(resource string) schema.GroupResource {
@@ -45,6 +53,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AntreaClusterNetworkPolicyStatsList{},
 		&AntreaNetworkPolicyStats{},
 		&AntreaNetworkPolicyStatsList{},
+		&EgressStats{},
+		&EgressStatsList{},
 		&NetworkPolicyStats{},
 		&NetworkPolicyStatsList{},
 		&MulticastGroup{},
@@ -71,6 +71,41 @@ type AntreaNetworkPolicyStatsList struct {
 	Items []AntreaNetworkPolicyStats `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+const (
+	// EgressNameLabelKey is the label key of EgressStats whose value is the name of the Egress.
+	EgressNameLabelKey = "stats.antrea.io/egress-name"
+	// EgressNodeLabelKey is the label key of EgressStats whose value is the name of the Node to which the Egress IP
+	// is assigned. It is not set if the Egress IP is not assigned to any Node.
+	EgressNodeLabelKey = "stats.antrea.io/egress-node"
+)
+
+// +genclient
+// +resourceName=egressstats
+// +genclient:readonly
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressStats is the statistics of an Egress.
+type EgressStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The stats of the traffic sent by the Pods selected by the Egress. Only
+	// Packets and Bytes are counted.
+	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressStatsList is a list of EgressStats.
+type EgressStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of EgressStats.
+	Items []EgressStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +genclient
 // +resourceName=networkpolicystats
 // +genclient:readonly
@@ -160,6 +195,8 @@ type NodeLatencyStats struct {
 
 	// The list of PeerNodeLatencyStats.
 	PeerNodeLatencyStats []PeerNodeLatencyStats `json:"peerNodeLatencyStats,omitempty" protobuf:"bytes,2,rep,name=peerNodeLatencyStats"`
+	// The list of ProbeTargetLatencyStats, one for each additional target configured in NodeLatencyMonitor.
+	ProbeTargetLatencyStats []ProbeTargetLatencyStats `json:"probeTargetLatencyStats,omitempty" protobuf:"bytes,3,rep,name=probeTargetLatencyStats"`
 }
 
 // PeerNodeLatencyStats contains the latency stats of a Peer Node.
@@ -182,6 +219,16 @@ type TargetIPLatencyStats struct {
 	LastMeasuredRTTNanoseconds int64 `json:"lastMeasuredRTTNanoseconds,omitempty" protobuf:"varint,4,opt,name=lastMeasuredRTTNanoseconds"`
 }
 
+// ProbeTargetLatencyStats contains the latency stats of an additional probe target.
+type ProbeTargetLatencyStats struct {
+	// The name of the target, as specified in NodeLatencyMonitor.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// The type of probe used for the target.
+	ProbeType string `json:"probeType,omitempty" protobuf:"bytes,2,opt,name=probeType"`
+	// The list of latency stats, one for each IP address the target resolved to.
+	TargetIPLatencyStats []TargetIPLatencyStats `json:"targetIPLatencyStats,omitempty" protobuf:"bytes,3,rep,name=targetIPLatencyStats"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NodeLatencyStatsList is a list of NodeLatencyStats objects.
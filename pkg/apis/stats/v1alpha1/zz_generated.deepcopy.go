@@ -153,6 +153,66 @@ func (in *AntreaNetworkPolicyStatsList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressStats) DeepCopyInto(out *EgressStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.TrafficStats = in.TrafficStats
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressStats.
+func (in *EgressStats) DeepCopy() *EgressStats {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressStatsList) DeepCopyInto(out *EgressStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EgressStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressStatsList.
+func (in *EgressStatsList) DeepCopy() *EgressStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MulticastGroup) DeepCopyInto(out *MulticastGroup) {
 	*out = *in
@@ -289,6 +349,13 @@ func (in *NodeLatencyStats) DeepCopyInto(out *NodeLatencyStats) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ProbeTargetLatencyStats != nil {
+		in, out := &in.ProbeTargetLatencyStats, &out.ProbeTargetLatencyStats
+		*out = make([]ProbeTargetLatencyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -382,6 +449,29 @@ func (in *PodReference) DeepCopy() *PodReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTargetLatencyStats) DeepCopyInto(out *ProbeTargetLatencyStats) {
+	*out = *in
+	if in.TargetIPLatencyStats != nil {
+		in, out := &in.TargetIPLatencyStats, &out.TargetIPLatencyStats
+		*out = make([]TargetIPLatencyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTargetLatencyStats.
+func (in *ProbeTargetLatencyStats) DeepCopy() *ProbeTargetLatencyStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTargetLatencyStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuleTrafficStats) DeepCopyInto(out *RuleTrafficStats) {
 	*out = *in
@@ -56,6 +56,7 @@ import (
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/networkpolicyevaluation"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreaclusternetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreanetworkpolicystats"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/egressstats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/multicastgroup"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/networkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/nodelatencystats"
@@ -241,6 +242,7 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	statsStorage["antreaclusternetworkpolicystats"] = antreaclusternetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["antreanetworkpolicystats"] = antreanetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["multicastgroups"] = multicastgroup.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["egressstats"] = egressstats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["nodelatencystats"] = nodelatencystats.NewREST()
 	statsGroup.VersionedResourcesStorageMap["v1alpha1"] = statsStorage
 
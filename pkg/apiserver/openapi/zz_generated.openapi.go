@@ -39,20 +39,26 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleFileServer":                  schema_pkg_apis_controlplane_v1beta2_BundleFileServer(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration":     schema_pkg_apis_controlplane_v1beta2_BundleServerAuthConfiguration(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ClusterGroupMembers":               schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol":                       schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup":                       schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupList":                   schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupPatch":                  schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressStats":                       schema_pkg_apis_controlplane_v1beta2_EgressStats(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Entity":                            schema_pkg_apis_controlplane_v1beta2_Entity(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference":           schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol":                      schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupAssociation":                  schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember":                       schema_pkg_apis_controlplane_v1beta2_GroupMember(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMembers":                      schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference":                    schema_pkg_apis_controlplane_v1beta2_GroupReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch":                   schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol":                      schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch":               schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol":                        schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.LogSampling":                       schema_pkg_apis_controlplane_v1beta2_LogSampling(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo":                schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort":                         schema_pkg_apis_controlplane_v1beta2_NamedPort(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy":                     schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref),
@@ -91,22 +97,29 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol":                                schema_pkg_apis_crd_v1beta1_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressList":                                 schema_pkg_apis_crd_v1beta1_EgressList(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressPreferredNodes":                       schema_pkg_apis_crd_v1beta1_EgressPreferredNodes(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSchedulingPolicy":                     schema_pkg_apis_crd_v1beta1_EgressSchedulingPolicy(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSpec":                                 schema_pkg_apis_crd_v1beta1_EgressSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressStatus":                               schema_pkg_apis_crd_v1beta1_EgressStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressZoneAntiAffinity":                     schema_pkg_apis_crd_v1beta1_EgressZoneAntiAffinity(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPool":                             schema_pkg_apis_crd_v1beta1_ExternalIPPool(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolList":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolSpec":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolStatus":                       schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol":                               schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Group":                                      schema_pkg_apis_crd_v1beta1_Group(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition":                             schema_pkg_apis_crd_v1beta1_GroupCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupList":                                  schema_pkg_apis_crd_v1beta1_GroupList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupSpec":                                  schema_pkg_apis_crd_v1beta1_GroupSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupStatus":                                schema_pkg_apis_crd_v1beta1_GroupStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch":                            schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol":                               schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch":                        schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader":                      schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol":                               schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol":                               schema_pkg_apis_crd_v1beta1_IGMPProtocol(ref),
@@ -122,6 +135,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling":                                schema_pkg_apis_crd_v1beta1_LogSampling(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition":                     schema_pkg_apis_crd_v1beta1_NetworkPolicyCondition(ref),
@@ -158,6 +172,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStatsList":     schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaNetworkPolicyStats":                schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaNetworkPolicyStatsList":            schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStatsList(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.EgressStats":                             schema_pkg_apis_stats_v1alpha1_EgressStats(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.EgressStatsList":                         schema_pkg_apis_stats_v1alpha1_EgressStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.MulticastGroup":                          schema_pkg_apis_stats_v1alpha1_MulticastGroup(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.MulticastGroupList":                      schema_pkg_apis_stats_v1alpha1_MulticastGroupList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NetworkPolicyStats":                      schema_pkg_apis_stats_v1alpha1_NetworkPolicyStats(ref),
@@ -166,6 +182,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeLatencyStatsList":                    schema_pkg_apis_stats_v1alpha1_NodeLatencyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerNodeLatencyStats":                    schema_pkg_apis_stats_v1alpha1_PeerNodeLatencyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference":                            schema_pkg_apis_stats_v1alpha1_PodReference(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.ProbeTargetLatencyStats":                 schema_pkg_apis_stats_v1alpha1_ProbeTargetLatencyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats":                        schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TargetIPLatencyStats":                    schema_pkg_apis_stats_v1alpha1_TargetIPLatencyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats":                            schema_pkg_apis_stats_v1alpha1_TrafficStats(ref),
@@ -940,6 +957,33 @@ func schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name and type. All fields could be used alone or together. If all fields are not provided, it matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the DNS query to match (Ex. \"www.example.com\", \"*.example.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queryType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryType represents the type of the DNS query to match. It could be A, AAAA, CNAME, MX, NS, PTR, SOA, SRV, TXT and ANY.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1091,6 +1135,42 @@ func schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_EgressStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressStats contains the information and traffic stats of an Egress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Egress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The UID of the Egress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"trafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The stats of the traffic sent by local Pods through the Egress.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_Entity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1138,6 +1218,33 @@ func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. Method can only be used together with Service. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1371,6 +1478,35 @@ func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header with specific name and value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the header to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the value of the header to match (Ex. \"tenant-a\", \"tenant-*\"). If not set, it matches any request which has the header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1399,7 +1535,73 @@ func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"pathRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathRegex represents a regular expression which the URI path must fully match (Ex. \"/api/v[0-9]+/pods\"). It cannot be used together with Path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. All headers must be matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the query parameters of the URI to match. All query parameters must be matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch matches a query parameter of the URI with specific name and value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the query parameter to match.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the value of the query parameter to match (Ex. \"v2\", \"v*\"). If not set, it matches any request which has the query parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -1533,11 +1735,48 @@ func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallbac
 							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_LogSampling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogSampling describes the sampling and rate limiting of the logs generated for a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"samplingRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SamplingRate is the sampling rate of the logged packets: one packet out of every SamplingRate packets is logged. 0 and 1 mean that all packets are logged.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxEventsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEventsPerSecond is the maximum number of packets logged per second. 0 means no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -2130,12 +2369,18 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.Reference
 							Format:      "",
 						},
 					},
+					"logSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSampling limits the logs generated for this rule when EnableLogging is true.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.LogSampling"),
+						},
+					},
 				},
 				Required: []string{"enableLogging"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.LogSampling", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
 	}
 }
 
@@ -2334,11 +2579,25 @@ func schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref common.ReferenceC
 							},
 						},
 					},
+					"egresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The TrafficStats of Egresses collected from the Node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressStats"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressStats", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3481,12 +3740,18 @@ func schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"logSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSampling limits the logs generated for the rules of this policy which have EnableLogging set to true and no LogSampling of their own.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling"),
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
 	}
 }
 
@@ -3541,6 +3806,33 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name and type. All fields could be used alone or together. If all fields are not provided, it matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the DNS query to match (Ex. \"www.example.com\", \"*.example.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queryType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryType represents the type of the DNS query to match. It could be A, AAAA, CNAME, MX, NS, PTR, SOA, SRV, TXT and ANY.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3724,37 +4016,102 @@ func schema_pkg_apis_crd_v1beta1_EgressList(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_EgressPreferredNodes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EgressSpec defines the desired state for Egress.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"appliedTo": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppliedTo selects Pods to which the Egress will be applied.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo"),
+							Description: "Weight associated with matching NodeSelector, in the range 1-100.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"egressIP": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIP specifies the SNAT IP address for the selected workloads. If ExternalIPPool is empty, it must be specified manually. If ExternalIPPool is non-empty, it can be empty and will be assigned by Antrea automatically. If both ExternalIPPool and EgressIP are non-empty, the IP must be in the pool.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NodeSelector selects the preferred Nodes by their labels.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"egressIPs": {
+				},
+				Required: []string{"weight", "nodeSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_EgressSchedulingPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressSchedulingPolicy defines the order in which the eligible Nodes are selected to hold an Egress IP. The first Node in that order holds the Egress IP, and the next ones are standby Nodes which take over in turn when the Nodes before them become unavailable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"preferredNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EgressIPs specifies multiple SNAT IP addresses for the selected workloads. Cannot be set with EgressIP.",
+							Description: "PreferredNodes is a list of weighted Node selectors. Each eligible Node is given a score equal to the sum of the weights of the selectors matching it, and Nodes with a higher score are selected first. Nodes with the same score are ordered using consistent hashing.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressPreferredNodes"),
+									},
+								},
+							},
+						},
+					},
+					"zoneAntiAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneAntiAffinity spreads the Node holding the Egress IP and the standby Nodes across zones, so that the Egress IP fails over to another zone when the zone of the Node holding it becomes unavailable.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressZoneAntiAffinity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressPreferredNodes", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressZoneAntiAffinity"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressSpec defines the desired state for Egress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appliedTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppliedTo selects Pods to which the Egress will be applied.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo"),
+						},
+					},
+					"egressIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressIP specifies the SNAT IP address for the selected workloads. If ExternalIPPool is empty, it must be specified manually. If ExternalIPPool is non-empty, it can be empty and will be assigned by Antrea automatically. If both ExternalIPPool and EgressIP are non-empty, the IP must be in the pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"egressIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressIPs specifies multiple SNAT IP addresses for the selected workloads. Cannot be set with EgressIP.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -3788,12 +4145,18 @@ func schema_pkg_apis_crd_v1beta1_EgressSpec(ref common.ReferenceCallback) common
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"),
 						},
 					},
+					"schedulingPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SchedulingPolicy specifies how the Egress IP is scheduled to the Nodes selected by the ExternalIPPool. If it is not set, Egress IPs are spread across the eligible Nodes using consistent hashing.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSchedulingPolicy"),
+						},
+					},
 				},
 				Required: []string{"appliedTo"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Bandwidth", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSchedulingPolicy"},
 	}
 }
 
@@ -3820,6 +4183,21 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"standbyNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StandbyNodes is the ordered list of Nodes which will take over the Egress IP if the Node holding it becomes unavailable. It is only reported for Egresses whose Egress IP is allocated from an ExternalIPPool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
@@ -3842,6 +4220,25 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_EgressZoneAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"topologyKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyKey is the key of the Node label whose value identifies the zone of a Node. Defaults to \"topology.kubernetes.io/zone\". Nodes without this label are considered to be in the same zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_ExternalIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4003,6 +4400,33 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. Method can only be used together with Service. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4230,6 +4654,35 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header with specific name and value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the header to match. It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the value of the header to match (Ex. \"tenant-a\", \"tenant-*\"). If not set, it matches any request which has the header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4258,7 +4711,73 @@ func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"pathRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathRegex represents a regular expression which the URI path must fully match (Ex. \"/api/v[0-9]+/pods\"). It cannot be used together with Path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. All headers must be matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the query parameters of the URI to match. All query parameters must be matched.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch matches a query parameter of the URI with specific name and value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name of the query parameter to match.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value represents the value of the query parameter to match (Ex. \"v2\", \"v*\"). If not set, it matches any request which has the query parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -4748,11 +5267,48 @@ func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_LogSampling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogSampling describes the sampling and rate limiting of the logs generated for a rule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"samplingRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SamplingRate is the sampling rate of the logged packets: one packet out of every SamplingRate packets is logged. Defaults to 1, which means that all packets are logged.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxEventsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEventsPerSecond is the maximum number of packets logged per second. Packets exceeding the limit are not logged. If not set, the number of logged packets is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -5178,12 +5734,18 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"logSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSampling limits the logs generated for the rules of this policy which have EnableLogging set to true and no LogSampling of their own.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling"),
+						},
+					},
 				},
 				Required: []string{"priority"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling", "antrea.io/antrea/pkg/apis/crd/v1beta1.Rule"},
 	}
 }
 
@@ -5276,6 +5838,13 @@ func schema_pkg_apis_crd_v1beta1_NodeResult(ref common.ReferenceCallback) common
 							Format:      "int64",
 						},
 					},
+					"timestampNanos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimestampNanos is the timestamp of the observations on the node in nanoseconds since the Unix epoch. It can be used to compute the latency between two observations, keeping in mind that the clocks of different Nodes may not be perfectly synchronized.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"observations": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Observations includes all observations from sender nodes, receiver ones, etc.",
@@ -5733,6 +6302,12 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							Format:      "",
 						},
 					},
+					"logSampling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogSampling limits the logs generated for this rule when EnableLogging is true, to avoid flooding the logs when the rule is matched by a large amount of traffic. If not set, all the packets sent to the agent for logging are logged.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling"),
+						},
+					},
 					"appliedTo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Select workloads on which this rule will be applied to. Cannot be set in conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.",
@@ -5752,7 +6327,7 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.LogSampling", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
 	}
 }
 
@@ -6176,6 +6751,13 @@ func schema_pkg_apis_crd_v1beta1_TraceflowSpec(ref common.ReferenceCallback) com
 							Format:      "int32",
 						},
 					},
+					"roundTrip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoundTrip indicates that the reply to the injected packet should be traced as well, when set to true. The observations of the reply are reported in ReplyResults. RoundTrip is not supported for live-traffic Traceflow.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -6218,6 +6800,13 @@ func schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref common.ReferenceCallback) c
 							Format:      "byte",
 						},
 					},
+					"replyDataplaneTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplyDataplaneTag is a tag to identify the reply packet of a round-trip traceflow session across Nodes.",
+							Type:        []string{"integer"},
+							Format:      "byte",
+						},
+					},
 					"results": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Results is the collection of all observations on different nodes.",
@@ -6232,6 +6821,42 @@ func schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"replyResults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplyResults is the collection of all observations of the reply packet on different nodes, for round-trip Traceflow.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult"),
+									},
+								},
+							},
+						},
+					},
+					"roundTripTimeNanos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoundTripTimeNanos is the time in nanoseconds between the request packet leaving the source Pod and the reply packet being delivered back to it, as observed by the source Node. It is only set for successful round-trip Traceflow.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sourcePodIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourcePodIPs are the IPs of the source Pod of a round-trip Traceflow, set by the Antrea Controller when the Traceflow is started. They are used by all Nodes to match the reply packet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"capturedPacket": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CapturedPacket is the captured packet in live-traffic Traceflow.",
@@ -6515,6 +7140,98 @@ func schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStatsList(ref common.Refe
 	}
 }
 
+func schema_pkg_apis_stats_v1alpha1_EgressStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressStats is the statistics of an Egress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"trafficStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The stats of the traffic sent by the Pods selected by the Egress. Only Packets and Bytes are counted.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_stats_v1alpha1_EgressStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressStatsList is a list of EgressStats.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of EgressStats.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.EgressStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.EgressStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_MulticastGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6755,11 +7472,25 @@ func schema_pkg_apis_stats_v1alpha1_NodeLatencyStats(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"probeTargetLatencyStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The list of ProbeTargetLatencyStats, one for each additional target configured in NodeLatencyMonitor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.ProbeTargetLatencyStats"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerNodeLatencyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerNodeLatencyStats", "antrea.io/antrea/pkg/apis/stats/v1alpha1.ProbeTargetLatencyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -6876,6 +7607,49 @@ func schema_pkg_apis_stats_v1alpha1_PodReference(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_stats_v1alpha1_ProbeTargetLatencyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProbeTargetLatencyStats contains the latency stats of an additional probe target.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the target, as specified in NodeLatencyMonitor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"probeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The type of probe used for the target.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetIPLatencyStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The list of latency stats, one for each IP address the target resolved to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TargetIPLatencyStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TargetIPLatencyStats"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -0,0 +1,59 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	scheme "antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// EgressStatsGetter has a method to return a EgressStatsInterface.
+// A group's client should implement this interface.
+type EgressStatsGetter interface {
+	EgressStats() EgressStatsInterface
+}
+
+// EgressStatsInterface has methods to work with EgressStats resources.
+type EgressStatsInterface interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.EgressStats, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.EgressStatsList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	EgressStatsExpansion
+}
+
+// egressStats implements EgressStatsInterface
+type egressStats struct {
+	*gentype.ClientWithList[*v1alpha1.EgressStats, *v1alpha1.EgressStatsList]
+}
+
+// newEgressStats returns a EgressStats
+func newEgressStats(c *StatsV1alpha1Client) *egressStats {
+	return &egressStats{
+		gentype.NewClientWithList[*v1alpha1.EgressStats, *v1alpha1.EgressStatsList](
+			"egressstats",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1alpha1.EgressStats { return &v1alpha1.EgressStats{} },
+			func() *v1alpha1.EgressStatsList { return &v1alpha1.EgressStatsList{} }),
+	}
+}
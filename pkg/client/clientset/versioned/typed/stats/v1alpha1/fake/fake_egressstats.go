@@ -0,0 +1,75 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeEgressStats implements EgressStatsInterface
+type FakeEgressStats struct {
+	Fake *FakeStatsV1alpha1
+}
+
+var egressstatsResource = v1alpha1.SchemeGroupVersion.WithResource("egressstats")
+
+var egressstatsKind = v1alpha1.SchemeGroupVersion.WithKind("EgressStats")
+
+// Get takes name of the egressStats, and returns the corresponding egressStats object, and an error if there is any.
+func (c *FakeEgressStats) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.EgressStats, err error) {
+	emptyResult := &v1alpha1.EgressStats{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(egressstatsResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1alpha1.EgressStats), err
+}
+
+// List takes label and field selectors, and returns the list of EgressStats that match those selectors.
+func (c *FakeEgressStats) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.EgressStatsList, err error) {
+	emptyResult := &v1alpha1.EgressStatsList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(egressstatsResource, egressstatsKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.EgressStatsList{ListMeta: obj.(*v1alpha1.EgressStatsList).ListMeta}
+	for _, item := range obj.(*v1alpha1.EgressStatsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested egressStats.
+func (c *FakeEgressStats) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(egressstatsResource, opts))
+}
@@ -34,6 +34,10 @@ func (c *FakeStatsV1alpha1) AntreaNetworkPolicyStats(namespace string) v1alpha1.
 	return &FakeAntreaNetworkPolicyStats{c, namespace}
 }
 
+func (c *FakeStatsV1alpha1) EgressStats() v1alpha1.EgressStatsInterface {
+	return &FakeEgressStats{c}
+}
+
 func (c *FakeStatsV1alpha1) MulticastGroups() v1alpha1.MulticastGroupInterface {
 	return &FakeMulticastGroups{c}
 }
@@ -20,6 +20,8 @@ type AntreaClusterNetworkPolicyStatsExpansion interface{}
 
 type AntreaNetworkPolicyStatsExpansion interface{}
 
+type EgressStatsExpansion interface{}
+
 type MulticastGroupExpansion interface{}
 
 type NetworkPolicyStatsExpansion interface{}
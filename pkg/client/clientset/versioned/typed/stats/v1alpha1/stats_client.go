@@ -28,6 +28,7 @@ type StatsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AntreaClusterNetworkPolicyStatsGetter
 	AntreaNetworkPolicyStatsGetter
+	EgressStatsGetter
 	MulticastGroupsGetter
 	NetworkPolicyStatsGetter
 	NodeLatencyStatsGetter
@@ -46,6 +47,10 @@ func (c *StatsV1alpha1Client) AntreaNetworkPolicyStats(namespace string) AntreaN
 	return newAntreaNetworkPolicyStats(c, namespace)
 }
 
+func (c *StatsV1alpha1Client) EgressStats() EgressStatsInterface {
+	return newEgressStats(c)
+}
+
 func (c *StatsV1alpha1Client) MulticastGroups() MulticastGroupInterface {
 	return newMulticastGroups(c)
 }
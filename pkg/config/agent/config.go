@@ -16,6 +16,8 @@ package agent
 
 import (
 	componentbaseconfig "k8s.io/component-base/config"
+
+	"antrea.io/antrea/pkg/util/flowfilter"
 )
 
 type AgentConfig struct {
@@ -163,6 +165,11 @@ type AgentConfig struct {
 	// The Cluster administrators should configure this value, ideally setting it to be equal to or greater than the maximum TTL
 	// value of the application's DNS cache.
 	FQDNCacheMinTTL int `yaml:"fqdnCacheMinTTL,omitempty"`
+	// The IP addresses of the DNS servers whose responses are trusted to resolve hostnames in FQDN policies, as seen
+	// by Pods (e.g. the ClusterIP of the kube-dns Service). DNS responses from other servers are still forwarded to
+	// Pods, but they are not used to update FQDN policy rules.
+	// Defaults to [], which means that the responses from all DNS servers are trusted.
+	FQDNTrustedDNSServers []string `yaml:"fqdnTrustedDNSServers,omitempty"`
 	// Cipher suites to use.
 	TLSCipherSuites string `yaml:"tlsCipherSuites,omitempty"`
 	// TLS min version.
@@ -312,6 +319,12 @@ type FlowExporterConfig struct {
 	// protocols are exported which are:
 	// "tcp", "udp", "sctp"
 	ProtocolFilter []string `yaml:"protocols,omitempty"`
+	// Provide a list of filters to select the flows that will be exported. The
+	// filters are OR-ed, and the conditions within a filter are AND-ed. The
+	// filters use the same syntax and semantics as the FlowLogger filters of the
+	// Flow Aggregator. Filters are applied after protocols. By default, all flows
+	// are exported.
+	Filters []flowfilter.FlowFilter `yaml:"filters,omitempty"`
 }
 
 type MulticastConfig struct {
@@ -406,6 +419,32 @@ type AuditLoggingConfig struct {
 	MaxAge *int32 `yaml:"maxAge,omitempty"`
 	// Compress enables gzip compression on rotated files. Defaults to true.
 	Compress *bool `yaml:"compress,omitempty"`
+	// Format is the format of the audit log records. Supported values are "text" and "json". The
+	// "json" format includes the Pods at both ends of the connection, the full NetworkPolicy
+	// reference and the number of deduplicated packets. Defaults to "text".
+	Format string `yaml:"format,omitempty"`
+	// Syslog configures sending the audit log records to a remote syslog server, in addition to
+	// the local log file.
+	Syslog AuditLoggingSyslogConfig `yaml:"syslog,omitempty"`
+}
+
+type AuditLoggingSyslogConfig struct {
+	// Enable sending the audit log records to a remote syslog server, using the message format
+	// defined in RFC 5424. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// Address is the address of the syslog server, in the "host:port" format.
+	Address string `yaml:"address,omitempty"`
+	// Protocol is the transport protocol used to send the records to the syslog server.
+	// Supported values are "udp", "tcp" and "tls". Defaults to "udp".
+	Protocol string `yaml:"protocol,omitempty"`
+	// Facility is the syslog facility of the records, e.g. "local0". Defaults to "local0".
+	Facility string `yaml:"facility,omitempty"`
+	// CACertFile is the path of the CA certificate used to verify the certificate of the syslog
+	// server when the protocol is "tls". If not set, the system CA certificates are used.
+	CACertFile string `yaml:"caCertFile,omitempty"`
+	// ServerName is the name used to verify the certificate of the syslog server when the
+	// protocol is "tls". If not set, the host of the address is used.
+	ServerName string `yaml:"serverName,omitempty"`
 }
 
 type SecondaryNetworkConfig struct {
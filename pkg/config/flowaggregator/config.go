@@ -14,6 +14,10 @@
 
 package flowaggregator
 
+import (
+	"antrea.io/antrea/pkg/util/flowfilter"
+)
+
 type AggregatorTransportProtocol string
 
 const (
@@ -70,6 +74,11 @@ type FlowAggregatorConfig struct {
 	S3Uploader S3UploaderConfig `yaml:"s3Uploader,omitempty"`
 	// FlowLogger contains configuration options for writing flow records to a local log file.
 	FlowLogger FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+	// Kafka contains configuration options for producing flow records to a Kafka topic.
+	Kafka KafkaConfig `yaml:"kafka,omitempty"`
+	// Prometheus contains configuration options for exposing traffic metrics computed from flow
+	// records, in the Prometheus format.
+	Prometheus PrometheusConfig `yaml:"prometheus,omitempty"`
 	// Provide a ClusterID to be added to records. By default this ID is an autogenerated UUID
 	// which can be found in the antrea-cluster-identity ConfigMap
 	ClusterID string `yaml:"clusterID,omitempty"`
@@ -223,8 +232,9 @@ type FlowLoggerConfig struct {
 	MaxAge int32 `yaml:"maxAge,omitempty"`
 	// Compress enables gzip compression on rotated files. Defaults to true.
 	Compress *bool `yaml:"compress,omitempty"`
-	// RecordFormat defines the format of the flow records logged to file. Only "CSV" is
-	// supported at the moment.
+	// RecordFormat defines the format of the flow records logged to file. Supported formats
+	// are "CSV", "JSON" (one indented JSON object per record) and "NDJSON" (one compact JSON
+	// object per line). Defaults to "CSV".
 	RecordFormat string `yaml:"recordFormat,omitempty"`
 	// Filters can be used to select which flow records to log to file. The provided filters are
 	// OR-ed to determine whether a specific flow should be logged. By default, all flows are
@@ -235,21 +245,100 @@ type FlowLoggerConfig struct {
 	PrettyPrint *bool `yaml:"prettyPrint,omitempty"`
 }
 
-type NetworkPolicyRuleAction string
+type KafkaConfig struct {
+	// Enable is the switch to enable producing flow records to Kafka.
+	Enable bool `yaml:"enable,omitempty"`
+	// Brokers is the list of bootstrap brokers used to discover the Kafka cluster, with format
+	// <host>:<port>. If this field is empty, initialization will fail.
+	Brokers []string `yaml:"brokers,omitempty"`
+	// Topic is the Kafka topic to which flow records are produced. Defaults to "antrea-flows".
+	Topic string `yaml:"topic,omitempty"`
+	// PartitionKey determines the key of each Kafka message, and therefore how flow records are
+	// distributed across the partitions of the topic. Supported values are "None" (records are
+	// distributed evenly across partitions), "SourcePodNamespace", "DestinationPodNamespace" and
+	// "FlowKey" (records for the same connection always go to the same partition). Defaults to
+	// "None".
+	PartitionKey string `yaml:"partitionKey,omitempty"`
+	// RecordFormat defines the encoding of the flow records. Supported formats are "Protobuf" and
+	// "JSON". In both cases, the message value is the flow record as defined by the Flow
+	// Protobuf message (pkg/apis/flow/v1alpha1/flow.proto). Defaults to "Protobuf".
+	RecordFormat string `yaml:"recordFormat,omitempty"`
+	// Compression is the compression codec used for batches of records. Supported values are
+	// "none", "gzip", "snappy", "lz4" and "zstd". Defaults to "none".
+	Compression string `yaml:"compression,omitempty"`
+	// BatchTimeout is the maximum amount of time to wait for a batch of records to fill up before
+	// sending it to the brokers. Defaults to "1s". Valid time units are "ns", "us" (or "µs"),
+	// "ms", "s", "m", "h".
+	BatchTimeout string `yaml:"batchTimeout,omitempty"`
+	// MaxBatchBytes is the maximum size of a batch of records in bytes. It should not exceed the
+	// max.message.bytes setting of the topic. Defaults to 1000000.
+	MaxBatchBytes int32 `yaml:"maxBatchBytes,omitempty"`
+	// MaxBufferedRecords is the maximum number of records buffered by the Flow Aggregator while
+	// waiting to be sent to the brokers. When this limit is reached, new records are dropped.
+	// Defaults to 10000.
+	MaxBufferedRecords int32 `yaml:"maxBufferedRecords,omitempty"`
+	// TLS / mTLS configuration when connecting to the Kafka brokers.
+	TLS KafkaTLSConfig `yaml:"tls,omitempty"`
+	// SASL configuration when authenticating with the Kafka brokers.
+	SASL KafkaSASLConfig `yaml:"sasl,omitempty"`
+}
+
+type KafkaTLSConfig struct {
+	// Enable TLS.
+	Enable bool `yaml:"enable,omitempty"`
+	// Name of the Secret containing the CA certificate used to authenticate the Kafka brokers.
+	// Default root CAs will be used if this field is empty. The Secret must be created in the
+	// Namespace in which the Flow Aggregator is deployed, and it must contain the ca.crt key.
+	CASecretName string `yaml:"caSecretName,omitempty"`
+	// ServerName is used to verify the hostname on the returned certificates. If this field is
+	// omitted, the hostname used for certificate verification will default to the address of
+	// each broker.
+	ServerName string `yaml:"serverName,omitempty"`
+	// Name of the Secret containing the client's certificate and private key for mTLS. If
+	// omitted, client authentication will be disabled. The Secret must be created in Namespace
+	// in which the Flow Aggregator is deployed, and it must be of type kubernetes.io/tls and
+	// contain the tls.crt and tls.key keys.
+	ClientSecretName string `yaml:"clientSecretName,omitempty"`
+	// TLS min version.
+	MinVersion string `yaml:"minVersion,omitempty"`
+}
+
+type KafkaSASLConfig struct {
+	// Mechanism is the SASL mechanism used to authenticate with the Kafka brokers. Supported
+	// values are "PLAIN", "SCRAM-SHA-256" and "SCRAM-SHA-512". SASL is disabled if this field is
+	// empty.
+	Mechanism string `yaml:"mechanism,omitempty"`
+	// Name of the Secret containing the SASL credentials. The Secret must be created in the
+	// Namespace in which the Flow Aggregator is deployed, and it must contain the username and
+	// password keys.
+	CredentialsSecretName string `yaml:"credentialsSecretName,omitempty"`
+}
+
+type PrometheusConfig struct {
+	// Enable is the switch to enable exposing traffic metrics on the /metrics endpoint of the
+	// Flow Aggregator API server. The metrics are counters of bytes, packets and connections,
+	// keyed by the source and destination Namespaces, workloads and Services of flows.
+	Enable bool `yaml:"enable,omitempty"`
+	// MaxSeries is the maximum number of distinct label sets (source / destination pairs)
+	// tracked by the Flow Aggregator. When this limit is reached, the traffic for new pairs is
+	// accounted for in a single overflow series, until stale series are removed. Defaults to
+	// 10000.
+	MaxSeries int32 `yaml:"maxSeries,omitempty"`
+	// StaleSeriesTimeout is the amount of time after which a series which has not been updated
+	// is removed. Defaults to "10m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m",
+	// "h".
+	StaleSeriesTimeout string `yaml:"staleSeriesTimeout,omitempty"`
+}
+
+type NetworkPolicyRuleAction = flowfilter.NetworkPolicyRuleAction
 
 const (
-	NetworkPolicyRuleActionNone   NetworkPolicyRuleAction = "None"
-	NetworkPolicyRuleActionAllow  NetworkPolicyRuleAction = "Allow"
-	NetworkPolicyRuleActionDrop   NetworkPolicyRuleAction = "Drop"
-	NetworkPolicyRuleActionReject NetworkPolicyRuleAction = "Reject"
+	NetworkPolicyRuleActionNone   = flowfilter.NetworkPolicyRuleActionNone
+	NetworkPolicyRuleActionAllow  = flowfilter.NetworkPolicyRuleActionAllow
+	NetworkPolicyRuleActionDrop   = flowfilter.NetworkPolicyRuleActionDrop
+	NetworkPolicyRuleActionReject = flowfilter.NetworkPolicyRuleActionReject
 )
 
-// FlowFilter will match a flow if all individual conditions are fulfilled.
-type FlowFilter struct {
-	// IngressNetworkPolicyRuleActions supports filtering based on the action name for the
-	// ingress policy rule applied to the flow. By default, all actions are considered.
-	IngressNetworkPolicyRuleActions []NetworkPolicyRuleAction `yaml:"ingressNetworkPolicyRuleActions,omitempty"`
-	// EgressNetworkPolicyRuleActions supports filtering based on the action name for the egress
-	// policy rule applied to the flow. By default, all actions are considered.
-	EgressNetworkPolicyRuleActions []NetworkPolicyRuleAction `yaml:"egressNetworkPolicyRuleActions,omitempty"`
-}
+// FlowFilter will match a flow if all individual conditions are fulfilled. The same filter
+// definition can be used for the FlowExporter in the Antrea Agent.
+type FlowFilter = flowfilter.FlowFilter
@@ -49,6 +49,17 @@ const (
 	DefaultLoggerMaxSize      = 100
 	DefaultLoggerMaxBackups   = 3
 	DefaultLoggerRecordFormat = "CSV"
+
+	DefaultKafkaTopic              = "antrea-flows"
+	DefaultKafkaPartitionKey       = "None"
+	DefaultKafkaRecordFormat       = "Protobuf"
+	DefaultKafkaCompression        = "none"
+	DefaultKafkaBatchTimeout       = "1s"
+	DefaultKafkaMaxBatchBytes      = 1000000
+	DefaultKafkaMaxBufferedRecords = 10000
+
+	DefaultPrometheusMaxSeries          = 10000
+	DefaultPrometheusStaleSeriesTimeout = "10m"
 )
 
 func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
@@ -121,4 +132,31 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.FlowLogger.PrettyPrint == nil {
 		flowAggregatorConf.FlowLogger.PrettyPrint = ptr.To(true)
 	}
+	if flowAggregatorConf.Kafka.Topic == "" {
+		flowAggregatorConf.Kafka.Topic = DefaultKafkaTopic
+	}
+	if flowAggregatorConf.Kafka.PartitionKey == "" {
+		flowAggregatorConf.Kafka.PartitionKey = DefaultKafkaPartitionKey
+	}
+	if flowAggregatorConf.Kafka.RecordFormat == "" {
+		flowAggregatorConf.Kafka.RecordFormat = DefaultKafkaRecordFormat
+	}
+	if flowAggregatorConf.Kafka.Compression == "" {
+		flowAggregatorConf.Kafka.Compression = DefaultKafkaCompression
+	}
+	if flowAggregatorConf.Kafka.BatchTimeout == "" {
+		flowAggregatorConf.Kafka.BatchTimeout = DefaultKafkaBatchTimeout
+	}
+	if flowAggregatorConf.Kafka.MaxBatchBytes == 0 {
+		flowAggregatorConf.Kafka.MaxBatchBytes = DefaultKafkaMaxBatchBytes
+	}
+	if flowAggregatorConf.Kafka.MaxBufferedRecords == 0 {
+		flowAggregatorConf.Kafka.MaxBufferedRecords = DefaultKafkaMaxBufferedRecords
+	}
+	if flowAggregatorConf.Prometheus.MaxSeries == 0 {
+		flowAggregatorConf.Prometheus.MaxSeries = DefaultPrometheusMaxSeries
+	}
+	if flowAggregatorConf.Prometheus.StaleSeriesTimeout == "" {
+		flowAggregatorConf.Prometheus.StaleSeriesTimeout = DefaultPrometheusStaleSeriesTimeout
+	}
 }
@@ -22,6 +22,7 @@ import (
 	admv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -65,6 +66,11 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 				return false, fmt.Sprintf("Burst %s in Egress %s is invalid: %v", newEgress.Spec.Bandwidth.Burst, newEgress.Name, err)
 			}
 		}
+		if newEgress.Spec.SchedulingPolicy != nil {
+			if msg := validateSchedulingPolicy(newEgress); msg != "" {
+				return false, msg
+			}
+		}
 		// Allow it if EgressIP and ExternalIPPool don't change.
 		if newEgress.Spec.EgressIP == oldEgress.Spec.EgressIP && newEgress.Spec.ExternalIPPool == oldEgress.Spec.ExternalIPPool {
 			return true, ""
@@ -117,3 +123,27 @@ func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 		},
 	}
 }
+
+// validateSchedulingPolicy validates the scheduling policy of an Egress, and returns an error message if it is
+// invalid.
+func validateSchedulingPolicy(egress *crdv1beta1.Egress) string {
+	if egress.Spec.ExternalIPPool == "" {
+		return "schedulingPolicy can only be set when externalIPPool is set"
+	}
+	policy := egress.Spec.SchedulingPolicy
+	for i := range policy.PreferredNodes {
+		preferredNodes := &policy.PreferredNodes[i]
+		if preferredNodes.Weight < 1 || preferredNodes.Weight > 100 {
+			return fmt.Sprintf("weight %d of preferredNodes[%d] must be in the range 1-100", preferredNodes.Weight, i)
+		}
+		if _, err := metav1.LabelSelectorAsSelector(&preferredNodes.NodeSelector); err != nil {
+			return fmt.Sprintf("nodeSelector of preferredNodes[%d] is invalid: %v", i, err)
+		}
+	}
+	if policy.ZoneAntiAffinity != nil && policy.ZoneAntiAffinity.TopologyKey != "" {
+		if errs := validation.IsQualifiedName(policy.ZoneAntiAffinity.TopologyKey); len(errs) > 0 {
+			return fmt.Sprintf("topologyKey %s is invalid: %v", policy.ZoneAntiAffinity.TopologyKey, errs)
+		}
+	}
+	return ""
+}
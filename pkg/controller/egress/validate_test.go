@@ -47,6 +47,15 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 			Rate:  "1.5G",
 			Burst: "10b",
 		}
+		newEgressWithSchedulingPolicy = func(pool string, policy *crdv1beta1.EgressSchedulingPolicy) *crdv1beta1.Egress {
+			egress := newEgress("foo", "", pool, nil, nil, nil)
+			egress.Spec.SchedulingPolicy = policy
+			return egress
+		}
+		preferredNodes = func(weight int32, selector metav1.LabelSelector) []crdv1beta1.EgressPreferredNodes {
+			return []crdv1beta1.EgressPreferredNodes{{Weight: weight, NodeSelector: selector}}
+		}
+		zoneSelector = metav1.LabelSelector{MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"}}
 	)
 	tests := []struct {
 		name                   string
@@ -190,6 +199,84 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Creating an Egress with scheduling policy should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(newEgressWithSchedulingPolicy("eipA", &crdv1beta1.EgressSchedulingPolicy{
+					PreferredNodes:   preferredNodes(50, zoneSelector),
+					ZoneAntiAffinity: &crdv1beta1.EgressZoneAntiAffinity{TopologyKey: "example.com/rack"},
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Creating an Egress with scheduling policy and no ExternalIPPool should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(newEgressWithSchedulingPolicy("", &crdv1beta1.EgressSchedulingPolicy{
+					ZoneAntiAffinity: &crdv1beta1.EgressZoneAntiAffinity{},
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "schedulingPolicy can only be set when externalIPPool is set",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with invalid preferred Node weight should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(newEgressWithSchedulingPolicy("eipA", &crdv1beta1.EgressSchedulingPolicy{
+					PreferredNodes: preferredNodes(0, zoneSelector),
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "weight 0 of preferredNodes[0] must be in the range 1-100",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with invalid preferred Node selector should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(newEgressWithSchedulingPolicy("eipA", &crdv1beta1.EgressSchedulingPolicy{
+					PreferredNodes: preferredNodes(10, metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "zone", Operator: metav1.LabelSelectorOpIn}},
+					}),
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "nodeSelector of preferredNodes[0] is invalid: values: Invalid value: []string(nil): for 'in', 'notin' operators, values set can't be empty",
+				},
+			},
+		},
+		{
+			name: "Creating an Egress with invalid topology key should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(newEgressWithSchedulingPolicy("eipA", &crdv1beta1.EgressSchedulingPolicy{
+					ZoneAntiAffinity: &crdv1beta1.EgressZoneAntiAffinity{TopologyKey: "-zone"},
+				}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "topologyKey -zone is invalid: [name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')]",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
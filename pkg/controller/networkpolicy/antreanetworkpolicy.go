@@ -117,6 +117,7 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(ingressRule.L7Protocols),
 			LogLabel:        ingressRule.LogLabel,
+			LogSampling:     toAntreaLogSamplingForCRD(ingressRule.LogSampling, np.Spec.LogSampling),
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
@@ -149,6 +150,7 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
 			LogLabel:        egressRule.LogLabel,
+			LogSampling:     toAntreaLogSamplingForCRD(egressRule.LogSampling, np.Spec.LogSampling),
 		})
 	}
 	tierPriority := n.getTierPriority(np.Spec.Tier)
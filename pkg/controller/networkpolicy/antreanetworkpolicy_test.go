@@ -699,6 +699,9 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 							Action:        &allowAction,
 							EnableLogging: true,
 							LogLabel:      "test-log-label",
+							LogSampling: &crdv1beta1.LogSampling{
+								MaxEventsPerSecond: ptr.To[int32](100),
+							},
 						},
 					},
 				},
@@ -730,6 +733,9 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 						Action:        &allowAction,
 						EnableLogging: true,
 						LogLabel:      "test-log-label",
+						LogSampling: &controlplane.LogSampling{
+							MaxEventsPerSecond: 100,
+						},
 					},
 				},
 				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns9", &selectorA, nil, nil, nil).NormalizedName)},
@@ -737,6 +743,87 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   1,
 		},
+		{
+			name: "with-policy-log-sampling",
+			inputPolicy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns10", Name: "npJ", UID: "uidJ"},
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{PodSelector: &selectorA},
+					},
+					Priority: p10,
+					Ingress: []crdv1beta1.Rule{
+						{
+							From: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &selectorB,
+								},
+							},
+							Action:        &allowAction,
+							EnableLogging: true,
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &selectorB,
+								},
+							},
+							Action:        &allowAction,
+							EnableLogging: true,
+							LogSampling: &crdv1beta1.LogSampling{
+								SamplingRate: ptr.To[int32](2),
+							},
+						},
+					},
+					LogSampling: &crdv1beta1.LogSampling{
+						MaxEventsPerSecond: ptr.To[int32](100),
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidJ",
+				Name: "uidJ",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type:      controlplane.AntreaNetworkPolicy,
+					Namespace: "ns10",
+					Name:      "npJ",
+					UID:       "uidJ",
+				},
+				Priority:     &p10,
+				TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns10", &selectorB, nil, nil, nil).NormalizedName)},
+						},
+						Priority:      0,
+						Action:        &allowAction,
+						EnableLogging: true,
+						LogSampling: &controlplane.LogSampling{
+							MaxEventsPerSecond: 100,
+						},
+					},
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns10", &selectorB, nil, nil, nil).NormalizedName)},
+						},
+						Priority:      0,
+						Action:        &allowAction,
+						EnableLogging: true,
+						LogSampling: &controlplane.LogSampling{
+							SamplingRate: 2,
+						},
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns10", &selectorA, nil, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
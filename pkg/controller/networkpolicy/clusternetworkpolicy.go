@@ -224,7 +224,9 @@ func (n *NetworkPolicyController) updateNamespace(oldObj, curObj interface{}) {
 		}
 	}
 
-	if oldNamespace.Annotations[EnableNPLoggingAnnotationKey] != curNamespace.Annotations[EnableNPLoggingAnnotationKey] {
+	if oldNamespace.Annotations[EnableNPLoggingAnnotationKey] != curNamespace.Annotations[EnableNPLoggingAnnotationKey] ||
+		oldNamespace.Annotations[NPLogSamplingRateAnnotationKey] != curNamespace.Annotations[NPLogSamplingRateAnnotationKey] ||
+		oldNamespace.Annotations[NPLogMaxEventsPerSecondAnnotationKey] != curNamespace.Annotations[NPLogMaxEventsPerSecondAnnotationKey] {
 		affectedNPs, _ := n.networkPolicyLister.NetworkPolicies(curNamespace.Name).List(labels.Everything())
 		for _, np := range affectedNPs {
 			n.enqueueInternalNetworkPolicy(getKNPReference(np))
@@ -417,6 +419,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
 					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
 					LogLabel:        cnpRule.LogLabel,
+					LogSampling:     toAntreaLogSamplingForCRD(cnpRule.LogSampling, cnp.Spec.LogSampling),
 				}
 				switch dir {
 				case controlplane.DirectionIn:
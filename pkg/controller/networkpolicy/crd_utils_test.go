@@ -226,6 +226,22 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{TLS: &controlplane.TLSProtocol{SNI: "test.com"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{HTTP: &crdv1beta1.HTTPProtocol{
+					PathRegex:   "/api/v[0-9]+/.*",
+					Headers:     []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant", Value: "tenant-a"}},
+					QueryParams: []crdv1beta1.HTTPQueryParamMatch{{Name: "version"}},
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{
+					PathRegex:   "/api/v[0-9]+/.*",
+					Headers:     []controlplane.HTTPHeaderMatch{{Name: "X-Tenant", Value: "tenant-a"}},
+					QueryParams: []controlplane.HTTPQueryParamMatch{{Name: "version"}},
+				}},
+			},
+		},
 	}
 	for _, table := range tables {
 		gotValue := toAntreaL7ProtocolsForCRD(table.l7Protocol)
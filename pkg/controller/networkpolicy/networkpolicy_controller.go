@@ -88,6 +88,12 @@ const (
 
 	// EnableNPLoggingAnnotationKey can be added to Namespace to enable logging K8s NP.
 	EnableNPLoggingAnnotationKey = "networkpolicy.antrea.io/enable-logging"
+	// NPLogSamplingRateAnnotationKey can be added to Namespace to log only one packet out of every N packets when
+	// logging K8s NP is enabled.
+	NPLogSamplingRateAnnotationKey = "networkpolicy.antrea.io/log-sampling-rate"
+	// NPLogMaxEventsPerSecondAnnotationKey can be added to Namespace to limit the number of packets logged per
+	// second for each rule when logging K8s NP is enabled.
+	NPLogMaxEventsPerSecondAnnotationKey = "networkpolicy.antrea.io/log-max-events-per-second"
 
 	appliedToGroupType grouping.GroupType = "appliedToGroup"
 	addressGroupType   grouping.GroupType = "addressGroup"
@@ -719,9 +725,13 @@ func (n *NetworkPolicyController) processNetworkPolicy(np *networkingv1.NetworkP
 	rules := make([]controlplane.NetworkPolicyRule, 0, len(np.Spec.Ingress)+len(np.Spec.Egress))
 	// Retrieve Namespace logging annotation.
 	enableLogging := false
+	var logSampling *controlplane.LogSampling
 	namespace, err := n.namespaceLister.Get(np.Namespace)
 	if err == nil {
 		enableLogging, _ = strconv.ParseBool(namespace.Annotations[EnableNPLoggingAnnotationKey])
+		if enableLogging {
+			logSampling = getNamespaceLogSampling(namespace)
+		}
 	}
 	var ingressRuleExists, egressRuleExists bool
 	// Compute NetworkPolicyRule for Ingress Rule.
@@ -737,6 +747,7 @@ func (n *NetworkPolicyController) processNetworkPolicy(np *networkingv1.NetworkP
 			Priority:      defaultRulePriority,
 			Action:        &defaultAction,
 			EnableLogging: enableLogging,
+			LogSampling:   logSampling,
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
@@ -752,6 +763,7 @@ func (n *NetworkPolicyController) processNetworkPolicy(np *networkingv1.NetworkP
 			Priority:      defaultRulePriority,
 			Action:        &defaultAction,
 			EnableLogging: enableLogging,
+			LogSampling:   logSampling,
 		})
 	}
 
@@ -768,6 +780,8 @@ func (n *NetworkPolicyController) processNetworkPolicy(np *networkingv1.NetworkP
 
 	// If ingress isolation is specified explicitly and there's no ingress rule, append a deny-all ingress rule.
 	// See https://kubernetes.io/docs/concepts/services-networking/network-policies/#default-deny-all-ingress-traffic
+	// The deny-all rules don't get the log sampling of the Namespace, as the packets they drop are logged by the
+	// default drop flows shared by all the K8s NetworkPolicies, which don't map to a rule.
 	if ingressIsolated && !ingressRuleExists {
 		rules = append(rules, denyAllRule(controlplane.DirectionIn, enableLogging))
 	}
@@ -1731,6 +1745,31 @@ func denyAllRule(direction controlplane.Direction, enableLogging bool) controlpl
 	}
 }
 
+// getNamespaceLogSampling returns the LogSampling configured with annotations of the Namespace for the K8s
+// NetworkPolicies created in it. Invalid annotation values are ignored.
+func getNamespaceLogSampling(namespace *v1.Namespace) *controlplane.LogSampling {
+	parse := func(key string) int32 {
+		value, ok := namespace.Annotations[key]
+		if !ok {
+			return 0
+		}
+		i, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || i < 1 {
+			klog.InfoS("Ignoring invalid Namespace annotation", "namespace", namespace.Name, "annotation", key, "value", value)
+			return 0
+		}
+		return int32(i)
+	}
+	logSampling := &controlplane.LogSampling{
+		SamplingRate:       parse(NPLogSamplingRateAnnotationKey),
+		MaxEventsPerSecond: parse(NPLogMaxEventsPerSecondAnnotationKey),
+	}
+	if logSampling.SamplingRate == 0 && logSampling.MaxEventsPerSecond == 0 {
+		return nil
+	}
+	return logSampling
+}
+
 // ipStrToIPAddress converts an IP string to a controlplane.IPAddress.
 // nil will returned if the IP string is not valid.
 func ipStrToIPAddress(ip string) controlplane.IPAddress {
@@ -2322,6 +2322,55 @@ func TestProcessNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   0,
 		},
+		{
+			name: "default-deny-egress-enabling-logging-with-sampling",
+			existingObjects: []runtime.Object{
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "nsA",
+						Annotations: map[string]string{
+							"networkpolicy.antrea.io/enable-logging":            "true",
+							"networkpolicy.antrea.io/log-sampling-rate":         "10",
+							"networkpolicy.antrea.io/log-max-events-per-second": "invalid",
+						},
+					},
+				},
+			},
+			inputPolicy: &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "nsA", Name: "npA", UID: "uidA"},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+					Ingress:     []networkingv1.NetworkPolicyIngressRule{{}},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidA",
+				Name: "uidA",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type:      controlplane.K8sNetworkPolicy,
+					Namespace: "nsA",
+					Name:      "npA",
+					UID:       "uidA",
+				},
+				// The log sampling of the Namespace doesn't apply to the deny-all rule.
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction:     controlplane.DirectionIn,
+						From:          matchAllPeer,
+						Services:      nil,
+						Priority:      defaultRulePriority,
+						Action:        &defaultAction,
+						EnableLogging: true,
+						LogSampling:   &controlplane.LogSampling{SamplingRate: 10},
+					},
+					denyAllRule(controlplane.DirectionOut, true),
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("nsA", &metav1.LabelSelector{}, nil, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   0,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -22,6 +22,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
 
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
@@ -86,6 +87,15 @@ var (
 	// allowedFQDNChars validates that the matchPattern field contains only valid DNS characters
 	// and the wildcard '*' character.
 	allowedFQDNChars = regexp.MustCompile("^[-0-9a-zA-Z.*]+$")
+	// grpcServiceName and grpcMethodName validate the names used in the gRPC layer 7 protocol,
+	// which follow the Protocol Buffers naming rules.
+	grpcServiceName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	grpcMethodName  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// httpHeaderName and httpQueryParamName validate the names used in the HTTP layer 7 protocol.
+	httpHeaderName     = regexp.MustCompile(`^[-0-9A-Za-z_.]+$`)
+	httpQueryParamName = regexp.MustCompile(`^[-0-9A-Za-z_.~]+$`)
+	// supportedDNSQueryTypes stores the DNS query types which can be matched by the DNS layer 7 protocol.
+	supportedDNSQueryTypes = sets.New[string]("A", "AAAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT", "ANY")
 )
 
 // RegisterAntreaPolicyValidator registers an Antrea-native policy validator
@@ -526,6 +536,7 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	var tier string
 	var ingress, egress []crdv1beta1.Rule
 	var specAppliedTo []crdv1beta1.AppliedTo
+	var specLogSampling *crdv1beta1.LogSampling
 	var warnings []string
 	switch curObj := curObj.(type) {
 	case *crdv1beta1.ClusterNetworkPolicy:
@@ -533,11 +544,13 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 		ingress = curObj.Spec.Ingress
 		egress = curObj.Spec.Egress
 		specAppliedTo = curObj.Spec.AppliedTo
+		specLogSampling = curObj.Spec.LogSampling
 	case *crdv1beta1.NetworkPolicy:
 		tier = curObj.Spec.Tier
 		ingress = curObj.Spec.Ingress
 		egress = curObj.Spec.Egress
 		specAppliedTo = curObj.Spec.AppliedTo
+		specLogSampling = curObj.Spec.LogSampling
 	}
 	reason, allowed := v.validateTierForPolicy(tier)
 	if !allowed {
@@ -578,6 +591,10 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	if !allowed {
 		return warnings, reason, allowed
 	}
+	reason, allowed = v.validateLogSampling(specLogSampling, ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return warnings, err.Error(), false
 	}
@@ -947,21 +964,139 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		haveHTTP, haveGRPC, haveDNS := false, false, false
 		for _, p := range r.L7Protocols {
 			if p.HTTP != nil {
 				haveHTTP = true
+				if reason, allowed := validateHTTPProtocol(p.HTTP); !allowed {
+					return reason, allowed
+				}
+			}
+			if p.GRPC != nil {
+				haveGRPC = true
+				if reason, allowed := validateGRPCProtocol(p.GRPC); !allowed {
+					return reason, allowed
+				}
+			}
+			if p.DNS != nil {
+				haveDNS = true
+				if reason, allowed := validateDNSProtocol(p.DNS); !allowed {
+					return reason, allowed
+				}
 			}
 		}
 		for _, port := range r.Ports {
-			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
+			if port.Protocol == nil {
+				continue
+			}
+			if haveHTTP && *port.Protocol != v1.ProtocolTCP {
 				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
 			}
+			if haveGRPC && *port.Protocol != v1.ProtocolTCP {
+				return "gRPC protocol can only be used when layer 4 protocol is TCP or unset", false
+			}
+			if haveDNS && *port.Protocol != v1.ProtocolTCP && *port.Protocol != v1.ProtocolUDP {
+				return "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset", false
+			}
 		}
 		for _, protocol := range r.Protocols {
-			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
+			if protocol.IGMP == nil && protocol.ICMP == nil {
+				continue
+			}
+			if haveHTTP {
 				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
 			}
+			if haveGRPC {
+				return "gRPC protocol can not be used with protocol IGMP or ICMP", false
+			}
+			if haveDNS {
+				return "DNS protocol can not be used with protocol IGMP or ICMP", false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateHTTPProtocol validates the path, headers and query parameters of an HTTP layer 7 protocol.
+func validateHTTPProtocol(http *crdv1beta1.HTTPProtocol) (string, bool) {
+	if http.PathRegex != "" {
+		if http.Path != "" {
+			return "HTTP path and pathRegex can not be used together", false
+		}
+		// The regular expression is compiled by Suricata with PCRE. The syntax accepted by Go is a subset of the PCRE
+		// syntax, and double quotes and semicolons can not be used in Suricata rule options.
+		if strings.ContainsAny(http.PathRegex, `";`) {
+			return fmt.Sprintf("invalid HTTP pathRegex %s: double quotes and semicolons are not allowed", http.PathRegex), false
+		}
+		if _, err := regexp.Compile(http.PathRegex); err != nil {
+			return fmt.Sprintf("invalid HTTP pathRegex %s: %v", http.PathRegex, err), false
+		}
+	}
+	for _, header := range http.Headers {
+		if !httpHeaderName.MatchString(header.Name) {
+			return fmt.Sprintf("invalid HTTP header name: %s", header.Name), false
+		}
+		if strings.ContainsFunc(header.Value, unicode.IsControl) {
+			return fmt.Sprintf("invalid characters in value of HTTP header %s", header.Name), false
+		}
+	}
+	for _, param := range http.QueryParams {
+		if !httpQueryParamName.MatchString(param.Name) {
+			return fmt.Sprintf("invalid HTTP query parameter name: %s", param.Name), false
+		}
+		if strings.ContainsFunc(param.Value, unicode.IsControl) {
+			return fmt.Sprintf("invalid characters in value of HTTP query parameter %s", param.Name), false
+		}
+	}
+	return "", true
+}
+
+// validateGRPCProtocol validates the service and method names of a gRPC layer 7 protocol.
+func validateGRPCProtocol(grpc *crdv1beta1.GRPCProtocol) (string, bool) {
+	if grpc.Method != "" && grpc.Service == "" {
+		return "gRPC method can only be used together with service", false
+	}
+	if grpc.Service != "" && !grpcServiceName.MatchString(grpc.Service) {
+		return fmt.Sprintf("invalid gRPC service name: %s", grpc.Service), false
+	}
+	if grpc.Method != "" && !grpcMethodName.MatchString(grpc.Method) {
+		return fmt.Sprintf("invalid gRPC method name: %s", grpc.Method), false
+	}
+	return "", true
+}
+
+// validateDNSProtocol validates the query name and type of a DNS layer 7 protocol.
+func validateDNSProtocol(dns *crdv1beta1.DNSProtocol) (string, bool) {
+	if dns.QueryName != "" && !allowedFQDNChars.MatchString(dns.QueryName) {
+		return fmt.Sprintf("invalid characters in DNS query name: %s", dns.QueryName), false
+	}
+	if dns.QueryType != "" && !supportedDNSQueryTypes.Has(dns.QueryType) {
+		return fmt.Sprintf("unsupported DNS query type: %s", dns.QueryType), false
+	}
+	return "", true
+}
+
+// validateLogSampling validates the logSampling field set in Antrea-native policy
+// spec and rules are valid.
+func (v *antreaPolicyValidator) validateLogSampling(specLogSampling *crdv1beta1.LogSampling, ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	validate := func(logSampling *crdv1beta1.LogSampling, location string) (string, bool) {
+		if logSampling == nil {
+			return "", true
+		}
+		if logSampling.SamplingRate != nil && *logSampling.SamplingRate < 1 {
+			return fmt.Sprintf("invalid logSampling samplingRate %d in %s: must be at least 1", *logSampling.SamplingRate, location), false
+		}
+		if logSampling.MaxEventsPerSecond != nil && *logSampling.MaxEventsPerSecond < 1 {
+			return fmt.Sprintf("invalid logSampling maxEventsPerSecond %d in %s: must be at least 1", *logSampling.MaxEventsPerSecond, location), false
+		}
+		return "", true
+	}
+	if reason, allowed := validate(specLogSampling, "spec"); !allowed {
+		return reason, allowed
+	}
+	for _, r := range append(ingressRules, egressRules...) {
+		if reason, allowed := validate(r.LogSampling, fmt.Sprintf("rule %q", r.Name)); !allowed {
+			return reason, allowed
 		}
 	}
 	return "", true
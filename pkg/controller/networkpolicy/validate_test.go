@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/component-base/featuregate"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
@@ -1763,6 +1764,498 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
 		},
+		{
+			name:         "acnp-l7protocols-gRPC-and-DNS",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolTCP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+										Method:  "SayHello",
+									},
+								},
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "*.example.com",
+										QueryType: "AAAA",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-gRPC-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "gRPC protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-gRPC-method-without-service",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Method: "SayHello",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "gRPC method can only be used together with service",
+		},
+		{
+			name:         "acnp-l7protocols-gRPC-invalid-service",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld/Greeter",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid gRPC service name: helloworld/Greeter",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "www.example.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-SCTP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolSCTP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "www.example.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-invalid-query-name",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: `www.example.com";`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `invalid characters in DNS query name: www.example.com";`,
+		},
+		{
+			name:         "acnp-l7protocols-DNS-unsupported-query-type",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryType: "HINFO",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "unsupported DNS query type: HINFO",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-headers-query-params-path-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										PathRegex:   "/api/v[0-9]+/.*",
+										Headers:     []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant", Value: "tenant-*"}},
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{{Name: "version", Value: "v2"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-path-and-path-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Path:      "/api/v2",
+										PathRegex: "/api/v[0-9]+",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "HTTP path and pathRegex can not be used together",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-invalid-path-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										PathRegex: "/api/(v1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid HTTP pathRegex /api/(v1: error parsing regexp: missing closing ): `/api/(v1`",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-path-regex-with-semicolon",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										PathRegex: "/api;v1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid HTTP pathRegex /api;v1: double quotes and semicolons are not allowed",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-invalid-header-name",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant:", Value: "tenant-a"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid HTTP header name: X-Tenant:",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-invalid-query-param-value",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{{Name: "version", Value: "v2\r\n"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid characters in value of HTTP query parameter version",
+		},
 		{
 			name:         "acnp-l7protocols-used-with-toService",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
@@ -1924,6 +2417,102 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "protocol IGMP does not support Pass or Reject",
 		},
+		{
+			name: "log-sampling-valid",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:          "ingress-log",
+							Action:        &allowAction,
+							EnableLogging: true,
+							LogSampling: &crdv1beta1.LogSampling{
+								SamplingRate:       ptr.To[int32](10),
+								MaxEventsPerSecond: ptr.To[int32](100),
+							},
+						},
+					},
+				},
+			},
+			operation: admv1.Create,
+		},
+		{
+			name: "log-sampling-invalid-sampling-rate",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Name:          "egress-log",
+							Action:        &allowAction,
+							EnableLogging: true,
+							LogSampling: &crdv1beta1.LogSampling{
+								SamplingRate: ptr.To[int32](0),
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid logSampling samplingRate 0 in rule \"egress-log\": must be at least 1",
+		},
+		{
+			name: "log-sampling-invalid-max-events-per-second",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:          "ingress-log",
+							Action:        &allowAction,
+							EnableLogging: true,
+							LogSampling: &crdv1beta1.LogSampling{
+								MaxEventsPerSecond: ptr.To[int32](-1),
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid logSampling maxEventsPerSecond -1 in rule \"ingress-log\": must be at least 1",
+		},
+		{
+			name: "log-sampling-invalid-spec-sampling-rate",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Name:          "ingress-log",
+							Action:        &allowAction,
+							EnableLogging: true,
+						},
+					},
+					LogSampling: &crdv1beta1.LogSampling{
+						SamplingRate: ptr.To[int32](0),
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid logSampling samplingRate 0 in spec: must be at least 1",
+		},
 		// Update use same validate function as create. Only provide one update case here.
 		{
 			name: "acnp-non-existent-tier",
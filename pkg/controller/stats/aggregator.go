@@ -31,6 +31,7 @@ import (
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -47,6 +48,7 @@ const (
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/multicastgroup.statsProvider
+// - pkg/apiserver/registry/stats/egressstats.statsProvider
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -59,6 +61,8 @@ type Aggregator struct {
 	// map[IP of multicast group]map[name of node]list of PodReference.
 	groupNodePodsMap      map[string]map[string][]statsv1alpha1.PodReference
 	groupNodePodsMapMutex sync.RWMutex
+	// egressStats caches the statistics of Egresses collected from the antrea-agents.
+	egressStats cache.Indexer
 	// dataCh is the channel that buffers the NodeSummaries sent by antrea-agents.
 	dataCh chan *controlplane.NodeStatsSummary
 	// npListerSynced is a function which returns true if the K8s NetworkPolicy shared informer has been synced at least once.
@@ -67,6 +71,10 @@ type Aggregator struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the Antrea NetworkPolicy shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+	// egressLister is used to get the Egress Node of an Egress when labeling its EgressStats.
+	egressLister crdlisters.EgressLister
+	// egressListerSynced is a function which returns true if the Egress shared informer has been synced at least once.
+	egressListerSynced cache.InformerSynced
 }
 
 // uidIndexFunc is an index function that indexes based on an object's UID.
@@ -78,27 +86,29 @@ func uidIndexFunc(obj interface{}) ([]string, error) {
 	return []string{string(meta.GetUID())}, nil
 }
 
-func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *Aggregator {
+func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer, egressInformer crdinformers.EgressInformer) *Aggregator {
 	aggregator := &Aggregator{
-		networkPolicyStats: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
-		dataCh:             make(chan *controlplane.NodeStatsSummary, 1000),
-		npListerSynced:     networkPolicyInformer.Informer().HasSynced,
+		dataCh: make(chan *controlplane.NodeStatsSummary, 1000),
 	}
-	// Add handlers for NetworkPolicy events.
+	// Register Informer and add handlers for NetworkPolicy events only if the feature is enabled.
 	// They are the source of truth of the NetworkPolicyStats, i.e., a NetworkPolicyStats is present only if the
 	// corresponding NetworkPolicy is present.
-	networkPolicyInformer.Informer().AddEventHandlerWithResyncPeriod(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc:    aggregator.addNetworkPolicy,
-			DeleteFunc: aggregator.deleteNetworkPolicy,
-		},
-		// Set resyncPeriod to 0 to disable resyncing.
-		0,
-	)
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		aggregator.networkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc})
+		aggregator.npListerSynced = networkPolicyInformer.Informer().HasSynced
+		networkPolicyInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    aggregator.addNetworkPolicy,
+				DeleteFunc: aggregator.deleteNetworkPolicy,
+			},
+			// Set resyncPeriod to 0 to disable resyncing.
+			0,
+		)
+	}
 	// Register Informer and add handlers for AntreaPolicy events only if the feature is enabled.
 	// They are the source of truth of the ClusterNetworkPolicyStats, i.e., a ClusterNetworkPolicyStats is present
 	// only if the corresponding ClusterNetworkPolicy is present.
-	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) && features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		aggregator.antreaClusterNetworkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
 		aggregator.acnpListerSynced = acnpInformer.Informer().HasSynced
 		acnpInformer.Informer().AddEventHandlerWithResyncPeriod(
@@ -124,6 +134,22 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 	if features.DefaultFeatureGate.Enabled(features.Multicast) {
 		aggregator.groupNodePodsMap = make(map[string]map[string][]statsv1alpha1.PodReference)
 	}
+	// Register Informer and add handlers for Egress events only if the feature is enabled.
+	// They are the source of truth of the EgressStats, i.e., an EgressStats is present only if the corresponding
+	// Egress is present.
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		aggregator.egressStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
+		aggregator.egressLister = egressInformer.Lister()
+		aggregator.egressListerSynced = egressInformer.Informer().HasSynced
+		egressInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    aggregator.addEgress,
+				DeleteFunc: aggregator.deleteEgress,
+			},
+			// Set resyncPeriod to 0 to disable resyncing.
+			0,
+		)
+	}
 	return aggregator
 }
 
@@ -248,6 +274,45 @@ func (a *Aggregator) deleteANNP(obj interface{}) {
 	a.antreaNetworkPolicyStats.Delete(stats)
 }
 
+// addEgress handles Egress ADD events and creates corresponding EgressStats objects.
+func (a *Aggregator) addEgress(obj interface{}) {
+	egress := obj.(*crdv1beta1.Egress)
+	stats := &statsv1alpha1.EgressStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: egress.Name,
+			UID:  egress.UID,
+			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
+			// start, instead of the CreationTimestamp of the Egress.
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+	}
+	a.egressStats.Add(stats)
+}
+
+// deleteEgress handles Egress DELETE events and deletes corresponding EgressStats objects.
+func (a *Aggregator) deleteEgress(obj interface{}) {
+	egress, ok := obj.(*crdv1beta1.Egress)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Egress, invalid type: %v", obj)
+			return
+		}
+		egress, ok = tombstone.Obj.(*crdv1beta1.Egress)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Egress, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.EgressStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: egress.Name,
+			UID:  egress.UID,
+		},
+	}
+	a.egressStats.Delete(stats)
+}
+
 func (a *Aggregator) ListAntreaClusterNetworkPolicyStats() []statsv1alpha1.AntreaClusterNetworkPolicyStats {
 	objs := a.antreaClusterNetworkPolicyStats.List()
 	stats := make([]statsv1alpha1.AntreaClusterNetworkPolicyStats, len(objs))
@@ -340,6 +405,37 @@ func (a *Aggregator) GetNetworkPolicyStats(namespace, name string) (*statsv1alph
 	return obj.(*statsv1alpha1.NetworkPolicyStats), true
 }
 
+func (a *Aggregator) ListEgressStats() []statsv1alpha1.EgressStats {
+	objs := a.egressStats.List()
+	stats := make([]statsv1alpha1.EgressStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.EgressStats))
+		stats[i].Labels = a.getEgressStatsLabels(stats[i].Name)
+	}
+	return stats
+}
+
+func (a *Aggregator) GetEgressStats(name string) (*statsv1alpha1.EgressStats, bool) {
+	obj, exists, _ := a.egressStats.GetByKey(name)
+	if !exists {
+		return nil, false
+	}
+	// The object returned by cache is supposed to be read only, create a new object and label it.
+	stats := obj.(*statsv1alpha1.EgressStats).DeepCopy()
+	stats.Labels = a.getEgressStatsLabels(stats.Name)
+	return stats, true
+}
+
+// getEgressStatsLabels returns the labels of the EgressStats of the given Egress. The Egress Node label is computed
+// when the stats are queried, as the Egress IP can be moved to another Node at any time.
+func (a *Aggregator) getEgressStatsLabels(name string) map[string]string {
+	labels := map[string]string{statsv1alpha1.EgressNameLabelKey: name}
+	if egress, err := a.egressLister.Get(name); err == nil && egress.Status.EgressNode != "" {
+		labels[statsv1alpha1.EgressNodeLabelKey] = egress.Status.EgressNode
+	}
+	return labels
+}
+
 // Collect collects the node summary asynchronously to avoid the competition for the statsLock and to save clients
 // from pending on it.
 func (a *Aggregator) Collect(summary *controlplane.NodeStatsSummary) {
@@ -352,10 +448,16 @@ func (a *Aggregator) Run(stopCh <-chan struct{}) {
 	klog.Info("Starting stats aggregator")
 	defer klog.Info("Shutting down stats aggregator")
 
-	cacheSyncs := []cache.InformerSynced{a.npListerSynced}
-	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+	var cacheSyncs []cache.InformerSynced
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		cacheSyncs = append(cacheSyncs, a.npListerSynced)
+	}
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) && features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, a.acnpListerSynced, a.annpListerSynced)
 	}
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		cacheSyncs = append(cacheSyncs, a.egressListerSynced)
+	}
 	if !cache.WaitForNamedCacheSync("stats aggregator", stopCh, cacheSyncs...) {
 		return
 	}
@@ -371,15 +473,17 @@ func (a *Aggregator) Run(stopCh <-chan struct{}) {
 }
 
 func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
-	for idx := range summary.NetworkPolicies {
-		stats := &summary.NetworkPolicies[idx]
-		// The policy might have been removed, skip processing it if missing.
-		objs, _ := a.networkPolicyStats.ByIndex(uidIndex, string(stats.NetworkPolicy.UID))
-		if len(objs) > 0 {
-			// The object returned by cache is supposed to be read only, create a new object and update it.
-			curStats := objs[0].(*statsv1alpha1.NetworkPolicyStats).DeepCopy()
-			addUp(&curStats.TrafficStats, &stats.TrafficStats)
-			a.networkPolicyStats.Update(curStats)
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		for idx := range summary.NetworkPolicies {
+			stats := &summary.NetworkPolicies[idx]
+			// The policy might have been removed, skip processing it if missing.
+			objs, _ := a.networkPolicyStats.ByIndex(uidIndex, string(stats.NetworkPolicy.UID))
+			if len(objs) > 0 {
+				// The object returned by cache is supposed to be read only, create a new object and update it.
+				curStats := objs[0].(*statsv1alpha1.NetworkPolicyStats).DeepCopy()
+				addUp(&curStats.TrafficStats, &stats.TrafficStats)
+				a.networkPolicyStats.Update(curStats)
+			}
 		}
 	}
 	if features.DefaultFeatureGate.Enabled(features.Multicast) {
@@ -410,7 +514,7 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 		}
 		a.groupNodePodsMapMutex.Unlock()
 	}
-	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) && features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		for idx := range summary.AntreaClusterNetworkPolicies {
 			stats := &summary.AntreaClusterNetworkPolicies[idx]
 			// The policy have might been removed, skip processing it if missing.
@@ -445,6 +549,19 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 			}
 		}
 	}
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		for idx := range summary.Egresses {
+			stats := &summary.Egresses[idx]
+			// The Egress might have been removed, skip processing it if missing.
+			objs, _ := a.egressStats.ByIndex(uidIndex, string(stats.UID))
+			if len(objs) > 0 {
+				// The object returned by cache is supposed to be read only, create a new object and update it.
+				curStats := objs[0].(*statsv1alpha1.EgressStats).DeepCopy()
+				addUp(&curStats.TrafficStats, &stats.TrafficStats)
+				a.egressStats.Update(curStats)
+			}
+		}
+	}
 }
 
 func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
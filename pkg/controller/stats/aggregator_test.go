@@ -56,6 +56,13 @@ var (
 	annp2 = &crdv1beta1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "baz", UID: "uid6"},
 	}
+	egress1 = &crdv1beta1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress1", UID: "uid7"},
+		Status:     crdv1beta1.EgressStatus{EgressNode: "node-3"},
+	}
+	egress2 = &crdv1beta1.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egress2", UID: "uid8"},
+	}
 )
 
 // runWrapper wraps the Run method of the Aggregator and is used to avoid race conditions in tests.
@@ -69,7 +76,13 @@ func runWrapper(t *testing.T, a *Aggregator, policyCount int, summaries []*contr
 	stopCh := make(chan struct{})
 	doneCh := make(chan struct{})
 	err := wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, time.Second, true, func(ctx context.Context) (done bool, err error) {
-		count := len(a.ListNetworkPolicyStats("")) + len(a.ListAntreaNetworkPolicyStats("")) + len(a.ListAntreaClusterNetworkPolicyStats())
+		count := 0
+		if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+			count += len(a.ListNetworkPolicyStats("")) + len(a.ListAntreaNetworkPolicyStats("")) + len(a.ListAntreaClusterNetworkPolicyStats())
+		}
+		if features.DefaultFeatureGate.Enabled(features.Egress) {
+			count += len(a.ListEgressStats())
+		}
 		return (count >= policyCount), nil
 	})
 	require.NoError(t, err, "Timeout while waiting for Add events to be processed by Aggregator")
@@ -522,7 +535,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 			informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 			crdClient := fakeversioned.NewSimpleClientset(append(tt.existingAntreaClusterNetworkPolicies, tt.existingAntreaNetworkPolicies...)...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().Egresses())
 			informerFactory.Start(stopCh)
 			crdInformerFactory.Start(stopCh)
 			expectedPolicyCount := len(tt.expectedNetworkPolicyStats) + len(tt.expectedAntreaClusterNetworkPolicyStats) + len(tt.expectedAntreaNetworkPolicyStats)
@@ -561,7 +574,7 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 	crdClient := fakeversioned.NewSimpleClientset(acnp1, annp1)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().Egresses())
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
 
@@ -627,3 +640,108 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestEgressStats(t *testing.T) {
+	tests := []struct {
+		name                      string
+		networkPolicyStatsEnabled bool
+	}{
+		{
+			name:                      "NetworkPolicyStats enabled",
+			networkPolicyStatsEnabled: true,
+		},
+		{
+			name:                      "NetworkPolicyStats disabled",
+			networkPolicyStatsEnabled: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, tt.networkPolicyStatsEnabled)
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			client := fake.NewSimpleClientset(np1)
+			informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+			crdClient := fakeversioned.NewSimpleClientset(egress1, egress2)
+			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().Egresses())
+			informerFactory.Start(stopCh)
+			crdInformerFactory.Start(stopCh)
+
+			summaries := []*controlplane.NodeStatsSummary{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+					// The NetworkPolicy stats are ignored if NetworkPolicyStats is disabled.
+					NetworkPolicies: []controlplane.NetworkPolicyStats{
+						{
+							NetworkPolicy: controlplane.NetworkPolicyReference{
+								Type:      controlplane.K8sNetworkPolicy,
+								Namespace: np1.Namespace,
+								Name:      np1.Name,
+								UID:       np1.UID,
+							},
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1},
+						},
+					},
+					Egresses: []controlplane.EgressStats{
+						{
+							Name:         egress1.Name,
+							UID:          egress1.UID,
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 100, Packets: 2},
+						},
+						// The Egress has been deleted, its stats are ignored.
+						{
+							Name:         "egress3",
+							UID:          "uid9",
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 100, Packets: 2},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+					Egresses: []controlplane.EgressStats{
+						{
+							Name:         egress1.Name,
+							UID:          egress1.UID,
+							TrafficStats: statsv1alpha1.TrafficStats{Bytes: 300, Packets: 5},
+						},
+					},
+				},
+			}
+			expectedCount := 2
+			if tt.networkPolicyStatsEnabled {
+				expectedCount++
+			}
+			runWrapper(t, a, expectedCount, summaries)
+
+			if tt.networkPolicyStatsEnabled {
+				actualNPStats, exists := a.GetNetworkPolicyStats(np1.Namespace, np1.Name)
+				require.True(t, exists)
+				assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1}, actualNPStats.TrafficStats)
+			}
+			require.Equal(t, 2, len(a.ListEgressStats()))
+			actualStats, exists := a.GetEgressStats(egress1.Name)
+			require.True(t, exists)
+			assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 400, Packets: 7}, actualStats.TrafficStats)
+			assert.Equal(t, map[string]string{
+				statsv1alpha1.EgressNameLabelKey: egress1.Name,
+				statsv1alpha1.EgressNodeLabelKey: "node-3",
+			}, actualStats.Labels)
+			actualStats, exists = a.GetEgressStats(egress2.Name)
+			require.True(t, exists)
+			assert.Equal(t, statsv1alpha1.TrafficStats{}, actualStats.TrafficStats)
+			// The Egress IP of egress2 is not assigned to any Node.
+			assert.Equal(t, map[string]string{statsv1alpha1.EgressNameLabelKey: egress2.Name}, actualStats.Labels)
+			_, exists = a.GetEgressStats("egress3")
+			assert.False(t, exists)
+
+			crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egress1.Name, metav1.DeleteOptions{})
+			// Event handlers are asynchronous, it's supposed to finish very soon.
+			err := wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, time.Second, true, func(ctx context.Context) (done bool, err error) {
+				return len(a.ListEgressStats()) == 1, nil
+			})
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -133,9 +133,14 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	}
 	for _, tf := range tfs {
 		if tf.Status.Phase == crdv1beta1.Running {
-			if err := c.occupyTag(tf); err != nil {
+			if err := c.occupyTag(tf, uint8(tf.Status.DataplaneTag)); err != nil {
 				klog.Errorf("Load Traceflow data plane tag failed %v+: %v", tf, err)
 			}
+			if tf.Status.ReplyDataplaneTag != 0 {
+				if err := c.occupyTag(tf, uint8(tf.Status.ReplyDataplaneTag)); err != nil {
+					klog.Errorf("Load Traceflow reply data plane tag failed %v+: %v", tf, err)
+				}
+			}
 		}
 	}
 
@@ -242,22 +247,50 @@ func (c *Controller) syncTraceflow(traceflowName string) error {
 }
 
 func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
-	// Allocate data plane tag.
-	tag, err := c.allocateTag(tf.Name)
+	// Allocate data plane tag. A round-trip Traceflow is allocated a pair of tags: the first one for the request
+	// packet and the second one for the reply packet.
+	numTags := 1
+	if tf.Spec.RoundTrip {
+		numTags = 2
+	}
+	tags, err := c.allocateTags(tf.Name, numTags)
 	if err != nil {
 		return err
 	}
-	if tag == 0 {
+	if tags == nil {
 		return nil
 	}
+	if tf.Spec.RoundTrip {
+		// The reply packet is matched on the source Pod IPs by all Nodes, but only the source Node knows the Pod.
+		tf = tf.DeepCopy()
+		tf.Status.SourcePodIPs = c.getPodIPs(tf.Spec.Source.Namespace, tf.Spec.Source.Pod)
+	}
 
-	err = c.updateTraceflowStatus(tf, crdv1beta1.Running, "", tag)
+	err = c.updateTraceflowStatus(tf, crdv1beta1.Running, "", tags...)
 	if err != nil {
-		c.deallocateTag(tf.Name, tag)
+		for _, tag := range tags {
+			c.deallocateTag(tf.Name, tag)
+		}
 	}
 	return err
 }
 
+// getPodIPs returns the IPs of a Pod, or nil if the Pod is not found.
+func (c *Controller) getPodIPs(namespace, name string) []string {
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		return nil
+	}
+	var podIPs []string
+	for _, podIP := range pod.Status.PodIPs {
+		podIPs = append(podIPs, podIP.IP)
+	}
+	if len(podIPs) == 0 && pod.Status.PodIP != "" {
+		podIPs = append(podIPs, pod.Status.PodIP)
+	}
+	return podIPs
+}
+
 // checkTraceflowStatus is only called for Traceflows in the Running phase
 func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 	succeeded := false
@@ -275,10 +308,7 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 				if ob.Component == crdv1beta1.ComponentSpoofGuard {
 					sender = true
 				}
-				if ob.Action == crdv1beta1.ActionDelivered ||
-					ob.Action == crdv1beta1.ActionDropped ||
-					ob.Action == crdv1beta1.ActionRejected ||
-					ob.Action == crdv1beta1.ActionForwardedOutOfOverlay {
+				if isFinalAction(ob.Action) {
 					receiver = true
 				}
 				if ob.TranslatedDstIP != "" {
@@ -302,10 +332,18 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 		// Pod is not specified (in live-traffic Traceflow), only the
 		// receiver Node will report the results.
 		succeeded = (sender && receiver) || (receiver && tf.Spec.Source.Pod == "")
+		// A round-trip Traceflow also requires the reply packet to reach its destination, unless the request
+		// packet was dropped.
+		if succeeded && tf.Spec.RoundTrip && !requestDropped(tf.Status.Results) {
+			succeeded = replyCompleted(tf.Status.ReplyResults)
+			if succeeded {
+				tf.Status.RoundTripTimeNanos = roundTripTime(tf.Status.Results, tf.Status.ReplyResults)
+			}
+		}
 	}
 	if succeeded {
 		c.deallocateTagForTF(tf)
-		return c.updateTraceflowStatus(tf, crdv1beta1.Succeeded, "", 0)
+		return c.updateTraceflowStatus(tf, crdv1beta1.Succeeded, "")
 	}
 
 	var timeout time.Duration
@@ -325,19 +363,91 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 	}
 	if startTime.Add(timeout).Before(time.Now()) {
 		c.deallocateTagForTF(tf)
-		return c.updateTraceflowStatus(tf, crdv1beta1.Failed, traceflowTimeout, 0)
+		return c.updateTraceflowStatus(tf, crdv1beta1.Failed, traceflowTimeout)
 	}
 	return nil
 }
 
-func (c *Controller) updateTraceflowStatus(tf *crdv1beta1.Traceflow, phase crdv1beta1.TraceflowPhase, reason string, dataPlaneTag uint8) error {
+func isFinalAction(action crdv1beta1.TraceflowAction) bool {
+	return action == crdv1beta1.ActionDelivered ||
+		action == crdv1beta1.ActionDropped ||
+		action == crdv1beta1.ActionRejected ||
+		action == crdv1beta1.ActionForwardedOutOfOverlay
+}
+
+// requestDropped returns true if the request packet of a Traceflow was dropped or rejected, in which case there will
+// be no reply to trace.
+func requestDropped(results []crdv1beta1.NodeResult) bool {
+	for _, nodeResult := range results {
+		for _, ob := range nodeResult.Observations {
+			if ob.Action == crdv1beta1.ActionDropped || ob.Action == crdv1beta1.ActionRejected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// replyCompleted returns true if the reply packet of a round-trip Traceflow has reached its final observation point.
+func replyCompleted(replyResults []crdv1beta1.NodeResult) bool {
+	for _, nodeResult := range replyResults {
+		for _, ob := range nodeResult.Observations {
+			if isFinalAction(ob.Action) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// roundTripTime computes the time between the request packet leaving the source Pod and the reply packet being
+// delivered back to it. Both timestamps are taken from the source Node, so the result is not affected by clock skew
+// between Nodes. 0 is returned if the time cannot be computed.
+func roundTripTime(results, replyResults []crdv1beta1.NodeResult) int64 {
+	var senderNode string
+	var sentTime int64
+	for _, nodeResult := range results {
+		for _, ob := range nodeResult.Observations {
+			if ob.Component == crdv1beta1.ComponentSpoofGuard {
+				senderNode = nodeResult.Node
+				sentTime = nodeResult.TimestampNanos
+			}
+		}
+	}
+	if senderNode == "" || sentTime == 0 {
+		return 0
+	}
+	for _, nodeResult := range replyResults {
+		if nodeResult.Node != senderNode || nodeResult.TimestampNanos < sentTime {
+			continue
+		}
+		for _, ob := range nodeResult.Observations {
+			if ob.Action == crdv1beta1.ActionDelivered {
+				return nodeResult.TimestampNanos - sentTime
+			}
+		}
+	}
+	return 0
+}
+
+// updateTraceflowStatus updates the phase of the Traceflow. dataPlaneTags are the tags allocated to the Traceflow when
+// it is Running: the first one is for the request packet, the second one, if any, is for the reply packet. The tags
+// are reset when the Traceflow is no longer Running.
+func (c *Controller) updateTraceflowStatus(tf *crdv1beta1.Traceflow, phase crdv1beta1.TraceflowPhase, reason string, dataPlaneTags ...uint8) error {
 	update := tf.DeepCopy()
 	update.Status.Phase = phase
 	if phase == crdv1beta1.Running && tf.Status.StartTime == nil {
 		t := metav1.Now()
 		update.Status.StartTime = &t
 	}
-	update.Status.DataplaneTag = int8(dataPlaneTag)
+	update.Status.DataplaneTag = 0
+	update.Status.ReplyDataplaneTag = 0
+	if len(dataPlaneTags) > 0 {
+		update.Status.DataplaneTag = int8(dataPlaneTags[0])
+	}
+	if len(dataPlaneTags) > 1 {
+		update.Status.ReplyDataplaneTag = int8(dataPlaneTags[1])
+	}
 	if reason != "" {
 		update.Status.Reason = reason
 	}
@@ -345,8 +455,7 @@ func (c *Controller) updateTraceflowStatus(tf *crdv1beta1.Traceflow, phase crdv1
 	return err
 }
 
-func (c *Controller) occupyTag(tf *crdv1beta1.Traceflow) error {
-	tag := uint8(tf.Status.DataplaneTag)
+func (c *Controller) occupyTag(tf *crdv1beta1.Traceflow, tag uint8) error {
 	if tag < minTagNum || tag > maxTagNum {
 		return errors.New("this Traceflow CRD's data plane tag is out of range")
 	}
@@ -364,33 +473,42 @@ func (c *Controller) occupyTag(tf *crdv1beta1.Traceflow) error {
 	return nil
 }
 
-// Allocates a tag. If the Traceflow request has been allocated with a tag
-// already, 0 is returned. If number of existing Traceflow requests reaches
-// the upper limit, an error is returned.
-func (c *Controller) allocateTag(name string) (uint8, error) {
+// Allocates numTags tags. If the Traceflow request has been allocated with
+// tags already, nil is returned. If there are not enough available tags for
+// the Traceflow request, an error is returned.
+func (c *Controller) allocateTags(name string, numTags int) ([]uint8, error) {
 	c.runningTraceflowsMutex.Lock()
 	defer c.runningTraceflowsMutex.Unlock()
 
 	for _, n := range c.runningTraceflows {
 		if n == name {
 			// The Traceflow request has been processed already.
-			return 0, nil
+			return nil, nil
 		}
 	}
-	for i := minTagNum; i <= maxTagNum; i += tagStep {
+	tags := make([]uint8, 0, numTags)
+	for i := minTagNum; i <= maxTagNum && len(tags) < numTags; i += tagStep {
 		if _, ok := c.runningTraceflows[i]; !ok {
-			c.runningTraceflows[i] = name
-			return i, nil
+			tags = append(tags, i)
 		}
 	}
-	return 0, fmt.Errorf("number of on-going Traceflow operations already reached the upper limit: %d", maxTagNum)
+	if len(tags) < numTags {
+		return nil, fmt.Errorf("number of on-going Traceflow operations already reached the upper limit: %d", maxTagNum)
+	}
+	for _, tag := range tags {
+		c.runningTraceflows[tag] = name
+	}
+	return tags, nil
 }
 
-// Deallocates tag from cache. Ignore DataplaneTag == 0 which is an invalid case.
+// Deallocates tags from cache. Ignore DataplaneTag == 0 which is an invalid case.
 func (c *Controller) deallocateTagForTF(tf *crdv1beta1.Traceflow) {
 	if tf.Status.DataplaneTag != 0 {
 		c.deallocateTag(tf.Name, uint8(tf.Status.DataplaneTag))
 	}
+	if tf.Status.ReplyDataplaneTag != 0 {
+		c.deallocateTag(tf.Name, uint8(tf.Status.ReplyDataplaneTag))
+	}
 }
 
 func (c *Controller) deallocateTag(name string, tag uint8) {
@@ -16,6 +16,7 @@ package traceflow
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -145,11 +146,98 @@ func TestTraceflow(t *testing.T) {
 		assert.Equal(t, res.Status.Reason, traceflowTimeout)
 		assert.True(t, res.Status.DataplaneTag == 0)
 		assert.Equal(t, numRunningTraceflows(), 0)
+		tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "tf1", metav1.DeleteOptions{})
+	})
+
+	t.Run("roundTripTraceflow", func(t *testing.T) {
+		tf2 := tf1.DeepCopy()
+		tf2.Name = "tf2"
+		tf2.Spec.RoundTrip = true
+		tf2.Spec.Timeout = 10
+		pod1, _ := tfc.kubeClient.CoreV1().Pods("ns1").Get(context.TODO(), "pod1", metav1.GetOptions{})
+		require.NotNil(t, pod1)
+		pod1.Status.PodIPs = []corev1.PodIP{{IP: "10.10.0.1"}, {IP: "fd00::1"}}
+		tfc.kubeClient.CoreV1().Pods("ns1").UpdateStatus(context.TODO(), pod1, metav1.UpdateOptions{})
+		require.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Len(c, tfc.getPodIPs("ns1", "pod1"), 2)
+		}, time.Second, 10*time.Millisecond)
+		tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), tf2, metav1.CreateOptions{})
+		res, _ := tfc.waitForTraceflow("tf2", crdv1beta1.Running, time.Second)
+		require.NotNil(t, res)
+		// The source Pod IPs should be set by Controller for the other Nodes to match the reply packet.
+		assert.Equal(t, []string{"10.10.0.1", "fd00::1"}, res.Status.SourcePodIPs)
+		// A pair of DataplaneTags should be allocated by Controller.
+		assert.True(t, res.Status.DataplaneTag > 0)
+		assert.True(t, res.Status.ReplyDataplaneTag > 0)
+		assert.NotEqual(t, res.Status.DataplaneTag, res.Status.ReplyDataplaneTag)
+		assert.Equal(t, numRunningTraceflows(), 2)
+
+		// The Traceflow is not complete until the reply has been delivered.
+		res.Status.Results = []crdv1beta1.NodeResult{
+			{
+				Node:           "node1",
+				TimestampNanos: 1000,
+				Observations:   []crdv1beta1.Observation{{Component: crdv1beta1.ComponentSpoofGuard}},
+			},
+			{
+				Node:           "node2",
+				TimestampNanos: 2000,
+				Observations:   []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+			},
+		}
+		res, _ = tfc.client.CrdV1beta1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		time.Sleep(200 * time.Millisecond)
+		res, _ = tfc.waitForTraceflow("tf2", crdv1beta1.Running, time.Second)
+		require.NotNil(t, res)
+
+		res.Status.ReplyResults = []crdv1beta1.NodeResult{
+			{
+				Node:           "node2",
+				TimestampNanos: 3000,
+				Observations:   []crdv1beta1.Observation{{Component: crdv1beta1.ComponentForwarding, Action: crdv1beta1.ActionReceived}},
+			},
+			{
+				Node:           "node1",
+				TimestampNanos: 4500,
+				Observations:   []crdv1beta1.Observation{{Component: crdv1beta1.ComponentForwarding, Action: crdv1beta1.ActionDelivered}},
+			},
+		}
+		tfc.client.CrdV1beta1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("tf2", crdv1beta1.Succeeded, time.Second)
+		require.NotNil(t, res)
+		assert.Equal(t, int64(3500), res.Status.RoundTripTimeNanos)
+		assert.Zero(t, res.Status.DataplaneTag)
+		assert.Zero(t, res.Status.ReplyDataplaneTag)
+		assert.Equal(t, numRunningTraceflows(), 0)
 	})
 
 	close(stopCh)
 }
 
+func TestAllocateTags(t *testing.T) {
+	tfc := newController()
+	numTags := int((maxTagNum-minTagNum)/tagStep) + 1
+
+	tags, err := tfc.allocateTags("tf1", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{minTagNum, minTagNum + tagStep}, tags)
+	// Tags have already been allocated to this Traceflow.
+	tags, err = tfc.allocateTags("tf1", 2)
+	require.NoError(t, err)
+	assert.Nil(t, tags)
+
+	for i := 2; i < numTags-1; i++ {
+		_, err := tfc.allocateTags(fmt.Sprintf("tf%d", i), 1)
+		require.NoError(t, err)
+	}
+	// Only one tag is left, which is not enough for a round-trip Traceflow.
+	_, err = tfc.allocateTags("round-trip", 2)
+	assert.Error(t, err)
+	tags, err = tfc.allocateTags("one-way", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint8{maxTagNum}, tags)
+}
+
 func (tfc *traceflowController) waitForPodInNamespace(ns string, name string, timeout time.Duration) (*corev1.Pod, error) {
 	var pod *corev1.Pod
 	var err error
@@ -83,6 +83,9 @@ func (c *Controller) validate(tf *crdv1beta1.Traceflow) (allowed bool, deniedRea
 			return false, "using hostNetwork Pod as source in non-live-traffic Traceflow is not supported"
 		}
 	}
+	if tf.Spec.LiveTraffic && tf.Spec.RoundTrip {
+		return false, "round-trip is not supported in live-traffic Traceflow"
+	}
 	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" {
 		return false, fmt.Sprintf("Traceflow %s has neither source nor destination Pod specified", tf.Name)
 	}
@@ -56,6 +56,15 @@ func TestControllerValidate(t *testing.T) {
 			},
 			deniedReason: "Traceflow tf has neither source nor destination Pod specified",
 		},
+		{
+			name: "Round-trip is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				RoundTrip:   true,
+				Source:      crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+			},
+			deniedReason: "round-trip is not supported in live-traffic Traceflow",
+		},
 		{
 			name: "Assigned source pod must exist",
 			newSpec: &crdv1beta1.TraceflowSpec{
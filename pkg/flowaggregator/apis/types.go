@@ -63,11 +63,13 @@ type RecordMetricsResponse struct {
 	WithClickHouseExporter bool  `json:"withClickHouseExporter,omitempty"`
 	WithS3Exporter         bool  `json:"withS3Exporter,omitempty"`
 	WithLogExporter        bool  `json:"withLogExporter,omitempty"`
+	WithKafkaExporter      bool  `json:"withKafkaExporter,omitempty"`
+	WithPrometheusExporter bool  `json:"withPrometheusExporter,omitempty"`
 	WithIPFIXExporter      bool  `json:"withIPFIXExporter,omitempty"`
 }
 
 func (r RecordMetricsResponse) GetTableHeader() []string {
-	return []string{"RECORDS-EXPORTED", "RECORDS-RECEIVED", "RECORDS-DROPPED", "FLOWS", "EXPORTERS-CONNECTED", "CLICKHOUSE-EXPORTER", "S3-EXPORTER", "LOG-EXPORTER", "IPFIX-EXPORTER"}
+	return []string{"RECORDS-EXPORTED", "RECORDS-RECEIVED", "RECORDS-DROPPED", "FLOWS", "EXPORTERS-CONNECTED", "CLICKHOUSE-EXPORTER", "S3-EXPORTER", "LOG-EXPORTER", "KAFKA-EXPORTER", "PROMETHEUS-EXPORTER", "IPFIX-EXPORTER"}
 }
 
 func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
@@ -80,6 +82,8 @@ func (r RecordMetricsResponse) GetTableRow(maxColumnLength int) []string {
 		strconv.FormatBool(r.WithClickHouseExporter),
 		strconv.FormatBool(r.WithS3Exporter),
 		strconv.FormatBool(r.WithLogExporter),
+		strconv.FormatBool(r.WithKafkaExporter),
+		strconv.FormatBool(r.WithPrometheusExporter),
 		strconv.FormatBool(r.WithIPFIXExporter),
 	}
 }
@@ -37,6 +37,8 @@ func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
 			WithClickHouseExporter: metrics.WithClickHouseExporter,
 			WithS3Exporter:         metrics.WithS3Exporter,
 			WithLogExporter:        metrics.WithLogExporter,
+			WithKafkaExporter:      metrics.WithKafkaExporter,
+			WithPrometheusExporter: metrics.WithPrometheusExporter,
 			WithIPFIXExporter:      metrics.WithIPFIXExporter,
 		}
 		err := json.NewEncoder(w).Encode(metricsResponse)
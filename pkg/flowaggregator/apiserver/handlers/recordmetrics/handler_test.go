@@ -40,6 +40,8 @@ func TestRecordMetricsQuery(t *testing.T) {
 		WithClickHouseExporter: true,
 		WithS3Exporter:         true,
 		WithLogExporter:        true,
+		WithKafkaExporter:      true,
+		WithPrometheusExporter: true,
 		WithIPFIXExporter:      true,
 	})
 
@@ -62,9 +64,11 @@ func TestRecordMetricsQuery(t *testing.T) {
 		WithClickHouseExporter: true,
 		WithS3Exporter:         true,
 		WithLogExporter:        true,
+		WithKafkaExporter:      true,
+		WithPrometheusExporter: true,
 		WithIPFIXExporter:      true,
 	}, received)
 
-	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true"})
+	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true", "true", "true"})
 
 }
@@ -0,0 +1,352 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/klog/v2"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// kafkaCertDir is where the Secrets referenced in the Kafka TLS and SASL configuration are
+// mounted.
+const kafkaCertDir = "/etc/flow-aggregator/certs/kafka"
+
+const (
+	// kafkaFlushTimeout is the maximum amount of time we wait for buffered records to be
+	// produced when stopping the exporter.
+	kafkaFlushTimeout = 5 * time.Second
+	// kafkaClientID is used by brokers for logging and quotas.
+	kafkaClientID = "antrea-flow-aggregator"
+)
+
+// kafkaConfig is the subset of the FlowAggregator configuration which is relevant to the Kafka
+// exporter. It is used to determine whether the client needs to be re-created when the
+// configuration is updated.
+type kafkaConfig struct {
+	config       flowaggregatorconfig.KafkaConfig
+	batchTimeout time.Duration
+}
+
+type KafkaExporter struct {
+	config       kafkaConfig
+	client       *kgo.Client
+	encode       func(record *flowpb.Flow) ([]byte, error)
+	partitionKey func(record *flowpb.Flow) []byte
+	// numRecordsFailed is updated from the callbacks invoked by the Kafka client.
+	numRecordsFailed atomic.Int64
+	errLogLimiter    *rate.Limiter
+}
+
+func buildKafkaConfig(opt *options.Options) kafkaConfig {
+	return kafkaConfig{
+		config:       opt.Config.Kafka,
+		batchTimeout: opt.KafkaBatchTimeout,
+	}
+}
+
+func NewKafkaExporter(opt *options.Options) (*KafkaExporter, error) {
+	config := buildKafkaConfig(opt)
+	klog.InfoS("Kafka configuration", "brokers", config.config.Brokers, "topic", config.config.Topic, "partitionKey", config.config.PartitionKey,
+		"recordFormat", config.config.RecordFormat, "compression", config.config.Compression, "batchTimeout", config.batchTimeout,
+		"tls", config.config.TLS.Enable, "saslMechanism", config.config.SASL.Mechanism)
+	e := &KafkaExporter{
+		errLogLimiter: rate.NewLimiter(rate.Every(time.Minute), 1),
+	}
+	if err := e.setConfig(config); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// setConfig creates a new Kafka client for the provided configuration, and replaces the existing
+// one if any. The existing client is only closed if the new client was created successfully.
+func (e *KafkaExporter) setConfig(config kafkaConfig) error {
+	encode, err := kafkaRecordEncoder(config.config.RecordFormat)
+	if err != nil {
+		return err
+	}
+	partitionKey, err := kafkaPartitionKeyFunc(config.config.PartitionKey)
+	if err != nil {
+		return err
+	}
+	clientOpts, err := kafkaClientOptions(config)
+	if err != nil {
+		return err
+	}
+	// The client connects to the brokers lazily, so creating it should not fail because of
+	// connectivity issues.
+	client, err := kgo.NewClient(clientOpts...)
+	if err != nil {
+		return fmt.Errorf("error when creating Kafka client: %w", err)
+	}
+	if e.client != nil {
+		e.closeClient()
+	}
+	e.config = config
+	e.client = client
+	e.encode = encode
+	e.partitionKey = partitionKey
+	return nil
+}
+
+func kafkaClientOptions(config kafkaConfig) ([]kgo.Opt, error) {
+	compression, err := kafkaCompressionCodec(config.config.Compression)
+	if err != nil {
+		return nil, err
+	}
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(config.config.Brokers...),
+		kgo.ClientID(kafkaClientID),
+		kgo.DefaultProduceTopic(config.config.Topic),
+		kgo.ProducerLinger(config.batchTimeout),
+		kgo.ProducerBatchCompression(compression),
+		kgo.ProducerBatchMaxBytes(config.config.MaxBatchBytes),
+		kgo.MaxBufferedRecords(int(config.config.MaxBufferedRecords)),
+	}
+	if config.config.TLS.Enable {
+		tlsConfig, err := kafkaTLSConfig(config.config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+	if config.config.SASL.Mechanism != "" {
+		mechanism, err := kafkaSASLMechanism(config.config.SASL)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+	return opts, nil
+}
+
+func kafkaCompressionCodec(compression string) (kgo.CompressionCodec, error) {
+	switch compression {
+	case "none":
+		return kgo.NoCompression(), nil
+	case "gzip":
+		return kgo.GzipCompression(), nil
+	case "snappy":
+		return kgo.SnappyCompression(), nil
+	case "lz4":
+		return kgo.Lz4Compression(), nil
+	case "zstd":
+		return kgo.ZstdCompression(), nil
+	}
+	return kgo.CompressionCodec{}, fmt.Errorf("unsupported compression codec %q", compression)
+}
+
+func kafkaTLSConfig(config flowaggregatorconfig.KafkaTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: config.ServerName,
+		// config.MinVersion has already been validated during FA config validation.
+		MinVersion: options.TLSVersionOrDie(config.MinVersion),
+	}
+	if config.CASecretName != "" {
+		caPath := filepath.Join(kafkaCertDir, "ca.crt")
+		caBytes, err := afero.ReadFile(defaultFS, caPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading CA cert %q, ensure Secret %q exists in this Namespace and has the 'ca.crt' key: %w", caPath, config.CASecretName, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("error when parsing CA cert %q: no valid certificate found", caPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.ClientSecretName != "" {
+		certPath := filepath.Join(kafkaCertDir, "tls.crt")
+		certBytes, err := afero.ReadFile(defaultFS, certPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client cert %q, ensure Secret %q exists in this Namespace and has the 'tls.crt' key: %w", certPath, config.ClientSecretName, err)
+		}
+		keyPath := filepath.Join(kafkaCertDir, "tls.key")
+		keyBytes, err := afero.ReadFile(defaultFS, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading client key %q, ensure Secret %q exists in this Namespace and has the 'tls.key' key: %w", keyPath, config.ClientSecretName, err)
+		}
+		cert, err := tls.X509KeyPair(certBytes, keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error when loading client certificate and key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func kafkaSASLMechanism(config flowaggregatorconfig.KafkaSASLConfig) (sasl.Mechanism, error) {
+	readCredential := func(key string) (string, error) {
+		path := filepath.Join(kafkaCertDir, key)
+		b, err := afero.ReadFile(defaultFS, path)
+		if err != nil {
+			return "", fmt.Errorf("error when reading SASL %s %q, ensure Secret %q exists in this Namespace and has the '%s' key: %w", key, path, config.CredentialsSecretName, key, err)
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+	username, err := readCredential("username")
+	if err != nil {
+		return nil, err
+	}
+	password, err := readCredential("password")
+	if err != nil {
+		return nil, err
+	}
+	switch config.Mechanism {
+	case "PLAIN":
+		return plain.Auth{User: username, Pass: password}.AsMechanism(), nil
+	case "SCRAM-SHA-256":
+		return scram.Auth{User: username, Pass: password}.AsSha256Mechanism(), nil
+	case "SCRAM-SHA-512":
+		return scram.Auth{User: username, Pass: password}.AsSha512Mechanism(), nil
+	}
+	return nil, fmt.Errorf("unsupported SASL mechanism %q", config.Mechanism)
+}
+
+func kafkaRecordEncoder(recordFormat string) (func(record *flowpb.Flow) ([]byte, error), error) {
+	switch recordFormat {
+	case "Protobuf":
+		return func(record *flowpb.Flow) ([]byte, error) {
+			return proto.Marshal(record)
+		}, nil
+	case "JSON":
+		return func(record *flowpb.Flow) ([]byte, error) {
+			return protojson.Marshal(record)
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported record format %q", recordFormat)
+}
+
+// kafkaPartitionKeyFunc returns a function computing the key of the Kafka message for a flow
+// record. Records with the same key are always produced to the same partition. A nil key lets
+// the client distribute records evenly across partitions.
+func kafkaPartitionKeyFunc(partitionKey string) (func(record *flowpb.Flow) []byte, error) {
+	switch partitionKey {
+	case "None":
+		return func(record *flowpb.Flow) []byte {
+			return nil
+		}, nil
+	case "SourcePodNamespace":
+		return func(record *flowpb.Flow) []byte {
+			if ns := record.GetK8S().GetSourcePodNamespace(); ns != "" {
+				return []byte(ns)
+			}
+			return nil
+		}, nil
+	case "DestinationPodNamespace":
+		return func(record *flowpb.Flow) []byte {
+			if ns := record.GetK8S().GetDestinationPodNamespace(); ns != "" {
+				return []byte(ns)
+			}
+			return nil
+		}, nil
+	case "FlowKey":
+		return func(record *flowpb.Flow) []byte {
+			src := record.GetIp().GetSource()
+			dst := record.GetIp().GetDestination()
+			key := make([]byte, 0, len(src)+len(dst)+5)
+			key = append(key, src...)
+			key = append(key, dst...)
+			key = binary.BigEndian.AppendUint16(key, uint16(record.GetTransport().GetSourcePort()))
+			key = binary.BigEndian.AppendUint16(key, uint16(record.GetTransport().GetDestinationPort()))
+			key = append(key, uint8(record.GetTransport().GetProtocolNumber()))
+			return key
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported partition key %q", partitionKey)
+}
+
+func (e *KafkaExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	value, err := e.encode(record)
+	if err != nil {
+		return fmt.Errorf("error when encoding flow record for Kafka: %w", err)
+	}
+	kafkaRecord := &kgo.Record{
+		Key:   e.partitionKey(record),
+		Value: value,
+	}
+	// We do not want to block the FlowAggregator if the brokers are not reachable, so records
+	// are dropped when the buffer is full.
+	e.client.TryProduce(context.TODO(), kafkaRecord, e.produceCallback)
+	return nil
+}
+
+func (e *KafkaExporter) produceCallback(_ *kgo.Record, err error) {
+	if err == nil {
+		return
+	}
+	numRecordsFailed := e.numRecordsFailed.Add(1)
+	if e.errLogLimiter.Allow() {
+		klog.ErrorS(err, "Failed to produce flow record to Kafka", "totalFailedRecords", numRecordsFailed)
+	}
+}
+
+func (e *KafkaExporter) Start() {
+	// Nothing to do: the client was created in NewKafkaExporter and connects to the brokers
+	// when producing the first record.
+}
+
+func (e *KafkaExporter) Stop() {
+	e.closeClient()
+}
+
+func (e *KafkaExporter) closeClient() {
+	ctx, cancel := context.WithTimeout(context.Background(), kafkaFlushTimeout)
+	defer cancel()
+	if err := e.client.Flush(ctx); err != nil {
+		klog.ErrorS(err, "Error when flushing buffered flow records to Kafka")
+	}
+	e.client.Close()
+}
+
+func (e *KafkaExporter) UpdateOptions(opt *options.Options) {
+	config := buildKafkaConfig(opt)
+	if reflect.DeepEqual(config, e.config) {
+		return
+	}
+	klog.InfoS("Updating Kafka exporter")
+	if err := e.setConfig(config); err != nil {
+		klog.ErrorS(err, "Error when updating Kafka exporter config")
+		return
+	}
+	klog.InfoS("New Kafka configuration", "brokers", config.config.Brokers, "topic", config.config.Topic, "partitionKey", config.config.PartitionKey,
+		"recordFormat", config.config.RecordFormat, "compression", config.config.Compression, "batchTimeout", config.batchTimeout,
+		"tls", config.config.TLS.Enable, "saslMechanism", config.config.SASL.Mechanism)
+}
+
+func (e *KafkaExporter) Flush() error {
+	return nil
+}
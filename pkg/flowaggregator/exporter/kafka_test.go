@@ -0,0 +1,269 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
+)
+
+const testKafkaTopic = "flows"
+
+func newTestKafkaOptions(brokers []string) *options.Options {
+	opt := &options.Options{
+		Config: &flowaggregatorconfig.FlowAggregatorConfig{
+			Kafka: flowaggregatorconfig.KafkaConfig{
+				Enable:  true,
+				Brokers: brokers,
+				Topic:   testKafkaTopic,
+			},
+		},
+		KafkaBatchTimeout: 10 * time.Millisecond,
+	}
+	flowaggregatorconfig.SetConfigDefaults(opt.Config)
+	return opt
+}
+
+// consumeKafkaRecords returns the records produced to topic, failing the test if fewer than
+// numRecords records can be consumed before the timeout.
+func consumeKafkaRecords(t *testing.T, brokers []string, topic string, numRecords int, opts ...kgo.Opt) []*kgo.Record {
+	opts = append(opts, kgo.SeedBrokers(brokers...), kgo.ConsumeTopics(topic), kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()))
+	consumer, err := kgo.NewClient(opts...)
+	require.NoError(t, err)
+	defer consumer.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var records []*kgo.Record
+	for len(records) < numRecords {
+		fetches := consumer.PollFetches(ctx)
+		require.NoError(t, ctx.Err(), "timeout when consuming records, got %d records", len(records))
+		fetches.EachRecord(func(r *kgo.Record) {
+			records = append(records, r)
+		})
+	}
+	return records
+}
+
+func TestKafkaExporter(t *testing.T) {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(3, testKafkaTopic, "flows-json"))
+	require.NoError(t, err)
+	defer cluster.Close()
+	brokers := cluster.ListenAddrs()
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+
+	t.Run("protobuf", func(t *testing.T) {
+		opt := newTestKafkaOptions(brokers)
+		opt.Config.Kafka.PartitionKey = "SourcePodNamespace"
+		exp, err := NewKafkaExporter(opt)
+		require.NoError(t, err)
+		exp.Start()
+		require.NoError(t, exp.AddRecord(record, false))
+		require.NoError(t, exp.AddRecord(record, false))
+		exp.Stop()
+
+		records := consumeKafkaRecords(t, brokers, testKafkaTopic, 2)
+		require.Len(t, records, 2)
+		for _, r := range records {
+			assert.Equal(t, record.K8S.SourcePodNamespace, string(r.Key))
+			var got flowpb.Flow
+			require.NoError(t, proto.Unmarshal(r.Value, &got))
+			assert.True(t, proto.Equal(record, &got))
+		}
+		// Records with the same key are produced to the same partition.
+		assert.Equal(t, records[0].Partition, records[1].Partition)
+		assert.Zero(t, exp.numRecordsFailed.Load())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		opt := newTestKafkaOptions(brokers)
+		opt.Config.Kafka.Topic = "flows-json"
+		opt.Config.Kafka.RecordFormat = "JSON"
+		opt.Config.Kafka.Compression = "zstd"
+		exp, err := NewKafkaExporter(opt)
+		require.NoError(t, err)
+		exp.Start()
+		require.NoError(t, exp.AddRecord(record, false))
+		exp.Stop()
+
+		records := consumeKafkaRecords(t, brokers, "flows-json", 1)
+		require.Len(t, records, 1)
+		assert.Nil(t, records[0].Key)
+		var got flowpb.Flow
+		require.NoError(t, protojson.Unmarshal(records[0].Value, &got))
+		assert.True(t, proto.Equal(record, &got))
+	})
+}
+
+func TestKafkaExporter_UpdateOptions(t *testing.T) {
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(1, testKafkaTopic, "flows-new"))
+	require.NoError(t, err)
+	defer cluster.Close()
+	brokers := cluster.ListenAddrs()
+
+	opt := newTestKafkaOptions(brokers)
+	exp, err := NewKafkaExporter(opt)
+	require.NoError(t, err)
+	exp.Start()
+	defer func() { exp.Stop() }()
+	oldClient := exp.client
+
+	// Unchanged configuration: the client is not re-created.
+	exp.UpdateOptions(newTestKafkaOptions(brokers))
+	assert.Same(t, oldClient, exp.client)
+
+	newOpt := newTestKafkaOptions(brokers)
+	newOpt.Config.Kafka.Topic = "flows-new"
+	exp.UpdateOptions(newOpt)
+	assert.NotSame(t, oldClient, exp.client)
+	assert.Equal(t, "flows-new", exp.config.config.Topic)
+
+	record := flowaggregatortesting.PrepareTestFlowRecord(true)
+	require.NoError(t, exp.AddRecord(record, false))
+	records := consumeKafkaRecords(t, brokers, "flows-new", 1)
+	require.Len(t, records, 1)
+
+	// Invalid configuration: the existing client is kept.
+	invalidOpt := newTestKafkaOptions(brokers)
+	invalidOpt.Config.Kafka.SASL.Mechanism = "PLAIN"
+	invalidOpt.Config.Kafka.SASL.CredentialsSecretName = "kafka-credentials"
+	defaultFS = afero.NewMemMapFs()
+	t.Cleanup(func() { defaultFS = afero.NewOsFs() })
+	currentClient := exp.client
+	exp.UpdateOptions(invalidOpt)
+	assert.Same(t, currentClient, exp.client)
+	assert.Equal(t, "flows-new", exp.config.config.Topic)
+}
+
+func TestKafkaExporter_SASL(t *testing.T) {
+	cluster, err := kfake.NewCluster(
+		kfake.NumBrokers(1),
+		kfake.SeedTopics(1, testKafkaTopic),
+		kfake.EnableSASL(),
+		kfake.Superuser("PLAIN", "antrea", "password"),
+	)
+	require.NoError(t, err)
+	defer cluster.Close()
+	brokers := cluster.ListenAddrs()
+
+	defaultFS = afero.NewMemMapFs()
+	t.Cleanup(func() { defaultFS = afero.NewOsFs() })
+	opt := newTestKafkaOptions(brokers)
+	opt.Config.Kafka.SASL.Mechanism = "PLAIN"
+	opt.Config.Kafka.SASL.CredentialsSecretName = "kafka-credentials"
+
+	_, err = NewKafkaExporter(opt)
+	assert.ErrorContains(t, err, "ensure Secret \"kafka-credentials\" exists in this Namespace and has the 'username' key")
+
+	require.NoError(t, afero.WriteFile(defaultFS, filepath.Join(kafkaCertDir, "username"), []byte("antrea"), 0644))
+	require.NoError(t, afero.WriteFile(defaultFS, filepath.Join(kafkaCertDir, "password"), []byte("password\n"), 0644))
+	exp, err := NewKafkaExporter(opt)
+	require.NoError(t, err)
+	exp.Start()
+	require.NoError(t, exp.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	exp.Stop()
+
+	mechanism, err := kafkaSASLMechanism(opt.Config.Kafka.SASL)
+	require.NoError(t, err)
+	records := consumeKafkaRecords(t, brokers, testKafkaTopic, 1, kgo.SASL(mechanism))
+	assert.Len(t, records, 1)
+}
+
+func TestKafkaExporter_TLS(t *testing.T) {
+	serverCertPEM, serverKeyPEM := generateLocalhostCert(t, false)
+	serverCert, err := tls.X509KeyPair(serverCertPEM, serverKeyPEM)
+	require.NoError(t, err)
+	cluster, err := kfake.NewCluster(
+		kfake.NumBrokers(1),
+		kfake.SeedTopics(1, testKafkaTopic),
+		kfake.TLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			MinVersion:   tls.VersionTLS12,
+		}),
+	)
+	require.NoError(t, err)
+	defer cluster.Close()
+	brokers := cluster.ListenAddrs()
+
+	defaultFS = afero.NewMemMapFs()
+	t.Cleanup(func() { defaultFS = afero.NewOsFs() })
+	// the certificate is used as the CA
+	require.NoError(t, afero.WriteFile(defaultFS, filepath.Join(kafkaCertDir, "ca.crt"), serverCertPEM, 0644))
+	opt := newTestKafkaOptions(brokers)
+	opt.Config.Kafka.TLS.Enable = true
+	opt.Config.Kafka.TLS.CASecretName = "kafka-ca"
+	exp, err := NewKafkaExporter(opt)
+	require.NoError(t, err)
+	exp.Start()
+	require.NoError(t, exp.AddRecord(flowaggregatortesting.PrepareTestFlowRecord(true), false))
+	exp.Stop()
+
+	tlsConfig, err := kafkaTLSConfig(opt.Config.Kafka.TLS)
+	require.NoError(t, err)
+	records := consumeKafkaRecords(t, brokers, testKafkaTopic, 1, kgo.DialTLSConfig(tlsConfig))
+	assert.Len(t, records, 1)
+}
+
+func TestKafkaPartitionKey(t *testing.T) {
+	record := &flowpb.Flow{
+		Ip: &flowpb.IP{
+			Source:      []byte{10, 0, 0, 1},
+			Destination: []byte{10, 0, 0, 2},
+		},
+		Transport: &flowpb.Transport{
+			ProtocolNumber:  6,
+			SourcePort:      12345,
+			DestinationPort: 80,
+		},
+		K8S: &flowpb.Kubernetes{
+			SourcePodNamespace: "frontend",
+		},
+	}
+	testCases := []struct {
+		partitionKey string
+		expected     []byte
+	}{
+		{partitionKey: "None", expected: nil},
+		{partitionKey: "SourcePodNamespace", expected: []byte("frontend")},
+		// flows to external destinations have no destination Pod
+		{partitionKey: "DestinationPodNamespace", expected: nil},
+		{partitionKey: "FlowKey", expected: []byte{10, 0, 0, 1, 10, 0, 0, 2, 0x30, 0x39, 0, 80, 6}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.partitionKey, func(t *testing.T) {
+			fn, err := kafkaPartitionKeyFunc(tc.partitionKey)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, fn(record))
+		})
+	}
+	_, err := kafkaPartitionKeyFunc("SourceNode")
+	assert.ErrorContains(t, err, "unsupported partition key")
+}
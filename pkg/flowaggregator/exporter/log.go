@@ -15,9 +15,8 @@
 package exporter
 
 import (
-	"math"
+	"fmt"
 	"reflect"
-	"slices"
 	"sync"
 
 	"k8s.io/klog/v2"
@@ -27,16 +26,13 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/flowlogger"
 	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/util/flowfilter"
 )
 
-type flowFilter struct {
-	IngressNetworkPolicyRuleActions []uint8
-	EgressNetworkPolicyRuleActions  []uint8
-}
-
 type LogExporter struct {
 	config     flowaggregatorconfig.FlowLoggerConfig
-	filters    []flowFilter
+	filter     *flowfilter.Matcher
+	proxyMode  bool
 	flowLogger *flowlogger.FlowLogger
 	stopCh     chan struct{}
 	wg         sync.WaitGroup
@@ -44,77 +40,43 @@ type LogExporter struct {
 
 func NewLogExporter(opt *options.Options) (*LogExporter, error) {
 	config := opt.Config.FlowLogger
-	klog.InfoS("FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress, "prettyPrint", *config.PrettyPrint)
+	klog.InfoS("FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress, "recordFormat", config.RecordFormat, "prettyPrint", *config.PrettyPrint)
 	exporter := &LogExporter{
-		config: config,
+		config:    config,
+		proxyMode: opt.Config.Mode == flowaggregatorconfig.AggregatorModeProxy,
+	}
+	if err := exporter.buildFilters(); err != nil {
+		return nil, err
 	}
-	exporter.buildFilters()
 	return exporter, nil
 }
 
-func (e *LogExporter) buildFilters() {
-	ruleActionToUint8 := func(a flowaggregatorconfig.NetworkPolicyRuleAction) uint8 {
-		switch a {
-		case flowaggregatorconfig.NetworkPolicyRuleActionNone:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION)
-		case flowaggregatorconfig.NetworkPolicyRuleActionAllow:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW)
-		case flowaggregatorconfig.NetworkPolicyRuleActionDrop:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP)
-		case flowaggregatorconfig.NetworkPolicyRuleActionReject:
-			return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT)
-		default: // invalid case
-			return math.MaxUint8
-		}
-	}
-	convertFilter := func(in *flowaggregatorconfig.FlowFilter) flowFilter {
-		ingressNetworkPolicyRuleActions := make([]uint8, len(in.IngressNetworkPolicyRuleActions))
-		for idx, a := range in.IngressNetworkPolicyRuleActions {
-			ingressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
-		}
-		egressNetworkPolicyRuleActions := make([]uint8, len(in.EgressNetworkPolicyRuleActions))
-		for idx, a := range in.EgressNetworkPolicyRuleActions {
-			egressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
-		}
-		return flowFilter{
-			IngressNetworkPolicyRuleActions: ingressNetworkPolicyRuleActions,
-			EgressNetworkPolicyRuleActions:  egressNetworkPolicyRuleActions,
-		}
-	}
-	e.filters = make([]flowFilter, 0, len(e.config.Filters))
-	for idx := range e.config.Filters {
-		e.filters = append(e.filters, convertFilter(&e.config.Filters[idx]))
+func (e *LogExporter) buildFilters() error {
+	filter, err := flowfilter.NewMatcher(e.config.Filters)
+	if err != nil {
+		return fmt.Errorf("invalid FlowLogger filters: %w", err)
 	}
+	e.filter = filter
+	return nil
 }
 
 func (e *LogExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	if !e.applyFilters(record) {
+		klog.V(5).InfoS("Ignoring record in FlowLogger because filters do not match")
+		return nil
+	}
 	r, err := flowrecord.GetFlowRecord(record)
 	if err != nil {
 		return err
 	}
-	if !e.applyFilters(r) {
-		klog.V(5).InfoS("Ignoring record in FlowLogger because filters do not match")
-		return nil
-	}
 	return e.flowLogger.WriteRecord(r, *e.config.PrettyPrint)
 }
 
-func (e *LogExporter) applyFilters(r *flowrecord.FlowRecord) bool {
-	if len(e.filters) == 0 {
-		return true
-	}
-	for idx := range e.filters {
-		filter := &e.filters[idx]
-		if len(filter.IngressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.IngressNetworkPolicyRuleActions, r.IngressNetworkPolicyRuleAction) {
-			continue
-		}
-		if len(filter.EgressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.EgressNetworkPolicyRuleActions, r.EgressNetworkPolicyRuleAction) {
-			continue
-		}
-		// both conditions match
+func (e *LogExporter) applyFilters(record *flowpb.Flow) bool {
+	if e.filter.Empty() {
 		return true
 	}
-	return false
+	return e.filter.Matches(flowrecord.GetFilterFlow(record, e.proxyMode))
 }
 
 func (e *LogExporter) Start() {
@@ -134,6 +96,7 @@ func (e *LogExporter) start() {
 		int(e.config.MaxBackups),
 		int(e.config.MaxAge),
 		*e.config.Compress,
+		e.config.RecordFormat,
 	)
 	e.wg.Add(1)
 	go func() {
@@ -157,8 +120,12 @@ func (e *LogExporter) UpdateOptions(opt *options.Options) {
 	klog.InfoS("Updating FlowLogger")
 	e.stop()
 	e.config = config
-	klog.InfoS("New FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress, "prettyPrint", *config.PrettyPrint)
-	e.buildFilters()
+	klog.InfoS("New FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress, "recordFormat", config.RecordFormat, "prettyPrint", *config.PrettyPrint)
+	if err := e.buildFilters(); err != nil {
+		// The configuration is validated when it is loaded, so this should not happen.
+		klog.ErrorS(err, "Failed to build FlowLogger filters, all flows will be logged")
+		e.filter = nil
+	}
 	e.start()
 }
 
@@ -23,8 +23,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
-	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
 )
@@ -75,29 +75,34 @@ func TestLog_UpdateOptions(t *testing.T) {
 func TestLog_Filters(t *testing.T) {
 	type testRecord struct {
 		name string
-		*flowrecord.FlowRecord
+		*flowpb.Flow
 	}
 	unprotectedRec := &testRecord{
-		name:       "unprotected",
-		FlowRecord: &flowrecord.FlowRecord{},
+		name: "unprotected",
+		Flow: &flowpb.Flow{K8S: &flowpb.Kubernetes{}},
 	}
 	droppedByEgressRec := &testRecord{
 		name: "dropped-by-egress",
-		FlowRecord: &flowrecord.FlowRecord{
-			EgressNetworkPolicyRuleAction: 2,
-		},
+		Flow: &flowpb.Flow{K8S: &flowpb.Kubernetes{
+			EgressNetworkPolicyRuleAction: flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP,
+		}},
 	}
 	rejectedByIngressRec := &testRecord{
 		name: "rejected-by-ingress",
-		FlowRecord: &flowrecord.FlowRecord{
-			IngressNetworkPolicyRuleAction: 3,
-		},
+		Flow: &flowpb.Flow{K8S: &flowpb.Kubernetes{
+			IngressNetworkPolicyRuleAction: flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT,
+		}},
 	}
 	allowedByBothRec := &testRecord{
 		name: "allowed-by-both-sides",
-		FlowRecord: &flowrecord.FlowRecord{
-			IngressNetworkPolicyRuleAction: 1,
-			EgressNetworkPolicyRuleAction:  1,
+		Flow: &flowpb.Flow{
+			Transport: &flowpb.Transport{ProtocolNumber: 6, DestinationPort: 443},
+			K8S: &flowpb.Kubernetes{
+				SourcePodNamespace:             "frontend",
+				SourcePodName:                  "web",
+				IngressNetworkPolicyRuleAction: flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW,
+				EgressNetworkPolicyRuleAction:  flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW,
+			},
 		},
 	}
 	testCases := []struct {
@@ -164,6 +169,22 @@ func TestLog_Filters(t *testing.T) {
 				allowedByBothRec:     false,
 			},
 		},
+		{
+			name: "namespace and port",
+			filters: []flowaggregatorconfig.FlowFilter{
+				{
+					SourceNamespaces: []string{"frontend"},
+					Protocols:        []string{"tcp"},
+					DestinationPorts: []string{"443", "8000-8080"},
+				},
+			},
+			testRecords: map[*testRecord]bool{
+				unprotectedRec:       false,
+				droppedByEgressRec:   false,
+				rejectedByIngressRec: false,
+				allowedByBothRec:     true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -179,9 +200,10 @@ func TestLog_Filters(t *testing.T) {
 					},
 				},
 			}
-			logExporter, _ := NewLogExporter(opt)
+			logExporter, err := NewLogExporter(opt)
+			require.NoError(t, err)
 			for record, expected := range tc.testRecords {
-				assert.Equal(t, expected, logExporter.applyFilters(record.FlowRecord), "unexpected result for record %s", record.name)
+				assert.Equal(t, expected, logExporter.applyFilters(record.Flow), "unexpected result for record %s", record.name)
 			}
 		})
 	}
@@ -0,0 +1,347 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	metricNamespaceAntrea         = "antrea"
+	metricSubsystemFlowAggregator = "flow_aggregator"
+
+	// overflowLabelValue is used for all the labels of the series to which traffic is
+	// attributed once the maximum number of series has been reached. K8s object names cannot
+	// start with an underscore, so it cannot be confused with an actual Namespace or workload.
+	overflowLabelValue = "_overflow"
+	// staleSeriesGCInterval is the minimum interval between 2 consecutive removals of stale
+	// series.
+	staleSeriesGCInterval = 30 * time.Second
+)
+
+var (
+	trafficLabelNames = []string{
+		"source_namespace",
+		"source_workload_kind",
+		"source_workload",
+		"destination_namespace",
+		"destination_workload_kind",
+		"destination_workload",
+		"destination_service",
+	}
+
+	TrafficBytes = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "traffic_bytes_total",
+			Help:           "Number of bytes sent from the source workload to the destination workload.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		trafficLabelNames,
+	)
+	TrafficReverseBytes = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "traffic_reverse_bytes_total",
+			Help:           "Number of bytes sent from the destination workload back to the source workload.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		trafficLabelNames,
+	)
+	TrafficPackets = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "traffic_packets_total",
+			Help:           "Number of packets sent from the source workload to the destination workload.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		trafficLabelNames,
+	)
+	TrafficReversePackets = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "traffic_reverse_packets_total",
+			Help:           "Number of packets sent from the destination workload back to the source workload.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		trafficLabelNames,
+	)
+	TrafficConnections = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "traffic_connections_total",
+			Help:           "Number of connections initiated by the source workload to the destination workload.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		trafficLabelNames,
+	)
+	TrafficSeries = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "traffic_series",
+			Help:           "Number of label sets currently tracked for traffic metrics, including the overflow label set.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	registerTrafficMetricsOnce sync.Once
+)
+
+func registerTrafficMetrics() {
+	registerTrafficMetricsOnce.Do(func() {
+		klog.InfoS("Registering Flow Aggregator traffic metrics")
+		legacyregistry.MustRegister(
+			TrafficBytes,
+			TrafficReverseBytes,
+			TrafficPackets,
+			TrafficReversePackets,
+			TrafficConnections,
+			TrafficSeries,
+		)
+	})
+}
+
+// PodStore is the subset of objectstore.PodStore used to look up the owner of Pods.
+type PodStore interface {
+	GetPodByIPAndTime(ip string, startTime time.Time) (*corev1.Pod, bool)
+}
+
+// trafficLabels is the set of labels for a traffic series. The order of the fields must match
+// trafficLabelNames.
+type trafficLabels struct {
+	sourceNamespace         string
+	sourceWorkloadKind      string
+	sourceWorkload          string
+	destinationNamespace    string
+	destinationWorkloadKind string
+	destinationWorkload     string
+	destinationService      string
+}
+
+var overflowTrafficLabels = trafficLabels{
+	sourceNamespace:         overflowLabelValue,
+	sourceWorkloadKind:      overflowLabelValue,
+	sourceWorkload:          overflowLabelValue,
+	destinationNamespace:    overflowLabelValue,
+	destinationWorkloadKind: overflowLabelValue,
+	destinationWorkload:     overflowLabelValue,
+	destinationService:      overflowLabelValue,
+}
+
+func (l *trafficLabels) values() []string {
+	return []string{
+		l.sourceNamespace,
+		l.sourceWorkloadKind,
+		l.sourceWorkload,
+		l.destinationNamespace,
+		l.destinationWorkloadKind,
+		l.destinationWorkload,
+		l.destinationService,
+	}
+}
+
+// PrometheusExporter maintains traffic counters keyed by source and destination workloads,
+// which are exposed by the Flow Aggregator API server. In order to bound the cardinality of the
+// metrics, the number of tracked series is limited, and series which have not been updated for
+// some time are removed.
+type PrometheusExporter struct {
+	config             flowaggregatorconfig.PrometheusConfig
+	staleSeriesTimeout time.Duration
+	podStore           PodStore
+	clock              clock.Clock
+	// series stores the last update time of each tracked series.
+	series    map[trafficLabels]time.Time
+	lastGCRun time.Time
+}
+
+func NewPrometheusExporter(opt *options.Options, podStore PodStore) *PrometheusExporter {
+	return newPrometheusExporterWithClock(opt, podStore, clock.RealClock{})
+}
+
+func newPrometheusExporterWithClock(opt *options.Options, podStore PodStore, clock clock.Clock) *PrometheusExporter {
+	config := opt.Config.Prometheus
+	klog.InfoS("Prometheus exporter configuration", "maxSeries", config.MaxSeries, "staleSeriesTimeout", opt.PrometheusStaleSeriesTimeout)
+	registerTrafficMetrics()
+	return &PrometheusExporter{
+		config:             config,
+		staleSeriesTimeout: opt.PrometheusStaleSeriesTimeout,
+		podStore:           podStore,
+		clock:              clock,
+		series:             make(map[trafficLabels]time.Time),
+	}
+}
+
+func (e *PrometheusExporter) AddRecord(record *flowpb.Flow, isRecordIPv6 bool) error {
+	labels := e.getTrafficLabels(record)
+	now := e.clock.Now()
+	if _, ok := e.series[labels]; !ok && len(e.series) >= int(e.config.MaxSeries) {
+		klog.V(4).InfoS("Maximum number of traffic series reached, using overflow series", "maxSeries", e.config.MaxSeries)
+		labels = overflowTrafficLabels
+	}
+	e.series[labels] = now
+	TrafficSeries.Set(float64(len(e.series)))
+
+	values := labels.values()
+	if record.Stats != nil {
+		TrafficBytes.WithLabelValues(values...).Add(float64(record.Stats.OctetDeltaCount))
+		TrafficPackets.WithLabelValues(values...).Add(float64(record.Stats.PacketDeltaCount))
+		// The first record exported for a connection is the only one for which the delta
+		// count is equal to the total count.
+		if record.Stats.PacketTotalCount > 0 && record.Stats.PacketDeltaCount == record.Stats.PacketTotalCount {
+			TrafficConnections.WithLabelValues(values...).Inc()
+		}
+	}
+	if record.ReverseStats != nil {
+		TrafficReverseBytes.WithLabelValues(values...).Add(float64(record.ReverseStats.OctetDeltaCount))
+		TrafficReversePackets.WithLabelValues(values...).Add(float64(record.ReverseStats.PacketDeltaCount))
+	}
+	return nil
+}
+
+func (e *PrometheusExporter) getTrafficLabels(record *flowpb.Flow) trafficLabels {
+	var labels trafficLabels
+	if record.K8S == nil {
+		return labels
+	}
+	var startTime time.Time
+	if record.StartTs != nil {
+		startTime = record.StartTs.AsTime()
+	}
+	var sourceIP, destinationIP net.IP
+	if record.Ip != nil {
+		sourceIP, destinationIP = record.Ip.Source, record.Ip.Destination
+	}
+	if record.K8S.SourcePodName != "" {
+		labels.sourceNamespace = record.K8S.SourcePodNamespace
+		labels.sourceWorkloadKind, labels.sourceWorkload = e.getWorkload(sourceIP, startTime, record.K8S.SourcePodNamespace, record.K8S.SourcePodName)
+	}
+	if record.K8S.DestinationPodName != "" {
+		labels.destinationNamespace = record.K8S.DestinationPodNamespace
+		labels.destinationWorkloadKind, labels.destinationWorkload = e.getWorkload(destinationIP, startTime, record.K8S.DestinationPodNamespace, record.K8S.DestinationPodName)
+	}
+	// The Service port name has the following format: <namespace>/<name>:<port name>. We drop
+	// the port name as it is not useful for dependency graphs.
+	if servicePortName := record.K8S.DestinationServicePortName; servicePortName != "" {
+		labels.destinationService, _, _ = strings.Cut(servicePortName, ":")
+	}
+	return labels
+}
+
+// getWorkload returns the kind and name of the workload which owns the Pod. If the Pod cannot
+// be found, the Pod itself is returned as the workload.
+func (e *PrometheusExporter) getWorkload(ip net.IP, startTime time.Time, podNamespace, podName string) (string, string) {
+	if ip != nil {
+		pod, ok := e.podStore.GetPodByIPAndTime(ip.String(), startTime)
+		if ok && pod.Namespace == podNamespace && pod.Name == podName {
+			return podWorkload(pod)
+		}
+	}
+	return "Pod", podName
+}
+
+// podWorkload returns the kind and name of the top-level workload which owns the Pod, using the
+// Pod's controller reference. Pods created by a ReplicaSet which is itself managed by a
+// Deployment are attributed to the Deployment, based on the pod-template-hash label.
+func podWorkload(pod *corev1.Pod) (string, string) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "Pod", pod.Name
+	}
+	if owner.Kind == "ReplicaSet" {
+		if hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok {
+			if name, found := strings.CutSuffix(owner.Name, "-"+hash); found {
+				return "Deployment", name
+			}
+		}
+	}
+	return owner.Kind, owner.Name
+}
+
+// Flush removes the series which have not been updated for longer than the configured timeout.
+// The FlowAggregator calls this method periodically.
+func (e *PrometheusExporter) Flush() error {
+	now := e.clock.Now()
+	if now.Sub(e.lastGCRun) < staleSeriesGCInterval {
+		return nil
+	}
+	e.lastGCRun = now
+	for labels, lastUpdate := range e.series {
+		if now.Sub(lastUpdate) < e.staleSeriesTimeout {
+			continue
+		}
+		values := labels.values()
+		TrafficBytes.DeleteLabelValues(values...)
+		TrafficReverseBytes.DeleteLabelValues(values...)
+		TrafficPackets.DeleteLabelValues(values...)
+		TrafficReversePackets.DeleteLabelValues(values...)
+		TrafficConnections.DeleteLabelValues(values...)
+		delete(e.series, labels)
+	}
+	TrafficSeries.Set(float64(len(e.series)))
+	return nil
+}
+
+func (e *PrometheusExporter) Start() {
+	// Nothing to do: the metrics are served by the Flow Aggregator API server.
+}
+
+func (e *PrometheusExporter) Stop() {
+	e.reset()
+}
+
+func (e *PrometheusExporter) reset() {
+	TrafficBytes.Reset()
+	TrafficReverseBytes.Reset()
+	TrafficPackets.Reset()
+	TrafficReversePackets.Reset()
+	TrafficConnections.Reset()
+	e.series = make(map[trafficLabels]time.Time)
+	TrafficSeries.Set(0)
+}
+
+func (e *PrometheusExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.Prometheus
+	if reflect.DeepEqual(e.config, config) {
+		return
+	}
+	klog.InfoS("Updating Prometheus exporter", "maxSeries", config.MaxSeries, "staleSeriesTimeout", opt.PrometheusStaleSeriesTimeout)
+	// If the maximum number of series is reduced, the existing series are kept until they
+	// become stale.
+	e.config = config
+	e.staleSeriesTimeout = opt.PrometheusStaleSeriesTimeout
+	klog.InfoS("Updated Prometheus exporter")
+}
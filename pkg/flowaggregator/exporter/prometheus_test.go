@@ -0,0 +1,263 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+type fakePodStore map[string]*corev1.Pod
+
+func (s fakePodStore) GetPodByIPAndTime(ip string, startTime time.Time) (*corev1.Pod, bool) {
+	pod, ok := s[ip]
+	return pod, ok
+}
+
+func newTestPod(namespace, name string, labels map[string]string, owner *metav1.OwnerReference) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    labels,
+		},
+	}
+	if owner != nil {
+		pod.OwnerReferences = []metav1.OwnerReference{*owner}
+	}
+	return pod
+}
+
+func newTestTrafficRecord(sourceIP, destinationIP string, sourcePod, destinationPod *corev1.Pod, servicePortName string, packets, totalPackets uint64) *flowpb.Flow {
+	record := &flowpb.Flow{
+		Ip: &flowpb.IP{
+			Source:      netip.MustParseAddr(sourceIP).AsSlice(),
+			Destination: netip.MustParseAddr(destinationIP).AsSlice(),
+		},
+		K8S: &flowpb.Kubernetes{
+			DestinationServicePortName: servicePortName,
+		},
+		Stats: &flowpb.Stats{
+			PacketDeltaCount: packets,
+			PacketTotalCount: totalPackets,
+			OctetDeltaCount:  packets * 100,
+			OctetTotalCount:  totalPackets * 100,
+		},
+		ReverseStats: &flowpb.Stats{
+			PacketDeltaCount: packets / 2,
+			OctetDeltaCount:  packets * 50,
+		},
+	}
+	if sourcePod != nil {
+		record.K8S.SourcePodNamespace = sourcePod.Namespace
+		record.K8S.SourcePodName = sourcePod.Name
+	}
+	if destinationPod != nil {
+		record.K8S.DestinationPodNamespace = destinationPod.Namespace
+		record.K8S.DestinationPodName = destinationPod.Name
+	}
+	return record
+}
+
+func newTestPrometheusExporter(t *testing.T, podStore PodStore, maxSeries int32) (*PrometheusExporter, *clocktesting.FakeClock) {
+	opt := &options.Options{
+		Config: &flowaggregatorconfig.FlowAggregatorConfig{
+			Prometheus: flowaggregatorconfig.PrometheusConfig{
+				Enable:             true,
+				MaxSeries:          maxSeries,
+				StaleSeriesTimeout: "10m",
+			},
+		},
+		PrometheusStaleSeriesTimeout: 10 * time.Minute,
+	}
+	clock := clocktesting.NewFakeClock(time.Now())
+	exp := newPrometheusExporterWithClock(opt, podStore, clock)
+	exp.Start()
+	t.Cleanup(exp.Stop)
+	return exp, clock
+}
+
+func TestPrometheusExporter(t *testing.T) {
+	webPod := newTestPod("frontend", "web-7d4b9c-x2k4p", map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "7d4b9c"}, &metav1.OwnerReference{
+		Kind:       "ReplicaSet",
+		Name:       "web-7d4b9c",
+		Controller: ptr.To(true),
+	})
+	dbPod := newTestPod("backend", "db-0", nil, &metav1.OwnerReference{
+		Kind:       "StatefulSet",
+		Name:       "db",
+		Controller: ptr.To(true),
+	})
+	podStore := fakePodStore{"10.0.0.1": webPod, "10.0.0.2": dbPod}
+	exp, _ := newTestPrometheusExporter(t, podStore, 10)
+
+	// first record for the connection
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.2", webPod, dbPod, "backend/db:postgres", 10, 10), false))
+	// subsequent record for the same connection
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.2", webPod, dbPod, "backend/db:postgres", 20, 30), false))
+	// Pod-to-external flow, with a source Pod which is no longer in the PodStore
+	deletedPod := newTestPod("frontend", "debug", nil, nil)
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.3", "8.8.8.8", deletedPod, nil, "", 4, 4), false))
+
+	expected := `
+	# HELP antrea_flow_aggregator_traffic_bytes_total [ALPHA] Number of bytes sent from the source workload to the destination workload.
+	# TYPE antrea_flow_aggregator_traffic_bytes_total counter
+	antrea_flow_aggregator_traffic_bytes_total{destination_namespace="",destination_service="",destination_workload="",destination_workload_kind="",source_namespace="frontend",source_workload="debug",source_workload_kind="Pod"} 400
+	antrea_flow_aggregator_traffic_bytes_total{destination_namespace="backend",destination_service="backend/db",destination_workload="db",destination_workload_kind="StatefulSet",source_namespace="frontend",source_workload="web",source_workload_kind="Deployment"} 3000
+	# HELP antrea_flow_aggregator_traffic_connections_total [ALPHA] Number of connections initiated by the source workload to the destination workload.
+	# TYPE antrea_flow_aggregator_traffic_connections_total counter
+	antrea_flow_aggregator_traffic_connections_total{destination_namespace="",destination_service="",destination_workload="",destination_workload_kind="",source_namespace="frontend",source_workload="debug",source_workload_kind="Pod"} 1
+	antrea_flow_aggregator_traffic_connections_total{destination_namespace="backend",destination_service="backend/db",destination_workload="db",destination_workload_kind="StatefulSet",source_namespace="frontend",source_workload="web",source_workload_kind="Deployment"} 1
+	# HELP antrea_flow_aggregator_traffic_reverse_packets_total [ALPHA] Number of packets sent from the destination workload back to the source workload.
+	# TYPE antrea_flow_aggregator_traffic_reverse_packets_total counter
+	antrea_flow_aggregator_traffic_reverse_packets_total{destination_namespace="",destination_service="",destination_workload="",destination_workload_kind="",source_namespace="frontend",source_workload="debug",source_workload_kind="Pod"} 2
+	antrea_flow_aggregator_traffic_reverse_packets_total{destination_namespace="backend",destination_service="backend/db",destination_workload="db",destination_workload_kind="StatefulSet",source_namespace="frontend",source_workload="web",source_workload_kind="Deployment"} 15
+	# HELP antrea_flow_aggregator_traffic_series [ALPHA] Number of label sets currently tracked for traffic metrics, including the overflow label set.
+	# TYPE antrea_flow_aggregator_traffic_series gauge
+	antrea_flow_aggregator_traffic_series 2
+	`
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected),
+		"antrea_flow_aggregator_traffic_bytes_total",
+		"antrea_flow_aggregator_traffic_connections_total",
+		"antrea_flow_aggregator_traffic_reverse_packets_total",
+		"antrea_flow_aggregator_traffic_series",
+	)
+	assert.NoError(t, err)
+}
+
+func TestPrometheusExporter_MaxSeries(t *testing.T) {
+	exp, _ := newTestPrometheusExporter(t, fakePodStore{}, 1)
+	podA := newTestPod("ns", "a", nil, nil)
+	podB := newTestPod("ns", "b", nil, nil)
+	podC := newTestPod("ns", "c", nil, nil)
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.2", podA, podB, "", 1, 1), false))
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.3", podA, podC, "", 2, 2), false))
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.2", "10.0.0.3", podB, podC, "", 3, 3), false))
+	// existing series can still be updated
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.2", podA, podB, "", 4, 5), false))
+
+	expected := `
+	# HELP antrea_flow_aggregator_traffic_packets_total [ALPHA] Number of packets sent from the source workload to the destination workload.
+	# TYPE antrea_flow_aggregator_traffic_packets_total counter
+	antrea_flow_aggregator_traffic_packets_total{destination_namespace="_overflow",destination_service="_overflow",destination_workload="_overflow",destination_workload_kind="_overflow",source_namespace="_overflow",source_workload="_overflow",source_workload_kind="_overflow"} 5
+	antrea_flow_aggregator_traffic_packets_total{destination_namespace="ns",destination_service="",destination_workload="b",destination_workload_kind="Pod",source_namespace="ns",source_workload="a",source_workload_kind="Pod"} 5
+	`
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_flow_aggregator_traffic_packets_total")
+	assert.NoError(t, err)
+}
+
+func TestPrometheusExporter_StaleSeries(t *testing.T) {
+	exp, clock := newTestPrometheusExporter(t, fakePodStore{}, 10)
+	podA := newTestPod("ns", "a", nil, nil)
+	podB := newTestPod("ns", "b", nil, nil)
+	podC := newTestPod("ns", "c", nil, nil)
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.2", podA, podB, "", 1, 1), false))
+	clock.Step(6 * time.Minute)
+	require.NoError(t, exp.AddRecord(newTestTrafficRecord("10.0.0.1", "10.0.0.3", podA, podC, "", 2, 2), false))
+	require.NoError(t, exp.Flush())
+	assert.Len(t, exp.series, 2)
+
+	clock.Step(5 * time.Minute)
+	require.NoError(t, exp.Flush())
+	assert.Len(t, exp.series, 1)
+	expected := `
+	# HELP antrea_flow_aggregator_traffic_packets_total [ALPHA] Number of packets sent from the source workload to the destination workload.
+	# TYPE antrea_flow_aggregator_traffic_packets_total counter
+	antrea_flow_aggregator_traffic_packets_total{destination_namespace="ns",destination_service="",destination_workload="c",destination_workload_kind="Pod",source_namespace="ns",source_workload="a",source_workload_kind="Pod"} 2
+	# HELP antrea_flow_aggregator_traffic_series [ALPHA] Number of label sets currently tracked for traffic metrics, including the overflow label set.
+	# TYPE antrea_flow_aggregator_traffic_series gauge
+	antrea_flow_aggregator_traffic_series 1
+	`
+	err := testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected),
+		"antrea_flow_aggregator_traffic_packets_total",
+		"antrea_flow_aggregator_traffic_series",
+	)
+	assert.NoError(t, err)
+
+	// Stopping the exporter removes all the series.
+	exp.Stop()
+	assert.Empty(t, exp.series)
+}
+
+func TestPodWorkload(t *testing.T) {
+	testCases := []struct {
+		name         string
+		pod          *corev1.Pod
+		expectedKind string
+		expectedName string
+	}{
+		{
+			name:         "standalone Pod",
+			pod:          newTestPod("ns", "pod", nil, nil),
+			expectedKind: "Pod",
+			expectedName: "pod",
+		},
+		{
+			name: "Deployment",
+			pod: newTestPod("ns", "web-5f6d8-abcde", map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5f6d8"}, &metav1.OwnerReference{
+				Kind: "ReplicaSet", Name: "web-5f6d8", Controller: ptr.To(true),
+			}),
+			expectedKind: "Deployment",
+			expectedName: "web",
+		},
+		{
+			name: "standalone ReplicaSet",
+			pod: newTestPod("ns", "rs-abcde", nil, &metav1.OwnerReference{
+				Kind: "ReplicaSet", Name: "rs", Controller: ptr.To(true),
+			}),
+			expectedKind: "ReplicaSet",
+			expectedName: "rs",
+		},
+		{
+			name: "DaemonSet",
+			pod: newTestPod("ns", "agent-abcde", nil, &metav1.OwnerReference{
+				Kind: "DaemonSet", Name: "agent", Controller: ptr.To(true),
+			}),
+			expectedKind: "DaemonSet",
+			expectedName: "agent",
+		},
+		{
+			name: "non-controller owner",
+			pod: newTestPod("ns", "pod", nil, &metav1.OwnerReference{
+				Kind: "ConfigMap", Name: "cm",
+			}),
+			expectedKind: "Pod",
+			expectedName: "pod",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			kind, name := podWorkload(tc.pod)
+			assert.Equal(t, tc.expectedKind, kind)
+			assert.Equal(t, tc.expectedName, name)
+		})
+	}
+}
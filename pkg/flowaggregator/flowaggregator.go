@@ -60,6 +60,12 @@ var (
 	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
 		return exporter.NewLogExporter(opt)
 	}
+	newKafkaExporter = func(opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewKafkaExporter(opt)
+	}
+	newPrometheusExporter = func(opt *options.Options, podStore objectstore.PodStore) exporter.Interface {
+		return exporter.NewPrometheusExporter(opt, podStore)
+	}
 )
 
 type flowAggregator struct {
@@ -91,6 +97,8 @@ type flowAggregator struct {
 	clickHouseExporter          exporter.Interface
 	s3Exporter                  exporter.Interface
 	logExporter                 exporter.Interface
+	kafkaExporter               exporter.Interface
+	prometheusExporter          exporter.Interface
 	logTickerDuration           time.Duration
 	recordCh                    chan *flowpb.Flow
 	exportersMutex              sync.Mutex
@@ -189,6 +197,16 @@ func NewFlowAggregator(
 			return nil, fmt.Errorf("error when creating log export process: %v", err)
 		}
 	}
+	if opt.Config.Kafka.Enable {
+		var err error
+		fa.kafkaExporter, err = newKafkaExporter(opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating Kafka export process: %v", err)
+		}
+	}
+	if opt.Config.Prometheus.Enable {
+		fa.prometheusExporter = newPrometheusExporter(opt, podStore)
+	}
 	if opt.Config.FlowCollector.Enable {
 		fa.ipfixExporter = newIPFIXExporter(clusterUUID, clusterID, opt, registry)
 	}
@@ -286,6 +304,12 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 	if fa.logExporter != nil {
 		fa.logExporter.Start()
 	}
+	if fa.kafkaExporter != nil {
+		fa.kafkaExporter.Start()
+	}
+	if fa.prometheusExporter != nil {
+		fa.prometheusExporter.Start()
+	}
 
 	wg.Add(1)
 	go func() {
@@ -343,6 +367,12 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 		if fa.logExporter != nil {
 			fa.logExporter.Stop()
 		}
+		if fa.kafkaExporter != nil {
+			fa.kafkaExporter.Stop()
+		}
+		if fa.prometheusExporter != nil {
+			fa.prometheusExporter.Stop()
+		}
 	}()
 	switch fa.aggregatorMode {
 	case flowaggregatorconfig.AggregatorModeAggregate:
@@ -509,6 +539,16 @@ func (fa *flowAggregator) sendRecord(record *flowpb.Flow, isRecordIPv6 bool) err
 			return err
 		}
 	}
+	if fa.kafkaExporter != nil {
+		if err := fa.kafkaExporter.AddRecord(record, isRecordIPv6); err != nil {
+			return err
+		}
+	}
+	if fa.prometheusExporter != nil {
+		if err := fa.prometheusExporter.AddRecord(record, isRecordIPv6); err != nil {
+			return err
+		}
+	}
 	fa.numRecordsExported.Add(1)
 	return nil
 }
@@ -519,6 +559,12 @@ func (fa *flowAggregator) flushExporters() error {
 			return err
 		}
 	}
+	// The Prometheus exporter uses Flush to remove stale series.
+	if fa.prometheusExporter != nil {
+		if err := fa.prometheusExporter.Flush(); err != nil {
+			return err
+		}
+	}
 	// Other exporters don't leverage Flush for now, so we skip them.
 	return nil
 }
@@ -694,6 +740,8 @@ func (fa *flowAggregator) GetRecordMetrics() querier.Metrics {
 	metrics.WithClickHouseExporter = fa.clickHouseExporter != nil
 	metrics.WithS3Exporter = fa.s3Exporter != nil
 	metrics.WithLogExporter = fa.logExporter != nil
+	metrics.WithKafkaExporter = fa.kafkaExporter != nil
+	metrics.WithPrometheusExporter = fa.prometheusExporter != nil
 	metrics.WithIPFIXExporter = fa.ipfixExporter != nil
 	return metrics
 }
@@ -844,6 +892,45 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 			klog.InfoS("Disabled FlowLogger")
 		}
 	}
+	if opt.Config.Kafka.Enable {
+		if fa.kafkaExporter == nil {
+			klog.InfoS("Enabling Kafka")
+			var err error
+			fa.kafkaExporter, err = newKafkaExporter(opt)
+			if err != nil {
+				klog.ErrorS(err, "Error when creating Kafka export process")
+				return
+			}
+			fa.kafkaExporter.Start()
+			klog.InfoS("Enabled Kafka")
+		} else {
+			fa.kafkaExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.kafkaExporter != nil {
+			klog.InfoS("Disabling Kafka")
+			fa.kafkaExporter.Stop()
+			fa.kafkaExporter = nil
+			klog.InfoS("Disabled Kafka")
+		}
+	}
+	if opt.Config.Prometheus.Enable {
+		if fa.prometheusExporter == nil {
+			klog.InfoS("Enabling Prometheus exporter")
+			fa.prometheusExporter = newPrometheusExporter(opt, fa.podStore)
+			fa.prometheusExporter.Start()
+			klog.InfoS("Enabled Prometheus exporter")
+		} else {
+			fa.prometheusExporter.UpdateOptions(opt)
+		}
+	} else {
+		if fa.prometheusExporter != nil {
+			klog.InfoS("Disabling Prometheus exporter")
+			fa.prometheusExporter.Stop()
+			fa.prometheusExporter = nil
+			klog.InfoS("Disabled Prometheus exporter")
+		}
+	}
 	if opt.Config.RecordContents.PodLabels != fa.includePodLabels {
 		fa.includePodLabels = opt.Config.RecordContents.PodLabels
 		klog.InfoS("Updated recordContents.podLabels configuration", "value", fa.includePodLabels)
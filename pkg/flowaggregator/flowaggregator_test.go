@@ -50,6 +50,7 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 	"antrea.io/antrea/pkg/ipfix"
 	ipfixtesting "antrea.io/antrea/pkg/ipfix/testing"
+	"antrea.io/antrea/pkg/util/objectstore"
 	objectstoretest "antrea.io/antrea/pkg/util/objectstore/testing"
 )
 
@@ -400,21 +401,29 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 	*exportertesting.MockInterface,
 	*exportertesting.MockInterface,
 	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
+	*exportertesting.MockInterface,
 ) {
 	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
 	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockS3Exporter := exportertesting.NewMockInterface(ctrl)
 	mockLogExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockPrometheusExporter := exportertesting.NewMockInterface(ctrl)
 
 	newIPFIXExporterSaved := newIPFIXExporter
 	newClickHouseExporterSaved := newClickHouseExporter
 	newS3ExporterSaved := newS3Exporter
 	newLogExporterSaved := newLogExporter
+	newKafkaExporterSaved := newKafkaExporter
+	newPrometheusExporterSaved := newPrometheusExporter
 	t.Cleanup(func() {
 		newIPFIXExporter = newIPFIXExporterSaved
 		newClickHouseExporter = newClickHouseExporterSaved
 		newS3Exporter = newS3ExporterSaved
 		newLogExporter = newLogExporterSaved
+		newKafkaExporter = newKafkaExporterSaved
+		newPrometheusExporter = newPrometheusExporterSaved
 	})
 	newIPFIXExporter = func(clusterUUID uuid.UUID, clusterID string, opts *options.Options, registry ipfix.IPFIXRegistry) exporter.Interface {
 		if expectedClusterUUID != nil {
@@ -440,14 +449,20 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
 		return mockLogExporter, nil
 	}
+	newKafkaExporter = func(opt *options.Options) (exporter.Interface, error) {
+		return mockKafkaExporter, nil
+	}
+	newPrometheusExporter = func(opt *options.Options, podStore objectstore.PodStore) exporter.Interface {
+		return mockPrometheusExporter
+	}
 
-	return mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter
+	return mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter, mockKafkaExporter, mockPrometheusExporter
 }
 
 func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
-	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
+	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter, mockKafkaExporter, mockPrometheusExporter := mockExporters(t, ctrl, nil, nil)
 
 	t.Run("updateIPFIX", func(t *testing.T) {
 		flowAggregator := &flowAggregator{
@@ -603,6 +618,89 @@ func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
 		mockLogExporter.EXPECT().UpdateOptions(opt)
 		flowAggregator.updateFlowAggregator(opt)
 	})
+	t.Run("enableKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable:  true,
+					Brokers: []string{"kafka:9092"},
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disableKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			kafkaExporter: mockKafkaExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updateKafka", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			kafkaExporter: mockKafkaExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Kafka: flowaggregatorconfig.KafkaConfig{
+					Enable:  true,
+					Brokers: []string{"kafka:9092"},
+				},
+			},
+		}
+		mockKafkaExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("enablePrometheus", func(t *testing.T) {
+		flowAggregator := &flowAggregator{}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Prometheus: flowaggregatorconfig.PrometheusConfig{
+					Enable: true,
+				},
+			},
+		}
+		mockPrometheusExporter.EXPECT().Start()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("disablePrometheus", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			prometheusExporter: mockPrometheusExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Prometheus: flowaggregatorconfig.PrometheusConfig{
+					Enable: false,
+				},
+			},
+		}
+		mockPrometheusExporter.EXPECT().Stop()
+		flowAggregator.updateFlowAggregator(opt)
+	})
+	t.Run("updatePrometheus", func(t *testing.T) {
+		flowAggregator := &flowAggregator{
+			prometheusExporter: mockPrometheusExporter,
+		}
+		opt := &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{
+				Prometheus: flowaggregatorconfig.PrometheusConfig{
+					Enable:    true,
+					MaxSeries: 100,
+				},
+			},
+		}
+		mockPrometheusExporter.EXPECT().UpdateOptions(opt)
+		flowAggregator.updateFlowAggregator(opt)
+	})
 	t.Run("includePodLabels", func(t *testing.T) {
 		flowAggregator := &flowAggregator{}
 		require.False(t, flowAggregator.includePodLabels)
@@ -638,7 +736,7 @@ func TestFlowAggregator_Run(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockPodStore := objectstoretest.NewMockPodStore(ctrl)
 	mockPodStore.EXPECT().HasSynced().Return(true)
-	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter := mockExporters(t, ctrl, nil, nil)
+	mockIPFIXExporter, mockClickHouseExporter, mockS3Exporter, mockLogExporter, mockKafkaExporter, mockPrometheusExporter := mockExporters(t, ctrl, nil, nil)
 	mockCollector := collectortesting.NewMockInterface(ctrl)
 	mockAggregationProcess := intermediatetesting.NewMockAggregationProcess(ctrl)
 
@@ -685,6 +783,10 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockS3Exporter.EXPECT().Stop()
 	mockLogExporter.EXPECT().Start()
 	mockLogExporter.EXPECT().Stop()
+	mockKafkaExporter.EXPECT().Start()
+	mockKafkaExporter.EXPECT().Stop()
+	mockPrometheusExporter.EXPECT().Start()
+	mockPrometheusExporter.EXPECT().Stop()
 
 	// this is not really relevant; but in practice there will be one call
 	// to mockClickHouseExporter.UpdateOptions because of the hack used to
@@ -693,6 +795,8 @@ func TestFlowAggregator_Run(t *testing.T) {
 	mockClickHouseExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockS3Exporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 	mockLogExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockKafkaExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
+	mockPrometheusExporter.EXPECT().UpdateOptions(gomock.Any()).AnyTimes()
 
 	stopCh := make(chan struct{})
 	var wg sync.WaitGroup
@@ -751,6 +855,26 @@ func TestFlowAggregator_Run(t *testing.T) {
 			Enable: false,
 		},
 	})
+	enableKafkaOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Kafka: flowaggregatorconfig.KafkaConfig{
+			Enable: true,
+		},
+	})
+	disableKafkaOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Kafka: flowaggregatorconfig.KafkaConfig{
+			Enable: false,
+		},
+	})
+	enablePrometheusOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Prometheus: flowaggregatorconfig.PrometheusConfig{
+			Enable: true,
+		},
+	})
+	disablePrometheusOptions := makeOptions(&flowaggregatorconfig.FlowAggregatorConfig{
+		Prometheus: flowaggregatorconfig.PrometheusConfig{
+			Enable: false,
+		},
+	})
 
 	// we do a few operations: the main purpose is to ensure that cleanup
 	// (i.e., stopping the exporters) is done properly.
@@ -762,8 +886,12 @@ func TestFlowAggregator_Run(t *testing.T) {
 	// 6. The S3Uploader is then disabled, so we expect a call to mockS3Exporter.Stop()
 	// 7. The FlowLogger is then enabled, so we expect a call to mockLogExporter.Start()
 	// 8. The FlowLogger is then disabled, so we expect a call to mockLogExporter.Stop()
-	// 9. The IPFIXExporter is then re-enabled, so we expect a second call to mockIPFIXExporter.Start()
-	// 10. Finally, when Run() is stopped, we expect a second call to mockIPFIXExporter.Stop()
+	// 9. The KafkaExporter is then enabled, so we expect a call to mockKafkaExporter.Start()
+	// 10. The KafkaExporter is then disabled, so we expect a call to mockKafkaExporter.Stop()
+	// 11. The PrometheusExporter is then enabled, so we expect a call to mockPrometheusExporter.Start()
+	// 12. The PrometheusExporter is then disabled, so we expect a call to mockPrometheusExporter.Stop()
+	// 13. The IPFIXExporter is then re-enabled, so we expect a second call to mockIPFIXExporter.Start()
+	// 14. Finally, when Run() is stopped, we expect a second call to mockIPFIXExporter.Stop()
 	updateOptions(disableIPFIXOptions)
 	updateOptions(enableClickHouseOptions)
 	updateOptions(disableClickHouseOptions)
@@ -771,6 +899,10 @@ func TestFlowAggregator_Run(t *testing.T) {
 	updateOptions(disableS3UploaderOptions)
 	updateOptions(enableFlowLoggerOptions)
 	updateOptions(disableFlowLoggerOptions)
+	updateOptions(enableKafkaOptions)
+	updateOptions(disableKafkaOptions)
+	updateOptions(enablePrometheusOptions)
+	updateOptions(disablePrometheusOptions)
 	updateOptions(enableIPFIXOptions)
 
 	close(stopCh)
@@ -908,6 +1040,8 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockS3Exporter := exportertesting.NewMockInterface(ctrl)
 	mockLogExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+	mockPrometheusExporter := exportertesting.NewMockInterface(ctrl)
 	want := querier.Metrics{
 		NumRecordsExported:     10,
 		NumRecordsReceived:     1,
@@ -917,6 +1051,8 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 		WithClickHouseExporter: true,
 		WithS3Exporter:         true,
 		WithLogExporter:        true,
+		WithKafkaExporter:      true,
+		WithPrometheusExporter: true,
 		WithIPFIXExporter:      true,
 	}
 
@@ -926,6 +1062,8 @@ func TestFlowAggregator_GetRecordMetrics(t *testing.T) {
 		clickHouseExporter: mockClickHouseExporter,
 		s3Exporter:         mockS3Exporter,
 		logExporter:        mockLogExporter,
+		kafkaExporter:      mockKafkaExporter,
+		prometheusExporter: mockPrometheusExporter,
 		ipfixExporter:      mockIPFIXExporter,
 	}
 	fa.numRecordsExported.Store(10)
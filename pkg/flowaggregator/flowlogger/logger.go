@@ -16,6 +16,7 @@ package flowlogger
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -29,14 +30,71 @@ import (
 
 const MaxLatency = 5 * time.Second
 
+const (
+	// RecordFormatCSV writes each record as a line of comma-separated values.
+	RecordFormatCSV = "CSV"
+	// RecordFormatJSON writes each record as an indented JSON object, spanning multiple lines.
+	RecordFormatJSON = "JSON"
+	// RecordFormatNDJSON writes each record as a compact JSON object on a single line
+	// (newline-delimited JSON).
+	RecordFormatNDJSON = "NDJSON"
+)
+
 type FlowLogger struct {
 	sync.Mutex
-	logger     io.Closer
-	maxLatency time.Duration
-	writer     *bufio.Writer
+	logger       io.Closer
+	maxLatency   time.Duration
+	writer       *bufio.Writer
+	recordFormat string
+}
+
+// jsonRecord is the representation of a flow record used for the JSON and NDJSON formats. When
+// pretty printing is enabled, the fields of type any are converted to a string representation.
+type jsonRecord struct {
+	FlowStartSeconds               any    `json:"flowStartSeconds"`
+	FlowEndSeconds                 any    `json:"flowEndSeconds"`
+	SourceIP                       string `json:"sourceIP"`
+	DestinationIP                  string `json:"destinationIP"`
+	SourceTransportPort            uint16 `json:"sourceTransportPort"`
+	DestinationTransportPort       uint16 `json:"destinationTransportPort"`
+	ProtocolIdentifier             any    `json:"protocolIdentifier"`
+	FlowType                       any    `json:"flowType"`
+	SourcePodName                  string `json:"sourcePodName"`
+	SourcePodNamespace             string `json:"sourcePodNamespace"`
+	SourceNodeName                 string `json:"sourceNodeName"`
+	DestinationPodName             string `json:"destinationPodName"`
+	DestinationPodNamespace        string `json:"destinationPodNamespace"`
+	DestinationNodeName            string `json:"destinationNodeName"`
+	DestinationClusterIP           string `json:"destinationClusterIP"`
+	DestinationServicePort         uint16 `json:"destinationServicePort"`
+	DestinationServicePortName     string `json:"destinationServicePortName"`
+	IngressNetworkPolicyName       string `json:"ingressNetworkPolicyName"`
+	IngressNetworkPolicyNamespace  string `json:"ingressNetworkPolicyNamespace"`
+	IngressNetworkPolicyRuleName   string `json:"ingressNetworkPolicyRuleName"`
+	IngressNetworkPolicyRuleAction any    `json:"ingressNetworkPolicyRuleAction"`
+	IngressNetworkPolicyType       any    `json:"ingressNetworkPolicyType"`
+	EgressNetworkPolicyName        string `json:"egressNetworkPolicyName"`
+	EgressNetworkPolicyNamespace   string `json:"egressNetworkPolicyNamespace"`
+	EgressNetworkPolicyRuleName    string `json:"egressNetworkPolicyRuleName"`
+	EgressNetworkPolicyRuleAction  any    `json:"egressNetworkPolicyRuleAction"`
+	EgressNetworkPolicyType        any    `json:"egressNetworkPolicyType"`
+	EgressName                     string `json:"egressName"`
+	EgressIP                       string `json:"egressIP"`
+	EgressNodeName                 string `json:"egressNodeName"`
+	AppProtocolName                string `json:"appProtocolName"`
+	HttpVals                       string `json:"httpVals"`
+	TcpState                       string `json:"tcpState"`
+	PacketTotalCount               uint64 `json:"packetTotalCount"`
+	PacketDeltaCount               uint64 `json:"packetDeltaCount"`
+	OctetTotalCount                uint64 `json:"octetTotalCount"`
+	OctetDeltaCount                uint64 `json:"octetDeltaCount"`
+	ReversePacketTotalCount        uint64 `json:"reversePacketTotalCount"`
+	ReversePacketDeltaCount        uint64 `json:"reversePacketDeltaCount"`
+	ReverseOctetTotalCount         uint64 `json:"reverseOctetTotalCount"`
+	ReverseOctetDeltaCount         uint64 `json:"reverseOctetDeltaCount"`
 }
 
-func NewFlowLogger(path string, maxSize int, maxBackups int, maxAge int, compress bool) *FlowLogger {
+func NewFlowLogger(path string, maxSize int, maxBackups int, maxAge int, compress bool, recordFormat string) *FlowLogger {
 	logger := &lumberjack.Logger{
 		Filename:   path,
 		MaxSize:    maxSize,
@@ -45,9 +103,10 @@ func NewFlowLogger(path string, maxSize int, maxBackups int, maxAge int, compres
 		Compress:   compress,
 	}
 	return &FlowLogger{
-		logger:     logger,
-		maxLatency: MaxLatency,
-		writer:     bufio.NewWriter(logger),
+		logger:       logger,
+		maxLatency:   MaxLatency,
+		writer:       bufio.NewWriter(logger),
+		recordFormat: recordFormat,
 	}
 }
 
@@ -70,6 +129,30 @@ func (fl *FlowLogger) Close() {
 }
 
 func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool) error {
+	var str string
+	switch fl.recordFormat {
+	case RecordFormatJSON, RecordFormatNDJSON:
+		b, err := formatJSON(r, prettyPrint, fl.recordFormat == RecordFormatJSON)
+		if err != nil {
+			return err
+		}
+		str = string(b)
+	default:
+		str = formatCSV(r, prettyPrint)
+	}
+
+	fl.Lock()
+	defer fl.Unlock()
+	if _, err := io.WriteString(fl.writer, str); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(fl.writer, "\n"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func formatCSV(r *flowrecord.FlowRecord, prettyPrint bool) string {
 	var protocolID string
 	var ingressNetworkPolicyRuleAction, ingressNetworkPolicyType string
 	var egressNetworkPolicyRuleAction, egressNetworkPolicyType string
@@ -121,17 +204,68 @@ func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool) er
 		r.EgressNodeName,
 	}
 
-	str := strings.Join(fields, ",")
+	return strings.Join(fields, ",")
+}
 
-	fl.Lock()
-	defer fl.Unlock()
-	if _, err := io.WriteString(fl.writer, str); err != nil {
-		return err
+func formatJSON(r *flowrecord.FlowRecord, prettyPrint bool, indent bool) ([]byte, error) {
+	record := &jsonRecord{
+		SourceIP:                      r.SourceIP,
+		DestinationIP:                 r.DestinationIP,
+		SourceTransportPort:           r.SourceTransportPort,
+		DestinationTransportPort:      r.DestinationTransportPort,
+		SourcePodName:                 r.SourcePodName,
+		SourcePodNamespace:            r.SourcePodNamespace,
+		SourceNodeName:                r.SourceNodeName,
+		DestinationPodName:            r.DestinationPodName,
+		DestinationPodNamespace:       r.DestinationPodNamespace,
+		DestinationNodeName:           r.DestinationNodeName,
+		DestinationClusterIP:          r.DestinationClusterIP,
+		DestinationServicePort:        r.DestinationServicePort,
+		DestinationServicePortName:    r.DestinationServicePortName,
+		IngressNetworkPolicyName:      r.IngressNetworkPolicyName,
+		IngressNetworkPolicyNamespace: r.IngressNetworkPolicyNamespace,
+		IngressNetworkPolicyRuleName:  r.IngressNetworkPolicyRuleName,
+		EgressNetworkPolicyName:       r.EgressNetworkPolicyName,
+		EgressNetworkPolicyNamespace:  r.EgressNetworkPolicyNamespace,
+		EgressNetworkPolicyRuleName:   r.EgressNetworkPolicyRuleName,
+		EgressName:                    r.EgressName,
+		EgressIP:                      r.EgressIP,
+		EgressNodeName:                r.EgressNodeName,
+		AppProtocolName:               r.AppProtocolName,
+		HttpVals:                      r.HttpVals,
+		TcpState:                      r.TcpState,
+		PacketTotalCount:              r.PacketTotalCount,
+		PacketDeltaCount:              r.PacketDeltaCount,
+		OctetTotalCount:               r.OctetTotalCount,
+		OctetDeltaCount:               r.OctetDeltaCount,
+		ReversePacketTotalCount:       r.ReversePacketTotalCount,
+		ReversePacketDeltaCount:       r.ReversePacketDeltaCount,
+		ReverseOctetTotalCount:        r.ReverseOctetTotalCount,
+		ReverseOctetDeltaCount:        r.ReverseOctetDeltaCount,
 	}
-	if _, err := io.WriteString(fl.writer, "\n"); err != nil {
-		return err
+	if prettyPrint {
+		record.FlowStartSeconds = r.FlowStartSeconds.UTC().Format(time.RFC3339)
+		record.FlowEndSeconds = r.FlowEndSeconds.UTC().Format(time.RFC3339)
+		record.ProtocolIdentifier = PrettyPrintProtocolIdentifier(r.ProtocolIdentifier)
+		record.FlowType = PrettyPrintFlowType(r.FlowType)
+		record.IngressNetworkPolicyRuleAction = PrettyPrintRuleAction(r.IngressNetworkPolicyRuleAction)
+		record.IngressNetworkPolicyType = PrettyPrintPolicyType(r.IngressNetworkPolicyType)
+		record.EgressNetworkPolicyRuleAction = PrettyPrintRuleAction(r.EgressNetworkPolicyRuleAction)
+		record.EgressNetworkPolicyType = PrettyPrintPolicyType(r.EgressNetworkPolicyType)
+	} else {
+		record.FlowStartSeconds = r.FlowStartSeconds.Unix()
+		record.FlowEndSeconds = r.FlowEndSeconds.Unix()
+		record.ProtocolIdentifier = r.ProtocolIdentifier
+		record.FlowType = r.FlowType
+		record.IngressNetworkPolicyRuleAction = r.IngressNetworkPolicyRuleAction
+		record.IngressNetworkPolicyType = r.IngressNetworkPolicyType
+		record.EgressNetworkPolicyRuleAction = r.EgressNetworkPolicyRuleAction
+		record.EgressNetworkPolicyType = r.EgressNetworkPolicyType
 	}
-	return nil
+	if indent {
+		return json.MarshalIndent(record, "", "  ")
+	}
+	return json.Marshal(record)
 }
 
 func (fl *FlowLogger) Flush() error {
@@ -17,7 +17,9 @@ package flowlogger
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -52,11 +54,12 @@ func (b *buffer) String() string {
 	return b.b.String()
 }
 
-func getTestFlowLogger(maxLatency time.Duration) (*FlowLogger, *buffer) {
+func getTestFlowLogger(maxLatency time.Duration, recordFormat string) (*FlowLogger, *buffer) {
 	var b buffer
 	flowLogger := &FlowLogger{
-		maxLatency: maxLatency,
-		writer:     bufio.NewWriter(&b),
+		maxLatency:   maxLatency,
+		writer:       bufio.NewWriter(&b),
+		recordFormat: recordFormat,
 	}
 	return flowLogger, &b
 }
@@ -80,7 +83,7 @@ func TestWriteRecord(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("pretty print: %t", tc.prettyPrint), func(t *testing.T) {
-			flowLogger, b := getTestFlowLogger(MaxLatency)
+			flowLogger, b := getTestFlowLogger(MaxLatency, RecordFormatCSV)
 			err := flowLogger.WriteRecord(record, tc.prettyPrint)
 			require.NoError(t, err)
 			flowLogger.Flush()
@@ -89,8 +92,84 @@ func TestWriteRecord(t *testing.T) {
 	}
 }
 
+func TestWriteRecordJSON(t *testing.T) {
+	record := flowrecordtesting.PrepareTestFlowRecord()
+
+	testCases := []struct {
+		recordFormat string
+		prettyPrint  bool
+		expected     map[string]any
+	}{
+		{
+			recordFormat: RecordFormatNDJSON,
+			prettyPrint:  true,
+			expected: map[string]any{
+				"flowStartSeconds":               "2021-11-23T22:36:01Z",
+				"flowEndSeconds":                 "2021-11-23T22:36:13Z",
+				"sourceIP":                       "10.10.0.79",
+				"sourceTransportPort":            float64(44752),
+				"protocolIdentifier":             "TCP",
+				"flowType":                       "Invalid",
+				"sourcePodName":                  "perftest-a",
+				"ingressNetworkPolicyRuleAction": "Drop",
+				"ingressNetworkPolicyType":       "K8sNetworkPolicy",
+				"egressNetworkPolicyRuleAction":  "Invalid",
+				"tcpState":                       "TIME_WAIT",
+				"packetTotalCount":               float64(823188),
+				"reverseOctetDeltaCount":         float64(7083284),
+				"egressNodeName":                 "test-egress-node",
+			},
+		},
+		{
+			recordFormat: RecordFormatJSON,
+			prettyPrint:  false,
+			expected: map[string]any{
+				"flowStartSeconds":               float64(1637706961),
+				"flowEndSeconds":                 float64(1637706973),
+				"sourceIP":                       "10.10.0.79",
+				"sourceTransportPort":            float64(44752),
+				"protocolIdentifier":             float64(6),
+				"flowType":                       float64(11),
+				"sourcePodName":                  "perftest-a",
+				"ingressNetworkPolicyRuleAction": float64(2),
+				"ingressNetworkPolicyType":       float64(1),
+				"egressNetworkPolicyRuleAction":  float64(5),
+				"tcpState":                       "TIME_WAIT",
+				"packetTotalCount":               float64(823188),
+				"reverseOctetDeltaCount":         float64(7083284),
+				"egressNodeName":                 "test-egress-node",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s pretty print: %t", tc.recordFormat, tc.prettyPrint), func(t *testing.T) {
+			flowLogger, b := getTestFlowLogger(MaxLatency, tc.recordFormat)
+			require.NoError(t, flowLogger.WriteRecord(record, tc.prettyPrint))
+			require.NoError(t, flowLogger.WriteRecord(record, tc.prettyPrint))
+			flowLogger.Flush()
+			output := b.String()
+			if tc.recordFormat == RecordFormatNDJSON {
+				// exactly one line per record
+				assert.Equal(t, 2, strings.Count(output, "\n"))
+			} else {
+				assert.Greater(t, strings.Count(output, "\n"), 2)
+			}
+			decoder := json.NewDecoder(strings.NewReader(output))
+			for range 2 {
+				var got map[string]any
+				require.NoError(t, decoder.Decode(&got))
+				for k, v := range tc.expected {
+					assert.Equal(t, v, got[k], "unexpected value for %s", k)
+				}
+			}
+			assert.False(t, decoder.More())
+		})
+	}
+}
+
 func TestFlushLoop(t *testing.T) {
-	flowLogger, b := getTestFlowLogger(100 * time.Millisecond)
+	flowLogger, b := getTestFlowLogger(100*time.Millisecond, RecordFormatCSV)
 	record := flowrecordtesting.PrepareTestFlowRecord()
 	err := flowLogger.WriteRecord(record, false)
 	require.NoError(t, err)
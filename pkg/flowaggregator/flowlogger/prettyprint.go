@@ -53,3 +53,20 @@ func PrettyPrintPolicyType(policyType uint8) string {
 func PrettyPrintProtocolIdentifier(protocolID uint8) string {
 	return ip.IPProtocolNumberToString(protocolID, "Unknown Protocol")
 }
+
+func PrettyPrintFlowType(flowType uint8) string {
+	switch flowType {
+	case 0:
+		return ""
+	case registry.FlowTypeIntraNode:
+		return "IntraNode"
+	case registry.FlowTypeInterNode:
+		return "InterNode"
+	case registry.FlowTypeToExternal:
+		return "ToExternal"
+	case registry.FlowTypeFromExternal:
+		return "FromExternal"
+	default:
+		return "Invalid"
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowrecord
+
+import (
+	"net/netip"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/util/flowfilter"
+)
+
+// GetFilterFlow converts a flow record to the representation used to evaluate flow filters. The direction of the flow
+// is only available when the Flow Aggregator runs in Proxy mode, in which case withDirection should be true.
+func GetFilterFlow(record *flowpb.Flow, withDirection bool) *flowfilter.Flow {
+	flow := &flowfilter.Flow{}
+	if record.Ip != nil {
+		flow.SourceIP, _ = netip.AddrFromSlice(record.Ip.Source)
+		flow.DestinationIP, _ = netip.AddrFromSlice(record.Ip.Destination)
+	}
+	if record.Transport != nil {
+		flow.Protocol = uint8(record.Transport.ProtocolNumber)
+		flow.SourcePort = uint16(record.Transport.SourcePort)
+		flow.DestinationPort = uint16(record.Transport.DestinationPort)
+	}
+	if k8s := record.K8S; k8s != nil {
+		flow.SourcePodNamespace = k8s.SourcePodNamespace
+		flow.SourcePodName = k8s.SourcePodName
+		if k8s.SourcePodLabels != nil {
+			flow.SourcePodLabels = labels.Set(k8s.SourcePodLabels.Labels)
+		}
+		flow.DestinationPodNamespace = k8s.DestinationPodNamespace
+		flow.DestinationPodName = k8s.DestinationPodName
+		if k8s.DestinationPodLabels != nil {
+			flow.DestinationPodLabels = labels.Set(k8s.DestinationPodLabels.Labels)
+		}
+		flow.DestinationServicePortName = k8s.DestinationServicePortName
+		flow.IngressNetworkPolicyRuleAction = uint8(k8s.IngressNetworkPolicyRuleAction)
+		flow.EgressNetworkPolicyRuleAction = uint8(k8s.EgressNetworkPolicyRuleAction)
+		flow.FlowType = uint8(k8s.FlowType)
+	}
+	if withDirection {
+		switch record.FlowDirection {
+		case flowpb.FlowDirection_FLOW_DIRECTION_INGRESS:
+			flow.Direction = flowfilter.FlowDirectionIngress
+		case flowpb.FlowDirection_FLOW_DIRECTION_EGRESS:
+			flow.Direction = flowfilter.FlowDirectionEgress
+		}
+	}
+	return flow
+}
@@ -23,6 +23,7 @@ import (
 
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/util/flowexport"
+	"antrea.io/antrea/pkg/util/flowfilter"
 	"antrea.io/antrea/pkg/util/yaml"
 )
 
@@ -47,6 +48,10 @@ type Options struct {
 	ClickHouseCommitInterval time.Duration
 	// Flow records batch upload interval from flow aggregator to S3 bucket
 	S3UploadInterval time.Duration
+	// Maximum amount of time to wait for a batch of flow records to fill up before producing it to Kafka
+	KafkaBatchTimeout time.Duration
+	// Amount of time after which a Prometheus traffic series which has not been updated is removed
+	PrometheusStaleSeriesTimeout time.Duration
 }
 
 func LoadConfig(configBytes []byte) (*Options, error) {
@@ -62,7 +67,10 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if opt.Config.S3Uploader.Enable && opt.Config.S3Uploader.BucketName == "" {
 		return nil, fmt.Errorf("s3Uploader enabled without specifying bucket name")
 	}
-	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable {
+	if opt.Config.Kafka.Enable && len(opt.Config.Kafka.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka enabled without providing brokers")
+	}
+	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable && !opt.Config.Kafka.Enable && !opt.Config.Prometheus.Enable {
 		klog.InfoS("No collector / sink has been configured, so no flow data will be exported")
 	}
 	// Validate common parameters
@@ -71,7 +79,7 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	}
 	opt.AggregatorMode = opt.Config.Mode
 	if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeProxy {
-		if opt.Config.ClickHouse.Enable || opt.Config.S3Uploader.Enable || opt.Config.FlowLogger.Enable {
+		if opt.Config.ClickHouse.Enable || opt.Config.S3Uploader.Enable || opt.Config.FlowLogger.Enable || opt.Config.Kafka.Enable || opt.Config.Prometheus.Enable {
 			return nil, fmt.Errorf("only flow collector is supported in Proxy mode")
 		}
 	}
@@ -155,9 +163,71 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	}
 	// Validate FlowLogger specific parameters
 	if opt.Config.FlowLogger.Enable {
-		if opt.Config.FlowLogger.RecordFormat != "CSV" {
+		switch opt.Config.FlowLogger.RecordFormat {
+		case "CSV", "JSON", "NDJSON":
+		default:
 			return nil, fmt.Errorf("record format %s is not supported", opt.Config.FlowLogger.RecordFormat)
 		}
+		if _, err := flowfilter.NewMatcher(opt.Config.FlowLogger.Filters); err != nil {
+			return nil, fmt.Errorf("invalid FlowLogger filters: %w", err)
+		}
+	}
+	// Validate Kafka specific parameters
+	if opt.Config.Kafka.Enable {
+		kafkaConfig := &opt.Config.Kafka
+		if kafkaConfig.RecordFormat != "Protobuf" && kafkaConfig.RecordFormat != "JSON" {
+			return nil, fmt.Errorf("record format %s is not supported", kafkaConfig.RecordFormat)
+		}
+		switch kafkaConfig.PartitionKey {
+		case "None", "SourcePodNamespace", "DestinationPodNamespace", "FlowKey":
+		default:
+			return nil, fmt.Errorf("partition key %s is not supported", kafkaConfig.PartitionKey)
+		}
+		switch kafkaConfig.Compression {
+		case "none", "gzip", "snappy", "lz4", "zstd":
+		default:
+			return nil, fmt.Errorf("compression codec %s is not supported", kafkaConfig.Compression)
+		}
+		opt.KafkaBatchTimeout, err = time.ParseDuration(kafkaConfig.BatchTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("batchTimeout is not a valid duration: %w", err)
+		}
+		if opt.KafkaBatchTimeout < 0 {
+			return nil, fmt.Errorf("batchTimeout cannot be a negative duration")
+		}
+		if kafkaConfig.MaxBatchBytes < 0 {
+			return nil, fmt.Errorf("maxBatchBytes cannot be negative")
+		}
+		if kafkaConfig.MaxBufferedRecords < 0 {
+			return nil, fmt.Errorf("maxBufferedRecords cannot be negative")
+		}
+		if kafkaConfig.TLS.Enable {
+			if _, err := TLSVersion(kafkaConfig.TLS.MinVersion); err != nil {
+				return nil, err
+			}
+		}
+		switch kafkaConfig.SASL.Mechanism {
+		case "":
+		case "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+			if kafkaConfig.SASL.CredentialsSecretName == "" {
+				return nil, fmt.Errorf("SASL mechanism %s requires credentialsSecretName", kafkaConfig.SASL.Mechanism)
+			}
+		default:
+			return nil, fmt.Errorf("SASL mechanism %s is not supported", kafkaConfig.SASL.Mechanism)
+		}
+	}
+	// Validate Prometheus specific parameters
+	if opt.Config.Prometheus.Enable {
+		if opt.Config.Prometheus.MaxSeries < 0 {
+			return nil, fmt.Errorf("maxSeries cannot be negative")
+		}
+		opt.PrometheusStaleSeriesTimeout, err = time.ParseDuration(opt.Config.Prometheus.StaleSeriesTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("staleSeriesTimeout is not a valid duration: %w", err)
+		}
+		if opt.PrometheusStaleSeriesTimeout <= 0 {
+			return nil, fmt.Errorf("staleSeriesTimeout must be a positive duration")
+		}
 	}
 	return &opt, nil
 }
@@ -27,6 +27,8 @@ type Metrics struct {
 	WithClickHouseExporter bool
 	WithS3Exporter         bool
 	WithLogExporter        bool
+	WithKafkaExporter      bool
+	WithPrometheusExporter bool
 	WithIPFIXExporter      bool
 }
 
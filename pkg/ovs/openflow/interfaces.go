@@ -343,9 +343,11 @@ type Group interface {
 	ResetBuckets() Group
 	Bucket() BucketBuilder
 	GetID() GroupIDType
+	HashSelectionMethod(basis uint64) Group
 }
 
 type BucketBuilder interface {
+	BucketID(id uint32) BucketBuilder
 	Weight(val uint16) BucketBuilder
 	LoadXXReg(regID int, data []byte) BucketBuilder
 	LoadToRegField(field *RegField, data uint32) BucketBuilder
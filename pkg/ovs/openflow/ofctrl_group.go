@@ -15,6 +15,8 @@
 package openflow
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"net"
 
@@ -27,9 +29,110 @@ var (
 	MaxBucketsPerMessage = 700
 )
 
+// MaxBucketID is the maximum ID of a bucket, the greater IDs are reserved by OpenFlow.
+const MaxBucketID uint32 = 0xffffff00
+
+const (
+	// ntrVendorID and ntrSelectionMethodType identify the experimenter group property used by OVS to specify the
+	// selection method of a select group.
+	ntrVendorID            uint32 = 0x0000154d
+	ntrSelectionMethodType uint32 = 1
+	// ntrMaxSelectionMethodLen is the size of the field storing the null-terminated name of the selection method.
+	ntrMaxSelectionMethodLen = 16
+	// ntrSelectionMethodHeaderLen is the length of the selection method property without the fields.
+	ntrSelectionMethodHeaderLen = 40
+	groupPropTypeExperimenter   = 0xffff
+)
+
+// hashSelectionFields are the fields hashed by the "hash" selection method. The fields whose prerequisites are not met
+// by a packet are ignored by OVS, so the same list can be used for all the IP and transport protocols.
+var hashSelectionFields = []struct {
+	field  uint8
+	length uint8
+}{
+	{openflow15.OXM_FIELD_IPV4_SRC, 4},
+	{openflow15.OXM_FIELD_IPV4_DST, 4},
+	{openflow15.OXM_FIELD_IPV6_SRC, 16},
+	{openflow15.OXM_FIELD_IPV6_DST, 16},
+	{openflow15.OXM_FIELD_IP_PROTO, 1},
+	{openflow15.OXM_FIELD_TCP_SRC, 2},
+	{openflow15.OXM_FIELD_TCP_DST, 2},
+	{openflow15.OXM_FIELD_UDP_SRC, 2},
+	{openflow15.OXM_FIELD_UDP_DST, 2},
+	{openflow15.OXM_FIELD_SCTP_SRC, 2},
+	{openflow15.OXM_FIELD_SCTP_DST, 2},
+}
+
+// groupPropSelectionMethod is the OVS extension group property which specifies the method used to select a bucket of
+// a select group, and the fields used by the method.
+type groupPropSelectionMethod struct {
+	Method string
+	Param  uint64
+	// Fields are the OXM headers of the fields used by the selection method.
+	Fields []uint32
+}
+
+// Len returns the length of the property, including the padding to a multiple of 8 bytes.
+func (p *groupPropSelectionMethod) Len() uint16 {
+	return (p.unpaddedLen() + 7) / 8 * 8
+}
+
+func (p *groupPropSelectionMethod) unpaddedLen() uint16 {
+	return uint16(ntrSelectionMethodHeaderLen + 4*len(p.Fields))
+}
+
+func (p *groupPropSelectionMethod) MarshalBinary() ([]byte, error) {
+	if len(p.Method) >= ntrMaxSelectionMethodLen {
+		return nil, fmt.Errorf("selection method name %s is too long", p.Method)
+	}
+	data := make([]byte, p.Len())
+	binary.BigEndian.PutUint16(data[0:], groupPropTypeExperimenter)
+	// The length doesn't include the padding.
+	binary.BigEndian.PutUint16(data[2:], p.unpaddedLen())
+	binary.BigEndian.PutUint32(data[4:], ntrVendorID)
+	binary.BigEndian.PutUint32(data[8:], ntrSelectionMethodType)
+	copy(data[16:16+ntrMaxSelectionMethodLen], p.Method)
+	binary.BigEndian.PutUint64(data[32:], p.Param)
+	for i, field := range p.Fields {
+		binary.BigEndian.PutUint32(data[ntrSelectionMethodHeaderLen+4*i:], field)
+	}
+	return data, nil
+}
+
+func (p *groupPropSelectionMethod) UnmarshalBinary(data []byte) error {
+	if len(data) < ntrSelectionMethodHeaderLen {
+		return fmt.Errorf("selection method property is too short: %d bytes", len(data))
+	}
+	if binary.BigEndian.Uint16(data[0:]) != groupPropTypeExperimenter || binary.BigEndian.Uint32(data[4:]) != ntrVendorID ||
+		binary.BigEndian.Uint32(data[8:]) != ntrSelectionMethodType {
+		return fmt.Errorf("not a selection method property")
+	}
+	length := int(binary.BigEndian.Uint16(data[2:]))
+	if length < ntrSelectionMethodHeaderLen || length > len(data) || (length-ntrSelectionMethodHeaderLen)%4 != 0 {
+		return fmt.Errorf("invalid selection method property length %d", length)
+	}
+	method := data[16 : 16+ntrMaxSelectionMethodLen]
+	if i := bytes.IndexByte(method, 0); i >= 0 {
+		method = method[:i]
+	}
+	p.Method = string(method)
+	p.Param = binary.BigEndian.Uint64(data[32:])
+	p.Fields = nil
+	for i := ntrSelectionMethodHeaderLen; i < length; i += 4 {
+		p.Fields = append(p.Fields, binary.BigEndian.Uint32(data[i:]))
+	}
+	return nil
+}
+
+func oxmHeader(class uint16, field uint8, length uint8) uint32 {
+	return uint32(class)<<16 | uint32(field)<<9 | uint32(length)
+}
+
 type ofGroup struct {
 	ofctrl *ofctrl.Group
 	bridge *OFBridge
+	// selectionMethod is the selection method of the group. The default selection method of OVS is used if it is nil.
+	selectionMethod *groupPropSelectionMethod
 }
 
 // Reset updates ofctrl.Group.Switch with the updated ofSwitch.
@@ -98,7 +201,12 @@ func (g *ofGroup) GetBundleMessages(entryOper OFOperation) ([]ofctrl.OpenFlowMod
 			Buckets:   g.ofctrl.Buckets[start:end],
 		}
 
-		messages = append(messages, groupMessage.GetBundleMessage(operation))
+		message := groupMessage.GetBundleMessage(operation)
+		if start == 0 && g.selectionMethod != nil && entryOper != DeleteMessage {
+			groupMod := message.GetMessage().(*openflow15.GroupMod)
+			groupMod.Properties = append(groupMod.Properties, g.selectionMethod)
+		}
+		messages = append(messages, message)
 	}
 	return messages, nil
 }
@@ -112,6 +220,21 @@ func (g *ofGroup) GetID() GroupIDType {
 	return GroupIDType(g.ofctrl.ID)
 }
 
+// HashSelectionMethod makes the group select buckets with the "hash" selection method of OVS, which hashes the IP
+// addresses, the IP protocol and the transport ports of packets with the given basis, and selects the bucket with the
+// highest random weight computed from this hash, the bucket IDs and the bucket weights. Unlike the default selection
+// method, the selected bucket only depends on these fields and on the buckets, and is therefore the same on all the
+// bridges which install the same group. Adding or removing a bucket only changes the selected bucket of the packets
+// which select the added bucket or selected the removed bucket.
+func (g *ofGroup) HashSelectionMethod(basis uint64) Group {
+	prop := &groupPropSelectionMethod{Method: "hash", Param: basis}
+	for _, f := range hashSelectionFields {
+		prop.Fields = append(prop.Fields, oxmHeader(openflow15.OXM_CLASS_OPENFLOW_BASIC, f.field, f.length))
+	}
+	g.selectionMethod = prop
+	return g
+}
+
 type bucketBuilder struct {
 	group  *ofGroup
 	bucket *openflow15.Bucket
@@ -154,6 +277,13 @@ func (b *bucketBuilder) SetTunnelDst(addr net.IP) BucketBuilder {
 	return b
 }
 
+// BucketID overrides the ID of a bucket, which is the index of the bucket in the group by default. The IDs of the
+// buckets must be unique in the group, and must not be greater than MaxBucketID.
+func (b *bucketBuilder) BucketID(id uint32) BucketBuilder {
+	b.bucket.BucketId = id
+	return b
+}
+
 // Weight sets the weight of a bucket.
 func (b *bucketBuilder) Weight(val uint16) BucketBuilder {
 	weight := openflow15.NewGroupBucketPropWeight(val)
@@ -165,3 +165,51 @@ func TestBucketBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestHashSelectionMethod(t *testing.T) {
+	g := &ofGroup{ofctrl: &ofctrl.Group{GroupType: ofctrl.GroupSelect}}
+	group := g.HashSelectionMethod(0)
+	for i := 0; i < MaxBucketsPerMessage+1; i++ {
+		group = group.Bucket().Weight(100).ResubmitToTable(tableID1).Done()
+	}
+
+	msgs, err := group.GetBundleMessages(AddMessage)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	groupMod := msgs[0].GetMessage().(*openflow15.GroupMod)
+	require.Len(t, groupMod.Properties, 1)
+	assert.Contains(t, GroupModToString(groupMod), "type=select,selection_method=hash,bucket=bucket_id:0")
+	// The selection method is only set in the first message, the other messages are used to insert buckets.
+	assert.Empty(t, msgs[1].GetMessage().(*openflow15.GroupMod).Properties)
+
+	data, err := groupMod.Properties[0].MarshalBinary()
+	require.NoError(t, err)
+	// 40 bytes for the header, and 4 bytes for each of the 11 fields, padded to a multiple of 8 bytes.
+	require.Len(t, data, 88)
+	assert.Equal(t, []byte{0xff, 0xff, 0x00, 0x54, 0x00, 0x00, 0x15, 0x4d, 0x00, 0x00, 0x00, 0x01}, data[:12])
+	assert.Equal(t, []byte("hash"), data[16:20])
+	// The first field is ipv4_src.
+	assert.Equal(t, []byte{0x80, 0x00, 0x16, 0x04}, data[40:44])
+
+	prop := &groupPropSelectionMethod{}
+	require.NoError(t, prop.UnmarshalBinary(data))
+	assert.Equal(t, groupMod.Properties[0], prop)
+
+	msgs, err = group.GetBundleMessages(DeleteMessage)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Empty(t, msgs[0].GetMessage().(*openflow15.GroupMod).Properties)
+}
+
+func TestBucketID(t *testing.T) {
+	g := &ofGroup{ofctrl: &ofctrl.Group{GroupType: ofctrl.GroupSelect}}
+	group := g.Bucket().Weight(100).ResubmitToTable(tableID1).Done()
+	group = group.Bucket().BucketID(MaxBucketID).Weight(100).ResubmitToTable(tableID1).Done()
+
+	msgs, err := group.GetBundleMessages(AddMessage)
+	require.NoError(t, err)
+	groupMod := msgs[0].GetMessage().(*openflow15.GroupMod)
+	require.Len(t, groupMod.Buckets, 2)
+	assert.Equal(t, uint32(0), groupMod.Buckets[0].BucketId)
+	assert.Equal(t, MaxBucketID, groupMod.Buckets[1].BucketId)
+}
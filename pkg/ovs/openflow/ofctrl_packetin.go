@@ -79,6 +79,18 @@ func GetTCPDNSData(tcpPkt *protocol.TCP) (data []byte, length int, err error) {
 	return dnsData, int(dnsDataLen), nil
 }
 
+// GetTCPPayload returns the payload of a TCP segment, i.e. the data after the TCP options.
+func GetTCPPayload(tcpPkt *protocol.TCP) ([]byte, error) {
+	if tcpPkt.HdrLen < tcpStandardHdrLen {
+		return nil, fmt.Errorf("invalid TCP header length %d", tcpPkt.HdrLen)
+	}
+	tcpOptionsLen := int(tcpPkt.HdrLen-tcpStandardHdrLen) * 4
+	if tcpOptionsLen > len(tcpPkt.Data) {
+		return nil, fmt.Errorf("TCP options length %d exceeds TCP data length %d", tcpOptionsLen, len(tcpPkt.Data))
+	}
+	return tcpPkt.Data[tcpOptionsLen:], nil
+}
+
 func GetUDPHeaderData(ipPkt util.Message) (udpSrcPort, udpDstPort uint16, err error) {
 	var udpIn *protocol.UDP
 	switch typedIPPkt := ipPkt.(type) {
@@ -288,3 +288,55 @@ func TestGetTCPDNSData(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTCPPayload(t *testing.T) {
+	tests := []struct {
+		name          string
+		tcp           protocol.TCP
+		expectErr     string
+		expectPayload []byte
+	}{
+		{
+			name: "invalid header length",
+			tcp: protocol.TCP{
+				HdrLen: 4,
+			},
+			expectErr: "invalid TCP header length 4",
+		},
+		{
+			name: "truncated options",
+			tcp: protocol.TCP{
+				HdrLen: 7,
+				Data:   []byte{1, 2, 3, 4, 0},
+			},
+			expectErr: "TCP options length 8 exceeds TCP data length 5",
+		},
+		{
+			name: "with options",
+			tcp: protocol.TCP{
+				HdrLen: 6,
+				Data:   []byte{1, 2, 3, 4, 0, 1, 5},
+			},
+			expectPayload: []byte{0, 1, 5},
+		},
+		{
+			name: "without payload",
+			tcp: protocol.TCP{
+				HdrLen: 5,
+				Data:   []byte{},
+			},
+			expectPayload: []byte{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := GetTCPPayload(&tt.tcp)
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectPayload, payload)
+		})
+	}
+}
@@ -2262,6 +2262,20 @@ func (mr *MockGroupMockRecorder) GetID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetID", reflect.TypeOf((*MockGroup)(nil).GetID))
 }
 
+// HashSelectionMethod mocks base method.
+func (m *MockGroup) HashSelectionMethod(basis uint64) openflow.Group {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HashSelectionMethod", basis)
+	ret0, _ := ret[0].(openflow.Group)
+	return ret0
+}
+
+// HashSelectionMethod indicates an expected call of HashSelectionMethod.
+func (mr *MockGroupMockRecorder) HashSelectionMethod(basis any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashSelectionMethod", reflect.TypeOf((*MockGroup)(nil).HashSelectionMethod), basis)
+}
+
 // Modify mocks base method.
 func (m *MockGroup) Modify() error {
 	m.ctrl.T.Helper()
@@ -2340,6 +2354,20 @@ func (m *MockBucketBuilder) EXPECT() *MockBucketBuilderMockRecorder {
 	return m.recorder
 }
 
+// BucketID mocks base method.
+func (m *MockBucketBuilder) BucketID(id uint32) openflow.BucketBuilder {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BucketID", id)
+	ret0, _ := ret[0].(openflow.BucketBuilder)
+	return ret0
+}
+
+// BucketID indicates an expected call of BucketID.
+func (mr *MockBucketBuilderMockRecorder) BucketID(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BucketID", reflect.TypeOf((*MockBucketBuilder)(nil).BucketID), id)
+}
+
 // Done mocks base method.
 func (m *MockBucketBuilder) Done() openflow.Group {
 	m.ctrl.T.Helper()
@@ -641,7 +641,8 @@ func nxActionConnTrackToString(action openflow15.Action) string {
 	if a.Flags&openflow15.NX_CT_F_COMMIT == openflow15.NX_CT_F_COMMIT {
 		parts = append(parts, "commit")
 	}
-	if a.RecircTable != 0 {
+	// The packet is not recirculated if the table is LastTableID (NX_CT_RECIRC_NONE).
+	if a.RecircTable != 0 && a.RecircTable != LastTableID {
 		if tableName, ok := TableNameCache[a.RecircTable]; ok {
 			parts = append(parts, fmt.Sprintf("table=%s", tableName))
 		} else {
@@ -1249,6 +1250,11 @@ func GroupModToString(groupMod *openflow15.GroupMod) string {
 	case openflow15.GT_SELECT:
 		parts = append(parts, "type=select")
 	}
+	for _, property := range groupMod.Properties {
+		if p, ok := property.(*groupPropSelectionMethod); ok {
+			parts = append(parts, fmt.Sprintf("selection_method=%s", p.Method))
+		}
+	}
 	if len(groupMod.Buckets) != 0 {
 		for _, bucket := range groupMod.Buckets {
 			bucketStr := fmt.Sprintf("bucket=bucket_id:%d", bucket.BucketId)
@@ -71,6 +71,8 @@ type ControllerNetworkPolicyInfoQuerier interface {
 type EgressQuerier interface {
 	GetEgressIPByMark(mark uint32) (string, error)
 	GetEgress(podNamespace, podName string) (types.EgressConfig, error)
+	// GetEgressOFPorts returns the ofPorts of the local Pods to which each Egress is effectively applied.
+	GetEgressOFPorts() []types.EgressOFPorts
 }
 
 // GetSelfPod gets current pod.
@@ -158,4 +160,6 @@ type AgentBGPPolicyInfoQuerier interface {
 	GetBGPPeerStatus(ctx context.Context) ([]bgp.PeerStatus, error)
 	// GetBGPRoutes returns the advertised BGP routes.
 	GetBGPRoutes(ctx context.Context) (map[bgp.Route]bgpcontroller.RouteMetadata, error)
+	// GetImportedBGPRoutes returns the routes received from BGP peers and installed on the Node.
+	GetImportedBGPRoutes(ctx context.Context) ([]bgp.ReceivedRoute, error)
 }
@@ -352,6 +352,20 @@ func (mr *MockEgressQuerierMockRecorder) GetEgressIPByMark(mark any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressIPByMark", reflect.TypeOf((*MockEgressQuerier)(nil).GetEgressIPByMark), mark)
 }
 
+// GetEgressOFPorts mocks base method.
+func (m *MockEgressQuerier) GetEgressOFPorts() []types.EgressOFPorts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgressOFPorts")
+	ret0, _ := ret[0].([]types.EgressOFPorts)
+	return ret0
+}
+
+// GetEgressOFPorts indicates an expected call of GetEgressOFPorts.
+func (mr *MockEgressQuerierMockRecorder) GetEgressOFPorts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressOFPorts", reflect.TypeOf((*MockEgressQuerier)(nil).GetEgressOFPorts))
+}
+
 // MockAgentBGPPolicyInfoQuerier is a mock of AgentBGPPolicyInfoQuerier interface.
 type MockAgentBGPPolicyInfoQuerier struct {
 	ctrl     *gomock.Controller
@@ -422,3 +436,18 @@ func (mr *MockAgentBGPPolicyInfoQuerierMockRecorder) GetBGPRoutes(ctx any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBGPRoutes", reflect.TypeOf((*MockAgentBGPPolicyInfoQuerier)(nil).GetBGPRoutes), ctx)
 }
+
+// GetImportedBGPRoutes mocks base method.
+func (m *MockAgentBGPPolicyInfoQuerier) GetImportedBGPRoutes(ctx context.Context) ([]bgp.ReceivedRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportedBGPRoutes", ctx)
+	ret0, _ := ret[0].([]bgp.ReceivedRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportedBGPRoutes indicates an expected call of GetImportedBGPRoutes.
+func (mr *MockAgentBGPPolicyInfoQuerierMockRecorder) GetImportedBGPRoutes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportedBGPRoutes", reflect.TypeOf((*MockAgentBGPPolicyInfoQuerier)(nil).GetImportedBGPRoutes), ctx)
+}
@@ -0,0 +1,423 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flowfilter implements the flow filters which can be configured for both the FlowExporter in the Antrea Agent
+// and the FlowLogger in the Flow Aggregator, so that the same filter definition selects the same flows in both places.
+package flowfilter
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	flowpb "antrea.io/antrea/pkg/apis/flow/v1alpha1"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+// Flow is the set of flow attributes that filters can be evaluated against. Components must convert their own flow
+// representation to a Flow before calling Matcher.Matches.
+type Flow struct {
+	SourceIP                   netip.Addr
+	DestinationIP              netip.Addr
+	Protocol                   uint8
+	SourcePort                 uint16
+	DestinationPort            uint16
+	SourcePodNamespace         string
+	SourcePodName              string
+	SourcePodLabels            labels.Labels
+	DestinationPodNamespace    string
+	DestinationPodName         string
+	DestinationPodLabels       labels.Labels
+	DestinationServicePortName string
+	// IngressNetworkPolicyRuleAction and EgressNetworkPolicyRuleAction use the values of the
+	// flowpb.NetworkPolicyRuleAction enum.
+	IngressNetworkPolicyRuleAction uint8
+	EgressNetworkPolicyRuleAction  uint8
+	// Direction is empty when the direction of the flow is unknown.
+	Direction FlowDirection
+	// FlowType uses the values of the flowpb.FlowType enum, and is 0 when the flow type is unknown.
+	FlowType uint8
+	// OnlyLocalPods is set when only the Pods running on the Node on which the flow was observed are known, as in
+	// the Antrea Agent. The conditions on a Pod which is not known are then fulfilled, as the Pod may be running on
+	// another Node: they can only be evaluated by the Flow Aggregator.
+	OnlyLocalPods bool
+}
+
+var protocolNumbers = map[string]uint8{
+	"TCP":    ip.TCPProtocol,
+	"UDP":    ip.UDPProtocol,
+	"SCTP":   ip.SCTPProtocol,
+	"ICMP":   ip.ICMPProtocol,
+	"ICMPV6": ip.ICMPv6Protocol,
+}
+
+type portRange struct {
+	start uint16
+	end   uint16
+}
+
+type serviceRef struct {
+	namespacedName string
+	// portName is empty if the reference matches all ports of the Service.
+	portName string
+}
+
+// filter is the compiled form of a FlowFilter.
+type filter struct {
+	ingressNetworkPolicyRuleActions []uint8
+	egressNetworkPolicyRuleActions  []uint8
+	protocols                       []uint8
+	sourceCIDRs                     []netip.Prefix
+	destinationCIDRs                []netip.Prefix
+	sourcePorts                     []portRange
+	destinationPorts                []portRange
+	sourceNamespaces                []string
+	destinationNamespaces           []string
+	sourcePodSelector               labels.Selector
+	destinationPodSelector          labels.Selector
+	destinationServices             []serviceRef
+	directions                      []FlowDirection
+	flowTypes                       []uint8
+}
+
+// Matcher evaluates a list of FlowFilters. The filters are OR-ed: a flow is matched if it is matched by at least one
+// filter. A Matcher with no filters matches all flows.
+type Matcher struct {
+	filters []filter
+}
+
+// NewMatcher validates and compiles the provided filters.
+func NewMatcher(filters []FlowFilter) (*Matcher, error) {
+	m := &Matcher{
+		filters: make([]filter, 0, len(filters)),
+	}
+	for idx := range filters {
+		f, err := compileFilter(&filters[idx])
+		if err != nil {
+			return nil, fmt.Errorf("invalid flow filter at index %d: %w", idx, err)
+		}
+		m.filters = append(m.filters, *f)
+	}
+	return m, nil
+}
+
+// Empty returns true if the Matcher has no filters, in which case all flows are matched.
+func (m *Matcher) Empty() bool {
+	return m == nil || len(m.filters) == 0
+}
+
+// NeedsPodLabels returns true if at least one filter selects Pods based on their labels. It can be used to avoid
+// retrieving Pod labels when they are not needed.
+func (m *Matcher) NeedsPodLabels() bool {
+	if m == nil {
+		return false
+	}
+	for idx := range m.filters {
+		if m.filters[idx].sourcePodSelector != nil || m.filters[idx].destinationPodSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsFlowType returns true if at least one filter selects flows based on their type.
+func (m *Matcher) NeedsFlowType() bool {
+	if m == nil {
+		return false
+	}
+	for idx := range m.filters {
+		if len(m.filters[idx].flowTypes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the flow is matched by at least one filter, or if there is no filter.
+func (m *Matcher) Matches(flow *Flow) bool {
+	if m.Empty() {
+		return true
+	}
+	for idx := range m.filters {
+		if m.filters[idx].matches(flow) {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleActionToUint8 converts a NetworkPolicyRuleAction to the corresponding flowpb.NetworkPolicyRuleAction value.
+func RuleActionToUint8(a NetworkPolicyRuleAction) (uint8, error) {
+	switch a {
+	case NetworkPolicyRuleActionNone:
+		return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_NO_ACTION), nil
+	case NetworkPolicyRuleActionAllow:
+		return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_ALLOW), nil
+	case NetworkPolicyRuleActionDrop:
+		return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_DROP), nil
+	case NetworkPolicyRuleActionReject:
+		return uint8(flowpb.NetworkPolicyRuleAction_NETWORK_POLICY_RULE_ACTION_REJECT), nil
+	default:
+		return 0, fmt.Errorf("unknown NetworkPolicy rule action %q", a)
+	}
+}
+
+// FlowTypeToUint8 converts a FlowType to the corresponding flowpb.FlowType value.
+func FlowTypeToUint8(t FlowType) (uint8, error) {
+	switch t {
+	case FlowTypeIntraNode:
+		return uint8(flowpb.FlowType_FLOW_TYPE_INTRA_NODE), nil
+	case FlowTypeInterNode:
+		return uint8(flowpb.FlowType_FLOW_TYPE_INTER_NODE), nil
+	case FlowTypeToExternal:
+		return uint8(flowpb.FlowType_FLOW_TYPE_TO_EXTERNAL), nil
+	case FlowTypeFromExternal:
+		return uint8(flowpb.FlowType_FLOW_TYPE_FROM_EXTERNAL), nil
+	default:
+		return 0, fmt.Errorf("unsupported flow type %q", t)
+	}
+}
+
+// DirectionFromPods returns the direction of a flow given whether its source and destination are Pods running on
+// the Node which observed the flow.
+func DirectionFromPods(localSource, localDestination bool) FlowDirection {
+	switch {
+	case localSource && !localDestination:
+		return FlowDirectionEgress
+	case !localSource && localDestination:
+		return FlowDirectionIngress
+	default:
+		return ""
+	}
+}
+
+func compileFilter(in *FlowFilter) (*filter, error) {
+	var err error
+	out := &filter{
+		sourceNamespaces:      in.SourceNamespaces,
+		destinationNamespaces: in.DestinationNamespaces,
+	}
+	convertActions := func(actions []NetworkPolicyRuleAction) ([]uint8, error) {
+		var result []uint8
+		for _, a := range actions {
+			v, err := RuleActionToUint8(a)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, v)
+		}
+		return result, nil
+	}
+	if out.ingressNetworkPolicyRuleActions, err = convertActions(in.IngressNetworkPolicyRuleActions); err != nil {
+		return nil, err
+	}
+	if out.egressNetworkPolicyRuleActions, err = convertActions(in.EgressNetworkPolicyRuleActions); err != nil {
+		return nil, err
+	}
+	for _, p := range in.Protocols {
+		number, ok := protocolNumbers[strings.ToUpper(p)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported protocol %q", p)
+		}
+		out.protocols = append(out.protocols, number)
+	}
+	if out.sourceCIDRs, err = parseCIDRs(in.SourceCIDRs); err != nil {
+		return nil, err
+	}
+	if out.destinationCIDRs, err = parseCIDRs(in.DestinationCIDRs); err != nil {
+		return nil, err
+	}
+	if out.sourcePorts, err = parsePortRanges(in.SourcePorts); err != nil {
+		return nil, err
+	}
+	if out.destinationPorts, err = parsePortRanges(in.DestinationPorts); err != nil {
+		return nil, err
+	}
+	if in.SourcePodSelector != "" {
+		if out.sourcePodSelector, err = labels.Parse(in.SourcePodSelector); err != nil {
+			return nil, fmt.Errorf("invalid sourcePodSelector %q: %w", in.SourcePodSelector, err)
+		}
+	}
+	if in.DestinationPodSelector != "" {
+		if out.destinationPodSelector, err = labels.Parse(in.DestinationPodSelector); err != nil {
+			return nil, fmt.Errorf("invalid destinationPodSelector %q: %w", in.DestinationPodSelector, err)
+		}
+	}
+	for _, s := range in.DestinationServices {
+		namespacedName, portName, _ := strings.Cut(s, ":")
+		namespace, name, ok := strings.Cut(namespacedName, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("invalid Service %q, expected <Namespace>/<Name>[:<PortName>]", s)
+		}
+		out.destinationServices = append(out.destinationServices, serviceRef{namespacedName: namespacedName, portName: portName})
+	}
+	for _, d := range in.Directions {
+		if d != FlowDirectionIngress && d != FlowDirectionEgress {
+			return nil, fmt.Errorf("unsupported flow direction %q", d)
+		}
+		out.directions = append(out.directions, d)
+	}
+	for _, t := range in.FlowTypes {
+		v, err := FlowTypeToUint8(t)
+		if err != nil {
+			return nil, err
+		}
+		out.flowTypes = append(out.flowTypes, v)
+	}
+	return out, nil
+}
+
+func parseCIDRs(cidrs []string) ([]netip.Prefix, error) {
+	var result []netip.Prefix
+	for _, c := range cidrs {
+		if !strings.Contains(c, "/") {
+			addr, err := netip.ParseAddr(c)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IP address %q: %w", c, err)
+			}
+			result = append(result, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", c, err)
+		}
+		result = append(result, prefix.Masked())
+	}
+	return result, nil
+}
+
+func parsePortRanges(ports []string) ([]portRange, error) {
+	parsePort := func(s string) (uint16, error) {
+		port, err := strconv.ParseUint(strings.TrimSpace(s), 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("invalid port %q", s)
+		}
+		return uint16(port), nil
+	}
+	var result []portRange
+	for _, p := range ports {
+		startStr, endStr, isRange := strings.Cut(p, "-")
+		start, err := parsePort(startStr)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = parsePort(endStr); err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid port range %q", p)
+			}
+		}
+		result = append(result, portRange{start: start, end: end})
+	}
+	return result, nil
+}
+
+func matchPrefixes(prefixes []netip.Prefix, addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPortRanges(ranges []portRange, port uint16) bool {
+	for _, r := range ranges {
+		if port >= r.start && port <= r.end {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPodSelector(selector labels.Selector, podName string, podLabels labels.Labels) bool {
+	if podName == "" {
+		return false
+	}
+	if podLabels == nil {
+		podLabels = labels.Set{}
+	}
+	return selector.Matches(podLabels)
+}
+
+func (s *serviceRef) matches(servicePortName string) bool {
+	if s.portName == "" {
+		namespacedName, _, _ := strings.Cut(servicePortName, ":")
+		return namespacedName == s.namespacedName
+	}
+	return servicePortName == s.namespacedName+":"+s.portName
+}
+
+func (f *filter) matches(flow *Flow) bool {
+	if len(f.ingressNetworkPolicyRuleActions) > 0 && !slices.Contains(f.ingressNetworkPolicyRuleActions, flow.IngressNetworkPolicyRuleAction) {
+		return false
+	}
+	if len(f.egressNetworkPolicyRuleActions) > 0 && !slices.Contains(f.egressNetworkPolicyRuleActions, flow.EgressNetworkPolicyRuleAction) {
+		return false
+	}
+	if len(f.protocols) > 0 && !slices.Contains(f.protocols, flow.Protocol) {
+		return false
+	}
+	if len(f.sourceCIDRs) > 0 && !matchPrefixes(f.sourceCIDRs, flow.SourceIP) {
+		return false
+	}
+	if len(f.destinationCIDRs) > 0 && !matchPrefixes(f.destinationCIDRs, flow.DestinationIP) {
+		return false
+	}
+	if len(f.sourcePorts) > 0 && !matchPortRanges(f.sourcePorts, flow.SourcePort) {
+		return false
+	}
+	if len(f.destinationPorts) > 0 && !matchPortRanges(f.destinationPorts, flow.DestinationPort) {
+		return false
+	}
+	if flow.SourcePodName != "" || !flow.OnlyLocalPods {
+		if len(f.sourceNamespaces) > 0 && (flow.SourcePodName == "" || !slices.Contains(f.sourceNamespaces, flow.SourcePodNamespace)) {
+			return false
+		}
+		if f.sourcePodSelector != nil && !matchPodSelector(f.sourcePodSelector, flow.SourcePodName, flow.SourcePodLabels) {
+			return false
+		}
+	}
+	if flow.DestinationPodName != "" || !flow.OnlyLocalPods {
+		if len(f.destinationNamespaces) > 0 && (flow.DestinationPodName == "" || !slices.Contains(f.destinationNamespaces, flow.DestinationPodNamespace)) {
+			return false
+		}
+		if f.destinationPodSelector != nil && !matchPodSelector(f.destinationPodSelector, flow.DestinationPodName, flow.DestinationPodLabels) {
+			return false
+		}
+	}
+	if len(f.destinationServices) > 0 {
+		if flow.DestinationServicePortName == "" || !slices.ContainsFunc(f.destinationServices, func(s serviceRef) bool {
+			return s.matches(flow.DestinationServicePortName)
+		}) {
+			return false
+		}
+	}
+	if len(f.directions) > 0 && !slices.Contains(f.directions, flow.Direction) {
+		return false
+	}
+	if len(f.flowTypes) > 0 && !slices.Contains(f.flowTypes, flow.FlowType) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowfilter
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestNewMatcherErrors(t *testing.T) {
+	testCases := []struct {
+		name        string
+		filter      FlowFilter
+		expectedErr string
+	}{
+		{
+			name:        "invalid rule action",
+			filter:      FlowFilter{IngressNetworkPolicyRuleActions: []NetworkPolicyRuleAction{"Deny"}},
+			expectedErr: "unknown NetworkPolicy rule action \"Deny\"",
+		},
+		{
+			name:        "invalid protocol",
+			filter:      FlowFilter{Protocols: []string{"GRE"}},
+			expectedErr: "unsupported protocol \"GRE\"",
+		},
+		{
+			name:        "invalid CIDR",
+			filter:      FlowFilter{SourceCIDRs: []string{"10.0.0.0/33"}},
+			expectedErr: "invalid CIDR",
+		},
+		{
+			name:        "invalid IP",
+			filter:      FlowFilter{DestinationCIDRs: []string{"10.0.0.300"}},
+			expectedErr: "invalid IP address",
+		},
+		{
+			name:        "invalid port",
+			filter:      FlowFilter{SourcePorts: []string{"70000"}},
+			expectedErr: "invalid port \"70000\"",
+		},
+		{
+			name:        "invalid port range",
+			filter:      FlowFilter{DestinationPorts: []string{"90-80"}},
+			expectedErr: "invalid port range \"90-80\"",
+		},
+		{
+			name:        "invalid selector",
+			filter:      FlowFilter{SourcePodSelector: "app in web"},
+			expectedErr: "invalid sourcePodSelector",
+		},
+		{
+			name:        "invalid Service",
+			filter:      FlowFilter{DestinationServices: []string{"svc"}},
+			expectedErr: "invalid Service \"svc\"",
+		},
+		{
+			name:        "invalid direction",
+			filter:      FlowFilter{Directions: []FlowDirection{"Both"}},
+			expectedErr: "unsupported flow direction \"Both\"",
+		},
+		{
+			name:        "invalid flow type",
+			filter:      FlowFilter{FlowTypes: []FlowType{"External"}},
+			expectedErr: "unsupported flow type \"External\"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewMatcher([]FlowFilter{{}, tc.filter})
+			assert.ErrorContains(t, err, "invalid flow filter at index 1")
+			assert.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestMatcher(t *testing.T) {
+	webToDB := &Flow{
+		SourceIP:                       netip.MustParseAddr("10.10.1.2"),
+		DestinationIP:                  netip.MustParseAddr("10.10.2.3"),
+		Protocol:                       6,
+		SourcePort:                     45678,
+		DestinationPort:                5432,
+		SourcePodNamespace:             "frontend",
+		SourcePodName:                  "web-1",
+		SourcePodLabels:                labels.Set{"app": "web", "tier": "frontend"},
+		DestinationPodNamespace:        "backend",
+		DestinationPodName:             "db-1",
+		DestinationPodLabels:           labels.Set{"app": "db"},
+		DestinationServicePortName:     "backend/db:postgres",
+		IngressNetworkPolicyRuleAction: 1,
+		FlowType:                       2,
+	}
+	podToExternal := &Flow{
+		SourceIP:                      netip.MustParseAddr("10.10.1.2"),
+		DestinationIP:                 netip.MustParseAddr("8.8.8.8"),
+		Protocol:                      17,
+		SourcePort:                    34567,
+		DestinationPort:               53,
+		SourcePodNamespace:            "frontend",
+		SourcePodName:                 "web-1",
+		SourcePodLabels:               labels.Set{"app": "web", "tier": "frontend"},
+		EgressNetworkPolicyRuleAction: 2,
+		Direction:                     FlowDirectionEgress,
+		FlowType:                      3,
+	}
+	ipv6Flow := &Flow{
+		SourceIP:      netip.MustParseAddr("fd00:10:10::2"),
+		DestinationIP: netip.MustParseAddr("fd00:10:20::3"),
+		Protocol:      58,
+		Direction:     FlowDirectionIngress,
+	}
+	testCases := []struct {
+		name     string
+		filters  []FlowFilter
+		expected map[*Flow]bool
+	}{
+		{
+			name:     "no filter",
+			expected: map[*Flow]bool{webToDB: true, podToExternal: true, ipv6Flow: true},
+		},
+		{
+			name:     "empty filter",
+			filters:  []FlowFilter{{}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: true, ipv6Flow: true},
+		},
+		{
+			name:     "protocols",
+			filters:  []FlowFilter{{Protocols: []string{"udp", "ICMPv6"}}},
+			expected: map[*Flow]bool{webToDB: false, podToExternal: true, ipv6Flow: true},
+		},
+		{
+			name:     "CIDRs",
+			filters:  []FlowFilter{{SourceCIDRs: []string{"10.10.0.0/16"}, DestinationCIDRs: []string{"10.10.2.3", "fd00:10:20::/64"}}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: false, ipv6Flow: false},
+		},
+		{
+			name:     "ports",
+			filters:  []FlowFilter{{SourcePorts: []string{"30000-40000"}}, {DestinationPorts: []string{"5432"}}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: true, ipv6Flow: false},
+		},
+		{
+			name:     "namespaces",
+			filters:  []FlowFilter{{SourceNamespaces: []string{"frontend"}, DestinationNamespaces: []string{"backend"}}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: false, ipv6Flow: false},
+		},
+		{
+			name:     "pod selectors",
+			filters:  []FlowFilter{{SourcePodSelector: "app=web,tier in (frontend)", DestinationPodSelector: "app!=cache"}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: false, ipv6Flow: false},
+		},
+		{
+			name:     "empty pod selector",
+			filters:  []FlowFilter{{DestinationPodSelector: "app"}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: false, ipv6Flow: false},
+		},
+		{
+			name:     "Service name",
+			filters:  []FlowFilter{{DestinationServices: []string{"backend/db"}}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: false, ipv6Flow: false},
+		},
+		{
+			name:     "Service port name",
+			filters:  []FlowFilter{{DestinationServices: []string{"backend/db:http"}}},
+			expected: map[*Flow]bool{webToDB: false, podToExternal: false, ipv6Flow: false},
+		},
+		{
+			name: "rule actions",
+			filters: []FlowFilter{
+				{IngressNetworkPolicyRuleActions: []NetworkPolicyRuleAction{NetworkPolicyRuleActionAllow}},
+				{EgressNetworkPolicyRuleActions: []NetworkPolicyRuleAction{NetworkPolicyRuleActionDrop, NetworkPolicyRuleActionReject}},
+			},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: true, ipv6Flow: false},
+		},
+		{
+			name:     "directions",
+			filters:  []FlowFilter{{Directions: []FlowDirection{FlowDirectionIngress}}},
+			expected: map[*Flow]bool{webToDB: false, podToExternal: false, ipv6Flow: true},
+		},
+		{
+			name:     "flow types",
+			filters:  []FlowFilter{{FlowTypes: []FlowType{FlowTypeInterNode, FlowTypeIntraNode}}},
+			expected: map[*Flow]bool{webToDB: true, podToExternal: false, ipv6Flow: false},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatcher(tc.filters)
+			require.NoError(t, err)
+			for flow, expected := range tc.expected {
+				assert.Equal(t, expected, m.Matches(flow), "unexpected result for flow %v", flow)
+			}
+		})
+	}
+}
+
+func TestMatcherOnlyLocalPods(t *testing.T) {
+	m, err := NewMatcher([]FlowFilter{{
+		SourceNamespaces:       []string{"frontend"},
+		DestinationNamespaces:  []string{"backend"},
+		DestinationPodSelector: "app=db",
+	}})
+	require.NoError(t, err)
+	// The flow observed on the Node of the source Pod, and on the Node of the destination Pod.
+	egressFlow := &Flow{
+		SourcePodNamespace: "frontend",
+		SourcePodName:      "web-1",
+		Direction:          FlowDirectionEgress,
+	}
+	ingressFlow := &Flow{
+		DestinationPodNamespace: "backend",
+		DestinationPodName:      "db-1",
+		DestinationPodLabels:    labels.Set{"app": "db"},
+		Direction:               FlowDirectionIngress,
+	}
+	assert.False(t, m.Matches(egressFlow))
+	assert.False(t, m.Matches(ingressFlow))
+
+	// The conditions on the Pods which are not known are left to the Flow Aggregator.
+	egressFlow.OnlyLocalPods = true
+	ingressFlow.OnlyLocalPods = true
+	assert.True(t, m.Matches(egressFlow))
+	assert.True(t, m.Matches(ingressFlow))
+
+	// The conditions on the known Pods are still evaluated.
+	egressFlow.SourcePodNamespace = "default"
+	ingressFlow.DestinationPodLabels = labels.Set{"app": "cache"}
+	assert.False(t, m.Matches(egressFlow))
+	assert.False(t, m.Matches(ingressFlow))
+}
+
+func TestDirectionFromPods(t *testing.T) {
+	assert.Equal(t, FlowDirectionEgress, DirectionFromPods(true, false))
+	assert.Equal(t, FlowDirectionIngress, DirectionFromPods(false, true))
+	assert.Equal(t, FlowDirection(""), DirectionFromPods(true, true))
+	assert.Equal(t, FlowDirection(""), DirectionFromPods(false, false))
+}
+
+func TestNeedsPodLabels(t *testing.T) {
+	m, err := NewMatcher([]FlowFilter{{SourceNamespaces: []string{"default"}}})
+	require.NoError(t, err)
+	assert.False(t, m.NeedsPodLabels())
+	m, err = NewMatcher([]FlowFilter{{SourceNamespaces: []string{"default"}}, {DestinationPodSelector: "app=web"}})
+	require.NoError(t, err)
+	assert.True(t, m.NeedsPodLabels())
+}
+
+func TestNeedsFlowType(t *testing.T) {
+	m, err := NewMatcher([]FlowFilter{{Directions: []FlowDirection{FlowDirectionIngress}}})
+	require.NoError(t, err)
+	assert.False(t, m.NeedsFlowType())
+	m, err = NewMatcher([]FlowFilter{{SourceNamespaces: []string{"default"}}, {FlowTypes: []FlowType{FlowTypeToExternal}}})
+	require.NoError(t, err)
+	assert.True(t, m.NeedsFlowType())
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowfilter
+
+type NetworkPolicyRuleAction string
+
+const (
+	NetworkPolicyRuleActionNone   NetworkPolicyRuleAction = "None"
+	NetworkPolicyRuleActionAllow  NetworkPolicyRuleAction = "Allow"
+	NetworkPolicyRuleActionDrop   NetworkPolicyRuleAction = "Drop"
+	NetworkPolicyRuleActionReject NetworkPolicyRuleAction = "Reject"
+)
+
+// FlowDirection is the direction of a flow, relative to the Node on which the flow was observed.
+type FlowDirection string
+
+const (
+	// FlowDirectionIngress is used for flows whose destination is a Pod running on the Node on which the flow was
+	// observed, while the source is not.
+	FlowDirectionIngress FlowDirection = "Ingress"
+	// FlowDirectionEgress is used for flows whose source is a Pod running on the Node on which the flow was observed,
+	// while the destination is not.
+	FlowDirectionEgress FlowDirection = "Egress"
+)
+
+// FlowType is the type of a flow, based on the location of its source and destination.
+type FlowType string
+
+const (
+	// FlowTypeIntraNode is used for flows between two Pods running on the same Node.
+	FlowTypeIntraNode FlowType = "IntraNode"
+	// FlowTypeInterNode is used for flows between two Pods running on different Nodes.
+	FlowTypeInterNode FlowType = "InterNode"
+	// FlowTypeToExternal is used for flows from a Pod to a destination outside of the cluster network.
+	FlowTypeToExternal FlowType = "ToExternal"
+	// FlowTypeFromExternal is used for flows from a source outside of the cluster network to a Pod.
+	FlowTypeFromExternal FlowType = "FromExternal"
+)
+
+// FlowFilter will match a flow if all individual conditions are fulfilled. For each condition specified as a list, the
+// condition is fulfilled if any of the list items matches the flow. Unset conditions are ignored.
+type FlowFilter struct {
+	// IngressNetworkPolicyRuleActions supports filtering based on the action name for the
+	// ingress policy rule applied to the flow. By default, all actions are considered.
+	IngressNetworkPolicyRuleActions []NetworkPolicyRuleAction `yaml:"ingressNetworkPolicyRuleActions,omitempty"`
+	// EgressNetworkPolicyRuleActions supports filtering based on the action name for the egress
+	// policy rule applied to the flow. By default, all actions are considered.
+	EgressNetworkPolicyRuleActions []NetworkPolicyRuleAction `yaml:"egressNetworkPolicyRuleActions,omitempty"`
+	// Protocols supports filtering based on the IP protocol of the flow. Supported values are "TCP", "UDP", "SCTP",
+	// "ICMP" and "ICMPv6" (case-insensitive).
+	Protocols []string `yaml:"protocols,omitempty"`
+	// SourceCIDRs supports filtering based on the source IP of the flow, e.g., "10.10.0.0/16". A single IP address
+	// can also be provided.
+	SourceCIDRs []string `yaml:"sourceCIDRs,omitempty"`
+	// DestinationCIDRs supports filtering based on the destination IP of the flow. For Service traffic, this is the
+	// IP of the selected Endpoint.
+	DestinationCIDRs []string `yaml:"destinationCIDRs,omitempty"`
+	// SourcePorts supports filtering based on the source port of the flow. Each item can be a single port (e.g.,
+	// "8080") or an inclusive port range (e.g., "30000-32767").
+	SourcePorts []string `yaml:"sourcePorts,omitempty"`
+	// DestinationPorts supports filtering based on the destination port of the flow, using the same format as
+	// SourcePorts.
+	DestinationPorts []string `yaml:"destinationPorts,omitempty"`
+	// SourceNamespaces supports filtering based on the Namespace of the source Pod.
+	SourceNamespaces []string `yaml:"sourceNamespaces,omitempty"`
+	// DestinationNamespaces supports filtering based on the Namespace of the destination Pod.
+	DestinationNamespaces []string `yaml:"destinationNamespaces,omitempty"`
+	// SourcePodSelector supports filtering based on the labels of the source Pod, using the label selector syntax
+	// supported by kubectl (e.g., "app=web,tier in (frontend,backend)"). Flows without a source Pod never match, except
+	// in the Antrea Agent, which only knows about local Pods: flows whose source is not a local Pod always match the
+	// conditions on the source Pod, including SourceNamespaces.
+	SourcePodSelector string `yaml:"sourcePodSelector,omitempty"`
+	// DestinationPodSelector supports filtering based on the labels of the destination Pod, using the same syntax as
+	// SourcePodSelector.
+	DestinationPodSelector string `yaml:"destinationPodSelector,omitempty"`
+	// DestinationServices supports filtering based on the destination Service of the flow. Each item can be
+	// "<Namespace>/<Name>", to match all ports of the Service, or "<Namespace>/<Name>:<PortName>".
+	DestinationServices []string `yaml:"destinationServices,omitempty"`
+	// Directions supports filtering based on the direction of the flow, relative to the Node on which the flow was
+	// observed. Flows for which neither the source nor the destination, or both the source and the destination, are
+	// Pods running on the Node have no direction, and never match when this condition is set.
+	Directions []FlowDirection `yaml:"directions,omitempty"`
+	// FlowTypes supports filtering based on the type of the flow. The flow type is only known once the flow records
+	// reach the Flow Aggregator, so this condition is not supported by the FlowExporter in the Antrea Agent.
+	FlowTypes []FlowType `yaml:"flowTypes,omitempty"`
+}
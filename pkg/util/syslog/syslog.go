@@ -0,0 +1,270 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package syslog implements a client which sends messages to a remote syslog server, using the message format
+// defined in RFC 5424. Messages are sent over UDP (RFC 5426), TCP (RFC 6587) or TLS (RFC 5425).
+package syslog
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+type Protocol string
+
+const (
+	ProtocolUDP Protocol = "udp"
+	ProtocolTCP Protocol = "tcp"
+	ProtocolTLS Protocol = "tls"
+)
+
+const (
+	// severityInformational is the severity of all the messages sent by the Writer.
+	severityInformational = 6
+	// nilValue is used for the header fields and the structured data which are not set.
+	nilValue = "-"
+	// rfc5424TimeFormat is the RFC 3339 format with microsecond precision, which is the maximum precision allowed by
+	// RFC 5424.
+	rfc5424TimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	defaultQueueSize    = 1024
+	defaultDialTimeout  = 5 * time.Second
+	defaultWriteTimeout = 5 * time.Second
+	// errorLogInterval is the minimum interval between two logs of the errors to send messages, so that an
+	// unreachable syslog server does not flood the logs.
+	errorLogInterval = time.Minute
+)
+
+// ErrQueueFull is returned by Writer.Write when the message cannot be queued because the syslog server is too slow or
+// unreachable.
+var ErrQueueFull = errors.New("syslog message queue is full")
+
+var facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// ParseFacility returns the numerical code of a syslog facility given its name, e.g. "local0".
+func ParseFacility(name string) (int, error) {
+	facility, ok := facilities[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown syslog facility: %s", name)
+	}
+	return facility, nil
+}
+
+// Config contains the configuration of a Writer.
+type Config struct {
+	// Protocol is the transport protocol used to send messages to the syslog server.
+	Protocol Protocol
+	// Address is the address of the syslog server, in the "host:port" format.
+	Address string
+	// TLSConfig is the TLS configuration used when Protocol is ProtocolTLS.
+	TLSConfig *tls.Config
+	// Facility is the numerical code of the syslog facility of the messages.
+	Facility int
+	// Hostname, AppName and MsgID are the values of the corresponding header fields of the messages. The nil value
+	// is used for the fields which are empty.
+	Hostname string
+	AppName  string
+	MsgID    string
+	// QueueSize is the maximum number of messages waiting to be sent. Defaults to 1024.
+	QueueSize int
+}
+
+// Writer is an io.Writer which sends each write as a separate message to a syslog server. Messages are queued and
+// sent asynchronously, so that writes never block on the network. A new connection is established when the current
+// one fails.
+type Writer struct {
+	config  Config
+	header  string
+	clock   func() time.Time
+	queue   chan []byte
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+	closeMu sync.Mutex
+	closed  bool
+	conn    net.Conn
+	dialFn  func() (net.Conn, error)
+	// droppedMessages is the number of messages which could not be sent since the last error log.
+	droppedMessages int
+	lastErrorLog    time.Time
+}
+
+// NewWriter creates a Writer and starts sending the queued messages in the background. The connection with the
+// syslog server is established when the first message is sent.
+func NewWriter(config Config) (*Writer, error) {
+	switch config.Protocol {
+	case ProtocolUDP, ProtocolTCP, ProtocolTLS:
+	default:
+		return nil, fmt.Errorf("unsupported syslog protocol: %s", config.Protocol)
+	}
+	if _, _, err := net.SplitHostPort(config.Address); err != nil {
+		return nil, fmt.Errorf("invalid syslog server address %s: %w", config.Address, err)
+	}
+	if config.Facility < 0 || config.Facility > 23 {
+		return nil, fmt.Errorf("invalid syslog facility: %d", config.Facility)
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = defaultQueueSize
+	}
+	w := &Writer{
+		config: config,
+		header: buildHeaderSuffix(config),
+		clock:  time.Now,
+		queue:  make(chan []byte, config.QueueSize),
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	w.dialFn = w.dial
+	go w.run()
+	return w, nil
+}
+
+// buildHeaderSuffix returns the header fields following the timestamp, which are the same for all messages.
+func buildHeaderSuffix(config Config) string {
+	field := func(value string) string {
+		if value == "" {
+			return nilValue
+		}
+		return value
+	}
+	return fmt.Sprintf("%s %s %s %s %s",
+		field(config.Hostname), field(config.AppName), strconv.Itoa(os.Getpid()), field(config.MsgID), nilValue)
+}
+
+// formatMessage returns the RFC 5424 representation of a message.
+func (w *Writer) formatMessage(msg []byte) []byte {
+	msg = bytes.TrimRight(msg, "\n")
+	priority := w.config.Facility*8 + severityInformational
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s ", priority, w.clock().Format(rfc5424TimeFormat), w.header)
+	buf.Write(msg)
+	return buf.Bytes()
+}
+
+// Write queues a message to be sent to the syslog server. It returns ErrQueueFull if the message is dropped.
+func (w *Writer) Write(p []byte) (int, error) {
+	// The caller may reuse p after Write returns.
+	msg := w.formatMessage(p)
+	select {
+	case w.queue <- msg:
+		return len(p), nil
+	default:
+		return 0, ErrQueueFull
+	}
+}
+
+// Close stops sending messages and closes the connection with the syslog server. The queued messages which have not
+// been sent yet are dropped.
+func (w *Writer) Close() error {
+	w.closeMu.Lock()
+	defer w.closeMu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	close(w.stopCh)
+	<-w.doneCh
+	return nil
+}
+
+func (w *Writer) run() {
+	defer close(w.doneCh)
+	defer func() {
+		if w.conn != nil {
+			w.conn.Close()
+		}
+	}()
+	for {
+		select {
+		case <-w.stopCh:
+			return
+		case msg := <-w.queue:
+			if err := w.send(msg); err != nil {
+				w.droppedMessages++
+				if now := time.Now(); now.Sub(w.lastErrorLog) >= errorLogInterval {
+					klog.ErrorS(err, "Failed to send messages to syslog server", "address", w.config.Address, "droppedMessages", w.droppedMessages)
+					w.droppedMessages = 0
+					w.lastErrorLog = now
+				}
+			}
+		}
+	}
+}
+
+// send sends a message to the syslog server, establishing a new connection if needed. When writing to an existing
+// connection fails, the message is sent again once with a new connection.
+func (w *Writer) send(msg []byte) error {
+	frame := msg
+	if w.config.Protocol != ProtocolUDP {
+		// Octet-counting framing, as specified in RFC 6587 and RFC 5425.
+		frame = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if w.conn == nil {
+			if w.conn, err = w.dialFn(); err != nil {
+				w.conn = nil
+				return fmt.Errorf("failed to connect to syslog server: %w", err)
+			}
+		}
+		w.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout))
+		if _, err = w.conn.Write(frame); err == nil {
+			return nil
+		}
+		w.conn.Close()
+		w.conn = nil
+	}
+	return err
+}
+
+func (w *Writer) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: defaultDialTimeout}
+	switch w.config.Protocol {
+	case ProtocolUDP:
+		return dialer.Dial("udp", w.config.Address)
+	case ProtocolTCP:
+		return dialer.Dial("tcp", w.config.Address)
+	default:
+		return tls.DialWithDialer(dialer, "tcp", w.config.Address, w.config.TLSConfig)
+	}
+}
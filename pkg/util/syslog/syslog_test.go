@@ -0,0 +1,248 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslog
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/util/tlstest"
+)
+
+var testTime = time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+func TestParseFacility(t *testing.T) {
+	facility, err := ParseFacility("local0")
+	require.NoError(t, err)
+	assert.Equal(t, 16, facility)
+	facility, err = ParseFacility("daemon")
+	require.NoError(t, err)
+	assert.Equal(t, 3, facility)
+	_, err = ParseFacility("local8")
+	assert.EqualError(t, err, "unknown syslog facility: local8")
+}
+
+func TestNewWriterInvalidConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      Config
+		expectedErr string
+	}{
+		{
+			name:        "invalid protocol",
+			config:      Config{Protocol: "sctp", Address: "127.0.0.1:514"},
+			expectedErr: "unsupported syslog protocol: sctp",
+		},
+		{
+			name:        "invalid address",
+			config:      Config{Protocol: ProtocolUDP, Address: "127.0.0.1"},
+			expectedErr: "invalid syslog server address 127.0.0.1: address 127.0.0.1: missing port in address",
+		},
+		{
+			name:        "invalid facility",
+			config:      Config{Protocol: ProtocolUDP, Address: "127.0.0.1:514", Facility: 24},
+			expectedErr: "invalid syslog facility: 24",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWriter(tt.config)
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestFormatMessage(t *testing.T) {
+	w := &Writer{
+		config: Config{Facility: 16},
+		header: buildHeaderSuffix(Config{Hostname: "node1", AppName: "antrea-agent", MsgID: "NetworkPolicy"}),
+		clock:  func() time.Time { return testTime },
+	}
+	expected := fmt.Sprintf("<134>1 2026-01-02T03:04:05.123456Z node1 antrea-agent %d NetworkPolicy - {\"key\":\"value\"}", os.Getpid())
+	assert.Equal(t, expected, string(w.formatMessage([]byte("{\"key\":\"value\"}\n"))))
+
+	w.header = buildHeaderSuffix(Config{})
+	expected = fmt.Sprintf("<134>1 2026-01-02T03:04:05.123456Z - - %d - - msg", os.Getpid())
+	assert.Equal(t, expected, string(w.formatMessage([]byte("msg"))))
+}
+
+func newTestWriter(t *testing.T, config Config) *Writer {
+	w, err := NewWriter(config)
+	require.NoError(t, err)
+	w.clock = func() time.Time { return testTime }
+	t.Cleanup(func() { w.Close() })
+	return w
+}
+
+func expectedMessage(msg string) string {
+	return fmt.Sprintf("<134>1 2026-01-02T03:04:05.123456Z node1 - %d - - %s", os.Getpid(), msg)
+}
+
+func TestWriterUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	w := newTestWriter(t, Config{Protocol: ProtocolUDP, Address: conn.LocalAddr().String(), Facility: 16, Hostname: "node1"})
+	for _, msg := range []string{"msg1", "msg2"} {
+		_, err := w.Write([]byte(msg + "\n"))
+		require.NoError(t, err)
+	}
+
+	buf := make([]byte, 1024)
+	for _, msg := range []string{"msg1", "msg2"} {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Equal(t, expectedMessage(msg), string(buf[:n]))
+	}
+}
+
+// readFrame reads a message framed with octet counting.
+func readFrame(r *bufio.Reader) (string, error) {
+	length, err := r.ReadString(' ')
+	if err != nil {
+		return "", err
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+	if err != nil {
+		return "", err
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return "", err
+	}
+	return string(msg), nil
+}
+
+func testWriterStream(t *testing.T, listener net.Listener, config Config) {
+	received := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					msg, err := readFrame(r)
+					if err != nil {
+						return
+					}
+					received <- msg
+				}
+			}()
+		}
+	}()
+
+	w := newTestWriter(t, config)
+	for _, msg := range []string{"msg1", "msg2"} {
+		_, err := w.Write([]byte(msg))
+		require.NoError(t, err)
+	}
+	for _, msg := range []string{"msg1", "msg2"} {
+		select {
+		case actual := <-received:
+			assert.Equal(t, expectedMessage(msg), actual)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Did not receive message %s in time", msg)
+		}
+	}
+}
+
+func TestWriterTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	testWriterStream(t, listener, Config{Protocol: ProtocolTCP, Address: listener.Addr().String(), Facility: 16, Hostname: "node1"})
+}
+
+func TestWriterTLS(t *testing.T) {
+	certPEM, keyPEM, err := tlstest.GenerateCert([]string{"127.0.0.1"}, time.Now(), time.Hour, true, false, 2048, "", false)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	require.NoError(t, err)
+	defer listener.Close()
+
+	caPool := x509.NewCertPool()
+	require.True(t, caPool.AppendCertsFromPEM(certPEM))
+	testWriterStream(t, listener, Config{
+		Protocol:  ProtocolTLS,
+		Address:   listener.Addr().String(),
+		TLSConfig: &tls.Config{RootCAs: caPool, MinVersion: tls.VersionTLS12},
+		Facility:  16,
+		Hostname:  "node1",
+	})
+}
+
+func TestWriterReconnect(t *testing.T) {
+	client1, server1 := net.Pipe()
+	client2, server2 := net.Pipe()
+	conns := []net.Conn{client1, client2}
+	w := &Writer{
+		config: Config{Protocol: ProtocolTCP},
+		dialFn: func() (net.Conn, error) {
+			if len(conns) == 0 {
+				return nil, fmt.Errorf("connection refused")
+			}
+			conn := conns[0]
+			conns = conns[1:]
+			return conn, nil
+		},
+	}
+
+	go io.Copy(io.Discard, server1)
+	require.NoError(t, w.send([]byte("msg1")))
+	// The first connection is closed by the server, the message should be sent with a new connection.
+	server1.Close()
+	received := make(chan string, 1)
+	go func() {
+		msg, _ := readFrame(bufio.NewReader(server2))
+		received <- msg
+	}()
+	require.NoError(t, w.send([]byte("msg2")))
+	assert.Equal(t, "msg2", <-received)
+
+	server2.Close()
+	assert.EqualError(t, w.send([]byte("msg3")), "failed to connect to syslog server: connection refused")
+}
+
+func TestWriterQueueFull(t *testing.T) {
+	w := &Writer{
+		header: buildHeaderSuffix(Config{}),
+		clock:  func() time.Time { return testTime },
+		queue:  make(chan []byte, 1),
+	}
+	_, err := w.Write([]byte("msg1"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("msg2"))
+	assert.ErrorIs(t, err, ErrQueueFull)
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+	"k8s.io/klog/v2"
+)
+
+type fileUploader struct {
+	fs afero.Fs
+}
+
+// NewFileUploader returns an Uploader for "file://" URLs, which writes files to a local directory, typically a volume
+// (e.g., a PersistentVolumeClaim) mounted into the container. Credentials are ignored.
+func NewFileUploader() Uploader {
+	return &fileUploader{fs: afero.NewOsFs()}
+}
+
+func (u *fileUploader) Upload(ctx context.Context, serverURL string, fileName string, _ *Credentials, file io.Reader) error {
+	if err := validateFileName(fileName); err != nil {
+		return err
+	}
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		return fmt.Errorf("invalid file server URL %q: %w", serverURL, err)
+	}
+	if parsedURL.Host != "" && parsedURL.Host != "localhost" {
+		return fmt.Errorf("file URL must refer to a local path, got host %q", parsedURL.Host)
+	}
+	dir := filepath.Clean(parsedURL.Path)
+	if !filepath.IsAbs(dir) {
+		return fmt.Errorf("file URL must have an absolute path, got %q", parsedURL.Path)
+	}
+	if err := u.fs.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	// Write to a temporary file first, so that a partially written file is never visible at the target path.
+	tmpFile, err := afero.TempFile(u.fs, dir, "."+fileName+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer u.fs.Remove(tmpFile.Name())
+	written, err := io.Copy(tmpFile, file)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error encountered after copying %d bytes to temporary file: %w", written, err)
+	}
+	targetPath := filepath.Join(dir, fileName)
+	if err := u.fs.Rename(tmpFile.Name(), targetPath); err != nil {
+		return fmt.Errorf("failed to rename temporary file to %s: %w", targetPath, err)
+	}
+	klog.InfoS("Successfully wrote file", "path", targetPath)
+	return nil
+}
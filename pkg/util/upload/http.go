@@ -0,0 +1,95 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/auth"
+)
+
+const (
+	// apiKeyHeader is the HTTP header used to send the APIKey credential.
+	apiKeyHeader = "X-API-Key"
+)
+
+type httpUploader struct {
+	client *http.Client
+}
+
+// NewHTTPUploader returns an Uploader for "http://" and "https://" URLs. The file is uploaded with a PUT request to
+// the server URL with the file name appended to its path. BasicAuthentication, BearerToken and APIKey credentials are
+// supported; the APIKey is sent in the X-API-Key header.
+func NewHTTPUploader() Uploader {
+	return &httpUploader{client: &http.Client{}}
+}
+
+func (u *httpUploader) Upload(ctx context.Context, serverURL string, fileName string, credentials *Credentials, file io.Reader) error {
+	if err := validateFileName(fileName); err != nil {
+		return err
+	}
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		return fmt.Errorf("invalid file server URL %q: %w", serverURL, err)
+	}
+	targetURL := parsedURL.JoinPath(fileName)
+	// An io.Seeker is used to compute the Content-Length, which is required by some servers (e.g., for S3 pre-signed
+	// URLs). Otherwise the request body is sent with chunked encoding.
+	contentLength := int64(-1)
+	if seeker, ok := file.(io.Seeker); ok {
+		if size, err := seeker.Seek(0, io.SeekEnd); err == nil {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return fmt.Errorf("failed to reset file offset: %w", err)
+			}
+			contentLength = size
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, targetURL.String(), io.NopCloser(file))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	if contentLength >= 0 {
+		req.ContentLength = contentLength
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if credentials != nil && credentials.Auth != nil {
+		switch a := credentials.Auth; {
+		case a.BasicAuthentication != nil:
+			req.SetBasicAuth(a.BasicAuthentication.Username, a.BasicAuthentication.Password)
+		case a.AuthType == auth.BearerTokenType || a.BearerToken != "":
+			req.Header.Set("Authorization", "Bearer "+a.BearerToken)
+		case a.AuthType == auth.APIKeyType || a.APIKey != "":
+			req.Header.Set(apiKeyHeader, a.APIKey)
+		}
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error when uploading file to the file server: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("file server returned unexpected status %q", resp.Status)
+	}
+	klog.InfoS("Successfully uploaded file", "url", targetURL.Redacted())
+	return nil
+}
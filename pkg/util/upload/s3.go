@@ -0,0 +1,131 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultS3Region = "us-west-2"
+)
+
+// s3Location is the result of parsing a "s3://" URL.
+type s3Location struct {
+	bucket string
+	prefix string
+	region string
+	// endpoint is used for S3-compatible object stores (e.g., MinIO). When set, path-style addressing is used.
+	endpoint string
+}
+
+func parseS3URL(serverURL string) (*s3Location, error) {
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 URL %q: %w", serverURL, err)
+	}
+	if parsedURL.Scheme != string(SchemeS3) {
+		return nil, fmt.Errorf("not s3 protocol")
+	}
+	if parsedURL.Host == "" {
+		return nil, fmt.Errorf("missing bucket name in S3 URL %q", serverURL)
+	}
+	query := parsedURL.Query()
+	loc := &s3Location{
+		bucket:   parsedURL.Host,
+		prefix:   strings.Trim(parsedURL.Path, "/"),
+		region:   query.Get("region"),
+		endpoint: query.Get("endpoint"),
+	}
+	if loc.region == "" {
+		loc.region = defaultS3Region
+	}
+	return loc, nil
+}
+
+type s3Uploader struct {
+	// uploadFn can be overridden in tests.
+	uploadFn func(ctx context.Context, client *s3.Client, input *s3.PutObjectInput) error
+}
+
+// NewS3Uploader returns an Uploader for URLs with the form "s3://<bucket>[/<prefix>][?region=<region>&endpoint=<URL>]".
+// When BasicAuthentication credentials are provided, the username and password are used as the access key ID and the
+// secret access key respectively. Otherwise, the default AWS credential chain is used.
+func NewS3Uploader() Uploader {
+	return &s3Uploader{
+		uploadFn: func(ctx context.Context, client *s3.Client, input *s3.PutObjectInput) error {
+			_, err := s3manager.NewUploader(client).Upload(ctx, input)
+			return err
+		},
+	}
+}
+
+func (u *s3Uploader) newClient(ctx context.Context, loc *s3Location, creds *Credentials) (*s3.Client, error) {
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(loc.region)}
+	if creds != nil && creds.Auth != nil && creds.Auth.BasicAuthentication != nil {
+		opts = append(opts, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			creds.Auth.BasicAuthentication.Username, creds.Auth.BasicAuthentication.Password, "",
+		)))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error when loading AWS config: %w", err)
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if loc.endpoint != "" {
+			o.BaseEndpoint = aws.String(loc.endpoint)
+			o.UsePathStyle = true
+		}
+	}), nil
+}
+
+func (u *s3Uploader) Upload(ctx context.Context, serverURL string, fileName string, credentials *Credentials, file io.Reader) error {
+	if err := validateFileName(fileName); err != nil {
+		return err
+	}
+	loc, err := parseS3URL(serverURL)
+	if err != nil {
+		return err
+	}
+	client, err := u.newClient(ctx, loc, credentials)
+	if err != nil {
+		return err
+	}
+	key := fileName
+	if loc.prefix != "" {
+		key = path.Join(loc.prefix, fileName)
+	}
+	if err := u.uploadFn(ctx, client, &s3.PutObjectInput{
+		Bucket: aws.String(loc.bucket),
+		Key:    aws.String(key),
+		Body:   file,
+	}); err != nil {
+		return fmt.Errorf("error when uploading file to S3: %w", err)
+	}
+	klog.InfoS("Successfully uploaded file to S3", "bucket", loc.bucket, "key", key)
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"antrea.io/antrea/pkg/util/sftp"
+)
+
+type sftpUploader struct {
+	uploader sftp.Uploader
+}
+
+// NewSFTPUploader returns an Uploader for "sftp://" URLs, which uses BasicAuthentication credentials and delegates
+// the upload to the provided sftp.Uploader.
+func NewSFTPUploader(uploader sftp.Uploader) Uploader {
+	return &sftpUploader{uploader: uploader}
+}
+
+func (u *sftpUploader) Upload(ctx context.Context, serverURL string, fileName string, credentials *Credentials, file io.Reader) error {
+	if credentials == nil || credentials.Auth == nil || credentials.Auth.BasicAuthentication == nil {
+		return fmt.Errorf("failed to get basic authentication info for the file server")
+	}
+	cfg, err := sftp.GetSSHClientConfig(
+		credentials.Auth.BasicAuthentication.Username,
+		credentials.Auth.BasicAuthentication.Password,
+		credentials.HostPublicKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to generate SSH client config: %w", err)
+	}
+	return u.uploader.Upload(serverURL, fileName, cfg, file)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upload provides a common interface to upload diagnostic files (e.g., support bundles and packet captures) to
+// different kinds of file servers. The implementation is selected based on the scheme of the file server URL.
+package upload
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+
+	"antrea.io/antrea/pkg/util/auth"
+	"antrea.io/antrea/pkg/util/sftp"
+)
+
+// Scheme is the scheme of a file server URL.
+type Scheme string
+
+const (
+	SchemeSFTP  Scheme = "sftp"
+	SchemeS3    Scheme = "s3"
+	SchemeHTTP  Scheme = "http"
+	SchemeHTTPS Scheme = "https"
+	SchemeFile  Scheme = "file"
+)
+
+// Credentials holds the information used to authenticate with a file server. Which fields are used depends on the
+// Uploader implementation.
+type Credentials struct {
+	// Auth is typically read from a Secret with auth.GetAuthConfigurationFromSecret. It can be nil if the file server
+	// does not require authentication.
+	Auth *auth.AuthConfiguration
+	// HostPublicKey specifies the only host public key that will be accepted when connecting to a SFTP server.
+	HostPublicKey []byte
+}
+
+// Uploader uploads files to a file server.
+type Uploader interface {
+	// Upload uploads the content read from file to the file server, as a file named fileName under the location
+	// specified by serverURL.
+	Upload(ctx context.Context, serverURL string, fileName string, credentials *Credentials, file io.Reader) error
+}
+
+// NewUploaders returns the default Uploader implementation for each supported scheme.
+func NewUploaders() map[Scheme]Uploader {
+	httpUploader := NewHTTPUploader()
+	return map[Scheme]Uploader{
+		SchemeSFTP:  NewSFTPUploader(sftp.NewUploader()),
+		SchemeS3:    NewS3Uploader(),
+		SchemeHTTP:  httpUploader,
+		SchemeHTTPS: httpUploader,
+		SchemeFile:  NewFileUploader(),
+	}
+}
+
+// ParseScheme returns the scheme of a file server URL. For backwards-compatibility, SFTP is assumed when the URL has
+// no scheme, e.g., "10.0.0.1:22/path".
+func ParseScheme(serverURL string) (Scheme, error) {
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		parsedURL, err = url.Parse("sftp://" + serverURL)
+		if err != nil {
+			return "", fmt.Errorf("invalid file server URL %q: %w", serverURL, err)
+		}
+	}
+	if parsedURL.Scheme == "" {
+		return SchemeSFTP, nil
+	}
+	return Scheme(parsedURL.Scheme), nil
+}
+
+// validateFileName makes sure that fileName cannot be used to write outside of the target location.
+func validateFileName(fileName string) error {
+	if fileName == "" || fileName != path.Base(fileName) || fileName == "." || fileName == ".." {
+		return fmt.Errorf("invalid file name %q", fileName)
+	}
+	return nil
+}
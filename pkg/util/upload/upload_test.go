@@ -0,0 +1,243 @@
+// Copyright 2026 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"antrea.io/antrea/pkg/util/auth"
+)
+
+func TestParseScheme(t *testing.T) {
+	cases := []struct {
+		url            string
+		expectedScheme Scheme
+	}{
+		{url: "sftp://127.0.0.1:22/path", expectedScheme: SchemeSFTP},
+		{url: "127.0.0.1:22/path", expectedScheme: SchemeSFTP},
+		{url: "s3://bucket/prefix?region=us-east-1", expectedScheme: SchemeS3},
+		{url: "http://127.0.0.1:8080/upload", expectedScheme: SchemeHTTP},
+		{url: "https://fileserver.example.com/upload", expectedScheme: SchemeHTTPS},
+		{url: "file:///var/log/antrea/captures", expectedScheme: SchemeFile},
+		{url: "ftp://127.0.0.1/path", expectedScheme: Scheme("ftp")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.url, func(t *testing.T) {
+			scheme, err := ParseScheme(tc.url)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedScheme, scheme)
+		})
+	}
+}
+
+type fakeSFTPUploader struct {
+	config   *ssh.ClientConfig
+	fileName string
+}
+
+func (f *fakeSFTPUploader) Upload(url string, fileName string, config *ssh.ClientConfig, outputFile io.Reader) error {
+	f.config = config
+	f.fileName = fileName
+	return nil
+}
+
+func TestSFTPUploader(t *testing.T) {
+	fake := &fakeSFTPUploader{}
+	uploader := NewSFTPUploader(fake)
+	err := uploader.Upload(context.Background(), "sftp://127.0.0.1:22/path", "file.tar.gz", &Credentials{}, strings.NewReader("data"))
+	assert.ErrorContains(t, err, "failed to get basic authentication info")
+
+	creds := &Credentials{Auth: &auth.AuthConfiguration{BasicAuthentication: &auth.BasicAuthentication{Username: "user", Password: "pass"}}}
+	require.NoError(t, uploader.Upload(context.Background(), "sftp://127.0.0.1:22/path", "file.tar.gz", creds, strings.NewReader("data")))
+	assert.Equal(t, "user", fake.config.User)
+	assert.Equal(t, "file.tar.gz", fake.fileName)
+}
+
+func TestHTTPUploader(t *testing.T) {
+	cases := []struct {
+		name            string
+		auth            *auth.AuthConfiguration
+		fileName        string
+		statusCode      int
+		checkRequest    func(t *testing.T, r *http.Request)
+		expectedErr     string
+		expectedContent string
+	}{
+		{
+			name:       "basic auth",
+			auth:       &auth.AuthConfiguration{BasicAuthentication: &auth.BasicAuthentication{Username: "user", Password: "pass"}},
+			fileName:   "bundle.tar.gz",
+			statusCode: http.StatusCreated,
+			checkRequest: func(t *testing.T, r *http.Request) {
+				username, password, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "user", username)
+				assert.Equal(t, "pass", password)
+			},
+			expectedContent: "bundle",
+		},
+		{
+			name:       "bearer token",
+			auth:       &auth.AuthConfiguration{AuthType: auth.BearerTokenType, BearerToken: "token"},
+			fileName:   "bundle.tar.gz",
+			statusCode: http.StatusOK,
+			checkRequest: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			},
+			expectedContent: "bundle",
+		},
+		{
+			name:       "api key",
+			auth:       &auth.AuthConfiguration{AuthType: auth.APIKeyType, APIKey: "key"},
+			fileName:   "bundle.tar.gz",
+			statusCode: http.StatusOK,
+			checkRequest: func(t *testing.T, r *http.Request) {
+				assert.Equal(t, "key", r.Header.Get(apiKeyHeader))
+			},
+			expectedContent: "bundle",
+		},
+		{
+			name:        "server error",
+			fileName:    "bundle.tar.gz",
+			statusCode:  http.StatusForbidden,
+			expectedErr: "file server returned unexpected status \"403 Forbidden\"",
+		},
+		{
+			name:        "invalid file name",
+			fileName:    "../bundle.tar.gz",
+			expectedErr: "invalid file name",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var received []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/upload/"+tc.fileName, r.URL.Path)
+				if tc.checkRequest != nil {
+					tc.checkRequest(t, r)
+				}
+				received, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			uploader := NewHTTPUploader()
+			err := uploader.Upload(context.Background(), server.URL+"/upload", tc.fileName, &Credentials{Auth: tc.auth}, bytes.NewReader([]byte("bundle")))
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContent, string(received))
+		})
+	}
+}
+
+func TestFileUploader(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	uploader := &fileUploader{fs: fs}
+
+	require.NoError(t, uploader.Upload(context.Background(), "file:///data/captures", "pc.pcapng", nil, strings.NewReader("packets")))
+	content, err := afero.ReadFile(fs, "/data/captures/pc.pcapng")
+	require.NoError(t, err)
+	assert.Equal(t, "packets", string(content))
+	files, err := afero.ReadDir(fs, "/data/captures")
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "temporary file should have been removed")
+
+	err = uploader.Upload(context.Background(), "file://remote-host/data", "pc.pcapng", nil, strings.NewReader("packets"))
+	assert.ErrorContains(t, err, "file URL must refer to a local path")
+	err = uploader.Upload(context.Background(), "file:///data/captures", "../pc.pcapng", nil, strings.NewReader("packets"))
+	assert.ErrorContains(t, err, "invalid file name")
+}
+
+func TestParseS3URL(t *testing.T) {
+	cases := []struct {
+		url         string
+		expected    *s3Location
+		expectedErr string
+	}{
+		{
+			url:      "s3://bucket",
+			expected: &s3Location{bucket: "bucket", region: defaultS3Region},
+		},
+		{
+			url:      "s3://bucket/a/b/?region=us-east-1&endpoint=http://minio:9000",
+			expected: &s3Location{bucket: "bucket", prefix: "a/b", region: "us-east-1", endpoint: "http://minio:9000"},
+		},
+		{
+			url:         "s3:///prefix",
+			expectedErr: "missing bucket name",
+		},
+		{
+			url:         "https://bucket/prefix",
+			expectedErr: "not s3 protocol",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.url, func(t *testing.T) {
+			loc, err := parseS3URL(tc.url)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, loc)
+		})
+	}
+}
+
+func TestS3Uploader(t *testing.T) {
+	var input *s3.PutObjectInput
+	var client *s3.Client
+	uploader := &s3Uploader{
+		uploadFn: func(ctx context.Context, c *s3.Client, i *s3.PutObjectInput) error {
+			client = c
+			input = i
+			return nil
+		},
+	}
+	creds := &Credentials{Auth: &auth.AuthConfiguration{BasicAuthentication: &auth.BasicAuthentication{Username: "access-key", Password: "secret-key"}}}
+	require.NoError(t, uploader.Upload(context.Background(), "s3://bucket/captures?endpoint=http://minio:9000", "pc.pcapng", creds, strings.NewReader("packets")))
+	assert.Equal(t, "bucket", *input.Bucket)
+	assert.Equal(t, "captures/pc.pcapng", *input.Key)
+	options := client.Options()
+	assert.True(t, options.UsePathStyle)
+	assert.Equal(t, "http://minio:9000", *options.BaseEndpoint)
+	value, err := options.Credentials.Retrieve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "access-key", value.AccessKeyID)
+	assert.Equal(t, "secret-key", value.SecretAccessKey)
+
+	uploader.uploadFn = func(ctx context.Context, c *s3.Client, i *s3.PutObjectInput) error {
+		return fmt.Errorf("access denied")
+	}
+	err = uploader.Upload(context.Background(), "s3://bucket", "pc.pcapng", creds, strings.NewReader("packets"))
+	assert.EqualError(t, err, "error when uploading file to S3: access denied")
+}
@@ -779,7 +779,7 @@ func TestProxyServiceFlowsAntreaPoilcyEnabled(t *testing.T) {
 func installServiceFlows(t *testing.T, svc *types.ServiceConfig, endpointList []k8sproxy.Endpoint) {
 	err := c.InstallEndpointFlows(svc.Protocol, endpointList)
 	assert.NoError(t, err, "no error should return when installing flows for Endpoints")
-	err = c.InstallServiceGroup(svc.ClusterGroupID, svc.AffinityTimeout != 0, endpointList)
+	err = c.InstallServiceGroup(svc.ClusterGroupID, svc.AffinityTimeout != 0, false, endpointList, nil)
 	assert.NoError(t, err, "no error should return when installing groups for Service")
 	err = c.InstallServiceFlows(svc)
 	assert.NoError(t, err, "no error should return when installing flows for Service")